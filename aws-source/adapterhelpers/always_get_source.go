@@ -192,6 +192,8 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 		return nil, err
 	}
 
+	enrichItem(ctx, item)
+
 	s.cache.StoreItem(item, s.cacheDuration(), ck)
 	return item, nil
 }
@@ -278,6 +280,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 					stream.SendError(WrapAWSError(err))
 				}
 				if item != nil {
+					enrichItem(ctx, item)
 					s.cache.StoreItem(item, s.cacheDuration(), ck)
 					stream.SendItem(item)
 				}
@@ -363,6 +366,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 		}
 
 		if item != nil {
+			enrichItem(ctx, item)
 			s.cache.StoreItem(item, s.cacheDuration(), ck)
 			stream.SendItem(item)
 		}
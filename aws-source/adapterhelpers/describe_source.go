@@ -262,6 +262,8 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) Get(ctx cont
 		return nil, qErr
 	}
 
+	enrichItem(ctx, items[0])
+
 	s.cache.StoreItem(items[0], s.cacheDuration(), ck)
 	return items[0], nil
 }
@@ -439,6 +441,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) describe(ctx
 			}
 
 			for _, item := range items {
+				enrichItem(ctx, item)
 				s.cache.StoreItem(item, s.cacheDuration(), ck)
 				stream.SendItem(item)
 			}
@@ -465,6 +468,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) describe(ctx
 		}
 
 		for _, item := range items {
+			enrichItem(ctx, item)
 			s.cache.StoreItem(item, s.cacheDuration(), ck)
 			stream.SendItem(item)
 		}
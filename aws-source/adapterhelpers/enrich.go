@@ -0,0 +1,53 @@
+package adapterhelpers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// ItemEnricher adds information to items after they have been mapped by an
+// adapter, such as the estimated cost of the resource. Enrichers are called
+// for every item that an adapter returns so they should be fast, or cache
+// anything that is slow to look up
+type ItemEnricher interface {
+	Enrich(ctx context.Context, item *sdp.Item)
+}
+
+var (
+	enrichersMu sync.RWMutex
+	enrichers   []ItemEnricher
+)
+
+// AddItemEnricher registers an enricher that is called for every item
+// returned by the adapters in this package. Enrichers are called in the order
+// that they are added, after health has been set
+func AddItemEnricher(enricher ItemEnricher) {
+	enrichersMu.Lock()
+	defer enrichersMu.Unlock()
+
+	enrichers = append(enrichers, enricher)
+}
+
+// ResetItemEnrichers removes all registered enrichers
+func ResetItemEnrichers() {
+	enrichersMu.Lock()
+	defer enrichersMu.Unlock()
+
+	enrichers = nil
+}
+
+// Adds health and the information from the registered enrichers to an item.
+// This must be called after the item's tags have been set, since enrichers
+// such as the cost enricher use them
+func enrichItem(ctx context.Context, item *sdp.Item) {
+	EnrichHealth(item)
+
+	enrichersMu.RLock()
+	defer enrichersMu.RUnlock()
+
+	for _, enricher := range enrichers {
+		enricher.Enrich(ctx, item)
+	}
+}
@@ -0,0 +1,56 @@
+package adapterhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testEnricher struct {
+	name string
+}
+
+func (e testEnricher) Enrich(ctx context.Context, item *sdp.Item) {
+	_ = item.GetAttributes().Set(e.name, true)
+}
+
+// tagsEnricher records the tags of the last item that it enriched
+type tagsEnricher struct {
+	tags map[string]string
+}
+
+func (e *tagsEnricher) Enrich(ctx context.Context, item *sdp.Item) {
+	e.tags = item.GetTags()
+}
+
+func TestEnrichItem(t *testing.T) {
+	t.Cleanup(ResetItemEnrichers)
+
+	AddItemEnricher(testEnricher{name: "first"})
+	AddItemEnricher(testEnricher{name: "second"})
+
+	attrs, err := sdp.ToAttributes(map[string]interface{}{
+		"Status": "available",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := &sdp.Item{
+		Type:       "test",
+		Attributes: attrs,
+	}
+
+	enrichItem(context.Background(), item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be set, got %v", item.GetHealth())
+	}
+
+	for _, name := range []string{"first", "second"} {
+		if _, err := item.GetAttributes().Get(name); err != nil {
+			t.Errorf("expected enricher %v to be called", name)
+		}
+	}
+}
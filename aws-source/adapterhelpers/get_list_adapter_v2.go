@@ -54,10 +54,10 @@ type GetListAdapterV2[ListInput InputType, ListOutput OutputType, AWSItem AWSIte
 	// passed to the ItemMapper for conversion to SDP items
 	ListExtractor func(ctx context.Context, output ListOutput, client ClientStruct) ([]AWSItem, error)
 
-	// NOTE
-	//
-	// This does not yet support custom searching, this will be added in a
-	// future version
+	// Optional search function that can be used to search for items in a
+	// different, adapter-specific way. If this is nil, searches are assumed to
+	// be by ARN
+	SearchFunc func(ctx context.Context, client ClientStruct, scope string, query string) ([]AWSItem, error)
 
 	// ItemMapper Maps an AWS representation of an item to the SDP version, the
 	// query will be nil if the method was LIST
@@ -207,6 +207,8 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 		}
 	}
 
+	enrichItem(ctx, item)
+
 	s.cache.StoreItem(item, s.cacheDuration(), ck)
 
 	return item, nil
@@ -279,6 +281,8 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 				}
 			}
 
+			enrichItem(ctx, item)
+
 			stream.SendItem(item)
 			s.cache.StoreItem(item, s.cacheDuration(), ck)
 		}
@@ -321,6 +325,11 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 		return
 	}
 
+	if s.SearchFunc != nil {
+		s.searchCustom(ctx, scope, query, ignoreCache, stream)
+		return
+	}
+
 	// Parse the ARN
 	a, err := ParseARN(query)
 	if err != nil {
@@ -358,3 +367,51 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 		stream.SendItem(item)
 	}
 }
+
+// Runs the custom search function, sends the results on the stream and
+// caches them
+func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) searchCustom(ctx context.Context, scope string, query string, ignoreCache bool, stream discovery.QueryResultStream) {
+	// We need to cache here since this is the only place it'll be called
+	s.ensureCache()
+	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_SEARCH, scope, s.ItemType, query, ignoreCache)
+	if qErr != nil {
+		stream.SendError(qErr)
+		return
+	}
+	if cacheHit {
+		for _, item := range cachedItems {
+			stream.SendItem(item)
+		}
+		return
+	}
+
+	awsItems, err := s.SearchFunc(ctx, s.Client, scope, query)
+	if err != nil {
+		err := WrapAWSError(err)
+		if !CanRetry(err) {
+			s.cache.StoreError(err, s.cacheDuration(), ck)
+		}
+		stream.SendError(err)
+		return
+	}
+
+	for _, awsItem := range awsItems {
+		item, err := s.ItemMapper(&query, scope, awsItem)
+		if err != nil {
+			stream.SendError(WrapAWSError(err))
+			continue
+		}
+
+		if s.ListTagsFunc != nil {
+			item.Tags, err = s.ListTagsFunc(ctx, awsItem, s.Client)
+			if err != nil {
+				item.Tags = HandleTagsError(ctx, err)
+			}
+		}
+
+		enrichItem(ctx, item)
+
+		s.cache.StoreItem(item, s.cacheDuration(), ck)
+		stream.SendItem(item)
+	}
+}
@@ -71,6 +71,38 @@ func TestGetListAdapterV2Get(t *testing.T) {
 		}
 	})
 
+	t.Run("enriches the item after listing tags", func(t *testing.T) {
+		t.Cleanup(ResetItemEnrichers)
+
+		enricher := &tagsEnricher{}
+		AddItemEnricher(enricher)
+
+		s := GetListAdapterV2[string, []string, string, struct{}, struct{}]{
+			ItemType:  "person",
+			Region:    "eu-west-2",
+			AccountID: "12345",
+			GetFunc: func(ctx context.Context, client struct{}, scope, query string) (string, error) {
+				return "", nil
+			},
+			ItemMapper: func(query *string, scope, awsItem string) (*sdp.Item, error) {
+				return &sdp.Item{}, nil
+			},
+			ListTagsFunc: func(ctx context.Context, s1 string, s2 struct{}) (map[string]string, error) {
+				return map[string]string{
+					"foo": "bar",
+				}, nil
+			},
+		}
+
+		if _, err := s.Get(context.Background(), "12345.eu-west-2", "", false); err != nil {
+			t.Error(err)
+		}
+
+		if enricher.tags["foo"] != "bar" {
+			t.Errorf("expected enricher to see tag foo, got %v", enricher.tags)
+		}
+	})
+
 	t.Run("with an error in the GetFunc", func(t *testing.T) {
 		s := GetListAdapterV2[string, []string, string, struct{}, struct{}]{
 			ItemType:  "person",
@@ -283,6 +315,87 @@ func TestListFuncPaginatorBuilder(t *testing.T) {
 	}
 }
 
+func TestGetListAdapterV2SearchStream(t *testing.T) {
+	t.Run("with a custom search function", func(t *testing.T) {
+		searches := 0
+		s := GetListAdapterV2[string, []string, string, struct{}, struct{}]{
+			ItemType:  "person",
+			Region:    "eu-west-2",
+			AccountID: "12345",
+			GetFunc: func(ctx context.Context, client struct{}, scope, query string) (string, error) {
+				return "", errors.New("get should not be called")
+			},
+			SearchFunc: func(ctx context.Context, client struct{}, scope, query string) ([]string, error) {
+				searches++
+				return []string{"one", "two"}, nil
+			},
+			ItemMapper: func(query *string, scope, awsItem string) (*sdp.Item, error) {
+				return &sdp.Item{
+					Scope:           "12345.eu-west-2",
+					Type:            "person",
+					UniqueAttribute: "name",
+					Attributes: &sdp.ItemAttributes{
+						AttrStruct: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"name": structpb.NewStringValue(awsItem),
+							},
+						},
+					},
+				}, nil
+			},
+			ListTagsFunc: func(ctx context.Context, s1 string, s2 struct{}) (map[string]string, error) {
+				return map[string]string{
+					"foo": "bar",
+				}, nil
+			},
+		}
+
+		stream := discovery.NewRecordingQueryResultStream()
+		s.SearchStream(context.Background(), "12345.eu-west-2", "not-an-arn", false, stream)
+		// The second search should be served from the cache
+		s.SearchStream(context.Background(), "12345.eu-west-2", "not-an-arn", false, stream)
+
+		errs := stream.GetErrors()
+		if len(errs) > 0 {
+			t.Error(errs)
+		}
+
+		items := stream.GetItems()
+		if len(items) != 4 {
+			t.Fatalf("expected 4 items, got %v", len(items))
+		}
+
+		if items[0].GetTags()["foo"] != "bar" {
+			t.Errorf("expected tag foo to be bar, got %v", items[0].GetTags()["foo"])
+		}
+
+		if searches != 1 {
+			t.Errorf("expected 1 search, got %v", searches)
+		}
+	})
+
+	t.Run("with an error in the search function", func(t *testing.T) {
+		s := GetListAdapterV2[string, []string, string, struct{}, struct{}]{
+			ItemType:  "person",
+			Region:    "eu-west-2",
+			AccountID: "12345",
+			SearchFunc: func(ctx context.Context, client struct{}, scope, query string) ([]string, error) {
+				return nil, errors.New("search func error")
+			},
+			ItemMapper: func(query *string, scope, awsItem string) (*sdp.Item, error) {
+				return &sdp.Item{}, nil
+			},
+		}
+
+		stream := discovery.NewRecordingQueryResultStream()
+		s.SearchStream(context.Background(), "12345.eu-west-2", "not-an-arn", false, stream)
+
+		if len(stream.GetErrors()) != 1 {
+			t.Errorf("expected 1 error, got %v", stream.GetErrors())
+		}
+	})
+}
+
 func TestGetListAdapterV2Caching(t *testing.T) {
 	ctx := context.Background()
 	generation := 0
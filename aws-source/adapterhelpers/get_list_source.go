@@ -180,6 +180,8 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) Get(ctx context.Context
 		}
 	}
 
+	enrichItem(ctx, item)
+
 	s.cache.StoreItem(item, s.cacheDuration(), ck)
 
 	return item, nil
@@ -227,6 +229,8 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) List(ctx context.Contex
 			}
 		}
 
+		enrichItem(ctx, item)
+
 		items = append(items, item)
 		s.cache.StoreItem(item, s.cacheDuration(), ck)
 	}
@@ -320,6 +324,8 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) SearchCustom(ctx contex
 			continue
 		}
 
+		enrichItem(ctx, item)
+
 		items = append(items, item)
 		s.cache.StoreItem(item, s.cacheDuration(), ck)
 	}
@@ -0,0 +1,89 @@
+package adapterhelpers
+
+import (
+	"strings"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// StatusAttributes are the attributes that are checked, in order, for a
+// status that can be converted to health. AWS doesn't use a consistent name
+// for the status of a resource, so this covers the names used by the most
+// common services. Nested attributes use dot notation
+var StatusAttributes = []string{
+	"Status",
+	"State",
+	"State.Name",
+	"Status.State",
+	"DBInstanceStatus",
+	"DBClusterStatus",
+	"CacheClusterStatus",
+	"ClusterStatus",
+	"TableStatus",
+	"StreamStatus",
+	"DeliveryStreamStatus",
+	"StackStatus",
+	"KeyState",
+	"LifeCycleState",
+	"LifecycleState",
+}
+
+// HealthFromStatus converts a status reported by AWS to health using the
+// vocabulary that is shared across services. Case and separators are ignored
+// so that "IN_PROGRESS", "in-progress" and "In progress" are treated the same.
+// Resources that are stopping are pending, the same as in the EC2 and RDS
+// adapters. Resources that are stopped, disabled or deleted don't have a
+// health and neither do statuses that aren't recognised, so these return nil
+func HealthFromStatus(status string) *sdp.Health {
+	s := strings.NewReplacer("_", "-", " ", "-").Replace(strings.ToLower(strings.TrimSpace(status)))
+
+	switch s {
+	case "available", "active", "running", "in-use", "ok", "healthy", "enabled", "ready", "issued", "associated", "attached", "backing-up", "succeeded", "successful", "in-sync", "insync":
+		return sdp.Health_HEALTH_OK.Enum()
+	case "pending", "creating", "updating", "modifying", "starting", "provisioning", "in-progress", "rebooting", "maintenance", "upgrading", "resizing", "restoring", "attaching", "detaching", "associating", "disassociating", "initializing", "renaming", "scaling", "stopping", "shutting-down":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case "deleting", "draining", "degraded", "impaired", "pending-deletion", "pending-delete", "suspended", "deprecated", "warning":
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case "failed", "error", "unhealthy", "alarm", "storage-full", "restore-error", "rollback-complete", "inoperable", "unavailable", "broken":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	switch {
+	case strings.HasSuffix(s, "-failed"), strings.HasPrefix(s, "incompatible-"), strings.HasPrefix(s, "inaccessible-"):
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case strings.HasSuffix(s, "-in-progress"), strings.HasPrefix(s, "configuring-"), strings.HasPrefix(s, "pending-"):
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case strings.HasSuffix(s, "-complete"):
+		return sdp.Health_HEALTH_OK.Enum()
+	}
+
+	return nil
+}
+
+// EnrichHealth sets the health of an item from its status attribute, so that
+// adapters which don't calculate health themselves still report it
+// consistently. Health that was already set by the adapter is kept since the
+// adapter knows more about the resource than its status alone
+func EnrichHealth(item *sdp.Item) {
+	if item == nil || item.Health != nil || item.GetAttributes() == nil {
+		return
+	}
+
+	for _, name := range StatusAttributes {
+		value, err := item.GetAttributes().Get(name)
+		if err != nil {
+			continue
+		}
+
+		status, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		item.Health = HealthFromStatus(status)
+
+		// Only the first status that is found is used, even if it isn't
+		// recognised, since the others are likely to describe something else
+		return
+	}
+}
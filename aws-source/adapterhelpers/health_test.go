@@ -0,0 +1,113 @@
+package adapterhelpers
+
+import (
+	"testing"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestHealthFromStatus(t *testing.T) {
+	tests := map[string]*sdp.Health{
+		"available":                           sdp.Health_HEALTH_OK.Enum(),
+		"ACTIVE":                              sdp.Health_HEALTH_OK.Enum(),
+		"CREATE_COMPLETE":                     sdp.Health_HEALTH_OK.Enum(),
+		"creating":                            sdp.Health_HEALTH_PENDING.Enum(),
+		"UPDATE_IN_PROGRESS":                  sdp.Health_HEALTH_PENDING.Enum(),
+		"configuring-enhanced-monitoring":     sdp.Health_HEALTH_PENDING.Enum(),
+		"Deleting":                            sdp.Health_HEALTH_WARNING.Enum(),
+		"shutting-down":                       sdp.Health_HEALTH_PENDING.Enum(),
+		"stopping":                            sdp.Health_HEALTH_PENDING.Enum(),
+		"FAILED":                              sdp.Health_HEALTH_ERROR.Enum(),
+		"CREATE_FAILED":                       sdp.Health_HEALTH_ERROR.Enum(),
+		"ROLLBACK_COMPLETE":                   sdp.Health_HEALTH_ERROR.Enum(),
+		"inaccessible-encryption-credentials": sdp.Health_HEALTH_ERROR.Enum(),
+		"stopped":                             nil,
+		"INACTIVE":                            nil,
+		"something-new":                       nil,
+	}
+
+	for status, expected := range tests {
+		health := HealthFromStatus(status)
+
+		switch {
+		case expected == nil && health != nil:
+			t.Errorf("expected no health for %v, got %v", status, health)
+		case expected != nil && (health == nil || *health != *expected):
+			t.Errorf("expected %v for %v, got %v", expected, status, health)
+		}
+	}
+}
+
+func TestEnrichHealth(t *testing.T) {
+	t.Run("top level status", func(t *testing.T) {
+		attributes, err := sdp.ToAttributes(map[string]interface{}{
+			"Name":   "test",
+			"Status": "available",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item := &sdp.Item{Attributes: attributes}
+		EnrichHealth(item)
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health OK, got %v", item.GetHealth())
+		}
+	})
+
+	t.Run("nested state", func(t *testing.T) {
+		attributes, err := sdp.ToAttributes(map[string]interface{}{
+			"InstanceId": "i-0123456789abcdef0",
+			"State": map[string]interface{}{
+				"Code": 32,
+				"Name": "shutting-down",
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item := &sdp.Item{Attributes: attributes}
+		EnrichHealth(item)
+
+		if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+			t.Errorf("expected health PENDING, got %v", item.GetHealth())
+		}
+	})
+
+	t.Run("existing health is kept", func(t *testing.T) {
+		attributes, err := sdp.ToAttributes(map[string]interface{}{
+			"Status": "available",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item := &sdp.Item{
+			Attributes: attributes,
+			Health:     sdp.Health_HEALTH_ERROR.Enum(),
+		}
+		EnrichHealth(item)
+
+		if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+			t.Errorf("expected health ERROR, got %v", item.GetHealth())
+		}
+	})
+
+	t.Run("no status", func(t *testing.T) {
+		attributes, err := sdp.ToAttributes(map[string]interface{}{
+			"Name": "test",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item := &sdp.Item{Attributes: attributes}
+		EnrichHealth(item)
+
+		if item.Health != nil {
+			t.Errorf("expected no health, got %v", item.GetHealth())
+		}
+	})
+}
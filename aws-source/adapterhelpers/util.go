@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsHttp "github.com/aws/smithy-go/transport/http"
+	"github.com/overmindtech/cli/aws-source/replay"
 	"github.com/overmindtech/cli/discovery"
 	"github.com/overmindtech/cli/sdp-go"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -45,6 +47,20 @@ func ParseScope(scope string) (string, string, error) {
 	return sections[0], sections[1], nil
 }
 
+// PartitionFromRegion Returns the partition that a region is in, for use in
+// ARNs. Regions that aren't in the China or GovCloud partitions are assumed to
+// be in the standard partition
+func PartitionFromRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	default:
+		return "aws"
+	}
+}
+
 // Returns whether or not it makes sense to retry the error. This can be used to
 // decide whether we should cache the error or not. Errors such as the item
 // being not found, or the scope not existing should not be retried for example
@@ -404,39 +420,112 @@ func (e E2ETest) Run(t *testing.T) {
 	})
 }
 
+// callerIdentityErr is the first error from getting the caller identity
+// without a fixture. Looking up credentials that don't exist can take several
+// seconds, so the remaining tests reuse the error instead of waiting again
+var (
+	callerIdentityErr   error
+	callerIdentityErrMu sync.Mutex
+)
+
+// skipWithoutCredentials skips a test that calls AWS when running in CI, where
+// no credentials are available, and fails it otherwise
+func skipWithoutCredentials(t *testing.T, format string, args ...any) {
+	t.Helper()
+
+	if strings.EqualFold(os.Getenv("CI"), "true") {
+		// These tests were always just really simple smoke tests that relied on data being already populated in AWS.
+		// They were just a good way to check the shape of the data coming back during development.
+		t.Skipf("Skipping test because no AWS credentials are available in CI environment and it has no replay fixture. They are for during development ONLY. "+format, args...)
+	}
+
+	t.Fatalf(format, args...)
+}
+
 // GetAutoConfig Uses automatic local config (i.e. `aws configure`) to get an
-// AWS config object, AWS account ID and region. Skips the tests if this is
-// unavailable
+// AWS config object, AWS account ID and region. If the test has a replay
+// fixture, or AWS_SOURCE_REPLAY is set, responses are replayed from or
+// recorded to the fixture instead, see the `replay` package. Only tests with a
+// fixture run without credentials: the others are skipped in CI (CI=true) if
+// the config can't be loaded or the caller identity can't be fetched, and
+// fail otherwise
 func GetAutoConfig(t *testing.T) (aws.Config, string, string) {
 	t.Helper()
 
-	config, err := config.LoadDefaultConfig(context.Background())
+	mode, err := replay.ModeFor(t)
 	if err != nil {
-		rawCIString := os.Getenv("CI")
-		if strings.EqualFold(rawCIString, "true") {
-			// These tests were always just really simple smoke tests that relied on data being already populated in AWS.
-			// They were just a good way to check the shape of the data coming back during development.
-			t.Skip("Skipping test because no AWS credentials are available in CI environment. They are for during development ONLY.")
+		t.Fatal(err)
+	}
+
+	var cfg aws.Config
+
+	if mode == replay.ModeReplay {
+		fixture, err := replay.LoadFixture(replay.FixturePath(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cfg = replay.Config(fixture)
+	} else {
+		cfg, err = config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			skipWithoutCredentials(t, "Failed to load default config: %v", err)
+		}
+
+		// Add OTel instrumentation
+		transport := otelhttp.NewTransport(http.DefaultTransport)
+
+		if mode == replay.ModeRecord {
+			recorder := replay.NewRecorder(transport, cfg.Region)
+
+			// Tests run their subtests in parallel, so the recording is
+			// only complete once they have all finished
+			t.Cleanup(func() {
+				if err := recorder.Save(replay.FixturePath(t), replay.NewRedactor()); err != nil {
+					t.Errorf("Failed to save replay fixture: %v", err)
+				}
+			})
+
+			cfg.HTTPClient = &http.Client{
+				Transport: recorder,
+			}
 		} else {
-			t.Fatalf("Failed to load default config: %v", err)
+			cfg.HTTPClient = &http.Client{
+				Transport: transport,
+			}
 		}
 	}
 
-	// Add OTel instrumentation
-	config.HTTPClient = &http.Client{
-		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	if mode == replay.ModeOff {
+		callerIdentityErrMu.Lock()
+		err = callerIdentityErr
+		callerIdentityErrMu.Unlock()
+
+		if err != nil {
+			skipWithoutCredentials(t, "Failed to get caller identity: %v", err)
+		}
 	}
 
-	stsClient := sts.NewFromConfig(config)
+	stsClient := sts.NewFromConfig(cfg)
 
 	var callerID *sts.GetCallerIdentityOutput
 
 	callerID, err = stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
 	if err != nil {
-		t.Fatalf("Failed to get caller identity, for config: %+v. %v", config, err)
+		// Loading the config succeeds without credentials, since they are
+		// only looked up when the first request is made. Broken fixtures and
+		// recordings should still fail
+		if mode == replay.ModeOff {
+			callerIdentityErrMu.Lock()
+			callerIdentityErr = err
+			callerIdentityErrMu.Unlock()
+
+			skipWithoutCredentials(t, "Failed to get caller identity, for config: %+v. %v", cfg, err)
+		}
+		t.Fatalf("Failed to get caller identity, for config: %+v. %v", cfg, err)
 	}
 
-	return config, *callerID.Account, config.Region
+	return cfg, *callerID.Account, cfg.Region
 }
 
 // Converts an interface to SDP attributes using the `sdp.ToAttributesSorted`
@@ -0,0 +1,281 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type acmClient interface {
+	DescribeCertificate(ctx context.Context, params *acm.DescribeCertificateInput, optFns ...func(*acm.Options)) (*acm.DescribeCertificateOutput, error)
+	ListTagsForCertificate(ctx context.Context, params *acm.ListTagsForCertificateInput, optFns ...func(*acm.Options)) (*acm.ListTagsForCertificateOutput, error)
+
+	acm.ListCertificatesAPIClient
+}
+
+func certificateGetFunc(ctx context.Context, client acmClient, scope, query string) (*types.CertificateDetail, error) {
+	certificateARN := query
+
+	// ACM only accepts ARNs, so if we have been given an ID we need to
+	// construct the ARN from the scope
+	if !strings.HasPrefix(query, "arn:") {
+		accountID, region, err := adapterhelpers.ParseScope(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		certificateARN = fmt.Sprintf("arn:aws:acm:%v:%v:certificate/%v", region, accountID, query)
+	}
+
+	out, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: &certificateARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Certificate, nil
+}
+
+// certificateAttributes are the attributes of an acm-certificate item. The ID
+// isn't returned by the API, but is needed so that the certificate can be
+// found by ID as well as by ARN
+type certificateAttributes struct {
+	*types.CertificateDetail
+	CertificateId string
+}
+
+func certificateStatusToHealth(status types.CertificateStatus) *sdp.Health {
+	switch status {
+	case types.CertificateStatusIssued:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.CertificateStatusPendingValidation:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.CertificateStatusInactive:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.CertificateStatusExpired, types.CertificateStatusRevoked, types.CertificateStatusFailed, types.CertificateStatusValidationTimedOut:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// Links from the certificate to a resource that uses it. These are the reverse
+// of the links that the resources have to the certificate
+func certificateInUseByLink(scope, resourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(resourceARN)
+	if err != nil {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Method: sdp.QueryMethod_SEARCH,
+		Query:  resourceARN,
+		Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+	}
+
+	switch a.Service {
+	case "elasticloadbalancing":
+		if strings.HasPrefix(a.Resource, "loadbalancer/app/") || strings.HasPrefix(a.Resource, "loadbalancer/net/") {
+			// The certificate is attached to the listeners of the load
+			// balancer, which can be searched for by the load balancer ARN
+			query.Type = "elbv2-listener"
+		} else {
+			query.Type = "elb-load-balancer"
+			query.Method = sdp.QueryMethod_GET
+			query.Query = a.ResourceID()
+		}
+	case "cloudfront":
+		query.Type = "cloudfront-distribution"
+	case "apigateway":
+		// API Gateway ARNs don't contain the account, and the resource is a
+		// path e.g. /domainnames/example.com
+		domainName, found := strings.CutPrefix(a.Resource, "/domainnames/")
+		if !found {
+			return nil
+		}
+
+		query.Type = "apigateway-domain-name"
+		query.Method = sdp.QueryMethod_GET
+		query.Query = domainName
+		query.Scope = scope
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the resource won't affect the certificate
+			In: false,
+			// Changing the certificate will affect the resource
+			Out: true,
+		},
+	}
+}
+
+func certificateItemMapper(_ *string, scope string, awsItem *types.CertificateDetail) (*sdp.Item, error) {
+	attrs := certificateAttributes{
+		CertificateDetail: awsItem,
+	}
+
+	if awsItem.CertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CertificateArn); err == nil {
+			attrs.CertificateId = a.ResourceID()
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "acm-certificate",
+		UniqueAttribute: "CertificateId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          certificateStatusToHealth(awsItem.Status),
+	}
+
+	for _, resourceARN := range awsItem.InUseBy {
+		if link := certificateInUseByLink(scope, resourceARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.CertificateAuthorityArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CertificateAuthorityArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-pca-certificate-authority",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.CertificateAuthorityArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The certificate authority issues the certificate
+					In: true,
+					// Changing the certificate won't affect the authority
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, validation := range awsItem.DomainValidationOptions {
+		if validation.ResourceRecord != nil && validation.ResourceRecord.Name != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  strings.TrimSuffix(*validation.ResourceRecord.Name, "."),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Removing the validation record will stop the
+					// certificate from renewing
+					In: true,
+					// Changing the certificate won't affect the record
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func certificateListTagsFunc(ctx context.Context, certificate *types.CertificateDetail, client acmClient) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	out, err := client.ListTagsForCertificate(ctx, &acm.ListTagsForCertificateInput{
+		CertificateArn: certificate.CertificateArn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewACMCertificateAdapter(client acmClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*acm.ListCertificatesInput, *acm.ListCertificatesOutput, *types.CertificateDetail, acmClient, *acm.Options] {
+	return &adapterhelpers.GetListAdapterV2[*acm.ListCertificatesInput, *acm.ListCertificatesOutput, *types.CertificateDetail, acmClient, *acm.Options]{
+		ItemType:        "acm-certificate",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: acmCertificateAdapterMetadata,
+		GetFunc:         certificateGetFunc,
+		InputMapperList: func(scope string) (*acm.ListCertificatesInput, error) {
+			return &acm.ListCertificatesInput{
+				// By default only RSA_2048 certificates are returned
+				Includes: &types.Filters{
+					KeyTypes: types.KeyAlgorithm("").Values(),
+				},
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client acmClient, params *acm.ListCertificatesInput) adapterhelpers.Paginator[*acm.ListCertificatesOutput, *acm.Options] {
+			return acm.NewListCertificatesPaginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *acm.ListCertificatesOutput, client acmClient) ([]*types.CertificateDetail, error) {
+			// The summaries don't include what the certificate is in use by,
+			// so we need to describe each one
+			certificates := make([]*types.CertificateDetail, 0, len(output.CertificateSummaryList))
+
+			for _, summary := range output.CertificateSummaryList {
+				out, err := client.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+					CertificateArn: summary.CertificateArn,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				certificates = append(certificates, out.Certificate)
+			}
+
+			return certificates, nil
+		},
+		ItemMapper:   certificateItemMapper,
+		ListTagsFunc: certificateListTagsFunc,
+	}
+}
+
+var acmCertificateAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "acm-certificate",
+	DescriptiveName: "ACM Certificate",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a certificate by ID",
+		ListDescription:   "List all certificates",
+		SearchDescription: "Search for a certificate by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_acm_certificate.arn",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_acm_certificate_validation.certificate_arn",
+		},
+	},
+	PotentialLinks: []string{"elbv2-listener", "elb-load-balancer", "cloudfront-distribution", "apigateway-domain-name", "acm-pca-certificate-authority", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(acmCertificateAdapterMetadata, sdp.AttributeSchemaFor(certificateAttributes{}))
@@ -0,0 +1,176 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testACMClient struct{}
+
+func (t testACMClient) DescribeCertificate(ctx context.Context, params *acm.DescribeCertificateInput, optFns ...func(*acm.Options)) (*acm.DescribeCertificateOutput, error) {
+	if *params.CertificateArn != "arn:aws:acm:eu-west-2:123456789012:certificate/f3a1b2c3-1234-5678-9abc-def012345678" {
+		return nil, &types.ResourceNotFoundException{
+			Message: aws.String("Could not find certificate"),
+		}
+	}
+
+	return &acm.DescribeCertificateOutput{
+		Certificate: &types.CertificateDetail{
+			CertificateArn:          params.CertificateArn,
+			DomainName:              aws.String("example.com"),
+			SubjectAlternativeNames: []string{"example.com", "www.example.com"},
+			Status:                  types.CertificateStatusIssued,
+			Type:                    types.CertificateTypeAmazonIssued,
+			KeyAlgorithm:            types.KeyAlgorithmRsa2048,
+			NotAfter:                aws.Time(time.Now().Add(90 * 24 * time.Hour)),
+			DomainValidationOptions: []types.DomainValidation{
+				{
+					DomainName:       aws.String("example.com"),
+					ValidationMethod: types.ValidationMethodDns,
+					ValidationStatus: types.DomainStatusSuccess,
+					ResourceRecord: &types.ResourceRecord{
+						Name:  aws.String("_abc123.example.com."),
+						Type:  types.RecordTypeCname,
+						Value: aws.String("_def456.acm-validations.aws."),
+					},
+				},
+			},
+			InUseBy: []string{
+				"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/web/50dc6c495c0c9188",
+				"arn:aws:cloudfront::123456789012:distribution/E1A2B3C4D5E6F7",
+				"arn:aws:apigateway:eu-west-2::/domainnames/api.example.com",
+			},
+		},
+	}, nil
+}
+
+func (t testACMClient) ListTagsForCertificate(ctx context.Context, params *acm.ListTagsForCertificateInput, optFns ...func(*acm.Options)) (*acm.ListTagsForCertificateOutput, error) {
+	return &acm.ListTagsForCertificateOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("env"),
+				Value: aws.String("prod"),
+			},
+		},
+	}, nil
+}
+
+func (t testACMClient) ListCertificates(ctx context.Context, params *acm.ListCertificatesInput, optFns ...func(*acm.Options)) (*acm.ListCertificatesOutput, error) {
+	return &acm.ListCertificatesOutput{
+		CertificateSummaryList: []types.CertificateSummary{
+			{
+				CertificateArn: aws.String("arn:aws:acm:eu-west-2:123456789012:certificate/f3a1b2c3-1234-5678-9abc-def012345678"),
+				DomainName:     aws.String("example.com"),
+			},
+		},
+	}, nil
+}
+
+func TestCertificateGetFunc(t *testing.T) {
+	certificate, err := certificateGetFunc(context.Background(), testACMClient{}, "123456789012.eu-west-2", "f3a1b2c3-1234-5678-9abc-def012345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *certificate.DomainName != "example.com" {
+		t.Errorf("expected domain name example.com, got %v", *certificate.DomainName)
+	}
+}
+
+func TestCertificateItemMapper(t *testing.T) {
+	certificate, err := certificateGetFunc(context.Background(), testACMClient{}, "123456789012.eu-west-2", "arn:aws:acm:eu-west-2:123456789012:certificate/f3a1b2c3-1234-5678-9abc-def012345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := certificateItemMapper(nil, "123456789012.eu-west-2", certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "f3a1b2c3-1234-5678-9abc-def012345678" {
+		t.Errorf("expected the certificate ID as the unique attribute, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected an issued certificate to be healthy, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elbv2-listener",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/web/50dc6c495c0c9188",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudfront-distribution",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:cloudfront::123456789012:distribution/E1A2B3C4D5E6F7",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "apigateway-domain-name",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "_abc123.example.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCertificateListFunc(t *testing.T) {
+	adapter := NewACMCertificateAdapter(testACMClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].GetTags()["env"] != "prod" {
+		t.Errorf("expected env tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewACMCertificateAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := acm.NewFromConfig(config)
+
+	adapter := NewACMCertificateAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -131,3 +131,5 @@ var apiKeyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_api_gateway_api_key.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiKeyAdapterMetadata, sdp.AttributeSchemaFor(&types.ApiKey{}, "tags"))
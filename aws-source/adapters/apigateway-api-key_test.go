@@ -32,6 +32,8 @@ func TestApiKeyOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "apigateway-rest-api",
@@ -118,3 +118,5 @@ var authorizerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_api_gateway_authorizer.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(authorizerAdapterMetadata, sdp.AttributeSchemaFor(&types.Authorizer{}, "tags"))
@@ -35,6 +35,8 @@ func TestAuthorizerOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "apigateway-rest-api",
@@ -135,3 +135,5 @@ var deploymentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_api_gateway_deployment.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(deploymentAdapterMetadata, sdp.AttributeSchemaFor(&types.Deployment{}, "tags"))
@@ -29,6 +29,8 @@ func TestDeploymentOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "apigateway-rest-api",
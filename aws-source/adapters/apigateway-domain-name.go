@@ -238,3 +238,5 @@ var apiGatewayDomainNameAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 		{TerraformQueryMap: "aws_api_gateway_domain_name.domain_name"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiGatewayDomainNameAdapterMetadata, sdp.AttributeSchemaFor(&types.DomainName{}, "tags"))
@@ -71,6 +71,8 @@ func TestDomainNameOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	a, err := adapterhelpers.ParseARN("arn:aws:acm:region:account-id:certificate/regional-certificate-id")
 	if err != nil {
 		t.Fatal(err)
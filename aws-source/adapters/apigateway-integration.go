@@ -131,3 +131,5 @@ var apiGatewayIntegrationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 		SearchDescription: "Search Integrations by ARN",
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiGatewayIntegrationAdapterMetadata, sdp.AttributeSchemaFor(&apigateway.GetIntegrationOutput{}, "tags").WithProperty("IntegrationID", stringAttribute))
@@ -54,6 +54,8 @@ func TestApiGatewayIntegrationGetFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	integrationID := fmt.Sprintf("%s/%s/%s", *input.RestApiId, *input.ResourceId, *input.HttpMethod)
 
 	tests := adapterhelpers.QueryTests{
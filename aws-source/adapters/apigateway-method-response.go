@@ -104,3 +104,5 @@ var apiGatewayMethodResponseAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 		SearchDescription: "Search Method Responses by ARN",
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiGatewayMethodResponseAdapterMetadata, sdp.AttributeSchemaFor(&apigateway.GetMethodResponseOutput{}, "tags").WithProperty("MethodResponseID", stringAttribute))
@@ -41,6 +41,8 @@ func TestApiGatewayMethodResponseGetFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	methodID := fmt.Sprintf("%s/%s/%s", *input.RestApiId, *input.ResourceId, *input.HttpMethod)
 
 	tests := adapterhelpers.QueryTests{
@@ -167,3 +167,5 @@ var apiGatewayMethodAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"apigateway-method-response",
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiGatewayMethodAdapterMetadata, sdp.AttributeSchemaFor(&apigateway.GetMethodOutput{}, "tags").WithProperty("MethodID", stringAttribute))
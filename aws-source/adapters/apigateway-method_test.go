@@ -69,6 +69,8 @@ func TestApiGatewayGetFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	methodID := fmt.Sprintf("%s/%s/%s", *input.RestApiId, *input.ResourceId, *input.HttpMethod)
 	authorizerID := fmt.Sprintf("%s/%s", *input.RestApiId, "authorizer-id")
 	validatorID := fmt.Sprintf("%s/%s", *input.RestApiId, "request-validator-id")
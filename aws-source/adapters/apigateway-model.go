@@ -119,3 +119,5 @@ var modelAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_api_gateway_model.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(modelAdapterMetadata, sdp.AttributeSchemaFor(&types.Model{}, "tags").WithProperty("UniqueAttribute", stringAttribute))
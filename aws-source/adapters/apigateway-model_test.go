@@ -30,6 +30,8 @@ func TestModelOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "apigateway-rest-api",
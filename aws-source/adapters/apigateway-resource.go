@@ -141,3 +141,5 @@ var apiGatewayResourceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"apigateway-method",
 	},
 })
+
+var _ = Metadata.RegisterSchema(apiGatewayResourceAdapterMetadata, sdp.AttributeSchemaFor(&types.Resource{}, "tags").WithProperty("UniqueName", stringAttribute))
@@ -160,6 +160,8 @@ func TestResourceOutputMapper(t *testing.T) {
 	if err := item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewAPIGatewayResourceAdapter(t *testing.T) {
@@ -48,6 +48,13 @@ func restApiListFunc(ctx context.Context, client *apigateway.Client, _ string) (
 	return items, nil
 }
 
+// restAPIWithParsedPolicy replaces the URL-encoded policy of the API with the
+// parsed policy document
+type restAPIWithParsedPolicy struct {
+	*types.RestApi
+	PolicyDocument *policy.Policy
+}
+
 func restApiOutputMapper(scope string, awsItem *types.RestApi) (*sdp.Item, error) {
 	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
 	if err != nil {
@@ -55,11 +62,6 @@ func restApiOutputMapper(scope string, awsItem *types.RestApi) (*sdp.Item, error
 	}
 
 	if awsItem.Policy != nil {
-		type restAPIWithParsedPolicy struct {
-			*types.RestApi
-			PolicyDocument *policy.Policy
-		}
-
 		restApi := restAPIWithParsedPolicy{
 			RestApi: awsItem,
 		}
@@ -253,3 +255,5 @@ var restApiAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	PotentialLinks: []string{"ec2-vpc-endpoint", "apigateway-resource"},
 })
+
+var _ = Metadata.RegisterSchema(restApiAdapterMetadata, sdp.AttributeSchemaFor(restAPIWithParsedPolicy{}, "tags"))
@@ -67,6 +67,8 @@ func TestRestApiOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "ec2-vpc-endpoint",
@@ -34,6 +34,36 @@ func convertGetStageOutputToStage(output *apigateway.GetStageOutput) *types.Stag
 	}
 }
 
+// Links to the destination of the access logs of a stage, which is either a
+// log group or a Firehose delivery stream. Used by both REST and v2 APIs
+func apiGatewayAccessLogLink(destinationARN string) *sdp.LinkedItemQuery {
+	query := logsLogGroupQuery(destinationARN)
+
+	if query == nil {
+		a, err := adapterhelpers.ParseARN(destinationARN)
+		if err != nil || a.Service != "firehose" {
+			return nil
+		}
+
+		query = &sdp.Query{
+			Type:   "firehose-delivery-stream",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.ResourceID(),
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		}
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Access logs can't be delivered if the destination changes
+			In: true,
+			// The stage writes access logs to the destination
+			Out: true,
+		},
+	}
+}
+
 func stageOutputMapper(query, scope string, awsItem *types.Stage) (*sdp.Item, error) {
 	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
 	if err != nil {
@@ -74,6 +104,31 @@ func stageOutputMapper(query, scope string, awsItem *types.Stage) (*sdp.Item, er
 		})
 	}
 
+	if awsItem.WebAclArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.WebAclArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "wafv2-web-acl",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.WebAclArn,
+					Scope:  wafv2ARNScope(a),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the ACL changes which requests reach the stage
+					In: true,
+					// The stage can't affect the ACL
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.AccessLogSettings != nil && awsItem.AccessLogSettings.DestinationArn != nil {
+		if link := apiGatewayAccessLogLink(*awsItem.AccessLogSettings.DestinationArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
 	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
 		Query: &sdp.Query{
 			Type:   "apigateway-rest-api",
@@ -168,8 +223,10 @@ var stageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		GetDescription:    "Get an API Gateway Stage by its rest API ID and stage name: rest-api-id/stage-name",
 		SearchDescription: "Search for API Gateway Stages by their rest API ID or with rest API ID and deployment-id: rest-api-id/deployment-id",
 	},
-	PotentialLinks: []string{"wafv2-web-acl"},
+	PotentialLinks: []string{"wafv2-web-acl", "logs-log-group", "firehose-delivery-stream"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_api_gateway_stage.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(stageAdapterMetadata, sdp.AttributeSchemaFor(&types.Stage{}, "tags").WithProperty("UniqueAttribute", stringAttribute))
@@ -14,13 +14,15 @@ import (
 
 func TestStageOutputMapper(t *testing.T) {
 	awsItem := &types.Stage{
-		DeploymentId:         aws.String("deployment-id"),
-		StageName:            aws.String("stage-name"),
-		Description:          aws.String("description"),
-		CreatedDate:          aws.Time(time.Now()),
-		LastUpdatedDate:      aws.Time(time.Now()),
-		Variables:            map[string]string{"key": "value"},
-		AccessLogSettings:    &types.AccessLogSettings{},
+		DeploymentId:    aws.String("deployment-id"),
+		StageName:       aws.String("stage-name"),
+		Description:     aws.String("description"),
+		CreatedDate:     aws.Time(time.Now()),
+		LastUpdatedDate: aws.Time(time.Now()),
+		Variables:       map[string]string{"key": "value"},
+		AccessLogSettings: &types.AccessLogSettings{
+			DestinationArn: aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:API-Gateway-Access-Logs"),
+		},
 		CacheClusterEnabled:  true,
 		CacheClusterSize:     "0.5",
 		CacheClusterStatus:   types.CacheClusterStatusAvailable,
@@ -29,7 +31,7 @@ func TestStageOutputMapper(t *testing.T) {
 		DocumentationVersion: aws.String("1.0"),
 		MethodSettings:       map[string]types.MethodSetting{},
 		TracingEnabled:       true,
-		WebAclArn:            aws.String("web-acl-arn"),
+		WebAclArn:            aws.String("arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/api/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
 		Tags:                 map[string]string{"tag-key": "tag-value"},
 	}
 
@@ -44,6 +46,8 @@ func TestStageOutputMapper(t *testing.T) {
 			t.Error(err)
 		}
 
+		validateAttributeSchema(t, item)
+
 		tests := adapterhelpers.QueryTests{
 			{
 				ExpectedType:   "apigateway-deployment",
@@ -57,6 +61,18 @@ func TestStageOutputMapper(t *testing.T) {
 				ExpectedQuery:  "rest-api-id",
 				ExpectedScope:  "scope",
 			},
+			{
+				ExpectedType:   "wafv2-web-acl",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/api/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "logs-log-group",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "API-Gateway-Access-Logs",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
 		}
 
 		tests.Execute(t, item)
@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func convertGetApiOutputToApi(output *apigatewayv2.GetApiOutput) *types.Api {
+	return &types.Api{
+		ApiEndpoint:               output.ApiEndpoint,
+		ApiGatewayManaged:         output.ApiGatewayManaged,
+		ApiId:                     output.ApiId,
+		ApiKeySelectionExpression: output.ApiKeySelectionExpression,
+		CorsConfiguration:         output.CorsConfiguration,
+		CreatedDate:               output.CreatedDate,
+		Description:               output.Description,
+		DisableExecuteApiEndpoint: output.DisableExecuteApiEndpoint,
+		DisableSchemaValidation:   output.DisableSchemaValidation,
+		ImportInfo:                output.ImportInfo,
+		IpAddressType:             output.IpAddressType,
+		Name:                      output.Name,
+		ProtocolType:              output.ProtocolType,
+		RouteSelectionExpression:  output.RouteSelectionExpression,
+		Tags:                      output.Tags,
+		Version:                   output.Version,
+		Warnings:                  output.Warnings,
+	}
+}
+
+func apiGatewayV2APIGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*types.Api, error) {
+	out, err := client.GetApi(ctx, &apigatewayv2.GetApiInput{
+		ApiId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGetApiOutputToApi(out), nil
+}
+
+func apiGatewayV2APIListFunc(ctx context.Context, client apiGatewayV2Client, _ string) ([]*types.Api, error) {
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*types.Api, *string, error) {
+		out, err := client.GetApis(ctx, &apigatewayv2.GetApisInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		apis := make([]*types.Api, 0, len(out.Items))
+		for i := range out.Items {
+			apis = append(apis, &out.Items[i])
+		}
+
+		return apis, out.NextToken, nil
+	})
+}
+
+// Searches by ARN e.g. `arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5`
+func apiGatewayV2APISearchFunc(ctx context.Context, client apiGatewayV2Client, scope, query string) ([]*types.Api, error) {
+	sections, err := parseAPIGatewayV2ARN(query, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sections) != 2 || sections[0] != "apis" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "ARN is not for an API Gateway v2 API: " + query,
+		}
+	}
+
+	api, err := apiGatewayV2APIGetFunc(ctx, client, scope, sections[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.Api{api}, nil
+}
+
+func apiGatewayV2APIOutputMapper(_, scope string, awsItem *types.Api) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-api",
+		UniqueAttribute: "ApiId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	if len(awsItem.Warnings) > 0 {
+		// The API was imported with warnings
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	for _, childType := range []string{
+		"apigatewayv2-stage",
+		"apigatewayv2-route",
+		"apigatewayv2-integration",
+		"apigatewayv2-authorizer",
+	} {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   childType,
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.ApiId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// They are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.ApiEndpoint != nil {
+		// The endpoint is https:// for HTTP APIs and wss:// for WebSocket
+		// APIs
+		if u, err := url.Parse(*awsItem.ApiEndpoint); err == nil && u.Hostname() != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  u.Hostname(),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always links
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2APIAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Api, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Api, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-api",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2APIAdapterMetadata,
+		GetFunc:         apiGatewayV2APIGetFunc,
+		ListFunc:        apiGatewayV2APIListFunc,
+		SearchFunc:      apiGatewayV2APISearchFunc,
+		ItemMapper:      apiGatewayV2APIOutputMapper,
+	}
+}
+
+var apiGatewayV2APIAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-api",
+	DescriptiveName: "API Gateway v2 API",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an HTTP or WebSocket API by ID",
+		List:              true,
+		ListDescription:   "List all HTTP and WebSocket APIs",
+		Search:            true,
+		SearchDescription: "Search for an HTTP or WebSocket API by ARN",
+	},
+	PotentialLinks: []string{"apigatewayv2-stage", "apigatewayv2-route", "apigatewayv2-integration", "apigatewayv2-authorizer", "dns"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_api.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2APIAdapterMetadata, sdp.AttributeSchemaFor(&types.Api{}, "tags"))
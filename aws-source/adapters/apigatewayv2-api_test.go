@@ -0,0 +1,98 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAPIGatewayV2APIOutputMapper(t *testing.T) {
+	adapter := NewAPIGatewayV2APIAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["env"] != "prod" {
+		t.Errorf("expected tag env=prod, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-stage",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-route",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-integration",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-authorizer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5.execute-api.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAPIGatewayV2APISearch(t *testing.T) {
+	adapter := NewAPIGatewayV2APIAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || items[0].UniqueAttributeValue() != "a1b2c3d4e5" {
+		t.Errorf("expected API a1b2c3d4e5, got %v", items)
+	}
+
+	if _, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5/stages/prod", false); err == nil {
+		t.Error("expected the ARN of a stage to be rejected")
+	}
+}
+
+func TestNewAPIGatewayV2APIAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2APIAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
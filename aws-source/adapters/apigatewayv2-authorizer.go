@@ -0,0 +1,185 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// apiGatewayV2Authorizer is an authorizer along with the API that it belongs
+// to, which isn't returned by the API
+type apiGatewayV2Authorizer struct {
+	// The ID of the API and the ID of the authorizer separated by a slash
+	UniqueName string
+	ApiId      string
+	types.Authorizer
+}
+
+func newAPIGatewayV2Authorizer(apiID string, authorizer types.Authorizer) *apiGatewayV2Authorizer {
+	return &apiGatewayV2Authorizer{
+		UniqueName: fmt.Sprintf("%s/%s", apiID, *authorizer.AuthorizerId),
+		ApiId:      apiID,
+		Authorizer: authorizer,
+	}
+}
+
+func convertGetAuthorizerOutputToAuthorizerV2(output *apigatewayv2.GetAuthorizerOutput) types.Authorizer {
+	return types.Authorizer{
+		AuthorizerCredentialsArn:       output.AuthorizerCredentialsArn,
+		AuthorizerId:                   output.AuthorizerId,
+		AuthorizerPayloadFormatVersion: output.AuthorizerPayloadFormatVersion,
+		AuthorizerResultTtlInSeconds:   output.AuthorizerResultTtlInSeconds,
+		AuthorizerType:                 output.AuthorizerType,
+		AuthorizerUri:                  output.AuthorizerUri,
+		EnableSimpleResponses:          output.EnableSimpleResponses,
+		IdentitySource:                 output.IdentitySource,
+		IdentityValidationExpression:   output.IdentityValidationExpression,
+		JwtConfiguration:               output.JwtConfiguration,
+		Name:                           output.Name,
+	}
+}
+
+func apiGatewayV2AuthorizerGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*apiGatewayV2Authorizer, error) {
+	apiID, authorizerID, err := parseAPIGatewayV2ChildQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetAuthorizer(ctx, &apigatewayv2.GetAuthorizerInput{
+		ApiId:        &apiID,
+		AuthorizerId: &authorizerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAPIGatewayV2Authorizer(apiID, convertGetAuthorizerOutputToAuthorizerV2(out)), nil
+}
+
+func apiGatewayV2AuthorizerSearchFunc(ctx context.Context, client apiGatewayV2Client, _, query string) ([]*apiGatewayV2Authorizer, error) {
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*apiGatewayV2Authorizer, *string, error) {
+		out, err := client.GetAuthorizers(ctx, &apigatewayv2.GetAuthorizersInput{
+			ApiId:     &query,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		authorizers := make([]*apiGatewayV2Authorizer, 0, len(out.Items))
+		for _, authorizer := range out.Items {
+			authorizers = append(authorizers, newAPIGatewayV2Authorizer(query, authorizer))
+		}
+
+		return authorizers, out.NextToken, nil
+	})
+}
+
+func apiGatewayV2AuthorizerOutputMapper(_, scope string, awsItem *apiGatewayV2Authorizer) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-authorizer",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apiGatewayV2APILink(awsItem.ApiId, scope))
+
+	if awsItem.AuthorizerUri != nil {
+		if link := apiGatewayV2LambdaLink(*awsItem.AuthorizerUri); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.AuthorizerCredentialsArn != nil {
+		if link := apiGatewayV2RoleLink(*awsItem.AuthorizerCredentialsArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.JwtConfiguration != nil && awsItem.JwtConfiguration.Issuer != nil {
+		if region, userPoolID, ok := cognitoUserPoolFromIssuer(*awsItem.JwtConfiguration.Issuer); ok {
+			// The issuer doesn't include the account, we assume that the
+			// user pool is in the same account as the API
+			accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cognito-idp-user-pool",
+					Method: sdp.QueryMethod_GET,
+					Query:  userPoolID,
+					Scope:  adapterhelpers.FormatScope(accountID, region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the user pool could stop users from being
+					// authorized
+					In: true,
+					// The authorizer won't affect the user pool
+					Out: false,
+				},
+			})
+		} else {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "http",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.JwtConfiguration.Issuer,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The authorizer fetches the keys from the issuer
+					In: true,
+					// The authorizer won't affect the issuer
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2AuthorizerAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*apiGatewayV2Authorizer, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*apiGatewayV2Authorizer, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-authorizer",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2AuthorizerAdapterMetadata,
+		GetFunc:         apiGatewayV2AuthorizerGetFunc,
+		DisableList:     true,
+		SearchFunc:      apiGatewayV2AuthorizerSearchFunc,
+		ItemMapper:      apiGatewayV2AuthorizerOutputMapper,
+	}
+}
+
+var apiGatewayV2AuthorizerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-authorizer",
+	DescriptiveName: "API Gateway v2 Authorizer",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an authorizer by API ID and authorizer ID: api-id/authorizer-id",
+		Search:            true,
+		SearchDescription: "Search for the authorizers of an API by API ID",
+	},
+	PotentialLinks: []string{"apigatewayv2-api", "lambda-function", "iam-role", "cognito-idp-user-pool", "http"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apigatewayv2_authorizer.api_id",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2AuthorizerAdapterMetadata, sdp.AttributeSchemaFor(&apiGatewayV2Authorizer{}))
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAPIGatewayV2AuthorizerGet(t *testing.T) {
+	adapter := NewAPIGatewayV2AuthorizerAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5/jwt123", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAPIGatewayV2AuthorizerSearch(t *testing.T) {
+	adapter := NewAPIGatewayV2AuthorizerAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 authorizer, got %v", len(items))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, items[0])
+}
+
+func TestNewAPIGatewayV2AuthorizerAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2AuthorizerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
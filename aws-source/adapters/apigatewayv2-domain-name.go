@@ -0,0 +1,291 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// apiGatewayV2DomainName is a custom domain name along with the API mappings
+// that route requests for the domain to the stages of APIs
+type apiGatewayV2DomainName struct {
+	types.DomainName
+	ApiMappings []types.ApiMapping
+}
+
+func convertGetDomainNameOutputToDomainNameV2(output *apigatewayv2.GetDomainNameOutput) types.DomainName {
+	return types.DomainName{
+		ApiMappingSelectionExpression: output.ApiMappingSelectionExpression,
+		DomainName:                    output.DomainName,
+		DomainNameArn:                 output.DomainNameArn,
+		DomainNameConfigurations:      output.DomainNameConfigurations,
+		MutualTlsAuthentication:       output.MutualTlsAuthentication,
+		RoutingMode:                   output.RoutingMode,
+		Tags:                          output.Tags,
+	}
+}
+
+func apiGatewayV2DomainNameWithMappings(ctx context.Context, client apiGatewayV2Client, domainName types.DomainName) (*apiGatewayV2DomainName, error) {
+	mappings, err := listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]types.ApiMapping, *string, error) {
+		out, err := client.GetApiMappings(ctx, &apigatewayv2.GetApiMappingsInput{
+			DomainName: domainName.DomainName,
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Items, out.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiGatewayV2DomainName{
+		DomainName:  domainName,
+		ApiMappings: mappings,
+	}, nil
+}
+
+func apiGatewayV2DomainNameGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*apiGatewayV2DomainName, error) {
+	out, err := client.GetDomainName(ctx, &apigatewayv2.GetDomainNameInput{
+		DomainName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiGatewayV2DomainNameWithMappings(ctx, client, convertGetDomainNameOutputToDomainNameV2(out))
+}
+
+func apiGatewayV2DomainNameListFunc(ctx context.Context, client apiGatewayV2Client, _ string) ([]*apiGatewayV2DomainName, error) {
+	domainNames, err := listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]types.DomainName, *string, error) {
+		out, err := client.GetDomainNames(ctx, &apigatewayv2.GetDomainNamesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Items, out.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*apiGatewayV2DomainName, 0, len(domainNames))
+	for _, domainName := range domainNames {
+		item, err := apiGatewayV2DomainNameWithMappings(ctx, client, domainName)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func apiGatewayV2DomainNameOutputMapper(_, scope string, awsItem *apiGatewayV2DomainName) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-domain-name",
+		UniqueAttribute: "DomainName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	if awsItem.DomainName.DomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.DomainName.DomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	// Domain names have one configuration per endpoint, the domain name is
+	// pending if any of them are
+	for _, config := range awsItem.DomainNameConfigurations {
+		switch config.DomainNameStatus {
+		case types.DomainNameStatusAvailable:
+			if item.Health == nil {
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			}
+		case types.DomainNameStatusUpdating,
+			types.DomainNameStatusPendingCertificateReimport,
+			types.DomainNameStatusPendingOwnershipVerification:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		if config.ApiGatewayDomainName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *config.ApiGatewayDomainName,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always links
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if config.HostedZoneId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53-hosted-zone",
+					Method: sdp.QueryMethod_GET,
+					Query:  *config.HostedZoneId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the hosted zone can affect the domain name
+					In: true,
+					// The domain name won't affect the hosted zone
+					Out: false,
+				},
+			})
+		}
+
+		for _, certificateARN := range []*string{config.CertificateArn, config.OwnershipVerificationCertificateArn} {
+			if certificateARN == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*certificateARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "acm-certificate",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *certificateARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// They are tightly linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.MutualTlsAuthentication != nil && awsItem.MutualTlsAuthentication.TruststoreUri != nil {
+		// The truststore is in the format s3://{bucket}/{key}
+		if u, err := url.Parse(*awsItem.MutualTlsAuthentication.TruststoreUri); err == nil && u.Scheme == "s3" {
+			accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  u.Host,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the truststore changes which clients can
+					// connect
+					In: true,
+					// The domain name won't affect the bucket
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, mapping := range awsItem.ApiMappings {
+		if mapping.ApiId == nil {
+			continue
+		}
+
+		// The domain name sends requests to the API
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-api",
+				Method: sdp.QueryMethod_GET,
+				Query:  *mapping.ApiId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the API would break the domain name
+				In: true,
+				// Changing the domain name changes how the API is reached
+				Out: true,
+			},
+		})
+
+		if mapping.Stage != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigatewayv2-stage",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", *mapping.ApiId, *mapping.Stage),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the stage would break the domain name
+					In: true,
+					// Changing the domain name changes how the stage is
+					// reached
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2DomainNameAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*apiGatewayV2DomainName, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*apiGatewayV2DomainName, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-domain-name",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2DomainNameAdapterMetadata,
+		GetFunc:         apiGatewayV2DomainNameGetFunc,
+		ListFunc:        apiGatewayV2DomainNameListFunc,
+		ItemMapper:      apiGatewayV2DomainNameOutputMapper,
+	}
+}
+
+var apiGatewayV2DomainNameAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-domain-name",
+	DescriptiveName: "API Gateway v2 Domain Name",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		GetDescription:  "Get a custom domain name by domain name",
+		List:            true,
+		ListDescription: "List all custom domain names",
+	},
+	PotentialLinks: []string{"dns", "route53-hosted-zone", "acm-certificate", "s3-bucket", "apigatewayv2-api", "apigatewayv2-stage"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_domain_name.domain_name"},
+		{TerraformQueryMap: "aws_apigatewayv2_api_mapping.domain_name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2DomainNameAdapterMetadata, sdp.AttributeSchemaFor(&apiGatewayV2DomainName{}, "tags"))
@@ -0,0 +1,233 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// apiGatewayV2Integration is an integration along with the API that it belongs
+// to, which isn't returned by the API
+type apiGatewayV2Integration struct {
+	// The ID of the API and the ID of the integration separated by a slash
+	UniqueName string
+	ApiId      string
+	types.Integration
+}
+
+func newAPIGatewayV2Integration(apiID string, integration types.Integration) *apiGatewayV2Integration {
+	return &apiGatewayV2Integration{
+		UniqueName:  fmt.Sprintf("%s/%s", apiID, *integration.IntegrationId),
+		ApiId:       apiID,
+		Integration: integration,
+	}
+}
+
+func convertGetIntegrationOutputToIntegrationV2(output *apigatewayv2.GetIntegrationOutput) types.Integration {
+	return types.Integration{
+		ApiGatewayManaged:                      output.ApiGatewayManaged,
+		ConnectionId:                           output.ConnectionId,
+		ConnectionType:                         output.ConnectionType,
+		ContentHandlingStrategy:                output.ContentHandlingStrategy,
+		CredentialsArn:                         output.CredentialsArn,
+		Description:                            output.Description,
+		IntegrationId:                          output.IntegrationId,
+		IntegrationMethod:                      output.IntegrationMethod,
+		IntegrationResponseSelectionExpression: output.IntegrationResponseSelectionExpression,
+		IntegrationSubtype:                     output.IntegrationSubtype,
+		IntegrationType:                        output.IntegrationType,
+		IntegrationUri:                         output.IntegrationUri,
+		PassthroughBehavior:                    output.PassthroughBehavior,
+		PayloadFormatVersion:                   output.PayloadFormatVersion,
+		RequestParameters:                      output.RequestParameters,
+		RequestTemplates:                       output.RequestTemplates,
+		ResponseParameters:                     output.ResponseParameters,
+		TemplateSelectionExpression:            output.TemplateSelectionExpression,
+		TimeoutInMillis:                        output.TimeoutInMillis,
+		TlsConfig:                              output.TlsConfig,
+	}
+}
+
+func apiGatewayV2IntegrationGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*apiGatewayV2Integration, error) {
+	apiID, integrationID, err := parseAPIGatewayV2ChildQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetIntegration(ctx, &apigatewayv2.GetIntegrationInput{
+		ApiId:         &apiID,
+		IntegrationId: &integrationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAPIGatewayV2Integration(apiID, convertGetIntegrationOutputToIntegrationV2(out)), nil
+}
+
+func apiGatewayV2IntegrationSearchFunc(ctx context.Context, client apiGatewayV2Client, _, query string) ([]*apiGatewayV2Integration, error) {
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*apiGatewayV2Integration, *string, error) {
+		out, err := client.GetIntegrations(ctx, &apigatewayv2.GetIntegrationsInput{
+			ApiId:     &query,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		integrations := make([]*apiGatewayV2Integration, 0, len(out.Items))
+		for _, integration := range out.Items {
+			integrations = append(integrations, newAPIGatewayV2Integration(query, integration))
+		}
+
+		return integrations, out.NextToken, nil
+	})
+}
+
+// Links to the backend that the integration sends requests to. Depending on the
+// type of the integration the URI is a Lambda function, the listener of a
+// private load balancer, a Cloud Map service, or a public URL
+func apiGatewayV2IntegrationURILink(uri string) *sdp.LinkedItemQuery {
+	if link := apiGatewayV2LambdaLink(uri); link != nil {
+		return link
+	}
+
+	blastPropagation := &sdp.BlastPropagation{
+		// Changing the backend changes how requests are handled
+		In: true,
+		// The integration sends requests to the backend
+		Out: true,
+	}
+
+	if a, err := adapterhelpers.ParseARN(uri); err == nil {
+		switch a.Service {
+		case "elasticloadbalancing":
+			return &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elbv2-listener",
+					Method: sdp.QueryMethod_GET,
+					Query:  uri,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: blastPropagation,
+			}
+		case "servicediscovery":
+			return &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "servicediscovery-service",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  uri,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: blastPropagation,
+			}
+		}
+
+		return nil
+	}
+
+	if u, err := url.Parse(uri); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  uri,
+				Scope:  "global",
+			},
+			BlastPropagation: blastPropagation,
+		}
+	}
+
+	return nil
+}
+
+func apiGatewayV2IntegrationOutputMapper(_, scope string, awsItem *apiGatewayV2Integration) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-integration",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apiGatewayV2APILink(awsItem.ApiId, scope))
+
+	if awsItem.ConnectionType == types.ConnectionTypeVpcLink && awsItem.ConnectionId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-vpc-link",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ConnectionId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the VPC link goes away, so does the integration
+				In: true,
+				// If the integration goes away, the VPC link is still there
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.IntegrationUri != nil {
+		// Integrations with AWS services such as SQS don't have a URI that
+		// we can link to
+		if link := apiGatewayV2IntegrationURILink(strings.TrimSpace(*awsItem.IntegrationUri)); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.CredentialsArn != nil {
+		if link := apiGatewayV2RoleLink(*awsItem.CredentialsArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2IntegrationAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*apiGatewayV2Integration, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*apiGatewayV2Integration, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-integration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2IntegrationAdapterMetadata,
+		GetFunc:         apiGatewayV2IntegrationGetFunc,
+		DisableList:     true,
+		SearchFunc:      apiGatewayV2IntegrationSearchFunc,
+		ItemMapper:      apiGatewayV2IntegrationOutputMapper,
+	}
+}
+
+var apiGatewayV2IntegrationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-integration",
+	DescriptiveName: "API Gateway v2 Integration",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an integration by API ID and integration ID: api-id/integration-id",
+		Search:            true,
+		SearchDescription: "Search for the integrations of an API by API ID",
+	},
+	PotentialLinks: []string{"apigatewayv2-api", "apigatewayv2-vpc-link", "lambda-function", "elbv2-listener", "servicediscovery-service", "http", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apigatewayv2_integration.api_id",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2IntegrationAdapterMetadata, sdp.AttributeSchemaFor(&apiGatewayV2Integration{}))
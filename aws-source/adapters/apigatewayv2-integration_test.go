@@ -0,0 +1,116 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAPIGatewayV2IntegrationGet(t *testing.T) {
+	adapter := NewAPIGatewayV2IntegrationAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5/int456", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAPIGatewayV2IntegrationSearch(t *testing.T) {
+	adapter := NewAPIGatewayV2IntegrationAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 integration, got %v", len(items))
+	}
+
+	validateAttributeSchema(t, items[0])
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-vpc-link",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vl1234",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-listener",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testAPIGatewayV2ListenerARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, items[0])
+}
+
+func TestAPIGatewayV2IntegrationURILink(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:servicediscovery:eu-west-2:123456789012:service/srv-abcdefghijklmnop": "servicediscovery-service",
+		"https://backend.example.com/orders":                                           "http",
+		"arn:aws:lambda:eu-west-2:123456789012:function:orders":                        "lambda-function",
+	}
+
+	for uri, expectedType := range tests {
+		link := apiGatewayV2IntegrationURILink(uri)
+		if link == nil {
+			t.Errorf("expected a link for %v", uri)
+			continue
+		}
+
+		if link.GetQuery().GetType() != expectedType {
+			t.Errorf("expected %v to link to %v, got %v", uri, expectedType, link.GetQuery().GetType())
+		}
+	}
+
+	if link := apiGatewayV2IntegrationURILink("arn:aws:apigateway:eu-west-2:sqs:path/123456789012/orders"); link != nil {
+		t.Errorf("expected no link for an AWS service integration, got %v", link)
+	}
+}
+
+func TestNewAPIGatewayV2IntegrationAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2IntegrationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
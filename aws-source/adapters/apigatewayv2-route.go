@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// apiGatewayV2Route is a route along with the API that it belongs to, which
+// isn't returned by the API
+type apiGatewayV2Route struct {
+	// The ID of the API and the ID of the route separated by a slash
+	UniqueName string
+	ApiId      string
+	types.Route
+}
+
+func newAPIGatewayV2Route(apiID string, route types.Route) *apiGatewayV2Route {
+	return &apiGatewayV2Route{
+		UniqueName: fmt.Sprintf("%s/%s", apiID, *route.RouteId),
+		ApiId:      apiID,
+		Route:      route,
+	}
+}
+
+func convertGetRouteOutputToRoute(output *apigatewayv2.GetRouteOutput) types.Route {
+	return types.Route{
+		ApiGatewayManaged:                output.ApiGatewayManaged,
+		ApiKeyRequired:                   output.ApiKeyRequired,
+		AuthorizationScopes:              output.AuthorizationScopes,
+		AuthorizationType:                output.AuthorizationType,
+		AuthorizerId:                     output.AuthorizerId,
+		ModelSelectionExpression:         output.ModelSelectionExpression,
+		OperationName:                    output.OperationName,
+		RequestModels:                    output.RequestModels,
+		RequestParameters:                output.RequestParameters,
+		RouteId:                          output.RouteId,
+		RouteKey:                         output.RouteKey,
+		RouteResponseSelectionExpression: output.RouteResponseSelectionExpression,
+		Target:                           output.Target,
+	}
+}
+
+func apiGatewayV2RouteGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*apiGatewayV2Route, error) {
+	apiID, routeID, err := parseAPIGatewayV2ChildQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetRoute(ctx, &apigatewayv2.GetRouteInput{
+		ApiId:   &apiID,
+		RouteId: &routeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAPIGatewayV2Route(apiID, convertGetRouteOutputToRoute(out)), nil
+}
+
+func apiGatewayV2RouteSearchFunc(ctx context.Context, client apiGatewayV2Client, _, query string) ([]*apiGatewayV2Route, error) {
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*apiGatewayV2Route, *string, error) {
+		out, err := client.GetRoutes(ctx, &apigatewayv2.GetRoutesInput{
+			ApiId:     &query,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		routes := make([]*apiGatewayV2Route, 0, len(out.Items))
+		for _, route := range out.Items {
+			routes = append(routes, newAPIGatewayV2Route(query, route))
+		}
+
+		return routes, out.NextToken, nil
+	})
+}
+
+func apiGatewayV2RouteOutputMapper(_, scope string, awsItem *apiGatewayV2Route) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-route",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apiGatewayV2APILink(awsItem.ApiId, scope))
+
+	// The target is in the format `integrations/{integration-id}`
+	if awsItem.Target != nil {
+		if integrationID, found := strings.CutPrefix(*awsItem.Target, "integrations/"); found {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigatewayv2-integration",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", awsItem.ApiId, integrationID),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the integration changes how the route is
+					// handled
+					In: true,
+					// The route sends requests to the integration
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.AuthorizerId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-authorizer",
+				Method: sdp.QueryMethod_GET,
+				Query:  fmt.Sprintf("%s/%s", awsItem.ApiId, *awsItem.AuthorizerId),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the authorizer changes who can call the route
+				In: true,
+				// The route can't affect the authorizer
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2RouteAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*apiGatewayV2Route, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*apiGatewayV2Route, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-route",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2RouteAdapterMetadata,
+		GetFunc:         apiGatewayV2RouteGetFunc,
+		DisableList:     true,
+		SearchFunc:      apiGatewayV2RouteSearchFunc,
+		ItemMapper:      apiGatewayV2RouteOutputMapper,
+	}
+}
+
+var apiGatewayV2RouteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-route",
+	DescriptiveName: "API Gateway v2 Route",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a route by API ID and route ID: api-id/route-id",
+		Search:            true,
+		SearchDescription: "Search for the routes of an API by API ID",
+	},
+	PotentialLinks: []string{"apigatewayv2-api", "apigatewayv2-integration", "apigatewayv2-authorizer"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apigatewayv2_route.api_id",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2RouteAdapterMetadata, sdp.AttributeSchemaFor(&apiGatewayV2Route{}))
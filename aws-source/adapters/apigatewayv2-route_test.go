@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAPIGatewayV2RouteGet(t *testing.T) {
+	adapter := NewAPIGatewayV2RouteAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5/route123", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-integration",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5/int123",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-authorizer",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5/auth123",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if _, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "route123", false); err == nil {
+		t.Error("expected an error for a query without the API ID")
+	}
+}
+
+func TestAPIGatewayV2RouteSearch(t *testing.T) {
+	adapter := NewAPIGatewayV2RouteAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 route, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "a1b2c3d4e5/route123" {
+		t.Errorf("expected unique attribute value a1b2c3d4e5/route123, got %v", items[0].UniqueAttributeValue())
+	}
+
+	// The $default route has no target or authorizer
+	if len(items[0].GetLinkedItemQueries()) != 1 {
+		t.Errorf("expected only the link to the API, got %v", items[0].GetLinkedItemQueries())
+	}
+}
+
+func TestNewAPIGatewayV2RouteAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2RouteAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
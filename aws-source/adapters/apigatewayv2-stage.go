@@ -0,0 +1,172 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// apiGatewayV2Stage is a stage along with the API that it belongs to, which
+// isn't returned by the API
+type apiGatewayV2Stage struct {
+	// The ID of the API and the name of the stage separated by a slash
+	UniqueName string
+	ApiId      string
+	types.Stage
+}
+
+func newAPIGatewayV2Stage(apiID string, stage types.Stage) *apiGatewayV2Stage {
+	return &apiGatewayV2Stage{
+		UniqueName: fmt.Sprintf("%s/%s", apiID, *stage.StageName),
+		ApiId:      apiID,
+		Stage:      stage,
+	}
+}
+
+func convertGetStageOutputToStageV2(output *apigatewayv2.GetStageOutput) types.Stage {
+	return types.Stage{
+		AccessLogSettings:           output.AccessLogSettings,
+		ApiGatewayManaged:           output.ApiGatewayManaged,
+		AutoDeploy:                  output.AutoDeploy,
+		ClientCertificateId:         output.ClientCertificateId,
+		CreatedDate:                 output.CreatedDate,
+		DefaultRouteSettings:        output.DefaultRouteSettings,
+		DeploymentId:                output.DeploymentId,
+		Description:                 output.Description,
+		LastDeploymentStatusMessage: output.LastDeploymentStatusMessage,
+		LastUpdatedDate:             output.LastUpdatedDate,
+		RouteSettings:               output.RouteSettings,
+		StageName:                   output.StageName,
+		StageVariables:              output.StageVariables,
+		Tags:                        output.Tags,
+	}
+}
+
+func apiGatewayV2StageGet(ctx context.Context, client apiGatewayV2Client, apiID string, stageName string) (*apiGatewayV2Stage, error) {
+	out, err := client.GetStage(ctx, &apigatewayv2.GetStageInput{
+		ApiId:     &apiID,
+		StageName: &stageName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAPIGatewayV2Stage(apiID, convertGetStageOutputToStageV2(out)), nil
+}
+
+func apiGatewayV2StageGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*apiGatewayV2Stage, error) {
+	apiID, stageName, err := parseAPIGatewayV2ChildQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiGatewayV2StageGet(ctx, client, apiID, stageName)
+}
+
+// Searches by API ID, or by ARN e.g.
+// `arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5/stages/prod`
+func apiGatewayV2StageSearchFunc(ctx context.Context, client apiGatewayV2Client, scope, query string) ([]*apiGatewayV2Stage, error) {
+	if strings.HasPrefix(query, "arn:") {
+		sections, err := parseAPIGatewayV2ARN(query, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sections) != 4 || sections[0] != "apis" || sections[2] != "stages" {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: "ARN is not for an API Gateway v2 stage: " + query,
+			}
+		}
+
+		stage, err := apiGatewayV2StageGet(ctx, client, sections[1], sections[3])
+		if err != nil {
+			return nil, err
+		}
+
+		return []*apiGatewayV2Stage{stage}, nil
+	}
+
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*apiGatewayV2Stage, *string, error) {
+		out, err := client.GetStages(ctx, &apigatewayv2.GetStagesInput{
+			ApiId:     &query,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stages := make([]*apiGatewayV2Stage, 0, len(out.Items))
+		for _, stage := range out.Items {
+			stages = append(stages, newAPIGatewayV2Stage(query, stage))
+		}
+
+		return stages, out.NextToken, nil
+	})
+}
+
+func apiGatewayV2StageOutputMapper(_, scope string, awsItem *apiGatewayV2Stage) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-stage",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apiGatewayV2APILink(awsItem.ApiId, scope))
+
+	if awsItem.AccessLogSettings != nil && awsItem.AccessLogSettings.DestinationArn != nil {
+		if link := apiGatewayAccessLogLink(*awsItem.AccessLogSettings.DestinationArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2StageAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*apiGatewayV2Stage, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*apiGatewayV2Stage, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-stage",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2StageAdapterMetadata,
+		GetFunc:         apiGatewayV2StageGetFunc,
+		DisableList:     true,
+		SearchFunc:      apiGatewayV2StageSearchFunc,
+		ItemMapper:      apiGatewayV2StageOutputMapper,
+	}
+}
+
+var apiGatewayV2StageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-stage",
+	DescriptiveName: "API Gateway v2 Stage",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a stage by API ID and stage name: api-id/stage-name",
+		Search:            true,
+		SearchDescription: "Search for the stages of an API by API ID, or for a stage by ARN",
+	},
+	PotentialLinks: []string{"apigatewayv2-api", "logs-log-group", "firehose-delivery-stream"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apigatewayv2_stage.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2StageAdapterMetadata, sdp.AttributeSchemaFor(&apiGatewayV2Stage{}, "tags"))
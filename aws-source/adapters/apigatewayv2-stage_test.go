@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAPIGatewayV2StageGet(t *testing.T) {
+	adapter := NewAPIGatewayV2StageAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5/prod", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "a1b2c3d4e5/prod" {
+		t.Errorf("expected unique attribute value a1b2c3d4e5/prod, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "amazon-apigateway-access-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAPIGatewayV2StageSearch(t *testing.T) {
+	adapter := NewAPIGatewayV2StageAdapter(testAPIGatewayV2Client{}, "123456789012", "eu-west-2")
+
+	t.Run("API ID", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "a1b2c3d4e5", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 2 {
+			t.Fatalf("expected 2 stages, got %v", len(items))
+		}
+
+		if items[0].UniqueAttributeValue() != "a1b2c3d4e5/$default" {
+			t.Errorf("expected unique attribute value a1b2c3d4e5/$default, got %v", items[0].UniqueAttributeValue())
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5/stages/prod", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "a1b2c3d4e5/prod" {
+			t.Errorf("expected stage a1b2c3d4e5/prod, got %v", items)
+		}
+	})
+}
+
+func TestNewAPIGatewayV2StageAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2StageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
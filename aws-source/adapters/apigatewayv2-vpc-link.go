@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func convertGetVpcLinkOutputToVpcLink(output *apigatewayv2.GetVpcLinkOutput) *types.VpcLink {
+	return &types.VpcLink{
+		CreatedDate:          output.CreatedDate,
+		Name:                 output.Name,
+		SecurityGroupIds:     output.SecurityGroupIds,
+		SubnetIds:            output.SubnetIds,
+		Tags:                 output.Tags,
+		VpcLinkId:            output.VpcLinkId,
+		VpcLinkStatus:        output.VpcLinkStatus,
+		VpcLinkStatusMessage: output.VpcLinkStatusMessage,
+		VpcLinkVersion:       output.VpcLinkVersion,
+	}
+}
+
+func apiGatewayV2VpcLinkGetFunc(ctx context.Context, client apiGatewayV2Client, _, query string) (*types.VpcLink, error) {
+	out, err := client.GetVpcLink(ctx, &apigatewayv2.GetVpcLinkInput{
+		VpcLinkId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertGetVpcLinkOutputToVpcLink(out), nil
+}
+
+func apiGatewayV2VpcLinkListFunc(ctx context.Context, client apiGatewayV2Client, _ string) ([]*types.VpcLink, error) {
+	return listAPIGatewayV2Pages(ctx, func(ctx context.Context, nextToken *string) ([]*types.VpcLink, *string, error) {
+		out, err := client.GetVpcLinks(ctx, &apigatewayv2.GetVpcLinksInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vpcLinks := make([]*types.VpcLink, 0, len(out.Items))
+		for i := range out.Items {
+			vpcLinks = append(vpcLinks, &out.Items[i])
+		}
+
+		return vpcLinks, out.NextToken, nil
+	})
+}
+
+func apiGatewayV2VpcLinkOutputMapper(_, scope string, awsItem *types.VpcLink) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-vpc-link",
+		UniqueAttribute: "VpcLinkId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.VpcLinkStatus {
+	case types.VpcLinkStatusAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.VpcLinkStatusPending, types.VpcLinkStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.VpcLinkStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case types.VpcLinkStatusInactive:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	for _, subnetID := range awsItem.SubnetIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnetID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The VPC link creates network interfaces in the subnet
+				In: true,
+				// The VPC link can't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, securityGroupID := range awsItem.SecurityGroupIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  securityGroupID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the security group can stop traffic reaching the
+				// backend
+				In: true,
+				// The VPC link can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2VpcLinkAdapter(client apiGatewayV2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.VpcLink, apiGatewayV2Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.VpcLink, apiGatewayV2Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-vpc-link",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2VpcLinkAdapterMetadata,
+		GetFunc:         apiGatewayV2VpcLinkGetFunc,
+		ListFunc:        apiGatewayV2VpcLinkListFunc,
+		ItemMapper:      apiGatewayV2VpcLinkOutputMapper,
+	}
+}
+
+var apiGatewayV2VpcLinkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-vpc-link",
+	DescriptiveName: "API Gateway v2 VPC Link",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		GetDescription:  "Get a VPC link by ID",
+		List:            true,
+		ListDescription: "List all VPC links",
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_vpc_link.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(apiGatewayV2VpcLinkAdapterMetadata, sdp.AttributeSchemaFor(&types.VpcLink{}, "tags"))
@@ -0,0 +1,183 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type apiGatewayV2Client interface {
+	GetApi(ctx context.Context, params *apigatewayv2.GetApiInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiOutput, error)
+	GetApis(ctx context.Context, params *apigatewayv2.GetApisInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error)
+	GetApiMappings(ctx context.Context, params *apigatewayv2.GetApiMappingsInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiMappingsOutput, error)
+	GetAuthorizer(ctx context.Context, params *apigatewayv2.GetAuthorizerInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetAuthorizerOutput, error)
+	GetAuthorizers(ctx context.Context, params *apigatewayv2.GetAuthorizersInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetAuthorizersOutput, error)
+	GetDomainName(ctx context.Context, params *apigatewayv2.GetDomainNameInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetDomainNameOutput, error)
+	GetDomainNames(ctx context.Context, params *apigatewayv2.GetDomainNamesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetDomainNamesOutput, error)
+	GetIntegration(ctx context.Context, params *apigatewayv2.GetIntegrationInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetIntegrationOutput, error)
+	GetIntegrations(ctx context.Context, params *apigatewayv2.GetIntegrationsInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetIntegrationsOutput, error)
+	GetRoute(ctx context.Context, params *apigatewayv2.GetRouteInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetRouteOutput, error)
+	GetRoutes(ctx context.Context, params *apigatewayv2.GetRoutesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetRoutesOutput, error)
+	GetStage(ctx context.Context, params *apigatewayv2.GetStageInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStageOutput, error)
+	GetStages(ctx context.Context, params *apigatewayv2.GetStagesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error)
+	GetVpcLink(ctx context.Context, params *apigatewayv2.GetVpcLinkInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetVpcLinkOutput, error)
+	GetVpcLinks(ctx context.Context, params *apigatewayv2.GetVpcLinksInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetVpcLinksOutput, error)
+}
+
+// Lists all resources using the given function, which returns one page of
+// results and the token for the next page. None of the API Gateway v2 APIs
+// have paginators
+func listAPIGatewayV2Pages[T any](ctx context.Context, listPage func(ctx context.Context, nextToken *string) ([]T, *string, error)) ([]T, error) {
+	items := make([]T, 0)
+	var nextToken *string
+
+	for {
+		page, next, err := listPage(ctx, nextToken)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+
+		if next == nil || *next == "" {
+			return items, nil
+		}
+		nextToken = next
+	}
+}
+
+// The stages, routes, integrations and authorizers of an API are identified by
+// the ID of the API and their own ID (or name for stages) separated by a slash
+// e.g. `a1b2c3d4e5/prod`
+func parseAPIGatewayV2ChildQuery(query string) (string, string, error) {
+	apiID, childID, found := strings.Cut(query, "/")
+	if !found || apiID == "" || childID == "" || strings.Contains(childID, "/") {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: api-id/id, but found: %s", query),
+		}
+	}
+
+	return apiID, childID, nil
+}
+
+// Parses the ARN of an API Gateway resource and returns the sections of its
+// path e.g. `arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5/stages/prod`
+// returns `["apis", "a1b2c3d4e5", "stages", "prod"]`. These ARNs don't contain
+// an account ID, so only the region is checked against the scope
+func parseAPIGatewayV2ARN(arn string, scope string) ([]string, error) {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+		}
+	}
+
+	_, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Service != "apigateway" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not the ARN of an API Gateway resource", arn),
+		}
+	}
+
+	if a.Region != region {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN region %v does not match request scope %v", a.Region, scope),
+			Scope:       scope,
+		}
+	}
+
+	return strings.Split(strings.TrimPrefix(a.Resource, "/"), "/"), nil
+}
+
+// The link from a child resource back to its API
+func apiGatewayV2APILink(apiID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigatewayv2-api",
+			Method: sdp.QueryMethod_GET,
+			Query:  apiID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// They are tightly coupled
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+// Returns the ARN of the Lambda function that an integration or authorizer
+// invokes. The URI is either the ARN of the function, or an API Gateway
+// invocation URI that contains it e.g.
+// `arn:aws:apigateway:eu-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-2:123456789012:function:api/invocations`
+func apiGatewayV2LambdaARN(uri string) (*adapterhelpers.ARN, bool) {
+	if _, functionARN, found := strings.Cut(uri, "/functions/"); found {
+		uri = strings.TrimSuffix(functionARN, "/invocations")
+	}
+
+	a, err := adapterhelpers.ParseARN(uri)
+	if err != nil || a.Service != "lambda" {
+		return nil, false
+	}
+
+	return a, true
+}
+
+// Links to the Lambda function that an integration or authorizer invokes
+func apiGatewayV2LambdaLink(uri string) *sdp.LinkedItemQuery {
+	a, ok := apiGatewayV2LambdaARN(uri)
+	if !ok {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "lambda-function",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  a.String(),
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the function changes how requests are handled
+			In: true,
+			// The API sends requests to the function
+			Out: true,
+		},
+	}
+}
+
+// Links to the IAM role that API Gateway assumes to call a backend
+func apiGatewayV2RoleLink(roleARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(roleARN)
+	if err != nil || a.Service != "iam" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  roleARN,
+			Scope:  a.AccountID,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's permissions can break the API
+			In: true,
+			// The API can't affect the role
+			Out: false,
+		},
+	}
+}
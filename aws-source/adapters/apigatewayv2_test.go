@@ -0,0 +1,287 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	testAPIGatewayV2LambdaURI   = "arn:aws:apigateway:eu-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-2:123456789012:function:orders/invocations"
+	testAPIGatewayV2ListenerARN = "arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/internal/50dc6c495c0c9188/f2f7dc8efc522ab2"
+)
+
+type testAPIGatewayV2Client struct{}
+
+func (t testAPIGatewayV2Client) GetApi(ctx context.Context, params *apigatewayv2.GetApiInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiOutput, error) {
+	return &apigatewayv2.GetApiOutput{
+		ApiId:                    params.ApiId,
+		ApiEndpoint:              aws.String("https://" + *params.ApiId + ".execute-api.eu-west-2.amazonaws.com"),
+		Name:                     aws.String("orders"),
+		ProtocolType:             types.ProtocolTypeHttp,
+		RouteSelectionExpression: aws.String("$request.method $request.path"),
+		CreatedDate:              aws.Time(time.Now()),
+		Tags:                     map[string]string{"env": "prod"},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetApis(ctx context.Context, params *apigatewayv2.GetApisInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApisOutput, error) {
+	// The APIs are split over two pages, to check that we follow the token
+	if params.NextToken == nil {
+		return &apigatewayv2.GetApisOutput{
+			Items: []types.Api{
+				{ApiId: aws.String("a1b2c3d4e5"), Name: aws.String("orders"), ProtocolType: types.ProtocolTypeHttp},
+			},
+			NextToken: aws.String("page2"),
+		}, nil
+	}
+
+	return &apigatewayv2.GetApisOutput{
+		Items: []types.Api{
+			{ApiId: aws.String("f6g7h8i9j0"), Name: aws.String("chat"), ProtocolType: types.ProtocolTypeWebsocket},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetApiMappings(ctx context.Context, params *apigatewayv2.GetApiMappingsInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetApiMappingsOutput, error) {
+	return &apigatewayv2.GetApiMappingsOutput{
+		Items: []types.ApiMapping{
+			{
+				ApiMappingId:  aws.String("abc123"),
+				ApiMappingKey: aws.String("orders"),
+				ApiId:         aws.String("a1b2c3d4e5"),
+				Stage:         aws.String("prod"),
+			},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetAuthorizer(ctx context.Context, params *apigatewayv2.GetAuthorizerInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetAuthorizerOutput, error) {
+	return &apigatewayv2.GetAuthorizerOutput{
+		AuthorizerId:   params.AuthorizerId,
+		Name:           aws.String("jwt"),
+		AuthorizerType: types.AuthorizerTypeJwt,
+		IdentitySource: []string{"$request.header.Authorization"},
+		JwtConfiguration: &types.JWTConfiguration{
+			Audience: []string{"client-id"},
+			Issuer:   aws.String("https://cognito-idp.eu-west-2.amazonaws.com/eu-west-2_EXAMPLE"),
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetAuthorizers(ctx context.Context, params *apigatewayv2.GetAuthorizersInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetAuthorizersOutput, error) {
+	return &apigatewayv2.GetAuthorizersOutput{
+		Items: []types.Authorizer{
+			{
+				AuthorizerId:   aws.String("auth123"),
+				Name:           aws.String("lambda"),
+				AuthorizerType: types.AuthorizerTypeRequest,
+				AuthorizerUri:  aws.String(testAPIGatewayV2LambdaURI),
+			},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetDomainName(ctx context.Context, params *apigatewayv2.GetDomainNameInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetDomainNameOutput, error) {
+	return &apigatewayv2.GetDomainNameOutput{
+		DomainName: params.DomainName,
+		DomainNameConfigurations: []types.DomainNameConfiguration{
+			{
+				ApiGatewayDomainName: aws.String("d-abcde12345.execute-api.eu-west-2.amazonaws.com"),
+				CertificateArn:       aws.String("arn:aws:acm:eu-west-2:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+				DomainNameStatus:     types.DomainNameStatusAvailable,
+				EndpointType:         types.EndpointTypeRegional,
+				HostedZoneId:         aws.String("ZOJJZC49E0EPZ"),
+				SecurityPolicy:       types.SecurityPolicyTls12,
+			},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetDomainNames(ctx context.Context, params *apigatewayv2.GetDomainNamesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetDomainNamesOutput, error) {
+	return &apigatewayv2.GetDomainNamesOutput{
+		Items: []types.DomainName{
+			{DomainName: aws.String("api.example.com")},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetIntegration(ctx context.Context, params *apigatewayv2.GetIntegrationInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetIntegrationOutput, error) {
+	return &apigatewayv2.GetIntegrationOutput{
+		IntegrationId:        params.IntegrationId,
+		IntegrationType:      types.IntegrationTypeAwsProxy,
+		IntegrationUri:       aws.String(testAPIGatewayV2LambdaURI),
+		PayloadFormatVersion: aws.String("2.0"),
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetIntegrations(ctx context.Context, params *apigatewayv2.GetIntegrationsInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetIntegrationsOutput, error) {
+	return &apigatewayv2.GetIntegrationsOutput{
+		Items: []types.Integration{
+			{
+				IntegrationId:     aws.String("int123"),
+				IntegrationType:   types.IntegrationTypeHttpProxy,
+				IntegrationMethod: aws.String("ANY"),
+				ConnectionType:    types.ConnectionTypeVpcLink,
+				ConnectionId:      aws.String("vl1234"),
+				IntegrationUri:    aws.String(testAPIGatewayV2ListenerARN),
+			},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetRoute(ctx context.Context, params *apigatewayv2.GetRouteInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetRouteOutput, error) {
+	return &apigatewayv2.GetRouteOutput{
+		RouteId:           params.RouteId,
+		RouteKey:          aws.String("GET /orders"),
+		AuthorizationType: types.AuthorizationTypeJwt,
+		AuthorizerId:      aws.String("auth123"),
+		Target:            aws.String("integrations/int123"),
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetRoutes(ctx context.Context, params *apigatewayv2.GetRoutesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetRoutesOutput, error) {
+	return &apigatewayv2.GetRoutesOutput{
+		Items: []types.Route{
+			{
+				RouteId:           aws.String("route123"),
+				RouteKey:          aws.String("$default"),
+				AuthorizationType: types.AuthorizationTypeNone,
+			},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetStage(ctx context.Context, params *apigatewayv2.GetStageInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStageOutput, error) {
+	return &apigatewayv2.GetStageOutput{
+		StageName:    params.StageName,
+		AutoDeploy:   aws.Bool(true),
+		DeploymentId: aws.String("dep123"),
+		AccessLogSettings: &types.AccessLogSettings{
+			DestinationArn: aws.String("arn:aws:firehose:eu-west-2:123456789012:deliverystream/amazon-apigateway-access-logs"),
+		},
+		Tags: map[string]string{"env": "prod"},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetStages(ctx context.Context, params *apigatewayv2.GetStagesInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetStagesOutput, error) {
+	return &apigatewayv2.GetStagesOutput{
+		Items: []types.Stage{
+			{StageName: aws.String("$default")},
+			{StageName: aws.String("prod")},
+		},
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetVpcLink(ctx context.Context, params *apigatewayv2.GetVpcLinkInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetVpcLinkOutput, error) {
+	return &apigatewayv2.GetVpcLinkOutput{
+		VpcLinkId:        params.VpcLinkId,
+		Name:             aws.String("internal"),
+		SubnetIds:        []string{"subnet-0123456789abcdef0"},
+		SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+		VpcLinkStatus:    types.VpcLinkStatusFailed,
+		VpcLinkVersion:   types.VpcLinkVersionV2,
+	}, nil
+}
+
+func (t testAPIGatewayV2Client) GetVpcLinks(ctx context.Context, params *apigatewayv2.GetVpcLinksInput, optFns ...func(*apigatewayv2.Options)) (*apigatewayv2.GetVpcLinksOutput, error) {
+	return &apigatewayv2.GetVpcLinksOutput{
+		Items: []types.VpcLink{
+			{
+				VpcLinkId:     aws.String("vl1234"),
+				Name:          aws.String("internal"),
+				VpcLinkStatus: types.VpcLinkStatusAvailable,
+			},
+		},
+	}, nil
+}
+
+func TestParseAPIGatewayV2ChildQuery(t *testing.T) {
+	apiID, childID, err := parseAPIGatewayV2ChildQuery("a1b2c3d4e5/prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if apiID != "a1b2c3d4e5" || childID != "prod" {
+		t.Errorf("unexpected API ID %v and child ID %v", apiID, childID)
+	}
+
+	for _, query := range []string{"a1b2c3d4e5", "a1b2c3d4e5/", "/prod", "a1b2c3d4e5/prod/extra"} {
+		if _, _, err := parseAPIGatewayV2ChildQuery(query); err == nil {
+			t.Errorf("expected an error for %v", query)
+		}
+	}
+}
+
+func TestParseAPIGatewayV2ARN(t *testing.T) {
+	t.Run("stage", func(t *testing.T) {
+		sections, err := parseAPIGatewayV2ARN("arn:aws:apigateway:eu-west-2::/apis/a1b2c3d4e5/stages/prod", "123456789012.eu-west-2")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"apis", "a1b2c3d4e5", "stages", "prod"}
+		if len(sections) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, sections)
+		}
+
+		for i := range expected {
+			if sections[i] != expected[i] {
+				t.Errorf("expected %v, got %v", expected, sections)
+			}
+		}
+	})
+
+	t.Run("wrong region", func(t *testing.T) {
+		_, err := parseAPIGatewayV2ARN("arn:aws:apigateway:us-east-1::/apis/a1b2c3d4e5", "123456789012.eu-west-2")
+
+		var queryErr *sdp.QueryError
+		if !errors.As(err, &queryErr) || queryErr.GetErrorType() != sdp.QueryError_NOSCOPE {
+			t.Errorf("expected a NOSCOPE error, got %v", err)
+		}
+	})
+
+	t.Run("wrong service", func(t *testing.T) {
+		if _, err := parseAPIGatewayV2ARN("arn:aws:lambda:eu-west-2:123456789012:function:orders", "123456789012.eu-west-2"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestAPIGatewayV2LambdaARN(t *testing.T) {
+	for _, uri := range []string{
+		testAPIGatewayV2LambdaURI,
+		"arn:aws:lambda:eu-west-2:123456789012:function:orders",
+	} {
+		a, ok := apiGatewayV2LambdaARN(uri)
+		if !ok {
+			t.Fatalf("expected %v to contain a function ARN", uri)
+		}
+
+		if a.String() != "arn:aws:lambda:eu-west-2:123456789012:function:orders" {
+			t.Errorf("unexpected function ARN %v", a.String())
+		}
+	}
+
+	if _, ok := apiGatewayV2LambdaARN(testAPIGatewayV2ListenerARN); ok {
+		t.Error("expected a listener ARN not to be a function")
+	}
+}
+
+func TestListAPIGatewayV2Pages(t *testing.T) {
+	apis, err := apiGatewayV2APIListFunc(context.Background(), testAPIGatewayV2Client{}, "123456789012.eu-west-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(apis) != 2 {
+		t.Errorf("expected 2 APIs from 2 pages, got %v", len(apis))
+	}
+}
@@ -0,0 +1,249 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// applicationAutoScalingScalableTarget is a scalable target along with its ID,
+// since a resource can have a target for each of its dimensions
+type applicationAutoScalingScalableTarget struct {
+	// The namespace, resource ID and dimension separated by slashes
+	UniqueName string
+	types.ScalableTarget
+}
+
+func describeApplicationAutoScalingScalableTargets(ctx context.Context, client applicationAutoScalingClient, input *applicationautoscaling.DescribeScalableTargetsInput) ([]*applicationAutoScalingScalableTarget, error) {
+	targets := make([]*applicationAutoScalingScalableTarget, 0)
+	paginator := applicationautoscaling.NewDescribeScalableTargetsPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range out.ScalableTargets {
+			if target.ResourceId == nil {
+				continue
+			}
+
+			targets = append(targets, &applicationAutoScalingScalableTarget{
+				UniqueName:     applicationAutoScalingTargetID(target.ServiceNamespace, *target.ResourceId, target.ScalableDimension),
+				ScalableTarget: target,
+			})
+		}
+	}
+
+	return targets, nil
+}
+
+func applicationAutoScalingScalableTargetGetFunc(ctx context.Context, client applicationAutoScalingClient, scope, query string) (*applicationAutoScalingScalableTarget, error) {
+	namespace, resourceID, dimension, err := parseApplicationAutoScalingTargetID(scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if dimension == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must include the scalable dimension, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	targets, err := describeApplicationAutoScalingScalableTargets(ctx, client, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  namespace,
+		ResourceIds:       []string{resourceID},
+		ScalableDimension: dimension,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targets) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("expected 1 scalable target, got %v", len(targets)),
+			Scope:       scope,
+		}
+	}
+
+	return targets[0], nil
+}
+
+// Targets can only be described one namespace at a time, so every namespace is
+// described in turn
+func applicationAutoScalingScalableTargetListFunc(ctx context.Context, client applicationAutoScalingClient, _ string) ([]*applicationAutoScalingScalableTarget, error) {
+	targets := make([]*applicationAutoScalingScalableTarget, 0)
+
+	for _, namespace := range types.ServiceNamespace("").Values() {
+		namespaceTargets, err := describeApplicationAutoScalingScalableTargets(ctx, client, &applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace: namespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, namespaceTargets...)
+	}
+
+	return targets, nil
+}
+
+// Searches for a target by ARN, or for the targets of a resource by
+// {serviceNamespace}/{resourceId}
+func applicationAutoScalingScalableTargetSearchFunc(ctx context.Context, client applicationAutoScalingClient, scope, query string) ([]*applicationAutoScalingScalableTarget, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		namespace, resourceID, dimension, err := parseApplicationAutoScalingTargetID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return describeApplicationAutoScalingScalableTargets(ctx, client, &applicationautoscaling.DescribeScalableTargetsInput{
+			ServiceNamespace:  namespace,
+			ResourceIds:       []string{resourceID},
+			ScalableDimension: dimension,
+		})
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	// The ARN doesn't include the resource, so all targets are described
+	targets, err := applicationAutoScalingScalableTargetListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range targets {
+		if target.ScalableTargetARN != nil && *target.ScalableTargetARN == query {
+			return []*applicationAutoScalingScalableTarget{target}, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("scalable target %v not found", query),
+		Scope:       scope,
+	}
+}
+
+func applicationAutoScalingScalableTargetItemMapper(_, scope string, awsItem *applicationAutoScalingScalableTarget) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "application-autoscaling-scalable-target",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Suspended scaling means the resource won't respond to load
+	item.Health = sdp.Health_HEALTH_OK.Enum()
+
+	if suspended := awsItem.SuspendedState; suspended != nil {
+		for _, s := range []*bool{suspended.DynamicScalingInSuspended, suspended.DynamicScalingOutSuspended, suspended.ScheduledScalingSuspended} {
+			if s != nil && *s {
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			}
+		}
+	}
+
+	if awsItem.ResourceId != nil {
+		if link := applicationAutoScalingResourceLink(scope, awsItem.ServiceNamespace, *awsItem.ResourceId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "application-autoscaling-scaling-policy",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  awsItem.UniqueName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The policies decide when the target is scaled
+			In: true,
+			// Changing the capacity limits changes what the policies can do
+			Out: true,
+		},
+	})
+
+	if awsItem.RoleARN != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		if link := iamRoleLink(accountID, *awsItem.RoleARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewApplicationAutoScalingScalableTargetAdapter(client applicationAutoScalingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*applicationAutoScalingScalableTarget, applicationAutoScalingClient, *applicationautoscaling.Options] {
+	return &adapterhelpers.GetListAdapter[*applicationAutoScalingScalableTarget, applicationAutoScalingClient, *applicationautoscaling.Options]{
+		ItemType:        "application-autoscaling-scalable-target",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: applicationAutoScalingScalableTargetAdapterMetadata,
+		GetFunc:         applicationAutoScalingScalableTargetGetFunc,
+		ListFunc:        applicationAutoScalingScalableTargetListFunc,
+		SearchFunc:      applicationAutoScalingScalableTargetSearchFunc,
+		ItemMapper:      applicationAutoScalingScalableTargetItemMapper,
+		ListTagsFunc: func(ctx context.Context, target *applicationAutoScalingScalableTarget, client applicationAutoScalingClient) (map[string]string, error) {
+			if target.ScalableTargetARN == nil {
+				return nil, nil
+			}
+
+			out, err := client.ListTagsForResource(ctx, &applicationautoscaling.ListTagsForResourceInput{
+				ResourceARN: target.ScalableTargetARN,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return out.Tags, nil
+		},
+	}
+}
+
+var applicationAutoScalingScalableTargetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "application-autoscaling-scalable-target",
+	DescriptiveName: "Application Auto Scaling Scalable Target",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a scalable target by {serviceNamespace}/{resourceId}/{scalableDimension}",
+		List:              true,
+		ListDescription:   "List all scalable targets",
+		Search:            true,
+		SearchDescription: "Search for a scalable target by ARN, or for the targets of a resource by {serviceNamespace}/{resourceId}",
+	},
+	PotentialLinks: []string{"ecs-service", "dynamodb-table", "rds-db-cluster", "lambda-function", "elasticache-replication-group", "elasticache-cache-cluster", "kafka-cluster", "application-autoscaling-scaling-policy", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_appautoscaling_target.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(applicationAutoScalingScalableTargetAdapterMetadata, sdp.AttributeSchemaFor(&applicationAutoScalingScalableTarget{}))
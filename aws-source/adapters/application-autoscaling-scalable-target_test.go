@@ -0,0 +1,113 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestApplicationAutoScalingScalableTargetGet(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalableTargetAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "ecs/service/default/web/ecs:service:DesiredCount", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected tags to be set, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecs-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "default/web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "application-autoscaling-scaling-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "ecs/service/default/web/ecs:service:DesiredCount",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-service-role/ecs.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_ECSService",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestApplicationAutoScalingScalableTargetList(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalableTargetAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if item.UniqueAttributeValue() != "dynamodb/table/orders/index/by-customer/dynamodb:index:ReadCapacityUnits" {
+			continue
+		}
+
+		// Scheduled scaling is suspended
+		if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Errorf("expected health WARNING, got %v", item.GetHealth())
+		}
+	}
+}
+
+func TestApplicationAutoScalingScalableTargetSearch(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalableTargetAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	tests := map[string]string{
+		"dynamodb/table/orders/index/by-customer": "dynamodb/table/orders/index/by-customer/dynamodb:index:ReadCapacityUnits",
+		"arn:aws:application-autoscaling:eu-west-2:123456789012:scalable-target/0ec5e5e0ef0f4a2f8a0e5a5c2a0b4d1e": "ecs/service/default/web/ecs:service:DesiredCount",
+	}
+
+	for query, expected := range tests {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != expected {
+			t.Errorf("expected %v for %v, got %v", expected, query, items)
+		}
+	}
+}
+
+func TestNewApplicationAutoScalingScalableTargetAdapter(t *testing.T) {
+	client, account, region := applicationAutoScalingGetAutoConfig(t)
+
+	adapter := NewApplicationAutoScalingScalableTargetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
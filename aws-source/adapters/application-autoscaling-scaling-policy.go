@@ -0,0 +1,252 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// applicationAutoScalingScalingPolicy is a scaling policy along with its ID,
+// since policy names are only unique within a scalable target
+type applicationAutoScalingScalingPolicy struct {
+	// The ID of the scalable target and the policy name separated by a slash
+	UniqueName string
+	types.ScalingPolicy
+}
+
+func describeApplicationAutoScalingScalingPolicies(ctx context.Context, client applicationAutoScalingClient, input *applicationautoscaling.DescribeScalingPoliciesInput) ([]*applicationAutoScalingScalingPolicy, error) {
+	policies := make([]*applicationAutoScalingScalingPolicy, 0)
+	paginator := applicationautoscaling.NewDescribeScalingPoliciesPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range out.ScalingPolicies {
+			if policy.ResourceId == nil || policy.PolicyName == nil {
+				continue
+			}
+
+			policies = append(policies, &applicationAutoScalingScalingPolicy{
+				UniqueName:    applicationAutoScalingTargetID(policy.ServiceNamespace, *policy.ResourceId, policy.ScalableDimension) + "/" + *policy.PolicyName,
+				ScalingPolicy: policy,
+			})
+		}
+	}
+
+	return policies, nil
+}
+
+// Gets a policy by the same ID as the Terraform import ID:
+// {serviceNamespace}/{resourceId}/{scalableDimension}/{policyName}
+func applicationAutoScalingScalingPolicyGetFunc(ctx context.Context, client applicationAutoScalingClient, scope, query string) (*applicationAutoScalingScalingPolicy, error) {
+	namespace, rest, _ := strings.Cut(query, "/")
+
+	// The dimension starts with the namespace and can't contain slashes, so it
+	// separates the resource ID from the policy name
+	i := strings.Index(rest, "/"+namespace+":")
+	if namespace == "" || i < 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {serviceNamespace}/{resourceId}/{scalableDimension}/{policyName}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	resourceID := rest[:i]
+	dimension, policyName, found := strings.Cut(rest[i+1:], "/")
+	if !found || policyName == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {serviceNamespace}/{resourceId}/{scalableDimension}/{policyName}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	policies, err := describeApplicationAutoScalingScalingPolicies(ctx, client, &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace:  types.ServiceNamespace(namespace),
+		ResourceId:        &resourceID,
+		ScalableDimension: types.ScalableDimension(dimension),
+		PolicyNames:       []string{policyName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("expected 1 scaling policy, got %v", len(policies)),
+			Scope:       scope,
+		}
+	}
+
+	return policies[0], nil
+}
+
+// Policies can only be described one namespace at a time, so every namespace
+// is described in turn
+func applicationAutoScalingScalingPolicyListFunc(ctx context.Context, client applicationAutoScalingClient, _ string) ([]*applicationAutoScalingScalingPolicy, error) {
+	policies := make([]*applicationAutoScalingScalingPolicy, 0)
+
+	for _, namespace := range types.ServiceNamespace("").Values() {
+		namespacePolicies, err := describeApplicationAutoScalingScalingPolicies(ctx, client, &applicationautoscaling.DescribeScalingPoliciesInput{
+			ServiceNamespace: namespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, namespacePolicies...)
+	}
+
+	return policies, nil
+}
+
+// Searches for a policy by ARN, which is what alarm actions use, or for the
+// policies of a scalable target by target ID
+func applicationAutoScalingScalingPolicySearchFunc(ctx context.Context, client applicationAutoScalingClient, scope, query string) ([]*applicationAutoScalingScalingPolicy, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		namespace, resourceID, dimension, err := parseApplicationAutoScalingTargetID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return describeApplicationAutoScalingScalingPolicies(ctx, client, &applicationautoscaling.DescribeScalingPoliciesInput{
+			ServiceNamespace:  namespace,
+			ResourceId:        &resourceID,
+			ScalableDimension: dimension,
+		})
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	namespace, resourceID, policyName, ok := parseApplicationAutoScalingPolicyARN(query)
+	if !ok {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not an Application Auto Scaling policy ARN", query),
+			Scope:       scope,
+		}
+	}
+
+	return describeApplicationAutoScalingScalingPolicies(ctx, client, &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace: namespace,
+		ResourceId:       &resourceID,
+		PolicyNames:      []string{policyName},
+	})
+}
+
+func applicationAutoScalingScalingPolicyItemMapper(_, scope string, awsItem *applicationAutoScalingScalingPolicy) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "application-autoscaling-scaling-policy",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.ResourceId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "application-autoscaling-scalable-target",
+				Method: sdp.QueryMethod_GET,
+				Query:  applicationAutoScalingTargetID(awsItem.ServiceNamespace, *awsItem.ResourceId, awsItem.ScalableDimension),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The target limits the capacity that the policy can set
+				In: true,
+				// The policy scales the target
+				Out: true,
+			},
+		})
+
+		if link := applicationAutoScalingResourceLink(scope, awsItem.ServiceNamespace, *awsItem.ResourceId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// Target tracking policies create and manage their own alarms, whereas
+	// step scaling policies are triggered by alarms that are managed elsewhere
+	managesAlarms := awsItem.PolicyType == types.PolicyTypeTargetTrackingScaling
+
+	for _, alarm := range awsItem.Alarms {
+		if alarm.AlarmName == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cloudwatch-alarm",
+				Method: sdp.QueryMethod_GET,
+				Query:  *alarm.AlarmName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The alarm triggers the policy
+				In: true,
+				// Changing a target tracking policy changes its alarms
+				Out: managesAlarms,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewApplicationAutoScalingScalingPolicyAdapter(client applicationAutoScalingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*applicationAutoScalingScalingPolicy, applicationAutoScalingClient, *applicationautoscaling.Options] {
+	return &adapterhelpers.GetListAdapter[*applicationAutoScalingScalingPolicy, applicationAutoScalingClient, *applicationautoscaling.Options]{
+		ItemType:        "application-autoscaling-scaling-policy",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: applicationAutoScalingScalingPolicyAdapterMetadata,
+		GetFunc:         applicationAutoScalingScalingPolicyGetFunc,
+		ListFunc:        applicationAutoScalingScalingPolicyListFunc,
+		SearchFunc:      applicationAutoScalingScalingPolicySearchFunc,
+		ItemMapper:      applicationAutoScalingScalingPolicyItemMapper,
+	}
+}
+
+var applicationAutoScalingScalingPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "application-autoscaling-scaling-policy",
+	DescriptiveName: "Application Auto Scaling Scaling Policy",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a scaling policy by {serviceNamespace}/{resourceId}/{scalableDimension}/{policyName}",
+		List:              true,
+		ListDescription:   "List all scaling policies",
+		Search:            true,
+		SearchDescription: "Search for a scaling policy by ARN, or for the policies of a scalable target by {serviceNamespace}/{resourceId}/{scalableDimension}",
+	},
+	PotentialLinks: []string{"application-autoscaling-scalable-target", "cloudwatch-alarm", "ecs-service", "dynamodb-table", "rds-db-cluster", "lambda-function", "elasticache-replication-group", "elasticache-cache-cluster", "kafka-cluster"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_appautoscaling_policy.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(applicationAutoScalingScalingPolicyAdapterMetadata, sdp.AttributeSchemaFor(&applicationAutoScalingScalingPolicy{}))
@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestApplicationAutoScalingScalingPolicyGet(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalingPolicyAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "ecs/service/default/web/ecs:service:DesiredCount/cpu-target", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "application-autoscaling-scalable-target",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ecs/service/default/web/ecs:service:DesiredCount",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "default/web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "TargetTracking-service/default/web-AlarmHigh-1b2c3d4e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Target tracking policies manage their alarms
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "cloudwatch-alarm" && !link.GetBlastPropagation().GetOut() {
+			t.Error("expected the alarm link to propagate out")
+		}
+	}
+}
+
+func TestApplicationAutoScalingScalingPolicySearch(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalingPolicyAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	tests := map[string]string{
+		"dynamodb/table/orders/index/by-customer/dynamodb:index:ReadCapacityUnits":                                                                             "dynamodb/table/orders/index/by-customer/dynamodb:index:ReadCapacityUnits/read-step",
+		"arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:6d8972f3-efc8-437c-92d1-6270f29a66e7:resource/ecs/service/default/web:policyName/cpu-target": "ecs/service/default/web/ecs:service:DesiredCount/cpu-target",
+	}
+
+	for query, expected := range tests {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != expected {
+			t.Fatalf("expected %v for %v, got %v", expected, query, items)
+		}
+
+		// Step scaling policies are triggered by alarms they don't manage
+		if expected == tests["dynamodb/table/orders/index/by-customer/dynamodb:index:ReadCapacityUnits"] {
+			for _, link := range items[0].GetLinkedItemQueries() {
+				if link.GetQuery().GetType() == "cloudwatch-alarm" && link.GetBlastPropagation().GetOut() {
+					t.Error("expected the alarm link not to propagate out")
+				}
+			}
+		}
+	}
+}
+
+func TestApplicationAutoScalingScalingPolicyList(t *testing.T) {
+	adapter := NewApplicationAutoScalingScalingPolicyAdapter(testApplicationAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Errorf("expected 2 items, got %v", len(items))
+	}
+}
+
+func TestNewApplicationAutoScalingScalingPolicyAdapter(t *testing.T) {
+	client, account, region := applicationAutoScalingGetAutoConfig(t)
+
+	adapter := NewApplicationAutoScalingScalingPolicyAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
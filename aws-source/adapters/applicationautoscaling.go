@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type applicationAutoScalingClient interface {
+	DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	DescribeScalingPolicies(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	ListTagsForResource(ctx context.Context, params *applicationautoscaling.ListTagsForResourceInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.ListTagsForResourceOutput, error)
+}
+
+// Returns the ID of a scalable target in the same format as the Terraform
+// import ID: {serviceNamespace}/{resourceId}/{scalableDimension}
+func applicationAutoScalingTargetID(namespace types.ServiceNamespace, resourceID string, dimension types.ScalableDimension) string {
+	return fmt.Sprintf("%v/%v/%v", namespace, resourceID, dimension)
+}
+
+// Parses a query in the format {serviceNamespace}/{resourceId} with an
+// optional /{scalableDimension} suffix. Resource IDs can contain slashes but
+// dimensions can't, and every dimension starts with its namespace
+func parseApplicationAutoScalingTargetID(scope, query string) (types.ServiceNamespace, string, types.ScalableDimension, error) {
+	namespace, rest, found := strings.Cut(query, "/")
+	if !found || namespace == "" || rest == "" {
+		return "", "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {serviceNamespace}/{resourceId}/{scalableDimension}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	if i := strings.LastIndex(rest, "/"); i > 0 && strings.HasPrefix(rest[i+1:], namespace+":") {
+		return types.ServiceNamespace(namespace), rest[:i], types.ScalableDimension(rest[i+1:]), nil
+	}
+
+	return types.ServiceNamespace(namespace), rest, "", nil
+}
+
+// Returns a query for the resource that Application Auto Scaling scales, for
+// the resource types that have adapters
+func applicationAutoScalingResourceLink(scope string, namespace types.ServiceNamespace, resourceID string) *sdp.LinkedItemQuery {
+	var query *sdp.Query
+
+	switch namespace {
+	case types.ServiceNamespaceEcs:
+		// service/{cluster}/{service}
+		if id, found := strings.CutPrefix(resourceID, "service/"); found {
+			query = &sdp.Query{
+				Type:   "ecs-service",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			}
+		}
+	case types.ServiceNamespaceDynamodb:
+		// table/{table} or table/{table}/index/{index}
+		if fields := strings.Split(resourceID, "/"); len(fields) >= 2 && fields[0] == "table" {
+			query = &sdp.Query{
+				Type:   "dynamodb-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  fields[1],
+				Scope:  scope,
+			}
+		}
+	case types.ServiceNamespaceRds, types.ServiceNamespaceNeptune:
+		// cluster:{cluster}
+		if id, found := strings.CutPrefix(resourceID, "cluster:"); found {
+			query = &sdp.Query{
+				Type:   "rds-db-cluster",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			}
+		}
+	case types.ServiceNamespaceLambda:
+		// function:{function}:{alias or version}
+		if fields := strings.Split(resourceID, ":"); len(fields) >= 2 && fields[0] == "function" {
+			query = &sdp.Query{
+				Type:   "lambda-function",
+				Method: sdp.QueryMethod_GET,
+				Query:  fields[1],
+				Scope:  scope,
+			}
+		}
+	case types.ServiceNamespaceElasticache:
+		// replication-group/{group} or cache-cluster/{cluster}
+		if id, found := strings.CutPrefix(resourceID, "replication-group/"); found {
+			query = &sdp.Query{
+				Type:   "elasticache-replication-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			}
+		} else if id, found := strings.CutPrefix(resourceID, "cache-cluster/"); found {
+			query = &sdp.Query{
+				Type:   "elasticache-cache-cluster",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			}
+		}
+	case types.ServiceNamespaceKafka:
+		// The resource ID is the ARN of the cluster
+		if a, err := adapterhelpers.ParseARN(resourceID); err == nil {
+			query = &sdp.Query{
+				Type:   "kafka-cluster",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  resourceID,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			}
+		}
+	}
+
+	if query == nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// The capacity of the resource affects how it is scaled
+			In: true,
+			// Scaling changes the capacity of the resource
+			Out: true,
+		},
+	}
+}
+
+// Parses an Application Auto Scaling policy ARN in the format
+// arn:aws:autoscaling:{region}:{account}:scalingPolicy:{id}:resource/{serviceNamespace}/{resourceId}:policyName/{policyName}.
+// Policies of EC2 Auto Scaling groups share the service but use
+// autoScalingGroupName instead of resource, so they aren't matched
+func parseApplicationAutoScalingPolicyARN(policyARN string) (types.ServiceNamespace, string, string, bool) {
+	_, rest, found := strings.Cut(policyARN, ":resource/")
+	if !found {
+		return "", "", "", false
+	}
+
+	i := strings.LastIndex(rest, ":policyName/")
+	if i < 0 {
+		return "", "", "", false
+	}
+
+	namespace, resourceID, found := strings.Cut(rest[:i], "/")
+	if !found {
+		return "", "", "", false
+	}
+
+	// Policies created by other services have the creator appended
+	policyName := rest[i+len(":policyName/"):]
+	if j := strings.LastIndex(policyName, ":createdBy/"); j >= 0 {
+		policyName = policyName[:j]
+	}
+
+	return types.ServiceNamespace(namespace), resourceID, policyName, true
+}
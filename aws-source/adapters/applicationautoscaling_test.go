@@ -0,0 +1,262 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+)
+
+type testApplicationAutoScalingClient struct{}
+
+func (t testApplicationAutoScalingClient) DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	targets := make([]types.ScalableTarget, 0)
+
+	for _, target := range []types.ScalableTarget{
+		{
+			ServiceNamespace:  types.ServiceNamespaceEcs,
+			ResourceId:        aws.String("service/default/web"),
+			ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+			ScalableTargetARN: aws.String("arn:aws:application-autoscaling:eu-west-2:123456789012:scalable-target/0ec5e5e0ef0f4a2f8a0e5a5c2a0b4d1e"),
+			MinCapacity:       aws.Int32(2),
+			MaxCapacity:       aws.Int32(10),
+			RoleARN:           aws.String("arn:aws:iam::123456789012:role/aws-service-role/ecs.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_ECSService"),
+			CreationTime:      aws.Time(time.Now()),
+			SuspendedState: &types.SuspendedState{
+				DynamicScalingInSuspended: aws.Bool(false),
+			},
+		},
+		{
+			ServiceNamespace:  types.ServiceNamespaceDynamodb,
+			ResourceId:        aws.String("table/orders/index/by-customer"),
+			ScalableDimension: types.ScalableDimensionDynamoDBIndexReadCapacityUnits,
+			ScalableTargetARN: aws.String("arn:aws:application-autoscaling:eu-west-2:123456789012:scalable-target/1fd6f6f1f01f5b3f9b1f6b6d3b1c5e2f"),
+			MinCapacity:       aws.Int32(5),
+			MaxCapacity:       aws.Int32(100),
+			CreationTime:      aws.Time(time.Now()),
+			SuspendedState: &types.SuspendedState{
+				ScheduledScalingSuspended: aws.Bool(true),
+			},
+		},
+	} {
+		if target.ServiceNamespace != params.ServiceNamespace {
+			continue
+		}
+
+		if len(params.ResourceIds) > 0 && !slices.Contains(params.ResourceIds, *target.ResourceId) {
+			continue
+		}
+
+		if params.ScalableDimension != "" && params.ScalableDimension != target.ScalableDimension {
+			continue
+		}
+
+		targets = append(targets, target)
+	}
+
+	return &applicationautoscaling.DescribeScalableTargetsOutput{
+		ScalableTargets: targets,
+	}, nil
+}
+
+func (t testApplicationAutoScalingClient) DescribeScalingPolicies(ctx context.Context, params *applicationautoscaling.DescribeScalingPoliciesInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+	policies := make([]types.ScalingPolicy, 0)
+
+	for _, policy := range []types.ScalingPolicy{
+		{
+			ServiceNamespace:  types.ServiceNamespaceEcs,
+			ResourceId:        aws.String("service/default/web"),
+			ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+			PolicyName:        aws.String("cpu-target"),
+			PolicyARN:         aws.String("arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:6d8972f3-efc8-437c-92d1-6270f29a66e7:resource/ecs/service/default/web:policyName/cpu-target"),
+			PolicyType:        types.PolicyTypeTargetTrackingScaling,
+			Alarms: []types.Alarm{
+				{
+					AlarmName: aws.String("TargetTracking-service/default/web-AlarmHigh-1b2c3d4e"),
+					AlarmARN:  aws.String("arn:aws:cloudwatch:eu-west-2:123456789012:alarm:TargetTracking-service/default/web-AlarmHigh-1b2c3d4e"),
+				},
+			},
+			TargetTrackingScalingPolicyConfiguration: &types.TargetTrackingScalingPolicyConfiguration{
+				TargetValue: aws.Float64(60),
+				PredefinedMetricSpecification: &types.PredefinedMetricSpecification{
+					PredefinedMetricType: types.MetricTypeECSServiceAverageCPUUtilization,
+				},
+			},
+			CreationTime: aws.Time(time.Now()),
+		},
+		{
+			ServiceNamespace:  types.ServiceNamespaceDynamodb,
+			ResourceId:        aws.String("table/orders/index/by-customer"),
+			ScalableDimension: types.ScalableDimensionDynamoDBIndexReadCapacityUnits,
+			PolicyName:        aws.String("read-step"),
+			PolicyARN:         aws.String("arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:7e9a83f4-f0d9-548d-a3e2-7381f3ab77f8:resource/dynamodb/table/orders/index/by-customer:policyName/read-step"),
+			PolicyType:        types.PolicyTypeStepScaling,
+			Alarms: []types.Alarm{
+				{
+					AlarmName: aws.String("orders-read-high"),
+					AlarmARN:  aws.String("arn:aws:cloudwatch:eu-west-2:123456789012:alarm:orders-read-high"),
+				},
+			},
+			CreationTime: aws.Time(time.Now()),
+		},
+	} {
+		if policy.ServiceNamespace != params.ServiceNamespace {
+			continue
+		}
+
+		if params.ResourceId != nil && *params.ResourceId != *policy.ResourceId {
+			continue
+		}
+
+		if params.ScalableDimension != "" && params.ScalableDimension != policy.ScalableDimension {
+			continue
+		}
+
+		if len(params.PolicyNames) > 0 && !slices.Contains(params.PolicyNames, *policy.PolicyName) {
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return &applicationautoscaling.DescribeScalingPoliciesOutput{
+		ScalingPolicies: policies,
+	}, nil
+}
+
+func (t testApplicationAutoScalingClient) ListTagsForResource(ctx context.Context, params *applicationautoscaling.ListTagsForResourceInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.ListTagsForResourceOutput, error) {
+	return &applicationautoscaling.ListTagsForResourceOutput{
+		Tags: map[string]string{
+			"team": "platform",
+		},
+	}, nil
+}
+
+func TestParseApplicationAutoScalingTargetID(t *testing.T) {
+	tests := []struct {
+		Query     string
+		Namespace types.ServiceNamespace
+		Resource  string
+		Dimension types.ScalableDimension
+	}{
+		{
+			Query:     "ecs/service/default/web/ecs:service:DesiredCount",
+			Namespace: types.ServiceNamespaceEcs,
+			Resource:  "service/default/web",
+			Dimension: types.ScalableDimensionECSServiceDesiredCount,
+		},
+		{
+			Query:     "dynamodb/table/orders/index/by-customer",
+			Namespace: types.ServiceNamespaceDynamodb,
+			Resource:  "table/orders/index/by-customer",
+		},
+		{
+			Query:     "lambda/function:processor:live/lambda:function:ProvisionedConcurrency",
+			Namespace: types.ServiceNamespaceLambda,
+			Resource:  "function:processor:live",
+			Dimension: types.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		},
+	}
+
+	for _, test := range tests {
+		namespace, resource, dimension, err := parseApplicationAutoScalingTargetID("foo", test.Query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if namespace != test.Namespace || resource != test.Resource || dimension != test.Dimension {
+			t.Errorf("unexpected result for %v: %v, %v, %v", test.Query, namespace, resource, dimension)
+		}
+	}
+
+	if _, _, _, err := parseApplicationAutoScalingTargetID("foo", "ecs"); err == nil {
+		t.Error("expected an error for a query without a resource ID")
+	}
+}
+
+func TestParseApplicationAutoScalingPolicyARN(t *testing.T) {
+	tests := []struct {
+		ARN       string
+		Namespace types.ServiceNamespace
+		Resource  string
+		Policy    string
+		OK        bool
+	}{
+		{
+			ARN:       "arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:6d8972f3-efc8-437c-92d1-6270f29a66e7:resource/ecs/service/default/web:policyName/cpu-target",
+			Namespace: types.ServiceNamespaceEcs,
+			Resource:  "service/default/web",
+			Policy:    "cpu-target",
+			OK:        true,
+		},
+		{
+			ARN:       "arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:6d8972f3-efc8-437c-92d1-6270f29a66e7:resource/dynamodb/table/orders:policyName/DynamoDBReadCapacityUtilization:table/orders:createdBy/0a1b2c3d-7d1b-4b4b-8d9a-1b2c3d4e5f6a",
+			Namespace: types.ServiceNamespaceDynamodb,
+			Resource:  "table/orders",
+			Policy:    "DynamoDBReadCapacityUtilization:table/orders",
+			OK:        true,
+		},
+		{
+			// EC2 Auto Scaling group policies aren't matched
+			ARN: "arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:6d8972f3-efc8-437c-92d1-6270f29a66e7:autoScalingGroupName/web:policyName/scale-out",
+			OK:  false,
+		},
+	}
+
+	for _, test := range tests {
+		namespace, resource, policy, ok := parseApplicationAutoScalingPolicyARN(test.ARN)
+
+		if ok != test.OK {
+			t.Fatalf("expected ok to be %v for %v", test.OK, test.ARN)
+		}
+
+		if namespace != test.Namespace || resource != test.Resource || policy != test.Policy {
+			t.Errorf("unexpected result for %v: %v, %v, %v", test.ARN, namespace, resource, policy)
+		}
+	}
+}
+
+func TestApplicationAutoScalingResourceLink(t *testing.T) {
+	tests := []struct {
+		Namespace types.ServiceNamespace
+		Resource  string
+		Type      string
+		Query     string
+		Scope     string
+	}{
+		{types.ServiceNamespaceEcs, "service/default/web", "ecs-service", "default/web", "foo"},
+		{types.ServiceNamespaceDynamodb, "table/orders/index/by-customer", "dynamodb-table", "orders", "foo"},
+		{types.ServiceNamespaceRds, "cluster:aurora", "rds-db-cluster", "aurora", "foo"},
+		{types.ServiceNamespaceLambda, "function:processor:live", "lambda-function", "processor", "foo"},
+		{types.ServiceNamespaceElasticache, "replication-group/cache", "elasticache-replication-group", "cache", "foo"},
+		{types.ServiceNamespaceKafka, "arn:aws:kafka:eu-west-2:123456789012:cluster/events/0a1b2c3d-7d1b-4b4b-8d9a-1b2c3d4e5f6a-1", "kafka-cluster", "arn:aws:kafka:eu-west-2:123456789012:cluster/events/0a1b2c3d-7d1b-4b4b-8d9a-1b2c3d4e5f6a-1", "123456789012.eu-west-2"},
+	}
+
+	for _, test := range tests {
+		link := applicationAutoScalingResourceLink("foo", test.Namespace, test.Resource)
+		if link == nil {
+			t.Fatalf("expected a link for %v", test.Resource)
+		}
+
+		if q := link.GetQuery(); q.GetType() != test.Type || q.GetQuery() != test.Query || q.GetScope() != test.Scope {
+			t.Errorf("unexpected link for %v: %v", test.Resource, q)
+		}
+	}
+
+	if link := applicationAutoScalingResourceLink("foo", types.ServiceNamespaceSagemaker, "endpoint/model/variant/main"); link != nil {
+		t.Errorf("expected no link for a resource without an adapter, got %v", link)
+	}
+}
+
+func applicationAutoScalingGetAutoConfig(t *testing.T) (*applicationautoscaling.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := applicationautoscaling.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -0,0 +1,195 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func athenaWorkGroupGetFunc(ctx context.Context, client athenaClient, _, query string) (*types.WorkGroup, error) {
+	out, err := client.GetWorkGroup(ctx, &athena.GetWorkGroupInput{
+		WorkGroup: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.WorkGroup, nil
+}
+
+func athenaWorkGroupListFunc(ctx context.Context, client athenaClient, scope string) ([]*types.WorkGroup, error) {
+	workGroups := make([]*types.WorkGroup, 0)
+	paginator := athena.NewListWorkGroupsPaginator(client, &athena.ListWorkGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include the configuration of the workgroups
+		for _, summary := range out.WorkGroups {
+			if summary.Name == nil {
+				continue
+			}
+
+			workGroup, err := athenaWorkGroupGetFunc(ctx, client, scope, *summary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			workGroups = append(workGroups, workGroup)
+		}
+	}
+
+	return workGroups, nil
+}
+
+func athenaWorkGroupItemMapper(_, scope string, awsItem *types.WorkGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "athena-workgroup",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	config := awsItem.Configuration
+	if config == nil {
+		return &item, nil
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*string, 0)
+
+	if results := config.ResultConfiguration; results != nil {
+		if results.OutputLocation != nil {
+			// The bucket can be owned by another account
+			bucketAccountID := accountID
+			if results.ExpectedBucketOwner != nil {
+				bucketAccountID = *results.ExpectedBucketOwner
+			}
+
+			if link := s3URILink(bucketAccountID, *results.OutputLocation); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if results.EncryptionConfiguration != nil {
+			keys = append(keys, results.EncryptionConfiguration.KmsKey)
+		}
+	}
+
+	if config.CustomerContentEncryptionConfiguration != nil {
+		keys = append(keys, config.CustomerContentEncryptionConfiguration.KmsKey)
+	}
+
+	if config.ManagedQueryResultsConfiguration != nil && config.ManagedQueryResultsConfiguration.EncryptionConfiguration != nil {
+		keys = append(keys, config.ManagedQueryResultsConfiguration.EncryptionConfiguration.KmsKey)
+	}
+
+	if monitoring := config.MonitoringConfiguration; monitoring != nil {
+		if logging := monitoring.CloudWatchLoggingConfiguration; logging != nil && logging.LogGroup != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logging.LogGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The log group can't affect the workgroup
+					In: false,
+					// The workgroup writes to the log group
+					Out: true,
+				},
+			})
+		}
+
+		if logging := monitoring.S3LoggingConfiguration; logging != nil {
+			if logging.LogLocation != nil {
+				if link := s3URILink(accountID, *logging.LogLocation); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+
+			keys = append(keys, logging.KmsKey)
+		}
+
+		if monitoring.ManagedLoggingConfiguration != nil {
+			keys = append(keys, monitoring.ManagedLoggingConfiguration.KmsKey)
+		}
+	}
+
+	// The same key is often used for everything, so each is only linked once
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if key == nil || seen[*key] {
+			continue
+		}
+		seen[*key] = true
+
+		if link := kmsKeyLink(scope, *key); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if config.ExecutionRole != nil {
+		if link := iamRoleLink(accountID, *config.ExecutionRole); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAthenaWorkGroupAdapter(client athenaClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.WorkGroup, athenaClient, *athena.Options] {
+	return &adapterhelpers.GetListAdapter[*types.WorkGroup, athenaClient, *athena.Options]{
+		ItemType:        "athena-workgroup",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: athenaWorkGroupAdapterMetadata,
+		GetFunc:         athenaWorkGroupGetFunc,
+		ListFunc:        athenaWorkGroupListFunc,
+		ItemMapper:      athenaWorkGroupItemMapper,
+		// Workgroups don't include their ARN, so it is built from the account
+		// and region of the adapter
+		ListTagsFunc: func(ctx context.Context, workGroup *types.WorkGroup, client athenaClient) (map[string]string, error) {
+			return athenaListTags(ctx, client, fmt.Sprintf("arn:aws:athena:%v:%v:workgroup/%v", region, accountID, *workGroup.Name))
+		},
+	}
+}
+
+var athenaWorkGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "athena-workgroup",
+	DescriptiveName: "Athena Workgroup",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a workgroup by name",
+		List:              true,
+		ListDescription:   "List all workgroups",
+		Search:            true,
+		SearchDescription: "Search for a workgroup by ARN",
+	},
+	PotentialLinks: []string{"s3-bucket", "kms-key", "iam-role", "logs-log-group"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_athena_workgroup.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(athenaWorkGroupAdapterMetadata, sdp.AttributeSchemaFor(&types.WorkGroup{}))
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAthenaWorkGroupGet(t *testing.T) {
+	adapter := NewAthenaWorkGroupAdapter(testAthenaClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "primary", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "analytics" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			// The results bucket is owned by another account
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "athena-results-123456789012",
+			ExpectedScope:  "210987654321",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testAthenaKeyARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAthenaWorkGroupGetSpark(t *testing.T) {
+	adapter := NewAthenaWorkGroupAdapter(testAthenaClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "spark", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "athena-results-123456789012",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws-athena/spark",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "athena-logs-123456789012",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testAthenaKeyARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/service-role/AWSAthenaSparkExecutionRole",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The same key is used for the content and the logs
+	if len(item.GetLinkedItemQueries()) != 5 {
+		t.Errorf("expected 5 links, got %v", len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestAthenaWorkGroupList(t *testing.T) {
+	adapter := NewAthenaWorkGroupAdapter(testAthenaClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 workgroups, got %v", len(items))
+	}
+}
+
+func TestNewAthenaWorkGroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := athena.NewFromConfig(config)
+
+	adapter := NewAthenaWorkGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+)
+
+const testAthenaKeyARN = "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+
+type testAthenaClient struct{}
+
+func (t testAthenaClient) GetWorkGroup(ctx context.Context, params *athena.GetWorkGroupInput, optFns ...func(*athena.Options)) (*athena.GetWorkGroupOutput, error) {
+	switch *params.WorkGroup {
+	case "primary":
+		return &athena.GetWorkGroupOutput{
+			WorkGroup: &types.WorkGroup{
+				Name:  params.WorkGroup,
+				State: types.WorkGroupStateEnabled,
+				Configuration: &types.WorkGroupConfiguration{
+					EnforceWorkGroupConfiguration:   aws.Bool(true),
+					PublishCloudWatchMetricsEnabled: aws.Bool(true),
+					BytesScannedCutoffPerQuery:      aws.Int64(10737418240),
+					ResultConfiguration: &types.ResultConfiguration{
+						OutputLocation:      aws.String("s3://athena-results-123456789012/primary/"),
+						ExpectedBucketOwner: aws.String("210987654321"),
+						EncryptionConfiguration: &types.EncryptionConfiguration{
+							EncryptionOption: types.EncryptionOptionSseKms,
+							KmsKey:           aws.String(testAthenaKeyARN),
+						},
+					},
+					EngineVersion: &types.EngineVersion{
+						SelectedEngineVersion:  aws.String("AUTO"),
+						EffectiveEngineVersion: aws.String("Athena engine version 3"),
+					},
+				},
+				CreationTime: aws.Time(time.Now()),
+			},
+		}, nil
+	case "spark":
+		return &athena.GetWorkGroupOutput{
+			WorkGroup: &types.WorkGroup{
+				Name:  params.WorkGroup,
+				State: types.WorkGroupStateDisabled,
+				Configuration: &types.WorkGroupConfiguration{
+					ExecutionRole: aws.String("arn:aws:iam::123456789012:role/service-role/AWSAthenaSparkExecutionRole"),
+					ResultConfiguration: &types.ResultConfiguration{
+						OutputLocation: aws.String("s3://athena-results-123456789012/spark/"),
+					},
+					CustomerContentEncryptionConfiguration: &types.CustomerContentEncryptionConfiguration{
+						KmsKey: aws.String(testAthenaKeyARN),
+					},
+					MonitoringConfiguration: &types.MonitoringConfiguration{
+						CloudWatchLoggingConfiguration: &types.CloudWatchLoggingConfiguration{
+							Enabled:  aws.Bool(true),
+							LogGroup: aws.String("/aws-athena/spark"),
+						},
+						S3LoggingConfiguration: &types.S3LoggingConfiguration{
+							Enabled:     aws.Bool(true),
+							LogLocation: aws.String("s3://athena-logs-123456789012/spark/"),
+							KmsKey:      aws.String(testAthenaKeyARN),
+						},
+					},
+					EngineVersion: &types.EngineVersion{
+						SelectedEngineVersion: aws.String("PySpark engine version 3"),
+					},
+				},
+			},
+		}, nil
+	}
+
+	return nil, &types.InvalidRequestException{Message: aws.String("WorkGroup is not found.")}
+}
+
+func (t testAthenaClient) ListTagsForResource(ctx context.Context, params *athena.ListTagsForResourceInput, optFns ...func(*athena.Options)) (*athena.ListTagsForResourceOutput, error) {
+	if *params.ResourceARN != "arn:aws:athena:eu-west-2:123456789012:workgroup/primary" {
+		return &athena.ListTagsForResourceOutput{}, nil
+	}
+
+	return &athena.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("team"), Value: aws.String("analytics")},
+		},
+	}, nil
+}
+
+func (t testAthenaClient) ListWorkGroups(ctx context.Context, params *athena.ListWorkGroupsInput, optFns ...func(*athena.Options)) (*athena.ListWorkGroupsOutput, error) {
+	return &athena.ListWorkGroupsOutput{
+		WorkGroups: []types.WorkGroupSummary{
+			{Name: aws.String("primary"), State: types.WorkGroupStateEnabled},
+			{Name: aws.String("spark"), State: types.WorkGroupStateDisabled},
+		},
+	}, nil
+}
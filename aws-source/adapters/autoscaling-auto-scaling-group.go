@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -197,6 +198,58 @@ func autoScalingGroupOutputMapper(_ context.Context, _ *autoscaling.Client, scop
 			})
 		}
 
+		if asg.AutoScalingGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries,
+				&sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-lifecycle-hook",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Hooks hold instances in a wait state as they launch
+						// or terminate
+						In: true,
+						// Deleting the ASG deletes its hooks
+						Out: true,
+					},
+				},
+				&sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-scheduled-action",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Scheduled actions change the capacity of the ASG
+						In: true,
+						// Deleting the ASG deletes its scheduled actions
+						Out: true,
+					},
+				},
+			)
+
+			if asg.WarmPoolConfiguration != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-warm-pool",
+						Method: sdp.QueryMethod_GET,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The ASG scales out using instances from the pool
+						In: true,
+						// Changes to the launch template of the ASG are
+						// applied to the pool
+						Out: true,
+					},
+				})
+			}
+		}
+
 		items = append(items, &item)
 	}
 
@@ -248,5 +301,7 @@ var autoScalingGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_SEARCH,
 		},
 	},
-	PotentialLinks: []string{"ec2-launch-template", "elbv2-target-group", "ec2-instance", "iam-role", "autoscaling-launch-configuration", "ec2-placement-group"},
+	PotentialLinks: []string{"ec2-launch-template", "elbv2-target-group", "ec2-instance", "iam-role", "autoscaling-launch-configuration", "ec2-placement-group", "autoscaling-lifecycle-hook", "autoscaling-scheduled-action", "autoscaling-warm-pool"},
 })
+
+var _ = Metadata.RegisterSchema(autoScalingGroupAdapterMetadata, sdp.AttributeSchemaFor(types.AutoScalingGroup{}))
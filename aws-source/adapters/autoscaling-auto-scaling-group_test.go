@@ -161,6 +161,8 @@ func TestAutoScalingGroupOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -214,6 +216,24 @@ func TestAutoScalingGroupOutputMapper(t *testing.T) {
 			ExpectedQuery:  "placementGroup",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "autoscaling-lifecycle-hook",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-scheduled-action",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-warm-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
 		{
 			ExpectedType:   "ec2-launch-template",
 			ExpectedMethod: sdp.QueryMethod_GET,
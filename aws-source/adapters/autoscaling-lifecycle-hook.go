@@ -0,0 +1,201 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// autoScalingLifecycleHook is a lifecycle hook along with its ID, since hook
+// names are only unique within their group
+type autoScalingLifecycleHook struct {
+	// The group name and hook name separated by a slash
+	UniqueName string
+	types.LifecycleHook
+}
+
+func describeAutoScalingLifecycleHooks(ctx context.Context, client autoScalingClient, input *autoscaling.DescribeLifecycleHooksInput) ([]*autoScalingLifecycleHook, error) {
+	out, err := client.DescribeLifecycleHooks(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]*autoScalingLifecycleHook, 0, len(out.LifecycleHooks))
+
+	for _, hook := range out.LifecycleHooks {
+		if hook.AutoScalingGroupName == nil || hook.LifecycleHookName == nil {
+			continue
+		}
+
+		hooks = append(hooks, &autoScalingLifecycleHook{
+			UniqueName:    *hook.AutoScalingGroupName + "/" + *hook.LifecycleHookName,
+			LifecycleHook: hook,
+		})
+	}
+
+	return hooks, nil
+}
+
+func autoScalingLifecycleHookGetFunc(ctx context.Context, client autoScalingClient, scope, query string) (*autoScalingLifecycleHook, error) {
+	groupName, hookName, err := parseAutoScalingGroupChildID(scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := describeAutoScalingLifecycleHooks(ctx, client, &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: &groupName,
+		LifecycleHookNames:   []string{hookName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hooks) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("expected 1 lifecycle hook, got %v", len(hooks)),
+			Scope:       scope,
+		}
+	}
+
+	return hooks[0], nil
+}
+
+// Hooks can only be described one group at a time
+func autoScalingLifecycleHookListFunc(ctx context.Context, client autoScalingClient, _ string) ([]*autoScalingLifecycleHook, error) {
+	groups, err := listAutoScalingGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]*autoScalingLifecycleHook, 0)
+
+	for _, group := range groups {
+		groupHooks, err := describeAutoScalingLifecycleHooks(ctx, client, &autoscaling.DescribeLifecycleHooksInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		hooks = append(hooks, groupHooks...)
+	}
+
+	return hooks, nil
+}
+
+// Searches for the hooks of a group by group name
+func autoScalingLifecycleHookSearchFunc(ctx context.Context, client autoScalingClient, _, query string) ([]*autoScalingLifecycleHook, error) {
+	return describeAutoScalingLifecycleHooks(ctx, client, &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: &query,
+	})
+}
+
+func autoScalingLifecycleHookItemMapper(_, scope string, awsItem *autoScalingLifecycleHook) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "autoscaling-lifecycle-hook",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.AutoScalingGroupName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "autoscaling-auto-scaling-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.AutoScalingGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the group deletes its hooks
+				In: true,
+				// The hook holds instances in a wait state as they launch or
+				// terminate
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.NotificationTargetARN != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.NotificationTargetARN); err == nil {
+			var targetType string
+
+			switch a.Service {
+			case "sns":
+				targetType = "sns-topic"
+			case "sqs":
+				targetType = "sqs-queue"
+			}
+
+			if targetType != "" {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   targetType,
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *awsItem.NotificationTargetARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the target is removed nothing completes the
+						// lifecycle action, so instances wait for the timeout
+						In: true,
+						// The hook sends a notification for each instance
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.RoleARN != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		if link := iamRoleLink(accountID, *awsItem.RoleARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAutoScalingLifecycleHookAdapter(client autoScalingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*autoScalingLifecycleHook, autoScalingClient, *autoscaling.Options] {
+	return &adapterhelpers.GetListAdapter[*autoScalingLifecycleHook, autoScalingClient, *autoscaling.Options]{
+		ItemType:        "autoscaling-lifecycle-hook",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: autoScalingLifecycleHookAdapterMetadata,
+		GetFunc:         autoScalingLifecycleHookGetFunc,
+		ListFunc:        autoScalingLifecycleHookListFunc,
+		SearchFunc:      autoScalingLifecycleHookSearchFunc,
+		ItemMapper:      autoScalingLifecycleHookItemMapper,
+	}
+}
+
+var autoScalingLifecycleHookAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-lifecycle-hook",
+	DescriptiveName: "Autoscaling Lifecycle Hook",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a lifecycle hook by {autoScalingGroupName}/{lifecycleHookName}",
+		List:              true,
+		ListDescription:   "List the lifecycle hooks of all Autoscaling Groups",
+		Search:            true,
+		SearchDescription: "Search for the lifecycle hooks of an Autoscaling Group by group name",
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group", "sns-topic", "sqs-queue", "iam-role"},
+})
+
+var _ = Metadata.RegisterSchema(autoScalingLifecycleHookAdapterMetadata, sdp.AttributeSchemaFor(&autoScalingLifecycleHook{}))
@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAutoScalingLifecycleHookGet(t *testing.T) {
+	adapter := NewAutoScalingLifecycleHookAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "web/drain", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:lifecycle",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/lifecycle-hooks",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAutoScalingLifecycleHookList(t *testing.T) {
+	adapter := NewAutoScalingLifecycleHookAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Errorf("expected 2 items, got %v", len(items))
+	}
+
+	items, err = adapter.Search(context.Background(), "123456789012.eu-west-2", "workers", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || items[0].UniqueAttributeValue() != "workers/bootstrap" {
+		t.Errorf("expected the bootstrap hook, got %v", items)
+	}
+}
+
+func TestNewAutoScalingLifecycleHookAdapter(t *testing.T) {
+	client, account, region := autoScalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingLifecycleHookAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// autoScalingScheduledAction is a scheduled action along with its ID, since
+// action names are only unique within their group
+type autoScalingScheduledAction struct {
+	// The group name and action name separated by a slash
+	UniqueName string
+	types.ScheduledUpdateGroupAction
+}
+
+func describeAutoScalingScheduledActions(ctx context.Context, client autoScalingClient, input *autoscaling.DescribeScheduledActionsInput) ([]*autoScalingScheduledAction, error) {
+	actions := make([]*autoScalingScheduledAction, 0)
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, action := range out.ScheduledUpdateGroupActions {
+			if action.AutoScalingGroupName == nil || action.ScheduledActionName == nil {
+				continue
+			}
+
+			actions = append(actions, &autoScalingScheduledAction{
+				UniqueName:                 *action.AutoScalingGroupName + "/" + *action.ScheduledActionName,
+				ScheduledUpdateGroupAction: action,
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+func autoScalingScheduledActionGetFunc(ctx context.Context, client autoScalingClient, scope, query string) (*autoScalingScheduledAction, error) {
+	groupName, actionName, err := parseAutoScalingGroupChildID(scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := describeAutoScalingScheduledActions(ctx, client, &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: &groupName,
+		ScheduledActionNames: []string{actionName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(actions) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("expected 1 scheduled action, got %v", len(actions)),
+			Scope:       scope,
+		}
+	}
+
+	return actions[0], nil
+}
+
+func autoScalingScheduledActionListFunc(ctx context.Context, client autoScalingClient, _ string) ([]*autoScalingScheduledAction, error) {
+	return describeAutoScalingScheduledActions(ctx, client, &autoscaling.DescribeScheduledActionsInput{})
+}
+
+// Searches for an action by ARN, or for the actions of a group by group name.
+// ARNs are in the format
+// arn:aws:autoscaling:{region}:{account}:scheduledUpdateGroupAction:{id}:autoScalingGroupName/{group}:scheduledActionName/{name}
+func autoScalingScheduledActionSearchFunc(ctx context.Context, client autoScalingClient, scope, query string) ([]*autoScalingScheduledAction, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return describeAutoScalingScheduledActions(ctx, client, &autoscaling.DescribeScheduledActionsInput{
+			AutoScalingGroupName: &query,
+		})
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	_, rest, found := strings.Cut(query, ":autoScalingGroupName/")
+	i := strings.LastIndex(rest, ":scheduledActionName/")
+	if !found || i < 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not a scheduled action ARN", query),
+			Scope:       scope,
+		}
+	}
+
+	action, err := autoScalingScheduledActionGetFunc(ctx, client, scope, rest[:i]+"/"+rest[i+len(":scheduledActionName/"):])
+	if err != nil {
+		return nil, err
+	}
+
+	return []*autoScalingScheduledAction{action}, nil
+}
+
+func autoScalingScheduledActionItemMapper(_, scope string, awsItem *autoScalingScheduledAction) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "autoscaling-scheduled-action",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.AutoScalingGroupName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "autoscaling-auto-scaling-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.AutoScalingGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the group deletes its scheduled actions
+				In: true,
+				// The action changes the capacity of the group
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAutoScalingScheduledActionAdapter(client autoScalingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*autoScalingScheduledAction, autoScalingClient, *autoscaling.Options] {
+	return &adapterhelpers.GetListAdapter[*autoScalingScheduledAction, autoScalingClient, *autoscaling.Options]{
+		ItemType:        "autoscaling-scheduled-action",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: autoScalingScheduledActionAdapterMetadata,
+		GetFunc:         autoScalingScheduledActionGetFunc,
+		ListFunc:        autoScalingScheduledActionListFunc,
+		SearchFunc:      autoScalingScheduledActionSearchFunc,
+		ItemMapper:      autoScalingScheduledActionItemMapper,
+	}
+}
+
+var autoScalingScheduledActionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-scheduled-action",
+	DescriptiveName: "Autoscaling Scheduled Action",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a scheduled action by {autoScalingGroupName}/{scheduledActionName}",
+		List:              true,
+		ListDescription:   "List all scheduled actions",
+		Search:            true,
+		SearchDescription: "Search for a scheduled action by ARN, or for the scheduled actions of an Autoscaling Group by group name",
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_autoscaling_schedule.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(autoScalingScheduledActionAdapterMetadata, sdp.AttributeSchemaFor(&autoScalingScheduledAction{}))
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAutoScalingScheduledActionGet(t *testing.T) {
+	adapter := NewAutoScalingScheduledActionAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "web/scale-up-mornings", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAutoScalingScheduledActionSearch(t *testing.T) {
+	adapter := NewAutoScalingScheduledActionAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	tests := map[string]string{
+		"workers": "workers/scale-down-nights",
+		"arn:aws:autoscaling:eu-west-2:123456789012:scheduledUpdateGroupAction:8e86b655-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/web:scheduledActionName/scale-up-mornings": "web/scale-up-mornings",
+	}
+
+	for query, expected := range tests {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != expected {
+			t.Errorf("expected %v for %v, got %v", expected, query, items)
+		}
+	}
+}
+
+func TestNewAutoScalingScheduledActionAdapter(t *testing.T) {
+	client, account, region := autoScalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingScheduledActionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
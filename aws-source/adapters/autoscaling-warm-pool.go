@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// autoScalingWarmPool is the warm pool of an Auto Scaling group along with the
+// pre-initialised instances in it. A group has at most one warm pool, so it is
+// identified by the group name
+type autoScalingWarmPool struct {
+	AutoScalingGroupName string
+	types.WarmPoolConfiguration
+	Instances []types.Instance
+}
+
+func autoScalingWarmPoolGetFunc(ctx context.Context, client autoScalingClient, scope, query string) (*autoScalingWarmPool, error) {
+	pool := &autoScalingWarmPool{
+		AutoScalingGroupName: query,
+		Instances:            make([]types.Instance, 0),
+	}
+
+	paginator := autoscaling.NewDescribeWarmPoolPaginator(client, &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.WarmPoolConfiguration != nil {
+			pool.WarmPoolConfiguration = *out.WarmPoolConfiguration
+		}
+
+		pool.Instances = append(pool.Instances, out.Instances...)
+	}
+
+	// Groups without a warm pool return an empty configuration
+	if pool.MinSize == nil && pool.MaxGroupPreparedCapacity == nil && pool.PoolState == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("Autoscaling Group %v does not have a warm pool", query),
+			Scope:       scope,
+		}
+	}
+
+	return pool, nil
+}
+
+// Groups include the configuration of their warm pool, so only the groups that
+// have one are described
+func autoScalingWarmPoolListFunc(ctx context.Context, client autoScalingClient, scope string) ([]*autoScalingWarmPool, error) {
+	groups, err := listAutoScalingGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]*autoScalingWarmPool, 0)
+
+	for _, group := range groups {
+		if group.WarmPoolConfiguration == nil || group.AutoScalingGroupName == nil {
+			continue
+		}
+
+		pool, err := autoScalingWarmPoolGetFunc(ctx, client, scope, *group.AutoScalingGroupName)
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+func autoScalingWarmPoolItemMapper(_, scope string, awsItem *autoScalingWarmPool) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "autoscaling-warm-pool",
+		UniqueAttribute: "AutoScalingGroupName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Status == types.WarmPoolStatusPendingDelete {
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "autoscaling-auto-scaling-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  awsItem.AutoScalingGroupName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The pool uses the launch configuration of the group
+			In: true,
+			// The group scales out using instances from the pool
+			Out: true,
+		},
+	})
+
+	for _, instance := range awsItem.Instances {
+		if instance.InstanceId == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  *instance.InstanceId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The instances are the capacity of the pool
+				In: true,
+				// Changing the pool replaces the instances
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAutoScalingWarmPoolAdapter(client autoScalingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*autoScalingWarmPool, autoScalingClient, *autoscaling.Options] {
+	return &adapterhelpers.GetListAdapter[*autoScalingWarmPool, autoScalingClient, *autoscaling.Options]{
+		ItemType:        "autoscaling-warm-pool",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: autoScalingWarmPoolAdapterMetadata,
+		GetFunc:         autoScalingWarmPoolGetFunc,
+		ListFunc:        autoScalingWarmPoolListFunc,
+		ItemMapper:      autoScalingWarmPoolItemMapper,
+	}
+}
+
+var autoScalingWarmPoolAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-warm-pool",
+	DescriptiveName: "Autoscaling Warm Pool",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		GetDescription:  "Get the warm pool of an Autoscaling Group by group name",
+		List:            true,
+		ListDescription: "List the warm pools of all Autoscaling Groups",
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group", "ec2-instance"},
+})
+
+var _ = Metadata.RegisterSchema(autoScalingWarmPoolAdapterMetadata, sdp.AttributeSchemaFor(&autoScalingWarmPool{}))
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestAutoScalingWarmPoolList(t *testing.T) {
+	adapter := NewAutoScalingWarmPoolAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	// Only groups with a warm pool are described
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAutoScalingWarmPoolGetWithoutPool(t *testing.T) {
+	adapter := NewAutoScalingWarmPoolAdapter(testAutoScalingClient{}, "123456789012", "eu-west-2")
+
+	_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "workers", false)
+
+	var qErr *sdp.QueryError
+	if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+		t.Errorf("expected a NOTFOUND error, got %v", err)
+	}
+}
+
+func TestNewAutoScalingWarmPoolAdapter(t *testing.T) {
+	client, account, region := autoScalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingWarmPoolAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,50 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type autoScalingClient interface {
+	DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	DescribeLifecycleHooks(ctx context.Context, params *autoscaling.DescribeLifecycleHooksInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeLifecycleHooksOutput, error)
+	DescribeScheduledActions(ctx context.Context, params *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error)
+	DescribeWarmPool(ctx context.Context, params *autoscaling.DescribeWarmPoolInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeWarmPoolOutput, error)
+}
+
+func listAutoScalingGroups(ctx context.Context, client autoScalingClient) ([]types.AutoScalingGroup, error) {
+	groups := make([]types.AutoScalingGroup, 0)
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(client, &autoscaling.DescribeAutoScalingGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, out.AutoScalingGroups...)
+	}
+
+	return groups, nil
+}
+
+// Parses the ID of something that belongs to an Auto Scaling group, in the
+// same format as the Terraform import ID: {autoScalingGroupName}/{name}
+func parseAutoScalingGroupChildID(scope, query string) (string, string, error) {
+	groupName, name, found := strings.Cut(query, "/")
+	if !found || groupName == "" || name == "" {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {autoScalingGroupName}/{name}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	return groupName, name, nil
+}
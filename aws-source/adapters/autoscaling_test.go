@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+)
+
+type testAutoScalingClient struct{}
+
+func (t testAutoScalingClient) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []types.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String("web"),
+				MinSize:              aws.Int32(2),
+				MaxSize:              aws.Int32(10),
+				DesiredCapacity:      aws.Int32(4),
+				WarmPoolConfiguration: &types.WarmPoolConfiguration{
+					MinSize:   aws.Int32(1),
+					PoolState: types.WarmPoolStateStopped,
+				},
+			},
+			{
+				AutoScalingGroupName: aws.String("workers"),
+				MinSize:              aws.Int32(0),
+				MaxSize:              aws.Int32(5),
+				DesiredCapacity:      aws.Int32(1),
+			},
+		},
+	}, nil
+}
+
+func (t testAutoScalingClient) DescribeLifecycleHooks(ctx context.Context, params *autoscaling.DescribeLifecycleHooksInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+	hooks := map[string][]types.LifecycleHook{
+		"web": {
+			{
+				AutoScalingGroupName:  aws.String("web"),
+				LifecycleHookName:     aws.String("drain"),
+				LifecycleTransition:   aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+				DefaultResult:         aws.String("CONTINUE"),
+				HeartbeatTimeout:      aws.Int32(300),
+				GlobalTimeout:         aws.Int32(30000),
+				NotificationTargetARN: aws.String("arn:aws:sqs:eu-west-2:123456789012:lifecycle"),
+				RoleARN:               aws.String("arn:aws:iam::123456789012:role/lifecycle-hooks"),
+			},
+		},
+		"workers": {
+			{
+				AutoScalingGroupName: aws.String("workers"),
+				LifecycleHookName:    aws.String("bootstrap"),
+				LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_LAUNCHING"),
+				DefaultResult:        aws.String("ABANDON"),
+				HeartbeatTimeout:     aws.Int32(600),
+			},
+		},
+	}[*params.AutoScalingGroupName]
+
+	if len(params.LifecycleHookNames) > 0 {
+		filtered := make([]types.LifecycleHook, 0)
+
+		for _, hook := range hooks {
+			if *hook.LifecycleHookName == params.LifecycleHookNames[0] {
+				filtered = append(filtered, hook)
+			}
+		}
+
+		hooks = filtered
+	}
+
+	return &autoscaling.DescribeLifecycleHooksOutput{
+		LifecycleHooks: hooks,
+	}, nil
+}
+
+func (t testAutoScalingClient) DescribeScheduledActions(ctx context.Context, params *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	actions := make([]types.ScheduledUpdateGroupAction, 0)
+
+	for _, action := range []types.ScheduledUpdateGroupAction{
+		{
+			AutoScalingGroupName: aws.String("web"),
+			ScheduledActionName:  aws.String("scale-up-mornings"),
+			ScheduledActionARN:   aws.String("arn:aws:autoscaling:eu-west-2:123456789012:scheduledUpdateGroupAction:8e86b655-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/web:scheduledActionName/scale-up-mornings"),
+			Recurrence:           aws.String("0 7 * * MON-FRI"),
+			TimeZone:             aws.String("Europe/London"),
+			MinSize:              aws.Int32(4),
+			DesiredCapacity:      aws.Int32(6),
+			StartTime:            aws.Time(time.Now()),
+		},
+		{
+			AutoScalingGroupName: aws.String("workers"),
+			ScheduledActionName:  aws.String("scale-down-nights"),
+			ScheduledActionARN:   aws.String("arn:aws:autoscaling:eu-west-2:123456789012:scheduledUpdateGroupAction:0a1b2c3d-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/workers:scheduledActionName/scale-down-nights"),
+			Recurrence:           aws.String("0 20 * * *"),
+			DesiredCapacity:      aws.Int32(0),
+		},
+	} {
+		if params.AutoScalingGroupName != nil && *params.AutoScalingGroupName != *action.AutoScalingGroupName {
+			continue
+		}
+
+		if len(params.ScheduledActionNames) > 0 && params.ScheduledActionNames[0] != *action.ScheduledActionName {
+			continue
+		}
+
+		actions = append(actions, action)
+	}
+
+	return &autoscaling.DescribeScheduledActionsOutput{
+		ScheduledUpdateGroupActions: actions,
+	}, nil
+}
+
+func (t testAutoScalingClient) DescribeWarmPool(ctx context.Context, params *autoscaling.DescribeWarmPoolInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeWarmPoolOutput, error) {
+	if *params.AutoScalingGroupName != "web" {
+		// Groups without a warm pool return an empty configuration
+		return &autoscaling.DescribeWarmPoolOutput{}, nil
+	}
+
+	return &autoscaling.DescribeWarmPoolOutput{
+		WarmPoolConfiguration: &types.WarmPoolConfiguration{
+			MinSize:   aws.Int32(1),
+			PoolState: types.WarmPoolStateStopped,
+			InstanceReusePolicy: &types.InstanceReusePolicy{
+				ReuseOnScaleIn: aws.Bool(true),
+			},
+		},
+		Instances: []types.Instance{
+			{
+				InstanceId:       aws.String("i-0123456789abcdef0"),
+				AvailabilityZone: aws.String("eu-west-2a"),
+				HealthStatus:     aws.String("Healthy"),
+				LifecycleState:   types.LifecycleStateWarmedStopped,
+			},
+		},
+	}, nil
+}
+
+func autoScalingGetAutoConfig(t *testing.T) (*autoscaling.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := autoscaling.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func backupPlanGetFunc(ctx context.Context, client backupClient, _, query string) (*backup.GetBackupPlanOutput, error) {
+	return client.GetBackupPlan(ctx, &backup.GetBackupPlanInput{
+		BackupPlanId: &query,
+	})
+}
+
+func backupPlanListFunc(ctx context.Context, client backupClient, scope string) ([]*backup.GetBackupPlanOutput, error) {
+	plans := make([]*backup.GetBackupPlanOutput, 0)
+	paginator := backup.NewListBackupPlansPaginator(client, &backup.ListBackupPlansInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include the rules of the plans
+		for _, summary := range out.BackupPlansList {
+			plan, err := backupPlanGetFunc(ctx, client, scope, *summary.BackupPlanId)
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans, nil
+}
+
+func backupPlanListTags(ctx context.Context, plan *backup.GetBackupPlanOutput, client backupClient) (map[string]string, error) {
+	return backupListTags(ctx, client, plan.BackupPlanArn)
+}
+
+func backupPlanOutputMapper(_, scope string, awsItem *backup.GetBackupPlanOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "backup-plan",
+		UniqueAttribute: "BackupPlanId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.BackupPlanId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "backup-selection",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.BackupPlanId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Selections can't affect the plan
+				In: false,
+				// Changing the plan changes how the selected resources are
+				// backed up
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.BackupPlan == nil {
+		return &item, nil
+	}
+
+	// Rules often share vaults, each vault is only linked once
+	seen := make(map[string]bool)
+	addLink := func(link *sdp.LinkedItemQuery) {
+		if link == nil {
+			return
+		}
+
+		key := link.GetQuery().GetScope() + "/" + link.GetQuery().GetQuery()
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+	}
+
+	for _, rule := range awsItem.BackupPlan.Rules {
+		if rule.TargetBackupVaultName != nil {
+			addLink(&sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "backup-vault",
+					Method: sdp.QueryMethod_GET,
+					Query:  *rule.TargetBackupVaultName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the vault stops backups from being stored in
+					// it
+					In: true,
+					// The plan can't affect the vault
+					Out: false,
+				},
+			})
+		}
+
+		if rule.TargetLogicallyAirGappedBackupVaultArn != nil {
+			addLink(backupVaultARNLink(*rule.TargetLogicallyAirGappedBackupVaultArn))
+		}
+
+		for _, copyAction := range rule.CopyActions {
+			if copyAction.DestinationBackupVaultArn != nil {
+				addLink(backupVaultARNLink(*copyAction.DestinationBackupVaultArn))
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewBackupPlanAdapter(client backupClient, accountID string, region string) *adapterhelpers.GetListAdapter[*backup.GetBackupPlanOutput, backupClient, *backup.Options] {
+	return &adapterhelpers.GetListAdapter[*backup.GetBackupPlanOutput, backupClient, *backup.Options]{
+		ItemType:        "backup-plan",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: backupPlanAdapterMetadata,
+		GetFunc:         backupPlanGetFunc,
+		ListFunc:        backupPlanListFunc,
+		ItemMapper:      backupPlanOutputMapper,
+		ListTagsFunc:    backupPlanListTags,
+	}
+}
+
+var backupPlanAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "backup-plan",
+	DescriptiveName: "Backup Plan",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a backup plan by ID",
+		List:              true,
+		ListDescription:   "List all backup plans",
+		Search:            true,
+		SearchDescription: "Search for a backup plan by ARN",
+	},
+	PotentialLinks: []string{"backup-selection", "backup-vault"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_backup_plan.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(backupPlanAdapterMetadata, sdp.AttributeSchemaFor(backup.GetBackupPlanOutput{}))
@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestBackupPlanGet(t *testing.T) {
+	adapter := NewBackupPlanAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testBackupPlanID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	// Both rules use the same vaults, which are only linked once
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("expected 3 links, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "backup-selection",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testBackupPlanID,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "backup-vault",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "primary",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "backup-vault",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dr",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBackupPlanList(t *testing.T) {
+	adapter := NewBackupPlanAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The plan that was deleted after being listed is skipped
+	if len(items) != 1 {
+		t.Fatalf("expected 1 plan, got %v", len(items))
+	}
+}
+
+func TestNewBackupPlanAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := backup.NewFromConfig(config)
+
+	adapter := NewBackupPlanAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,218 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// backupSelection assigns resources to a backup plan, either by ARN or by
+// tag. It is only unique within its plan
+type backupSelection struct {
+	// The plan ID and selection ID separated by a slash
+	UniqueName   string
+	BackupPlanId *string
+	SelectionId  *string
+	CreationDate *time.Time
+	types.BackupSelection
+
+	// The resources in this region that are assigned by tag, found with the
+	// tagging API
+	TaggedResources []string
+}
+
+func backupSelectionGetFunc(ctx context.Context, client backupSelectionClient, _, query string) (*backupSelection, error) {
+	planID, selectionID, found := strings.Cut(query, "/")
+	if !found || planID == "" || selectionID == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format backup-plan-id/selection-id, got %v", query),
+		}
+	}
+
+	out, err := client.GetBackupSelection(ctx, &backup.GetBackupSelectionInput{
+		BackupPlanId: &planID,
+		SelectionId:  &selectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selection := &backupSelection{
+		UniqueName:   query,
+		BackupPlanId: out.BackupPlanId,
+		SelectionId:  out.SelectionId,
+		CreationDate: out.CreationDate,
+	}
+	if out.BackupSelection != nil {
+		selection.BackupSelection = *out.BackupSelection
+	}
+
+	if client.Tagging != nil {
+		selection.TaggedResources, err = backupSelectionTaggedResources(ctx, client.Tagging, selection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return selection, nil
+}
+
+// Searches for the selections of a plan by the ID of the plan
+func backupSelectionSearchFunc(ctx context.Context, client backupSelectionClient, scope, query string) ([]*backupSelection, error) {
+	selections := make([]*backupSelection, 0)
+	paginator := backup.NewListBackupSelectionsPaginator(client, &backup.ListBackupSelectionsInput{
+		BackupPlanId: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include the resources or tags of the selections
+		for _, summary := range out.BackupSelectionsList {
+			selection, err := backupSelectionGetFunc(ctx, client, scope, fmt.Sprintf("%s/%s", query, *summary.SelectionId))
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			selections = append(selections, selection)
+		}
+	}
+
+	return selections, nil
+}
+
+func backupSelectionOutputMapper(_, scope string, awsItem *backupSelection) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "backup-selection",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.BackupPlanId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "backup-plan",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.BackupPlanId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the plan changes how the selected resources are
+				// backed up
+				In: true,
+				// The selection can't affect the plan
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.IamRoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.IamRoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.IamRoleArn,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Backup uses the role to back up the resources
+					In: true,
+					// The selection can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	// Resources that are selected by ARN, and resources that are selected by
+	// tag. Wildcard ARNs aren't linked, they only narrow down the tagged
+	// resources
+	seen := make(map[string]bool)
+	resourceARNs := make([]string, 0, len(awsItem.Resources)+len(awsItem.TaggedResources))
+	for _, resourceARN := range awsItem.Resources {
+		if !strings.Contains(resourceARN, "*") && !backupARNMatchesAny(awsItem.NotResources, resourceARN) {
+			resourceARNs = append(resourceARNs, resourceARN)
+		}
+	}
+	resourceARNs = append(resourceARNs, awsItem.TaggedResources...)
+
+	for _, resourceARN := range resourceARNs {
+		if seen[resourceARN] {
+			continue
+		}
+		seen[resourceARN] = true
+
+		if link := backupResourceLink(accountID, resourceARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+// The tagging client is used to resolve the resources that are selected by tag,
+// if it is nil only the resources that are selected by ARN are linked
+func NewBackupSelectionAdapter(client backupClient, tagging backupTaggingClient, accountID string, region string) *adapterhelpers.GetListAdapter[*backupSelection, backupSelectionClient, *backup.Options] {
+	return &adapterhelpers.GetListAdapter[*backupSelection, backupSelectionClient, *backup.Options]{
+		ItemType: "backup-selection",
+		Client: backupSelectionClient{
+			backupClient: client,
+			Tagging:      tagging,
+		},
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: backupSelectionAdapterMetadata,
+		GetFunc:         backupSelectionGetFunc,
+		DisableList:     true,
+		SearchFunc:      backupSelectionSearchFunc,
+		ItemMapper:      backupSelectionOutputMapper,
+	}
+}
+
+var backupSelectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "backup-selection",
+	DescriptiveName: "Backup Selection",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a backup selection by backup plan ID and selection ID, separated by a slash",
+		Search:            true,
+		SearchDescription: "Search for the selections of a backup plan by backup plan ID",
+	},
+	PotentialLinks: []string{"backup-plan", "iam-role", "rds-db-instance", "rds-db-cluster", "efs-file-system", "dynamodb-table", "ec2-instance", "ec2-volume", "s3-bucket"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_backup_selection.plan_id",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(backupSelectionAdapterMetadata, sdp.AttributeSchemaFor(&backupSelection{}))
@@ -0,0 +1,145 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestBackupSelectionGetByARN(t *testing.T) {
+	adapter := NewBackupSelectionAdapter(testBackupClient{}, testBackupTaggingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testBackupPlanID+"/by-arn", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	// The file system is excluded, and the wildcard isn't linked
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("expected 3 links, got %v", item.GetLinkedItemQueries())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "backup-plan",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testBackupPlanID,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testBackupRoleARN,
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "rds-db-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBackupSelectionGetByTag(t *testing.T) {
+	adapter := NewBackupSelectionAdapter(testBackupClient{}, testBackupTaggingClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testBackupPlanID+"/by-tag", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	// The excluded instance and the bucket, which doesn't match the resources
+	// of the selection, aren't linked
+	if len(item.GetLinkedItemQueries()) != 4 {
+		t.Errorf("expected 4 links, got %v", item.GetLinkedItemQueries())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Without a tagging client only the resources selected by ARN are linked
+	adapter = NewBackupSelectionAdapter(testBackupClient{}, nil, "123456789012", "eu-west-2")
+
+	item, err = adapter.Get(context.Background(), "123456789012.eu-west-2", testBackupPlanID+"/by-tag", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(item.GetLinkedItemQueries()) != 2 {
+		t.Errorf("expected 2 links, got %v", item.GetLinkedItemQueries())
+	}
+}
+
+func TestBackupSelectionGetBadQuery(t *testing.T) {
+	adapter := NewBackupSelectionAdapter(testBackupClient{}, testBackupTaggingClient{}, "123456789012", "eu-west-2")
+
+	_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testBackupPlanID, false)
+
+	var qErr *sdp.QueryError
+	if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+		t.Errorf("expected a NOTFOUND error, got %v", err)
+	}
+}
+
+func TestBackupSelectionSearch(t *testing.T) {
+	adapter := NewBackupSelectionAdapter(testBackupClient{}, testBackupTaggingClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testBackupPlanID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The selection that was deleted after being listed is skipped
+	if len(items) != 2 {
+		t.Fatalf("expected 2 selections, got %v", len(items))
+	}
+}
+
+func TestNewBackupSelectionAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := backup.NewFromConfig(config)
+	tagging := resourcegroupstaggingapi.NewFromConfig(config)
+
+	adapter := NewBackupSelectionAdapter(client, tagging, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
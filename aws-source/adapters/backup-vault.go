@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func backupVaultGetFunc(ctx context.Context, client backupClient, _, query string) (*backup.DescribeBackupVaultOutput, error) {
+	return client.DescribeBackupVault(ctx, &backup.DescribeBackupVaultInput{
+		BackupVaultName: &query,
+	})
+}
+
+func backupVaultListFunc(ctx context.Context, client backupClient, scope string) ([]*backup.DescribeBackupVaultOutput, error) {
+	vaults := make([]*backup.DescribeBackupVaultOutput, 0)
+	paginator := backup.NewListBackupVaultsPaginator(client, &backup.ListBackupVaultsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include all the details of the vaults
+		for _, summary := range out.BackupVaultList {
+			vault, err := backupVaultGetFunc(ctx, client, scope, *summary.BackupVaultName)
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			vaults = append(vaults, vault)
+		}
+	}
+
+	return vaults, nil
+}
+
+func backupVaultListTags(ctx context.Context, vault *backup.DescribeBackupVaultOutput, client backupClient) (map[string]string, error) {
+	return backupListTags(ctx, client, vault.BackupVaultArn)
+}
+
+func backupVaultOutputMapper(_, scope string, awsItem *backup.DescribeBackupVaultOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "backup-vault",
+		UniqueAttribute: "BackupVaultName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.VaultState {
+	case types.VaultStateAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.VaultStateCreating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.VaultStateFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.EncryptionKeyArn != nil {
+		if link := kmsKeyLink(scope, *awsItem.EncryptionKeyArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.SourceBackupVaultArn != nil {
+		// Restore access vaults give access to the recovery points of another
+		// vault
+		if a, err := adapterhelpers.ParseARN(*awsItem.SourceBackupVaultArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "backup-vault",
+					Method: sdp.QueryMethod_GET,
+					Query:  a.ResourceID(),
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the source vault removes the recovery points
+					In: true,
+					// This vault can't affect the source vault
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewBackupVaultAdapter(client backupClient, accountID string, region string) *adapterhelpers.GetListAdapter[*backup.DescribeBackupVaultOutput, backupClient, *backup.Options] {
+	return &adapterhelpers.GetListAdapter[*backup.DescribeBackupVaultOutput, backupClient, *backup.Options]{
+		ItemType:        "backup-vault",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: backupVaultAdapterMetadata,
+		GetFunc:         backupVaultGetFunc,
+		ListFunc:        backupVaultListFunc,
+		ItemMapper:      backupVaultOutputMapper,
+		ListTagsFunc:    backupVaultListTags,
+	}
+}
+
+var backupVaultAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "backup-vault",
+	DescriptiveName: "Backup Vault",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a backup vault by name",
+		List:              true,
+		ListDescription:   "List all backup vaults",
+		Search:            true,
+		SearchDescription: "Search for a backup vault by ARN",
+	},
+	PotentialLinks: []string{"kms-key", "backup-vault"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_backup_vault.name"},
+		{TerraformQueryMap: "aws_backup_vault_lock_configuration.backup_vault_name"},
+		{TerraformQueryMap: "aws_backup_vault_policy.backup_vault_name"},
+		{TerraformQueryMap: "aws_backup_vault_notifications.backup_vault_name"},
+		{TerraformQueryMap: "aws_backup_logically_air_gapped_vault.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(backupVaultAdapterMetadata, sdp.AttributeSchemaFor(backup.DescribeBackupVaultOutput{}))
@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestBackupVaultGet(t *testing.T) {
+	adapter := NewBackupVaultAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "primary", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBackupVaultGetRestoreAccess(t *testing.T) {
+	adapter := NewBackupVaultAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "restore-access", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "backup-vault",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "primary",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBackupVaultList(t *testing.T) {
+	adapter := NewBackupVaultAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The vault that was deleted after being listed is skipped
+	if len(items) != 2 {
+		t.Fatalf("expected 2 vaults, got %v", len(items))
+	}
+}
+
+func TestBackupVaultSearch(t *testing.T) {
+	adapter := NewBackupVaultAdapter(testBackupClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testBackupVaultARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 vault, got %v", len(items))
+	}
+}
+
+func TestNewBackupVaultAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := backup.NewFromConfig(config)
+
+	adapter := NewBackupVaultAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,266 @@
+package adapters
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type backupClient interface {
+	DescribeBackupVault(ctx context.Context, params *backup.DescribeBackupVaultInput, optFns ...func(*backup.Options)) (*backup.DescribeBackupVaultOutput, error)
+	GetBackupPlan(ctx context.Context, params *backup.GetBackupPlanInput, optFns ...func(*backup.Options)) (*backup.GetBackupPlanOutput, error)
+	GetBackupSelection(ctx context.Context, params *backup.GetBackupSelectionInput, optFns ...func(*backup.Options)) (*backup.GetBackupSelectionOutput, error)
+	ListBackupPlans(ctx context.Context, params *backup.ListBackupPlansInput, optFns ...func(*backup.Options)) (*backup.ListBackupPlansOutput, error)
+	ListBackupSelections(ctx context.Context, params *backup.ListBackupSelectionsInput, optFns ...func(*backup.Options)) (*backup.ListBackupSelectionsOutput, error)
+	ListBackupVaults(ctx context.Context, params *backup.ListBackupVaultsInput, optFns ...func(*backup.Options)) (*backup.ListBackupVaultsOutput, error)
+	ListTags(ctx context.Context, params *backup.ListTagsInput, optFns ...func(*backup.Options)) (*backup.ListTagsOutput, error)
+}
+
+// The tagging API is used to find the resources that a backup selection
+// assigns by tag, since Backup itself doesn't return them
+type backupTaggingClient interface {
+	resourcegroupstaggingapi.GetResourcesAPIClient
+}
+
+// backupSelectionClient is the pair of clients that the backup selection
+// adapter needs
+type backupSelectionClient struct {
+	backupClient
+	Tagging backupTaggingClient
+}
+
+// The resource types that backup selections are resolved to, in the format
+// that the tagging API expects
+var backupTaggedResourceTypes = []string{
+	"dynamodb:table",
+	"ec2:instance",
+	"ec2:volume",
+	"elasticfilesystem:file-system",
+	"rds:cluster",
+	"rds:db",
+	"s3",
+}
+
+func backupListTags(ctx context.Context, client backupClient, resourceARN *string) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := backup.NewListTagsPaginator(client, &backup.ListTagsInput{
+		ResourceArn: resourceARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range out.Tags {
+			tags[k] = v
+		}
+	}
+
+	return tags, nil
+}
+
+// Links to a vault by ARN, e.g. the destination of a copy action
+func backupVaultARNLink(vaultARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(vaultARN)
+	if err != nil || a.Service != "backup" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "backup-vault",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.ResourceID(),
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the vault stops backups from being stored in it
+			In: true,
+			// The plan can't affect the vault
+			Out: false,
+		},
+	}
+}
+
+// Links to a resource that a backup selection protects. Resources of types
+// that don't have an adapter aren't linked
+func backupResourceLink(accountID string, resourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(resourceARN)
+	if err != nil {
+		return nil
+	}
+
+	scope := adapterhelpers.FormatScope(a.AccountID, a.Region)
+	query := a.ResourceID()
+
+	var queryType string
+	switch a.Service {
+	case "rds":
+		// RDS ARNs use colons, e.g. arn:aws:rds:eu-west-2:123456789012:db:mydb
+		switch a.Type() {
+		case "db":
+			queryType = "rds-db-instance"
+		case "cluster":
+			queryType = "rds-db-cluster"
+		}
+	case "elasticfilesystem":
+		if a.Type() == "file-system" {
+			queryType = "efs-file-system"
+		}
+	case "dynamodb":
+		if a.Type() == "table" {
+			queryType = "dynamodb-table"
+		}
+	case "ec2":
+		switch a.Type() {
+		case "instance":
+			queryType = "ec2-instance"
+		case "volume":
+			queryType = "ec2-volume"
+		}
+	case "s3":
+		// Bucket ARNs don't contain the account, buckets are assumed to be
+		// in the same account as the selection
+		queryType = "s3-bucket"
+		query = a.Resource
+		scope = adapterhelpers.FormatScope(accountID, "")
+	}
+
+	if queryType == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_GET,
+			Query:  query,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The resource can't affect the selection
+			In: false,
+			// Changing the selection changes whether the resource is backed
+			// up
+			Out: true,
+		},
+	}
+}
+
+// Returns whether an ARN matches a pattern from the resources of a backup
+// selection, which can contain `*` wildcards
+func backupARNMatches(pattern string, resourceARN string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == resourceARN
+	}
+
+	quoted := strings.Split(pattern, "*")
+	for i, part := range quoted {
+		quoted[i] = regexp.QuoteMeta(part)
+	}
+
+	matched, err := regexp.MatchString("^"+strings.Join(quoted, ".*")+"$", resourceARN)
+
+	return err == nil && matched
+}
+
+// Returns the tag filters of a backup selection. Each set of filters is
+// resolved separately and the results combined. Resources match any of the
+// legacy ListOfTags conditions, but must match all of the StringEquals
+// conditions. Other condition types can't be expressed as tag filters and
+// are ignored
+func backupSelectionTagFilters(selection *backupSelection) [][]taggingtypes.TagFilter {
+	filterSets := make([][]taggingtypes.TagFilter, 0)
+
+	for _, condition := range selection.ListOfTags {
+		if condition.ConditionKey != nil && condition.ConditionValue != nil {
+			filterSets = append(filterSets, []taggingtypes.TagFilter{
+				{Key: condition.ConditionKey, Values: []string{*condition.ConditionValue}},
+			})
+		}
+	}
+
+	if selection.Conditions != nil && len(selection.Conditions.StringEquals) > 0 {
+		filters := make([]taggingtypes.TagFilter, 0, len(selection.Conditions.StringEquals))
+		for _, condition := range selection.Conditions.StringEquals {
+			if condition.ConditionKey == nil || condition.ConditionValue == nil {
+				continue
+			}
+
+			// The keys are in the format aws:ResourceTag/{key}
+			key := strings.TrimPrefix(*condition.ConditionKey, "aws:ResourceTag/")
+			filters = append(filters, taggingtypes.TagFilter{Key: &key, Values: []string{*condition.ConditionValue}})
+		}
+
+		if len(filters) > 0 {
+			filterSets = append(filterSets, filters)
+		}
+	}
+
+	return filterSets
+}
+
+// Finds the resources in the current region that a backup selection assigns
+// by tag, narrowed down by the resources and excluded resources of the
+// selection
+func backupSelectionTaggedResources(ctx context.Context, client backupTaggingClient, selection *backupSelection) ([]string, error) {
+	resourceARNs := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, filters := range backupSelectionTagFilters(selection) {
+		paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(client, &resourcegroupstaggingapi.GetResourcesInput{
+			TagFilters:          filters,
+			ResourceTypeFilters: backupTaggedResourceTypes,
+		})
+
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, mapping := range out.ResourceTagMappingList {
+				if mapping.ResourceARN == nil || seen[*mapping.ResourceARN] {
+					continue
+				}
+				seen[*mapping.ResourceARN] = true
+
+				if backupSelectionIncludes(selection, *mapping.ResourceARN) {
+					resourceARNs = append(resourceARNs, *mapping.ResourceARN)
+				}
+			}
+		}
+	}
+
+	return resourceARNs, nil
+}
+
+// Returns whether an ARN matches any of the patterns
+func backupARNMatchesAny(patterns []string, resourceARN string) bool {
+	for _, pattern := range patterns {
+		if backupARNMatches(pattern, resourceARN) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns whether a resource that matches the tags of a selection is also
+// within its resources, and isn't excluded
+func backupSelectionIncludes(selection *backupSelection, resourceARN string) bool {
+	if backupARNMatchesAny(selection.NotResources, resourceARN) {
+		return false
+	}
+
+	return len(selection.Resources) == 0 || backupARNMatchesAny(selection.Resources, resourceARN)
+}
@@ -0,0 +1,315 @@
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	testBackupVaultARN    = "arn:aws:backup:eu-west-2:123456789012:backup-vault:primary"
+	testBackupDRVaultARN  = "arn:aws:backup:us-east-1:123456789012:backup-vault:dr"
+	testBackupPlanID      = "8f2b8e47-1c1d-4d6a-9a4c-EXAMPLE11111"
+	testBackupRoleARN     = "arn:aws:iam::123456789012:role/service-role/AWSBackupDefaultServiceRole"
+	testBackupDBARN       = "arn:aws:rds:eu-west-2:123456789012:db:orders"
+	testBackupFileSystem  = "arn:aws:elasticfilesystem:eu-west-2:123456789012:file-system/fs-0123456789abcdef0"
+	testBackupTableARN    = "arn:aws:dynamodb:eu-west-2:123456789012:table/orders"
+	testBackupInstanceARN = "arn:aws:ec2:eu-west-2:123456789012:instance/i-0123456789abcdef0"
+	// An instance that is tagged for backup but excluded from the selection
+	testBackupExcludedInstanceARN = "arn:aws:ec2:eu-west-2:123456789012:instance/i-0fedcba9876543210"
+)
+
+type testBackupClient struct{}
+
+func (t testBackupClient) DescribeBackupVault(ctx context.Context, params *backup.DescribeBackupVaultInput, optFns ...func(*backup.Options)) (*backup.DescribeBackupVaultOutput, error) {
+	switch *params.BackupVaultName {
+	case "primary":
+		return &backup.DescribeBackupVaultOutput{
+			BackupVaultName:        params.BackupVaultName,
+			BackupVaultArn:         aws.String(testBackupVaultARN),
+			VaultType:              types.VaultTypeBackupVault,
+			VaultState:             types.VaultStateAvailable,
+			EncryptionKeyArn:       aws.String("arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+			NumberOfRecoveryPoints: 42,
+			Locked:                 aws.Bool(true),
+			MinRetentionDays:       aws.Int64(7),
+			CreationDate:           aws.Time(time.Now()),
+		}, nil
+	case "restore-access":
+		return &backup.DescribeBackupVaultOutput{
+			BackupVaultName:      params.BackupVaultName,
+			BackupVaultArn:       aws.String("arn:aws:backup:eu-west-2:123456789012:backup-vault:restore-access"),
+			VaultType:            types.VaultTypeRestoreAccessBackupVault,
+			VaultState:           types.VaultStateCreating,
+			SourceBackupVaultArn: aws.String(testBackupVaultARN),
+			CreationDate:         aws.Time(time.Now()),
+		}, nil
+	}
+
+	return nil, &types.ResourceNotFoundException{Message: aws.String("vault not found")}
+}
+
+func (t testBackupClient) GetBackupPlan(ctx context.Context, params *backup.GetBackupPlanInput, optFns ...func(*backup.Options)) (*backup.GetBackupPlanOutput, error) {
+	if *params.BackupPlanId != testBackupPlanID {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("plan not found")}
+	}
+
+	return &backup.GetBackupPlanOutput{
+		BackupPlanId:  params.BackupPlanId,
+		BackupPlanArn: aws.String("arn:aws:backup:eu-west-2:123456789012:backup-plan:" + testBackupPlanID),
+		VersionId:     aws.String("ZjQ2ZTI5YWQtZDg5Yi00MzYzLWJmZTAtMDI1MzhlMDhjYjEz"),
+		BackupPlan: &types.BackupPlan{
+			BackupPlanName: aws.String("daily"),
+			Rules: []types.BackupRule{
+				{
+					RuleName:              aws.String("daily"),
+					TargetBackupVaultName: aws.String("primary"),
+					ScheduleExpression:    aws.String("cron(0 5 ? * * *)"),
+					Lifecycle: &types.Lifecycle{
+						DeleteAfterDays: aws.Int64(35),
+					},
+					CopyActions: []types.CopyAction{
+						{DestinationBackupVaultArn: aws.String(testBackupDRVaultARN)},
+					},
+				},
+				{
+					// Uses the same vaults as the daily rule
+					RuleName:              aws.String("monthly"),
+					TargetBackupVaultName: aws.String("primary"),
+					ScheduleExpression:    aws.String("cron(0 5 1 * ? *)"),
+					CopyActions: []types.CopyAction{
+						{DestinationBackupVaultArn: aws.String(testBackupDRVaultARN)},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (t testBackupClient) GetBackupSelection(ctx context.Context, params *backup.GetBackupSelectionInput, optFns ...func(*backup.Options)) (*backup.GetBackupSelectionOutput, error) {
+	out := &backup.GetBackupSelectionOutput{
+		BackupPlanId: params.BackupPlanId,
+		SelectionId:  params.SelectionId,
+		CreationDate: aws.Time(time.Now()),
+	}
+
+	switch *params.SelectionId {
+	case "by-arn":
+		out.BackupSelection = &types.BackupSelection{
+			SelectionName: aws.String("by-arn"),
+			IamRoleArn:    aws.String(testBackupRoleARN),
+			Resources: []string{
+				testBackupDBARN,
+				testBackupFileSystem,
+				// Wildcards are only resolved through tags
+				"arn:aws:ec2:*:*:volume/*",
+			},
+			NotResources: []string{
+				testBackupFileSystem,
+			},
+		}
+	case "by-tag":
+		out.BackupSelection = &types.BackupSelection{
+			SelectionName: aws.String("by-tag"),
+			IamRoleArn:    aws.String(testBackupRoleARN),
+			Resources: []string{
+				"arn:aws:dynamodb:*:*:table/*",
+				"arn:aws:ec2:*:*:instance/*",
+			},
+			NotResources: []string{
+				testBackupExcludedInstanceARN,
+			},
+			Conditions: &types.Conditions{
+				StringEquals: []types.ConditionParameter{
+					{ConditionKey: aws.String("aws:ResourceTag/backup"), ConditionValue: aws.String("daily")},
+				},
+			},
+		}
+	default:
+		return nil, &types.ResourceNotFoundException{Message: aws.String("selection not found")}
+	}
+
+	return out, nil
+}
+
+func (t testBackupClient) ListBackupPlans(ctx context.Context, params *backup.ListBackupPlansInput, optFns ...func(*backup.Options)) (*backup.ListBackupPlansOutput, error) {
+	return &backup.ListBackupPlansOutput{
+		BackupPlansList: []types.BackupPlansListMember{
+			{BackupPlanId: aws.String(testBackupPlanID), BackupPlanName: aws.String("daily")},
+			// Deleted between being listed and fetched
+			{BackupPlanId: aws.String("deleted"), BackupPlanName: aws.String("deleted")},
+		},
+	}, nil
+}
+
+func (t testBackupClient) ListBackupSelections(ctx context.Context, params *backup.ListBackupSelectionsInput, optFns ...func(*backup.Options)) (*backup.ListBackupSelectionsOutput, error) {
+	return &backup.ListBackupSelectionsOutput{
+		BackupSelectionsList: []types.BackupSelectionsListMember{
+			{BackupPlanId: params.BackupPlanId, SelectionId: aws.String("by-arn")},
+			{BackupPlanId: params.BackupPlanId, SelectionId: aws.String("by-tag")},
+			{BackupPlanId: params.BackupPlanId, SelectionId: aws.String("deleted")},
+		},
+	}, nil
+}
+
+func (t testBackupClient) ListBackupVaults(ctx context.Context, params *backup.ListBackupVaultsInput, optFns ...func(*backup.Options)) (*backup.ListBackupVaultsOutput, error) {
+	// The vaults are split over two pages to check that we follow the token
+	if params.NextToken == nil {
+		return &backup.ListBackupVaultsOutput{
+			BackupVaultList: []types.BackupVaultListMember{
+				{BackupVaultName: aws.String("primary")},
+				{BackupVaultName: aws.String("deleted")},
+			},
+			NextToken: aws.String("page2"),
+		}, nil
+	}
+
+	return &backup.ListBackupVaultsOutput{
+		BackupVaultList: []types.BackupVaultListMember{
+			{BackupVaultName: aws.String("restore-access")},
+		},
+	}, nil
+}
+
+func (t testBackupClient) ListTags(ctx context.Context, params *backup.ListTagsInput, optFns ...func(*backup.Options)) (*backup.ListTagsOutput, error) {
+	return &backup.ListTagsOutput{
+		Tags: map[string]string{
+			"team": "platform",
+		},
+	}, nil
+}
+
+type testBackupTaggingClient struct{}
+
+func (t testBackupTaggingClient) GetResources(ctx context.Context, params *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	if len(params.TagFilters) != 1 || *params.TagFilters[0].Key != "backup" {
+		return &resourcegroupstaggingapi.GetResourcesOutput{}, nil
+	}
+
+	return &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []taggingtypes.ResourceTagMapping{
+			{ResourceARN: aws.String(testBackupTableARN)},
+			{ResourceARN: aws.String(testBackupInstanceARN)},
+			{ResourceARN: aws.String(testBackupExcludedInstanceARN)},
+			// Tagged, but not one of the resource types of the selection
+			{ResourceARN: aws.String("arn:aws:s3:::orders-archive")},
+		},
+	}, nil
+}
+
+func TestBackupARNMatches(t *testing.T) {
+	tests := []struct {
+		Pattern string
+		ARN     string
+		Matches bool
+	}{
+		{"arn:aws:ec2:*:*:instance/*", testBackupInstanceARN, true},
+		{"arn:aws:ec2:*:*:volume/*", testBackupInstanceARN, false},
+		{"*", testBackupTableARN, true},
+		{testBackupDBARN, testBackupDBARN, true},
+		{testBackupDBARN, testBackupDBARN + "-replica", false},
+		// Other characters are matched literally
+		{"arn:aws:rds:eu-west-2:123456789012:db:orders.*", "arn:aws:rds:eu-west-2:123456789012:db:ordersX", false},
+	}
+
+	for _, test := range tests {
+		if matches := backupARNMatches(test.Pattern, test.ARN); matches != test.Matches {
+			t.Errorf("expected %v matching %v to be %v", test.ARN, test.Pattern, test.Matches)
+		}
+	}
+}
+
+func TestBackupSelectionTagFilters(t *testing.T) {
+	selection := &backupSelection{
+		BackupSelection: types.BackupSelection{
+			ListOfTags: []types.Condition{
+				{ConditionType: types.ConditionTypeStringequals, ConditionKey: aws.String("backup"), ConditionValue: aws.String("daily")},
+				{ConditionType: types.ConditionTypeStringequals, ConditionKey: aws.String("backup"), ConditionValue: aws.String("weekly")},
+			},
+			Conditions: &types.Conditions{
+				StringEquals: []types.ConditionParameter{
+					{ConditionKey: aws.String("aws:ResourceTag/env"), ConditionValue: aws.String("prod")},
+					{ConditionKey: aws.String("aws:ResourceTag/team"), ConditionValue: aws.String("orders")},
+				},
+				// Can't be expressed as a tag filter
+				StringNotEquals: []types.ConditionParameter{
+					{ConditionKey: aws.String("aws:ResourceTag/ephemeral"), ConditionValue: aws.String("true")},
+				},
+			},
+		},
+	}
+
+	filterSets := backupSelectionTagFilters(selection)
+
+	// Each of the legacy conditions is a separate set, and the StringEquals
+	// conditions are combined
+	if len(filterSets) != 3 {
+		t.Fatalf("expected 3 sets of filters, got %v", len(filterSets))
+	}
+
+	keys := make([][]string, 0, len(filterSets))
+	for _, filters := range filterSets {
+		setKeys := make([]string, 0, len(filters))
+		for _, filter := range filters {
+			setKeys = append(setKeys, *filter.Key)
+		}
+		keys = append(keys, setKeys)
+	}
+
+	expected := [][]string{{"backup"}, {"backup"}, {"env", "team"}}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected filter keys %v, got %v", expected, keys)
+	}
+
+	if len(backupSelectionTagFilters(&backupSelection{})) != 0 {
+		t.Error("expected no filters for a selection without tags")
+	}
+}
+
+func TestBackupResourceLink(t *testing.T) {
+	tests := []struct {
+		ARN           string
+		ExpectedType  string
+		ExpectedQuery string
+		ExpectedScope string
+	}{
+		{testBackupDBARN, "rds-db-instance", "orders", "123456789012.eu-west-2"},
+		{"arn:aws:rds:eu-west-2:123456789012:cluster:orders", "rds-db-cluster", "orders", "123456789012.eu-west-2"},
+		{testBackupFileSystem, "efs-file-system", "fs-0123456789abcdef0", "123456789012.eu-west-2"},
+		{testBackupTableARN, "dynamodb-table", "orders", "123456789012.eu-west-2"},
+		{testBackupInstanceARN, "ec2-instance", "i-0123456789abcdef0", "123456789012.eu-west-2"},
+		{"arn:aws:ec2:eu-west-2:123456789012:volume/vol-0123456789abcdef0", "ec2-volume", "vol-0123456789abcdef0", "123456789012.eu-west-2"},
+		{"arn:aws:s3:::orders-archive", "s3-bucket", "orders-archive", "123456789012"},
+	}
+
+	for _, test := range tests {
+		link := backupResourceLink("123456789012", test.ARN)
+		if link == nil {
+			t.Errorf("expected a link for %v", test.ARN)
+			continue
+		}
+
+		query := link.GetQuery()
+		if query.GetType() != test.ExpectedType || query.GetMethod() != sdp.QueryMethod_GET || query.GetQuery() != test.ExpectedQuery || query.GetScope() != test.ExpectedScope {
+			t.Errorf("unexpected link for %v: %v", test.ARN, query)
+		}
+
+		if link.GetBlastPropagation().GetIn() || !link.GetBlastPropagation().GetOut() {
+			t.Errorf("expected blast propagation out to %v only", test.ARN)
+		}
+	}
+
+	// Types without an adapter aren't linked
+	if link := backupResourceLink("123456789012", "arn:aws:fsx:eu-west-2:123456789012:file-system/fs-0123456789abcdef0"); link != nil {
+		t.Errorf("expected no link for an FSx file system, got %v", link)
+	}
+}
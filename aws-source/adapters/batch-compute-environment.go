@@ -0,0 +1,280 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// Links to the instance profile of the instances that a compute environment
+// launches, which can be a name or an ARN
+func batchInstanceProfileLink(accountID string, instanceRole string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   "iam-instance-profile",
+		Method: sdp.QueryMethod_GET,
+		Query:  instanceRole,
+		Scope:  accountID,
+	}
+
+	if a, err := adapterhelpers.ParseARN(instanceRole); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changes to the profile will affect the instances
+			In: true,
+			// The compute environment can't affect the profile
+			Out: false,
+		},
+	}
+}
+
+// Links to the EC2 resources that a managed compute environment launches
+// instances with
+func batchComputeResourcesLinks(accountID string, scope string, resources *types.ComputeResource) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, subnet := range resources.Subnets {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnet,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Instances are launched in the subnet
+				In: true,
+				// The compute environment can't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, group := range resources.SecurityGroupIds {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  group,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The security group controls the traffic of the instances
+				In: true,
+				// The compute environment can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	if resources.InstanceRole != nil {
+		links = append(links, batchInstanceProfileLink(accountID, *resources.InstanceRole))
+	}
+
+	if resources.SpotIamFleetRole != nil {
+		if link := iamRoleLink(accountID, *resources.SpotIamFleetRole); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if resources.LaunchTemplate != nil && resources.LaunchTemplate.LaunchTemplateId != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-launch-template",
+				Method: sdp.QueryMethod_GET,
+				Query:  *resources.LaunchTemplate.LaunchTemplateId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Instances are launched from the template
+				In: true,
+				// The compute environment can't affect the template
+				Out: false,
+			},
+		})
+	}
+
+	if resources.Ec2KeyPair != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-key-pair",
+				Method: sdp.QueryMethod_GET,
+				Query:  *resources.Ec2KeyPair,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The key pair is used to access the instances
+				In: true,
+				// The compute environment can't affect the key pair
+				Out: false,
+			},
+		})
+	}
+
+	images := make([]string, 0)
+	if resources.ImageId != nil {
+		images = append(images, *resources.ImageId)
+	}
+	for _, config := range resources.Ec2Configuration {
+		if config.ImageIdOverride != nil {
+			images = append(images, *config.ImageIdOverride)
+		}
+	}
+
+	for _, image := range images {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-image",
+				Method: sdp.QueryMethod_GET,
+				Query:  image,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Instances are launched from the image
+				In: true,
+				// The compute environment can't affect the image
+				Out: false,
+			},
+		})
+	}
+
+	return links
+}
+
+func batchComputeEnvironmentOutputMapper(_ context.Context, _ batchClient, scope string, _ *batch.DescribeComputeEnvironmentsInput, output *batch.DescribeComputeEnvironmentsOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, environment := range output.ComputeEnvironments {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(environment, "Tags")
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "batch-compute-environment",
+			UniqueAttribute: "ComputeEnvironmentName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            environment.Tags,
+			Health:          batchStatusToHealth(string(environment.Status)),
+		}
+
+		if environment.EcsClusterArn != nil {
+			if a, err := adapterhelpers.ParseARN(*environment.EcsClusterArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-cluster",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *environment.EcsClusterArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Batch manages the cluster that jobs run in, so they
+						// are tightly coupled
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if environment.EksConfiguration != nil && environment.EksConfiguration.EksClusterArn != nil {
+			if a, err := adapterhelpers.ParseARN(*environment.EksConfiguration.EksClusterArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "eks-cluster",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *environment.EksConfiguration.EksClusterArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Jobs run in the cluster
+						In: true,
+						// The compute environment adds nodes to the cluster
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if environment.ServiceRole != nil {
+			if link := iamRoleLink(accountID, *environment.ServiceRole); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if environment.ComputeResources != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, batchComputeResourcesLinks(accountID, scope, environment.ComputeResources)...)
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewBatchComputeEnvironmentAdapter(client batchClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*batch.DescribeComputeEnvironmentsInput, *batch.DescribeComputeEnvironmentsOutput, batchClient, *batch.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*batch.DescribeComputeEnvironmentsInput, *batch.DescribeComputeEnvironmentsOutput, batchClient, *batch.Options]{
+		ItemType:        "batch-compute-environment",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: batchComputeEnvironmentAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client batchClient, input *batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error) {
+			return client.DescribeComputeEnvironments(ctx, input)
+		},
+		PaginatorBuilder: func(client batchClient, params *batch.DescribeComputeEnvironmentsInput) adapterhelpers.Paginator[*batch.DescribeComputeEnvironmentsOutput, *batch.Options] {
+			return batch.NewDescribeComputeEnvironmentsPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*batch.DescribeComputeEnvironmentsInput, error) {
+			return &batch.DescribeComputeEnvironmentsInput{
+				ComputeEnvironments: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*batch.DescribeComputeEnvironmentsInput, error) {
+			return &batch.DescribeComputeEnvironmentsInput{}, nil
+		},
+		OutputMapper: batchComputeEnvironmentOutputMapper,
+	}
+}
+
+var batchComputeEnvironmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "batch-compute-environment",
+	DescriptiveName: "Batch Compute Environment",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a compute environment by name",
+		List:              true,
+		ListDescription:   "List all compute environments",
+		Search:            true,
+		SearchDescription: "Search for a compute environment by ARN",
+	},
+	PotentialLinks: []string{"ecs-cluster", "eks-cluster", "iam-role", "iam-instance-profile", "ec2-subnet", "ec2-security-group", "ec2-launch-template", "ec2-key-pair", "ec2-image"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_batch_compute_environment.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(batchComputeEnvironmentAdapterMetadata, sdp.AttributeSchemaFor(&types.ComputeEnvironmentDetail{}, "Tags"))
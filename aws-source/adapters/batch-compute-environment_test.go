@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestBatchComputeEnvironmentOutputMapper(t *testing.T) {
+	output, err := testBatchClient{}.DescribeComputeEnvironments(context.Background(), &batch.DescribeComputeEnvironmentsInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := batchComputeEnvironmentOutputMapper(context.Background(), testBatchClient{}, "123456789012.eu-west-2", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 3 {
+		t.Fatalf("expected 3 compute environments, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecs-cluster",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:cluster/AWSBatch-ec2-0123456789abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-service-role/batch.amazonaws.com/AWSServiceRoleForBatch",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0fedcba9876543210",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ecsInstanceRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-key-pair",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "batch",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item = items[1]
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:instance-profile/ecsInstanceRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/AmazonEC2SpotFleetTaggingRole",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item = items[2]
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "eks-cluster",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:eks:eu-west-2:123456789012:cluster/batch",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBatchComputeEnvironmentGet(t *testing.T) {
+	adapter := NewBatchComputeEnvironmentAdapter(testBatchClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "spot", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "spot" {
+		t.Errorf("expected spot, got %v", item.UniqueAttributeValue())
+	}
+}
+
+func TestNewBatchComputeEnvironmentAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := batch.NewFromConfig(config)
+
+	adapter := NewBatchComputeEnvironmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
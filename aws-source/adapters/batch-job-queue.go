@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func batchJobQueueOutputMapper(_ context.Context, _ batchClient, scope string, _ *batch.DescribeJobQueuesInput, output *batch.DescribeJobQueuesOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, queue := range output.JobQueues {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(queue, "Tags")
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "batch-job-queue",
+			UniqueAttribute: "JobQueueName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            queue.Tags,
+			Health:          batchStatusToHealth(string(queue.Status)),
+		}
+
+		for _, order := range queue.ComputeEnvironmentOrder {
+			if order.ComputeEnvironment == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*order.ComputeEnvironment); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "batch-compute-environment",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *order.ComputeEnvironment,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Jobs in the queue run on the compute environment
+						In: true,
+						// The queue can't affect the compute environment
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewBatchJobQueueAdapter(client batchClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobQueuesInput, *batch.DescribeJobQueuesOutput, batchClient, *batch.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobQueuesInput, *batch.DescribeJobQueuesOutput, batchClient, *batch.Options]{
+		ItemType:        "batch-job-queue",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: batchJobQueueAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client batchClient, input *batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error) {
+			return client.DescribeJobQueues(ctx, input)
+		},
+		PaginatorBuilder: func(client batchClient, params *batch.DescribeJobQueuesInput) adapterhelpers.Paginator[*batch.DescribeJobQueuesOutput, *batch.Options] {
+			return batch.NewDescribeJobQueuesPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*batch.DescribeJobQueuesInput, error) {
+			return &batch.DescribeJobQueuesInput{
+				JobQueues: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*batch.DescribeJobQueuesInput, error) {
+			return &batch.DescribeJobQueuesInput{}, nil
+		},
+		OutputMapper: batchJobQueueOutputMapper,
+	}
+}
+
+var batchJobQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "batch-job-queue",
+	DescriptiveName: "Batch Job Queue",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a job queue by name",
+		List:              true,
+		ListDescription:   "List all job queues",
+		Search:            true,
+		SearchDescription: "Search for a job queue by ARN",
+	},
+	PotentialLinks: []string{"batch-compute-environment"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_batch_job_queue.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(batchJobQueueAdapterMetadata, sdp.AttributeSchemaFor(&types.JobQueueDetail{}, "Tags"))
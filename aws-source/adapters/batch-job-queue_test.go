@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestBatchJobQueueGet(t *testing.T) {
+	adapter := NewBatchJobQueueAdapter(testBatchClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "high-priority", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "batch-compute-environment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testBatchEC2EnvironmentARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "batch-compute-environment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testBatchSpotEnvironmentARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestBatchJobQueueList(t *testing.T) {
+	adapter := NewBatchJobQueueAdapter(testBatchClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 job queues, got %v", len(items))
+	}
+
+	if items[1].GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", items[1].GetHealth())
+	}
+}
+
+func TestBatchJobQueueSearch(t *testing.T) {
+	adapter := NewBatchJobQueueAdapter(testBatchClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.SearchStream(context.Background(), "123456789012.eu-west-2", testBatchHighPriorityQueueARN, false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	if len(stream.GetItems()) != 1 {
+		t.Fatalf("expected 1 job queue, got %v", len(stream.GetItems()))
+	}
+}
+
+func TestNewBatchJobQueueAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := batch.NewFromConfig(config)
+
+	adapter := NewBatchJobQueueAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
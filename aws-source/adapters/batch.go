@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type batchClient interface {
+	DescribeComputeEnvironments(ctx context.Context, params *batch.DescribeComputeEnvironmentsInput, optFns ...func(*batch.Options)) (*batch.DescribeComputeEnvironmentsOutput, error)
+	DescribeJobQueues(ctx context.Context, params *batch.DescribeJobQueuesInput, optFns ...func(*batch.Options)) (*batch.DescribeJobQueuesOutput, error)
+}
+
+// Job queues and compute environments share the same set of statuses, which
+// are mapped to health here
+func batchStatusToHealth(status string) *sdp.Health {
+	switch status {
+	case "VALID":
+		return sdp.Health_HEALTH_OK.Enum()
+	case "INVALID":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case "CREATING", "UPDATING", "DELETING":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+)
+
+const (
+	testBatchEC2EnvironmentARN    = "arn:aws:batch:eu-west-2:123456789012:compute-environment/ec2"
+	testBatchSpotEnvironmentARN   = "arn:aws:batch:eu-west-2:123456789012:compute-environment/spot"
+	testBatchEKSEnvironmentARN    = "arn:aws:batch:eu-west-2:123456789012:compute-environment/eks"
+	testBatchHighPriorityQueueARN = "arn:aws:batch:eu-west-2:123456789012:job-queue/high-priority"
+)
+
+type testBatchClient struct{}
+
+// Returns whether a Batch resource was requested by name or ARN. No filter
+// means that everything was requested
+func testBatchRequested(filter []string, name string, arn string) bool {
+	return len(filter) == 0 || slices.Contains(filter, name) || slices.Contains(filter, arn)
+}
+
+func (t testBatchClient) DescribeComputeEnvironments(ctx context.Context, params *batch.DescribeComputeEnvironmentsInput, optFns ...func(*batch.Options)) (*batch.DescribeComputeEnvironmentsOutput, error) {
+	environments := []types.ComputeEnvironmentDetail{
+		{
+			ComputeEnvironmentName: aws.String("ec2"),
+			ComputeEnvironmentArn:  aws.String(testBatchEC2EnvironmentARN),
+			EcsClusterArn:          aws.String("arn:aws:ecs:eu-west-2:123456789012:cluster/AWSBatch-ec2-0123456789abcdef"),
+			ServiceRole:            aws.String("arn:aws:iam::123456789012:role/aws-service-role/batch.amazonaws.com/AWSServiceRoleForBatch"),
+			State:                  types.CEStateEnabled,
+			Status:                 types.CEStatusValid,
+			Type:                   types.CETypeManaged,
+			Tags: map[string]string{
+				"team": "data",
+			},
+			ComputeResources: &types.ComputeResource{
+				Type:             types.CRTypeEc2,
+				MaxvCpus:         aws.Int32(256),
+				Subnets:          []string{"subnet-0123456789abcdef0", "subnet-0fedcba9876543210"},
+				SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+				InstanceRole:     aws.String("ecsInstanceRole"),
+				InstanceTypes:    []string{"optimal"},
+				LaunchTemplate: &types.LaunchTemplateSpecification{
+					LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+				},
+				Ec2KeyPair: aws.String("batch"),
+				Ec2Configuration: []types.Ec2Configuration{
+					{
+						ImageType:       aws.String("ECS_AL2"),
+						ImageIdOverride: aws.String("ami-0123456789abcdef0"),
+					},
+				},
+			},
+		},
+		{
+			ComputeEnvironmentName: aws.String("spot"),
+			ComputeEnvironmentArn:  aws.String(testBatchSpotEnvironmentARN),
+			EcsClusterArn:          aws.String("arn:aws:ecs:eu-west-2:123456789012:cluster/AWSBatch-spot-0123456789abcdef"),
+			State:                  types.CEStateEnabled,
+			Status:                 types.CEStatusInvalid,
+			StatusReason:           aws.String("CLIENT_ERROR - Not authorized to create spot fleet"),
+			Type:                   types.CETypeManaged,
+			ComputeResources: &types.ComputeResource{
+				Type:             types.CRTypeSpot,
+				MaxvCpus:         aws.Int32(64),
+				Subnets:          []string{"subnet-0123456789abcdef0"},
+				SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+				InstanceRole:     aws.String("arn:aws:iam::123456789012:instance-profile/ecsInstanceRole"),
+				SpotIamFleetRole: aws.String("arn:aws:iam::123456789012:role/AmazonEC2SpotFleetTaggingRole"),
+			},
+		},
+		{
+			ComputeEnvironmentName:     aws.String("eks"),
+			ComputeEnvironmentArn:      aws.String(testBatchEKSEnvironmentARN),
+			ContainerOrchestrationType: types.OrchestrationTypeEks,
+			EksConfiguration: &types.EksConfiguration{
+				EksClusterArn:       aws.String("arn:aws:eks:eu-west-2:123456789012:cluster/batch"),
+				KubernetesNamespace: aws.String("batch"),
+			},
+			State:  types.CEStateEnabled,
+			Status: types.CEStatusCreating,
+			Type:   types.CETypeManaged,
+			ComputeResources: &types.ComputeResource{
+				Type:     types.CRTypeFargate,
+				MaxvCpus: aws.Int32(16),
+				Subnets:  []string{"subnet-0123456789abcdef0"},
+			},
+		},
+	}
+
+	out := &batch.DescribeComputeEnvironmentsOutput{}
+	for _, environment := range environments {
+		if testBatchRequested(params.ComputeEnvironments, *environment.ComputeEnvironmentName, *environment.ComputeEnvironmentArn) {
+			out.ComputeEnvironments = append(out.ComputeEnvironments, environment)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testBatchClient) DescribeJobQueues(ctx context.Context, params *batch.DescribeJobQueuesInput, optFns ...func(*batch.Options)) (*batch.DescribeJobQueuesOutput, error) {
+	queues := []types.JobQueueDetail{
+		{
+			JobQueueName: aws.String("high-priority"),
+			JobQueueArn:  aws.String(testBatchHighPriorityQueueARN),
+			Priority:     aws.Int32(10),
+			State:        types.JQStateEnabled,
+			Status:       types.JQStatusValid,
+			ComputeEnvironmentOrder: []types.ComputeEnvironmentOrder{
+				{ComputeEnvironment: aws.String(testBatchEC2EnvironmentARN), Order: aws.Int32(1)},
+				{ComputeEnvironment: aws.String(testBatchSpotEnvironmentARN), Order: aws.Int32(2)},
+			},
+			Tags: map[string]string{
+				"team": "data",
+			},
+		},
+		{
+			JobQueueName: aws.String("low-priority"),
+			JobQueueArn:  aws.String("arn:aws:batch:eu-west-2:123456789012:job-queue/low-priority"),
+			Priority:     aws.Int32(1),
+			State:        types.JQStateDisabled,
+			Status:       types.JQStatusUpdating,
+			ComputeEnvironmentOrder: []types.ComputeEnvironmentOrder{
+				{ComputeEnvironment: aws.String(testBatchSpotEnvironmentARN), Order: aws.Int32(1)},
+			},
+		},
+	}
+
+	out := &batch.DescribeJobQueuesOutput{}
+	for _, queue := range queues {
+		if testBatchRequested(params.JobQueues, *queue.JobQueueName, *queue.JobQueueArn) {
+			out.JobQueues = append(out.JobQueues, queue)
+		}
+	}
+
+	return out, nil
+}
@@ -92,3 +92,5 @@ var cachePolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_cache_policy.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(cachePolicyAdapterMetadata, sdp.AttributeSchemaFor(&types.CachePolicy{}))
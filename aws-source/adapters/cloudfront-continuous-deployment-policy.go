@@ -99,3 +99,5 @@ var continuousDeploymentPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(continuousDeploymentPolicyAdapterMetadata, sdp.AttributeSchemaFor(&types.ContinuousDeploymentPolicy{}))
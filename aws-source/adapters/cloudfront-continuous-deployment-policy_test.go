@@ -47,6 +47,8 @@ func TestContinuousDeploymentPolicyItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "dns",
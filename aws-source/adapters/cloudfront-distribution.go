@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -599,7 +600,7 @@ func distributionGetFunc(ctx context.Context, client CloudFrontClient, scope str
 						Type:   "wafv2-web-acl",
 						Method: sdp.QueryMethod_SEARCH,
 						Query:  *dc.WebACLId,
-						Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+						Scope:  wafv2ARNScope(arn),
 					},
 					BlastPropagation: &sdp.BlastPropagation{
 						// Changing the ACL could affect the distribution
@@ -681,6 +682,7 @@ var distributionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		},
 	},
 	PotentialLinks: []string{
+		"acm-certificate",
 		"cloudfront-key-group",
 		"cloudfront-cloud-front-origin-access-identity",
 		"cloudfront-continuous-deployment-policy",
@@ -695,3 +697,5 @@ var distributionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"s3-bucket",
 	},
 })
+
+var _ = Metadata.RegisterSchema(distributionAdapterMetadata, sdp.AttributeSchemaFor(&types.Distribution{}))
@@ -366,6 +366,8 @@ func TestDistributionGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetHealth() != sdp.Health_HEALTH_OK {
 		t.Errorf("expected health to be HEALTH_OK, got %s", item.GetHealth())
 	}
@@ -480,10 +482,11 @@ func TestDistributionGetFunc(t *testing.T) {
 			ExpectedScope:  scope,
 		},
 		{
+			// CloudFront ACLs are global
 			ExpectedType:   "wafv2-web-acl",
 			ExpectedMethod: sdp.QueryMethod_SEARCH,
 			ExpectedQuery:  "arn:aws:wafv2:us-east-1:123456789012:global/webacl/ExampleWebACL/473e64fd-f30b-4765-81a0-62ad96dd167a",
-			ExpectedScope:  "123456789012.us-east-1",
+			ExpectedScope:  "123456789012",
 		},
 		{
 			ExpectedType:   "s3-bucket",
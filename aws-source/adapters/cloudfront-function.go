@@ -82,3 +82,5 @@ var cloudfrontFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(cloudfrontFunctionAdapterMetadata, sdp.AttributeSchemaFor(&types.FunctionSummary{}))
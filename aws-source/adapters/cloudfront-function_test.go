@@ -33,6 +33,8 @@ func TestFunctionItemMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewCloudfrontCloudfrontFunctionAdapter(t *testing.T) {
@@ -80,3 +80,5 @@ var keyGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_key_group.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(keyGroupAdapterMetadata, sdp.AttributeSchemaFor(&types.KeyGroup{}))
@@ -30,6 +30,8 @@ func TestKeyGroupItemMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewCloudfrontKeyGroupAdapter(t *testing.T) {
@@ -94,3 +94,5 @@ var originAccessControlAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_origin_access_control.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(originAccessControlAdapterMetadata, sdp.AttributeSchemaFor(&types.OriginAccessControl{}))
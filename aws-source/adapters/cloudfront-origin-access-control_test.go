@@ -29,6 +29,8 @@ func TestOriginAccessControlItemMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewCloudfrontOriginAccessControlAdapter(t *testing.T) {
@@ -80,3 +80,5 @@ var originRequestPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_origin_request_policy.id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(originRequestPolicyAdapterMetadata, sdp.AttributeSchemaFor(&types.OriginRequestPolicy{}))
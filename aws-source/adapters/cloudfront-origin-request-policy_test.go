@@ -48,6 +48,8 @@ func TestOriginRequestPolicyItemMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewCloudfrontOriginRequestPolicyAdapter(t *testing.T) {
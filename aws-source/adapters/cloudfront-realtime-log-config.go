@@ -125,3 +125,5 @@ var realtimeLogConfigsAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		},
 	},
 })
+
+var _ = Metadata.RegisterSchema(realtimeLogConfigsAdapterMetadata, sdp.AttributeSchemaFor(&types.RealtimeLogConfig{}))
@@ -39,6 +39,8 @@ func TestRealtimeLogConfigsItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "iam-role",
@@ -80,3 +80,5 @@ var responseHeadersPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(responseHeadersPolicyAdapterMetadata, sdp.AttributeSchemaFor(&types.ResponseHeadersPolicy{}))
@@ -85,6 +85,8 @@ func TestResponseHeadersPolicyItemMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewCloudfrontResponseHeadersPolicyAdapter(t *testing.T) {
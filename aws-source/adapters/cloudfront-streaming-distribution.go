@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -211,3 +212,5 @@ var streamingDistributionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	PotentialLinks: []string{"dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(streamingDistributionAdapterMetadata, sdp.AttributeSchemaFor(&types.StreamingDistribution{}))
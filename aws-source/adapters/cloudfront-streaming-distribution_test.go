@@ -92,6 +92,8 @@ func TestStreamingDistributionGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetHealth() != sdp.Health_HEALTH_OK {
 		t.Errorf("expected health to be HEALTH_OK, got %s", item.GetHealth())
 	}
@@ -0,0 +1,265 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type cloudtrailClient interface {
+	DescribeTrails(ctx context.Context, params *cloudtrail.DescribeTrailsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error)
+	GetTrail(ctx context.Context, params *cloudtrail.GetTrailInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailOutput, error)
+	GetTrailStatus(ctx context.Context, params *cloudtrail.GetTrailStatusInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error)
+	ListTags(ctx context.Context, params *cloudtrail.ListTagsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.ListTagsOutput, error)
+}
+
+// cloudtrailTrail is a trail along with whether it is logging, which is
+// returned by a separate API
+type cloudtrailTrail struct {
+	types.Trail
+	IsLogging                         *bool
+	LatestDeliveryError               *string
+	LatestDeliveryTime                *time.Time
+	LatestCloudWatchLogsDeliveryError *string
+	LatestNotificationError           *string
+	StartLoggingTime                  *time.Time
+	StopLoggingTime                   *time.Time
+}
+
+func getCloudtrailTrail(ctx context.Context, client cloudtrailClient, trail types.Trail) (*cloudtrailTrail, error) {
+	status, err := client.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{
+		Name: trail.TrailARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudtrailTrail{
+		Trail:                             trail,
+		IsLogging:                         status.IsLogging,
+		LatestDeliveryError:               status.LatestDeliveryError,
+		LatestDeliveryTime:                status.LatestDeliveryTime,
+		LatestCloudWatchLogsDeliveryError: status.LatestCloudWatchLogsDeliveryError,
+		LatestNotificationError:           status.LatestNotificationError,
+		StartLoggingTime:                  status.StartLoggingTime,
+		StopLoggingTime:                   status.StopLoggingTime,
+	}, nil
+}
+
+func cloudtrailTrailGetFunc(ctx context.Context, client cloudtrailClient, _, query string) (*cloudtrailTrail, error) {
+	out, err := client.GetTrail(ctx, &cloudtrail.GetTrailInput{
+		Name: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getCloudtrailTrail(ctx, client, *out.Trail)
+}
+
+func cloudtrailTrailListFunc(ctx context.Context, client cloudtrailClient, _ string) ([]*cloudtrailTrail, error) {
+	// Multi-region trails are returned in every region as shadow trails, they
+	// are only included in their home region
+	out, err := client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: adapterhelpers.PtrBool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trails := make([]*cloudtrailTrail, 0, len(out.TrailList))
+	for _, trail := range out.TrailList {
+		t, err := getCloudtrailTrail(ctx, client, trail)
+		if err != nil {
+			var notFound *types.TrailNotFoundException
+			if errors.As(err, &notFound) {
+				// Deleted since it was listed
+				continue
+			}
+
+			return nil, err
+		}
+
+		trails = append(trails, t)
+	}
+
+	return trails, nil
+}
+
+func cloudtrailTrailListTags(ctx context.Context, trail *cloudtrailTrail, client cloudtrailClient) (map[string]string, error) {
+	out, err := client.ListTags(ctx, &cloudtrail.ListTagsInput{
+		ResourceIdList: []string{*trail.TrailARN},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for _, resource := range out.ResourceTagList {
+		for _, tag := range resource.TagsList {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+func cloudtrailTrailOutputMapper(_, scope string, awsItem *cloudtrailTrail) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cloudtrail-trail",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// A trail that has been stopped, or can't deliver its logs, isn't
+	// auditing anything
+	switch {
+	case awsItem.LatestDeliveryError != nil && *awsItem.LatestDeliveryError != "":
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case awsItem.IsLogging != nil && !*awsItem.IsLogging:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case awsItem.LatestCloudWatchLogsDeliveryError != nil && *awsItem.LatestCloudWatchLogsDeliveryError != "":
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	default:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	if awsItem.S3BucketName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.S3BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the bucket's policy can stop the trail from
+				// delivering logs
+				In: true,
+				// The trail writes to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.KmsKeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.KmsKeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.SnsTopicARN != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.SnsTopicARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sns-topic",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.SnsTopicARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The topic can't affect the trail
+					In: false,
+					// The trail sends notifications to the topic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.CloudWatchLogsLogGroupArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CloudWatchLogsLogGroupArn); err == nil {
+			// Log groups are looked up by name, the ARN is in the format
+			// arn:aws:logs:{region}:{account}:log-group:{name}:*
+			name := strings.TrimSuffix(strings.TrimPrefix(a.Resource, "log-group:"), ":*")
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  name,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the log group stops the trail from delivering
+					// to it
+					In: true,
+					// The trail writes to the log group
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.CloudWatchLogsRoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CloudWatchLogsRoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.CloudWatchLogsRoleArn,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The trail uses the role to write to CloudWatch Logs
+					In: true,
+					// The trail can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewCloudtrailTrailAdapter(client cloudtrailClient, accountID string, region string) *adapterhelpers.GetListAdapter[*cloudtrailTrail, cloudtrailClient, *cloudtrail.Options] {
+	return &adapterhelpers.GetListAdapter[*cloudtrailTrail, cloudtrailClient, *cloudtrail.Options]{
+		ItemType:        "cloudtrail-trail",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cloudtrailTrailAdapterMetadata,
+		GetFunc:         cloudtrailTrailGetFunc,
+		ListFunc:        cloudtrailTrailListFunc,
+		ItemMapper:      cloudtrailTrailOutputMapper,
+		ListTagsFunc:    cloudtrailTrailListTags,
+	}
+}
+
+var cloudtrailTrailAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cloudtrail-trail",
+	DescriptiveName: "CloudTrail Trail",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a trail by name",
+		List:              true,
+		ListDescription:   "List all trails whose home region is this region",
+		Search:            true,
+		SearchDescription: "Search for a trail by ARN",
+	},
+	PotentialLinks: []string{"s3-bucket", "kms-key", "sns-topic", "logs-log-group", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudtrail.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(cloudtrailTrailAdapterMetadata, sdp.AttributeSchemaFor(&cloudtrailTrail{}))
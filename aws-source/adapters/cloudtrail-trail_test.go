@@ -0,0 +1,227 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const testCloudtrailTrailARN = "arn:aws:cloudtrail:eu-west-2:123456789012:trail/management-events"
+
+type testCloudtrailClient struct {
+	// The error returned by GetTrailStatus for the stopped trail
+	statusErr error
+}
+
+func testCloudtrailTrail(name string) types.Trail {
+	return types.Trail{
+		Name:                       aws.String(name),
+		TrailARN:                   aws.String("arn:aws:cloudtrail:eu-west-2:123456789012:trail/" + name),
+		HomeRegion:                 aws.String("eu-west-2"),
+		IsMultiRegionTrail:         aws.Bool(true),
+		IncludeGlobalServiceEvents: aws.Bool(true),
+		LogFileValidationEnabled:   aws.Bool(true),
+		S3BucketName:               aws.String("audit-logs"),
+		KmsKeyId:                   aws.String("arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+		SnsTopicARN:                aws.String("arn:aws:sns:eu-west-2:123456789012:audit"),
+		CloudWatchLogsLogGroupArn:  aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/cloudtrail/management-events:*"),
+		CloudWatchLogsRoleArn:      aws.String("arn:aws:iam::123456789012:role/cloudtrail-logs"),
+	}
+}
+
+func (t testCloudtrailClient) DescribeTrails(ctx context.Context, params *cloudtrail.DescribeTrailsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error) {
+	return &cloudtrail.DescribeTrailsOutput{
+		TrailList: []types.Trail{
+			testCloudtrailTrail("management-events"),
+			testCloudtrailTrail("stopped"),
+		},
+	}, nil
+}
+
+func (t testCloudtrailClient) GetTrail(ctx context.Context, params *cloudtrail.GetTrailInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailOutput, error) {
+	trail := testCloudtrailTrail(*params.Name)
+
+	return &cloudtrail.GetTrailOutput{
+		Trail: &trail,
+	}, nil
+}
+
+func (t testCloudtrailClient) GetTrailStatus(ctx context.Context, params *cloudtrail.GetTrailStatusInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error) {
+	if *params.Name == "arn:aws:cloudtrail:eu-west-2:123456789012:trail/stopped" {
+		if t.statusErr != nil {
+			return nil, t.statusErr
+		}
+
+		return &cloudtrail.GetTrailStatusOutput{
+			IsLogging:       aws.Bool(false),
+			StopLoggingTime: aws.Time(time.Now()),
+		}, nil
+	}
+
+	return &cloudtrail.GetTrailStatusOutput{
+		IsLogging:          aws.Bool(true),
+		LatestDeliveryTime: aws.Time(time.Now()),
+		StartLoggingTime:   aws.Time(time.Now().Add(-24 * time.Hour)),
+	}, nil
+}
+
+func (t testCloudtrailClient) ListTags(ctx context.Context, params *cloudtrail.ListTagsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.ListTagsOutput, error) {
+	return &cloudtrail.ListTagsOutput{
+		ResourceTagList: []types.ResourceTag{
+			{
+				ResourceId: aws.String(params.ResourceIdList[0]),
+				TagsList: []types.Tag{
+					{Key: aws.String("team"), Value: aws.String("security")},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestCloudtrailTrailGet(t *testing.T) {
+	adapter := NewCloudtrailTrailAdapter(testCloudtrailClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "management-events", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "security" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "audit-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:audit",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/cloudtrail/management-events",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/cloudtrail-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCloudtrailTrailList(t *testing.T) {
+	adapter := NewCloudtrailTrailAdapter(testCloudtrailClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 trails, got %v", len(items))
+	}
+
+	for _, item := range items {
+		name, _ := item.GetAttributes().Get("Name")
+		if name == "stopped" && item.GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Errorf("expected a stopped trail to have WARNING health, got %v", item.GetHealth())
+		}
+	}
+
+	// Trails that are deleted after being listed are skipped
+	adapter = NewCloudtrailTrailAdapter(testCloudtrailClient{
+		statusErr: &types.TrailNotFoundException{},
+	}, "123456789012", "eu-west-2")
+
+	items, err = adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 trail, got %v", len(items))
+	}
+}
+
+func TestCloudtrailTrailSearch(t *testing.T) {
+	adapter := NewCloudtrailTrailAdapter(testCloudtrailClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testCloudtrailTrailARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 trail, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "management-events" {
+		t.Errorf("expected the management-events trail, got %v", items[0].UniqueAttributeValue())
+	}
+}
+
+func TestCloudtrailTrailOutputMapperHealth(t *testing.T) {
+	trail := &cloudtrailTrail{
+		Trail:               testCloudtrailTrail("management-events"),
+		IsLogging:           aws.Bool(true),
+		LatestDeliveryError: aws.String("AccessDenied"),
+	}
+
+	item, err := cloudtrailTrailOutputMapper("management-events", "123456789012.eu-west-2", trail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected a trail that can't deliver to have ERROR health, got %v", item.GetHealth())
+	}
+}
+
+func TestNewCloudtrailTrailAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cloudtrail.NewFromConfig(config)
+
+	adapter := NewCloudtrailTrailAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
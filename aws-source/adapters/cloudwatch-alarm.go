@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
@@ -178,6 +179,28 @@ func alarmOutputMapper(ctx context.Context, client CloudwatchClient, scope strin
 			if err == nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, q)
 			}
+
+			// Metrics in custom namespaces can be published by log metric
+			// filters, which can't use the AWS/ namespaces
+			if alarm.Metric.MetricName != nil && !strings.HasPrefix(*alarm.Metric.Namespace, "AWS/") {
+				if query, err := logsMetricFilterQueryString(*alarm.Metric.Namespace, *alarm.Metric.MetricName); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "logs-metric-filter",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  query,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changing the filter changes the metric that the
+							// alarm evaluates
+							In: true,
+							// The alarm can't affect the filter
+							Out: false,
+						},
+					})
+				}
+			}
 		}
 
 		items = append(items, &item)
@@ -241,7 +264,7 @@ func NewCloudwatchAlarmAdapter(client *cloudwatch.Client, accountID string, regi
 var cloudwatchAlarmAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	DescriptiveName: "CloudWatch Alarm",
 	Type:            "cloudwatch-alarm",
-	PotentialLinks:  []string{"cloudwatch-metric"},
+	PotentialLinks:  []string{"cloudwatch-metric", "logs-metric-filter", "application-autoscaling-scaling-policy"},
 	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
 		Get:               true,
 		List:              true,
@@ -258,6 +281,12 @@ var cloudwatchAlarmAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
 
+var _ = Metadata.RegisterSchema(cloudwatchAlarmAdapterMetadata, sdp.AttributeSchemaFor(struct {
+	// Alarms are either metric or composite alarms
+	*types.MetricAlarm
+	*types.CompositeAlarm
+}{}))
+
 // actionToLink converts an action string to a link to the resource that the
 // action refers to. The actions to execute when this alarm transitions to the
 // ALARM state from any other state. Each action is specified as an Amazon
@@ -304,6 +333,25 @@ func actionToLink(action string) (*sdp.LinkedItemQuery, error) {
 
 	switch arn.Service {
 	case "autoscaling":
+		// Application Auto Scaling policies share the service with EC2 Auto
+		// Scaling policies, but refer to a resource instead of a group
+		if _, _, _, ok := parseApplicationAutoScalingPolicyARN(action); ok {
+			return &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "application-autoscaling-scaling-policy",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  action,
+					Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the policy won't affect the alarm
+					In: false,
+					// Changes to the metric alarm will affect the policy
+					Out: true,
+				},
+			}, nil
+		}
+
 		return &sdp.LinkedItemQuery{
 			Query: &sdp.Query{
 				Type:   "autoscaling-policy",
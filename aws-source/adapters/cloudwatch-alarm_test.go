@@ -120,6 +120,8 @@ func TestAlarmOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetTags()["Name"] != "example" {
 		t.Errorf("Expected tag Name to be example, got %s", item.GetTags()["Name"])
 	}
@@ -141,6 +143,8 @@ func TestAlarmOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests = adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "dynamodb-table",
@@ -148,11 +152,55 @@ func TestAlarmOutputMapper(t *testing.T) {
 			ExpectedQuery:  "dylan-tfstate",
 			ExpectedScope:  scope,
 		},
+		{
+			ExpectedType:   "application-autoscaling-scaling-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:autoscaling:eu-west-2:052392120703:scalingPolicy:32f3f053-dc75-46fa-9cd4-8e8c34c47b37:resource/dynamodb/table/dylan-tfstate:policyName/$dylan-tfstate-scaling-policy:createdBy/e5bd51d8-94a8-461e-a989-08f4d10b326b",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
 	}
 
 	tests.Execute(t, item)
 }
 
+func TestAlarmOutputMapperMetricFilter(t *testing.T) {
+	output := &cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms: []types.MetricAlarm{
+			{
+				AlarmName:  adapterhelpers.PtrString("payment-errors"),
+				AlarmArn:   adapterhelpers.PtrString("arn:aws:cloudwatch:eu-west-2:123456789012:alarm:payment-errors"),
+				StateValue: types.StateValueAlarm,
+				MetricName: adapterhelpers.PtrString("PaymentErrors"),
+				Namespace:  adapterhelpers.PtrString("Payments"),
+				Statistic:  types.StatisticSum,
+			},
+		},
+	}
+
+	scope := "123456789012.eu-west-2"
+	items, err := alarmOutputMapper(context.Background(), testCloudwatchClient{}, scope, &cloudwatch.DescribeAlarmsInput{}, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query, err := logsMetricFilterQueryString("Payments", "PaymentErrors")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Metrics in custom namespaces may be published by a metric filter
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-metric-filter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  query,
+			ExpectedScope:  scope,
+		},
+	}
+
+	tests.Execute(t, items[0])
+}
+
 func TestNewCloudwatchAlarmAdapter(t *testing.T) {
 	config, account, region := adapterhelpers.GetAutoConfig(t)
 	client := cloudwatch.NewFromConfig(config)
@@ -0,0 +1,356 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type codebuildClient interface {
+	BatchGetProjects(ctx context.Context, params *codebuild.BatchGetProjectsInput, optFns ...func(*codebuild.Options)) (*codebuild.BatchGetProjectsOutput, error)
+
+	codebuild.ListProjectsAPIClient
+}
+
+func codebuildProjectGetFunc(ctx context.Context, client codebuildClient, scope, query string) (*types.Project, error) {
+	out, err := client.BatchGetProjects(ctx, &codebuild.BatchGetProjectsInput{
+		Names: []string{query},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Projects) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "project not found",
+		}
+	}
+
+	return &out.Projects[0], nil
+}
+
+func codebuildTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// Links to a secret that is referenced by name or ARN, optionally followed by
+// the JSON key, version stage and version ID e.g. name:password::
+func codebuildSecretLink(scope, reference string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:  "secretsmanager-secret",
+		Scope: scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(reference); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Query = reference
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	} else {
+		name, _, _ := strings.Cut(reference, ":")
+		query.Method = sdp.QueryMethod_GET
+		query.Query = name
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// The secret can affect the build
+			In: true,
+			// The build can't affect the secret
+			Out: false,
+		},
+	}
+}
+
+// Links to an S3 bucket from a location in the format bucket/path
+func codebuildS3Link(accountID, location string) *sdp.LinkedItemQuery {
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(location, "arn:aws:s3:::"), "/")
+	if bucket == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-bucket",
+			Method: sdp.QueryMethod_GET,
+			Query:  bucket,
+			Scope:  adapterhelpers.FormatScope(accountID, ""),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The build reads from and writes to the bucket, so they can
+			// affect each other
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+func codebuildProjectItemMapper(_ *string, scope string, awsItem *types.Project) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "codebuild-project",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            codebuildTagsToMap(awsItem.Tags),
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.ServiceRole != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.ServiceRole); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ServiceRole,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The role controls what the build can do
+					In: true,
+					// The project can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.EncryptionKey != nil {
+		if link := kmsKeyLink(scope, *awsItem.EncryptionKey); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if env := awsItem.Environment; env != nil {
+		if env.Image != nil {
+			if link := ecrImageLink(*env.Image); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if env.RegistryCredential != nil && env.RegistryCredential.Credential != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, codebuildSecretLink(scope, *env.RegistryCredential.Credential))
+		}
+
+		for _, variable := range env.EnvironmentVariables {
+			if variable.Value == nil {
+				continue
+			}
+
+			switch variable.Type {
+			case types.EnvironmentVariableTypeParameterStore:
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ssm-parameter",
+						Method: sdp.QueryMethod_GET,
+						Query:  *variable.Value,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The parameter can affect the build
+						In: true,
+						// The build can't affect the parameter
+						Out: false,
+					},
+				})
+			case types.EnvironmentVariableTypeSecretsManager:
+				item.LinkedItemQueries = append(item.LinkedItemQueries, codebuildSecretLink(scope, *variable.Value))
+			case types.EnvironmentVariableTypePlaintext:
+				if links, err := sdp.ExtractLinksFrom(*variable.Value); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, links...)
+				}
+			}
+		}
+	}
+
+	if vpc := awsItem.VpcConfig; vpc != nil {
+		if vpc.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *vpc.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The VPC can affect the build's network access
+					In: true,
+					// The build can't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnet := range vpc.Subnets {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The subnet can affect the build's network access
+					In: true,
+					// The build can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, securityGroup := range vpc.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  securityGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group can affect the build's network access
+					In: true,
+					// The build can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if logs := awsItem.LogsConfig; logs != nil {
+		if logs.CloudWatchLogs != nil && logs.CloudWatchLogs.Status == types.LogsConfigStatusTypeEnabled && logs.CloudWatchLogs.GroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logs.CloudWatchLogs.GroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The log group can't affect the build
+					In: false,
+					// The build writes to the log group
+					Out: true,
+				},
+			})
+		}
+
+		if logs.S3Logs != nil && logs.S3Logs.Status == types.LogsConfigStatusTypeEnabled && logs.S3Logs.Location != nil {
+			if link := codebuildS3Link(accountID, *logs.S3Logs.Location); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	sources := slices.Clone(awsItem.SecondarySources)
+	if awsItem.Source != nil {
+		sources = append(sources, *awsItem.Source)
+	}
+
+	for _, source := range sources {
+		if source.Type == types.SourceTypeS3 && source.Location != nil {
+			if link := codebuildS3Link(accountID, *source.Location); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	allArtifacts := slices.Clone(awsItem.SecondaryArtifacts)
+	if awsItem.Artifacts != nil {
+		allArtifacts = append(allArtifacts, *awsItem.Artifacts)
+	}
+
+	for _, artifacts := range allArtifacts {
+		if artifacts.Type == types.ArtifactsTypeS3 && artifacts.Location != nil {
+			if link := codebuildS3Link(accountID, *artifacts.Location); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewCodeBuildProjectAdapter(client codebuildClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*codebuild.ListProjectsInput, *codebuild.ListProjectsOutput, *types.Project, codebuildClient, *codebuild.Options] {
+	return &adapterhelpers.GetListAdapterV2[*codebuild.ListProjectsInput, *codebuild.ListProjectsOutput, *types.Project, codebuildClient, *codebuild.Options]{
+		ItemType:        "codebuild-project",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codebuildProjectAdapterMetadata,
+		GetFunc:         codebuildProjectGetFunc,
+		InputMapperList: func(scope string) (*codebuild.ListProjectsInput, error) {
+			return &codebuild.ListProjectsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client codebuildClient, params *codebuild.ListProjectsInput) adapterhelpers.Paginator[*codebuild.ListProjectsOutput, *codebuild.Options] {
+			return codebuild.NewListProjectsPaginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *codebuild.ListProjectsOutput, client codebuildClient) ([]*types.Project, error) {
+			if len(output.Projects) == 0 {
+				return nil, nil
+			}
+
+			// Each page contains up to 100 names, which is the most that can
+			// be fetched in a single batch
+			out, err := client.BatchGetProjects(ctx, &codebuild.BatchGetProjectsInput{
+				Names: output.Projects,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			projects := make([]*types.Project, 0, len(out.Projects))
+			for i := range out.Projects {
+				projects = append(projects, &out.Projects[i])
+			}
+
+			return projects, nil
+		},
+		ItemMapper: codebuildProjectItemMapper,
+	}
+}
+
+var codebuildProjectAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codebuild-project",
+	DescriptiveName: "CodeBuild Project",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a project by name",
+		ListDescription:   "List all projects",
+		SearchDescription: "Search for a project by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_codebuild_project.name",
+		},
+	},
+	PotentialLinks: []string{"iam-role", "kms-key", "ecr-image", "secretsmanager-secret", "ssm-parameter", "ec2-vpc", "ec2-subnet", "ec2-security-group", "logs-log-group", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var _ = Metadata.RegisterSchema(codebuildProjectAdapterMetadata, sdp.AttributeSchemaFor(types.Project{}, "tags"))
@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testCodeBuildClient struct{}
+
+func (t testCodeBuildClient) BatchGetProjects(ctx context.Context, params *codebuild.BatchGetProjectsInput, optFns ...func(*codebuild.Options)) (*codebuild.BatchGetProjectsOutput, error) {
+	out := &codebuild.BatchGetProjectsOutput{}
+
+	for _, name := range params.Names {
+		if name != "app-build" {
+			out.ProjectsNotFound = append(out.ProjectsNotFound, name)
+			continue
+		}
+
+		out.Projects = append(out.Projects, types.Project{
+			Arn:           aws.String("arn:aws:codebuild:eu-west-2:123456789012:project/app-build"),
+			Name:          aws.String("app-build"),
+			ServiceRole:   aws.String("arn:aws:iam::123456789012:role/codebuild-app"),
+			EncryptionKey: aws.String("arn:aws:kms:eu-west-2:123456789012:alias/aws/s3"),
+			Created:       aws.Time(time.Now()),
+			Source: &types.ProjectSource{
+				Type:      types.SourceTypeCodepipeline,
+				Buildspec: aws.String("buildspec.yml"),
+			},
+			Artifacts: &types.ProjectArtifacts{
+				Type: types.ArtifactsTypeCodepipeline,
+			},
+			SecondaryArtifacts: []types.ProjectArtifacts{
+				{
+					Type:     types.ArtifactsTypeS3,
+					Location: aws.String("build-reports"),
+				},
+			},
+			Environment: &types.ProjectEnvironment{
+				Type:        types.EnvironmentTypeLinuxContainer,
+				ComputeType: types.ComputeTypeBuildGeneral1Small,
+				Image:       aws.String("123456789012.dkr.ecr.eu-west-2.amazonaws.com/build-image:latest"),
+				EnvironmentVariables: []types.EnvironmentVariable{
+					{
+						Name:  aws.String("DOCKERHUB_PASSWORD"),
+						Type:  types.EnvironmentVariableTypeSecretsManager,
+						Value: aws.String("dockerhub:password::"),
+					},
+					{
+						Name:  aws.String("API_URL"),
+						Type:  types.EnvironmentVariableTypeParameterStore,
+						Value: aws.String("/app/api-url"),
+					},
+				},
+			},
+			VpcConfig: &types.VpcConfig{
+				VpcId:            aws.String("vpc-0123456789abcdef0"),
+				Subnets:          []string{"subnet-0123456789abcdef0"},
+				SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+			},
+			LogsConfig: &types.LogsConfig{
+				CloudWatchLogs: &types.CloudWatchLogsConfig{
+					Status:    types.LogsConfigStatusTypeEnabled,
+					GroupName: aws.String("/codebuild/app-build"),
+				},
+				S3Logs: &types.S3LogsConfig{
+					Status: types.LogsConfigStatusTypeDisabled,
+				},
+			},
+			Tags: []types.Tag{
+				{
+					Key:   aws.String("team"),
+					Value: aws.String("platform"),
+				},
+			},
+		})
+	}
+
+	return out, nil
+}
+
+func (t testCodeBuildClient) ListProjects(ctx context.Context, params *codebuild.ListProjectsInput, optFns ...func(*codebuild.Options)) (*codebuild.ListProjectsOutput, error) {
+	return &codebuild.ListProjectsOutput{
+		Projects: []string{"app-build"},
+	}, nil
+}
+
+func TestCodeBuildProjectItemMapper(t *testing.T) {
+	project, err := codebuildProjectGetFunc(context.Background(), testCodeBuildClient{}, "123456789012.eu-west-2", "app-build")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := codebuildProjectItemMapper(nil, "123456789012.eu-west-2", project)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codebuild-app",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "123456789012.dkr.ecr.eu-west-2.amazonaws.com/build-image:latest",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dockerhub",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-parameter",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/app/api-url",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/codebuild/app-build",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-reports",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The encryption key is an alias, and S3 logs are disabled
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestCodeBuildProjectGetFuncNotFound(t *testing.T) {
+	_, err := codebuildProjectGetFunc(context.Background(), testCodeBuildClient{}, "123456789012.eu-west-2", "missing")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestCodeBuildProjectListFunc(t *testing.T) {
+	adapter := NewCodeBuildProjectAdapter(testCodeBuildClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	if len(stream.GetItems()) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(stream.GetItems()))
+	}
+}
+
+func TestNewCodeBuildProjectAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := codebuild.NewFromConfig(config)
+
+	adapter := NewCodeBuildProjectAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,368 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type codepipelineClient interface {
+	GetPipeline(ctx context.Context, params *codepipeline.GetPipelineInput, optFns ...func(*codepipeline.Options)) (*codepipeline.GetPipelineOutput, error)
+	ListTagsForResource(ctx context.Context, params *codepipeline.ListTagsForResourceInput, optFns ...func(*codepipeline.Options)) (*codepipeline.ListTagsForResourceOutput, error)
+
+	codepipeline.ListPipelinesAPIClient
+}
+
+// pipelineAttributes are the attributes of a codepipeline-pipeline item, which
+// combine the pipeline's structure with its metadata
+type pipelineAttributes struct {
+	*types.PipelineDeclaration
+	Metadata *types.PipelineMetadata
+}
+
+func pipelineGetFunc(ctx context.Context, client codepipelineClient, scope, query string) (*codepipeline.GetPipelineOutput, error) {
+	return client.GetPipeline(ctx, &codepipeline.GetPipelineInput{
+		Name: &query,
+	})
+}
+
+// Links from an action to the resource that it uses, based on the provider of
+// the action. Actions can run in a different region to the pipeline
+func pipelineActionLinks(accountID, region string, action types.ActionDeclaration) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if action.Region != nil {
+		region = *action.Region
+	}
+	scope := adapterhelpers.FormatScope(accountID, region)
+
+	if action.RoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*action.RoleArn); err == nil {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *action.RoleArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The role controls what the action can do
+					In: true,
+					// The pipeline can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if action.ActionTypeId == nil || action.ActionTypeId.Provider == nil {
+		return links
+	}
+
+	provider := *action.ActionTypeId.Provider
+	config := action.Configuration
+
+	switch action.ActionTypeId.Category {
+	case types.ActionCategorySource:
+		switch provider {
+		case "ECR":
+			if repository, ok := config["RepositoryName"]; ok {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecr-repository",
+						Method: sdp.QueryMethod_GET,
+						Query:  repository,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Pushing an image starts the pipeline
+						In: true,
+						// The pipeline doesn't change the repository
+						Out: false,
+					},
+				})
+			}
+		case "S3":
+			if bucket, ok := config["S3Bucket"]; ok {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Uploading a new object starts the pipeline
+						In: true,
+						// The pipeline doesn't change the source bucket
+						Out: false,
+					},
+				})
+			}
+		}
+	case types.ActionCategoryBuild, types.ActionCategoryTest:
+		if provider == "CodeBuild" {
+			if project, ok := config["ProjectName"]; ok {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "codebuild-project",
+						Method: sdp.QueryMethod_GET,
+						Query:  project,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The project builds what the pipeline ships
+						In: true,
+						// The pipeline doesn't change the project
+						Out: false,
+					},
+				})
+			}
+		}
+	case types.ActionCategoryDeploy:
+		switch provider {
+		case "ECS":
+			cluster, clusterOK := config["ClusterName"]
+			service, serviceOK := config["ServiceName"]
+			if clusterOK && serviceOK {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-service",
+						Method: sdp.QueryMethod_GET,
+						Query:  cluster + "/" + service,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The service can't affect the pipeline
+						In: false,
+						// The pipeline deploys to the service
+						Out: true,
+					},
+				})
+			}
+		case "S3":
+			if bucket, ok := config["BucketName"]; ok {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The bucket can't affect the pipeline
+						In: false,
+						// The pipeline deploys to the bucket
+						Out: true,
+					},
+				})
+			}
+		}
+	case types.ActionCategoryInvoke:
+		if provider == "Lambda" {
+			if function, ok := config["FunctionName"]; ok {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_GET,
+						Query:  function,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The function reports success or failure back to the
+						// pipeline, and the pipeline runs the function
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return links
+}
+
+// Links to the bucket and key that a pipeline stores its artifacts in
+func pipelineArtifactStoreLinks(scope string, store types.ArtifactStore) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return links
+	}
+
+	if store.Type == types.ArtifactStoreTypeS3 && store.Location != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *store.Location,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The pipeline can't run without its artifacts
+				In: true,
+				// The pipeline writes artifacts to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	if store.EncryptionKey != nil && store.EncryptionKey.Id != nil {
+		if link := kmsKeyLink(scope, *store.EncryptionKey.Id); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+func pipelineItemMapper(_ *string, scope string, awsItem *codepipeline.GetPipelineOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(pipelineAttributes{
+		PipelineDeclaration: awsItem.Pipeline,
+		Metadata:            awsItem.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "codepipeline-pipeline",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	pipeline := awsItem.Pipeline
+	if pipeline == nil {
+		return &item, nil
+	}
+
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipeline.RoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*pipeline.RoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *pipeline.RoleArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The role controls what the pipeline can do
+					In: true,
+					// The pipeline can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if pipeline.ArtifactStore != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineArtifactStoreLinks(scope, *pipeline.ArtifactStore)...)
+	}
+
+	// Cross-region pipelines have an artifact store in each region
+	for storeRegion, store := range pipeline.ArtifactStores {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineArtifactStoreLinks(adapterhelpers.FormatScope(accountID, storeRegion), store)...)
+	}
+
+	for _, stage := range pipeline.Stages {
+		for _, action := range stage.Actions {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineActionLinks(accountID, region, action)...)
+		}
+	}
+
+	return &item, nil
+}
+
+func pipelineListTagsFunc(ctx context.Context, pipeline *codepipeline.GetPipelineOutput, client codepipelineClient) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	if pipeline.Metadata == nil || pipeline.Metadata.PipelineArn == nil {
+		return tags, nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &codepipeline.ListTagsForResourceInput{
+		ResourceArn: pipeline.Metadata.PipelineArn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewCodePipelinePipelineAdapter(client codepipelineClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*codepipeline.ListPipelinesInput, *codepipeline.ListPipelinesOutput, *codepipeline.GetPipelineOutput, codepipelineClient, *codepipeline.Options] {
+	return &adapterhelpers.GetListAdapterV2[*codepipeline.ListPipelinesInput, *codepipeline.ListPipelinesOutput, *codepipeline.GetPipelineOutput, codepipelineClient, *codepipeline.Options]{
+		ItemType:        "codepipeline-pipeline",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codepipelinePipelineAdapterMetadata,
+		GetFunc:         pipelineGetFunc,
+		InputMapperList: func(scope string) (*codepipeline.ListPipelinesInput, error) {
+			return &codepipeline.ListPipelinesInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client codepipelineClient, params *codepipeline.ListPipelinesInput) adapterhelpers.Paginator[*codepipeline.ListPipelinesOutput, *codepipeline.Options] {
+			return codepipeline.NewListPipelinesPaginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *codepipeline.ListPipelinesOutput, client codepipelineClient) ([]*codepipeline.GetPipelineOutput, error) {
+			// The summaries don't include the stages, so we need to get each
+			// pipeline
+			pipelines := make([]*codepipeline.GetPipelineOutput, 0, len(output.Pipelines))
+
+			for _, summary := range output.Pipelines {
+				out, err := client.GetPipeline(ctx, &codepipeline.GetPipelineInput{
+					Name: summary.Name,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				pipelines = append(pipelines, out)
+			}
+
+			return pipelines, nil
+		},
+		ItemMapper:   pipelineItemMapper,
+		ListTagsFunc: pipelineListTagsFunc,
+	}
+}
+
+var codepipelinePipelineAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codepipeline-pipeline",
+	DescriptiveName: "CodePipeline Pipeline",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a pipeline by name",
+		ListDescription:   "List all pipelines",
+		SearchDescription: "Search for a pipeline by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_codepipeline.name",
+		},
+	},
+	PotentialLinks: []string{"iam-role", "s3-bucket", "kms-key", "ecr-repository", "codebuild-project", "ecs-service", "lambda-function"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var _ = Metadata.RegisterSchema(codepipelinePipelineAdapterMetadata, sdp.AttributeSchemaFor(pipelineAttributes{}))
@@ -0,0 +1,223 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testCodePipelineClient struct{}
+
+func (t testCodePipelineClient) GetPipeline(ctx context.Context, params *codepipeline.GetPipelineInput, optFns ...func(*codepipeline.Options)) (*codepipeline.GetPipelineOutput, error) {
+	if *params.Name != "app" {
+		return nil, &types.PipelineNotFoundException{
+			Message: aws.String("pipeline not found"),
+		}
+	}
+
+	return &codepipeline.GetPipelineOutput{
+		Pipeline: &types.PipelineDeclaration{
+			Name:         aws.String("app"),
+			RoleArn:      aws.String("arn:aws:iam::123456789012:role/codepipeline-app"),
+			PipelineType: types.PipelineTypeV2,
+			Version:      aws.Int32(3),
+			ArtifactStore: &types.ArtifactStore{
+				Type:     types.ArtifactStoreTypeS3,
+				Location: aws.String("codepipeline-eu-west-2-artifacts"),
+				EncryptionKey: &types.EncryptionKey{
+					Id:   aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+					Type: types.EncryptionKeyTypeKms,
+				},
+			},
+			Stages: []types.StageDeclaration{
+				{
+					Name: aws.String("Source"),
+					Actions: []types.ActionDeclaration{
+						{
+							Name: aws.String("Image"),
+							ActionTypeId: &types.ActionTypeId{
+								Category: types.ActionCategorySource,
+								Owner:    types.ActionOwnerAws,
+								Provider: aws.String("ECR"),
+								Version:  aws.String("1"),
+							},
+							Configuration: map[string]string{
+								"RepositoryName": "team/app",
+								"ImageTag":       "latest",
+							},
+						},
+					},
+				},
+				{
+					Name: aws.String("Build"),
+					Actions: []types.ActionDeclaration{
+						{
+							Name: aws.String("Build"),
+							ActionTypeId: &types.ActionTypeId{
+								Category: types.ActionCategoryBuild,
+								Owner:    types.ActionOwnerAws,
+								Provider: aws.String("CodeBuild"),
+								Version:  aws.String("1"),
+							},
+							Configuration: map[string]string{
+								"ProjectName": "app-build",
+							},
+						},
+					},
+				},
+				{
+					Name: aws.String("Deploy"),
+					Actions: []types.ActionDeclaration{
+						{
+							Name: aws.String("Deploy"),
+							ActionTypeId: &types.ActionTypeId{
+								Category: types.ActionCategoryDeploy,
+								Owner:    types.ActionOwnerAws,
+								Provider: aws.String("ECS"),
+								Version:  aws.String("1"),
+							},
+							Region: aws.String("us-east-1"),
+							Configuration: map[string]string{
+								"ClusterName": "prod",
+								"ServiceName": "app",
+							},
+						},
+					},
+				},
+			},
+		},
+		Metadata: &types.PipelineMetadata{
+			PipelineArn: aws.String("arn:aws:codepipeline:eu-west-2:123456789012:app"),
+			Created:     aws.Time(time.Now()),
+			Updated:     aws.Time(time.Now()),
+		},
+	}, nil
+}
+
+func (t testCodePipelineClient) ListTagsForResource(ctx context.Context, params *codepipeline.ListTagsForResourceInput, optFns ...func(*codepipeline.Options)) (*codepipeline.ListTagsForResourceOutput, error) {
+	return &codepipeline.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("platform"),
+			},
+		},
+	}, nil
+}
+
+func (t testCodePipelineClient) ListPipelines(ctx context.Context, params *codepipeline.ListPipelinesInput, optFns ...func(*codepipeline.Options)) (*codepipeline.ListPipelinesOutput, error) {
+	return &codepipeline.ListPipelinesOutput{
+		Pipelines: []types.PipelineSummary{
+			{
+				Name:    aws.String("app"),
+				Version: aws.Int32(3),
+			},
+		},
+	}, nil
+}
+
+func TestPipelineItemMapper(t *testing.T) {
+	pipeline, err := pipelineGetFunc(context.Background(), testCodePipelineClient{}, "123456789012.eu-west-2", "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := pipelineItemMapper(nil, "123456789012.eu-west-2", pipeline)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codepipeline-app",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "codepipeline-eu-west-2-artifacts",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "team/app",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "codebuild-project",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "app-build",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			// The deploy action runs in a different region
+			ExpectedType:   "ecs-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "prod/app",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestPipelineListFunc(t *testing.T) {
+	adapter := NewCodePipelinePipelineAdapter(testCodePipelineClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "app" {
+		t.Errorf("expected pipeline app, got %v", items[0].UniqueAttributeValue())
+	}
+
+	if items[0].GetTags()["team"] != "platform" {
+		t.Errorf("expected team tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewCodePipelinePipelineAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := codepipeline.NewFromConfig(config)
+
+	adapter := NewCodePipelinePipelineAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
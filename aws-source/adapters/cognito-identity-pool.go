@@ -0,0 +1,228 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// cognitoIdentityPool is an identity pool along with the IAM roles that it
+// vends to identities, which are returned by a separate API
+type cognitoIdentityPool struct {
+	IdentityPoolId                 *string
+	IdentityPoolName               *string
+	AllowUnauthenticatedIdentities bool
+	AllowClassicFlow               *bool
+	CognitoIdentityProviders       []types.CognitoIdentityProvider
+	DeveloperProviderName          *string
+	IdentityPoolTags               map[string]string
+	OpenIdConnectProviderARNs      []string
+	SamlProviderARNs               []string
+	SupportedLoginProviders        map[string]string
+
+	// The roles for authenticated and unauthenticated identities
+	Roles map[string]string
+	// How identities from each provider are mapped to roles
+	RoleMappings map[string]types.RoleMapping
+}
+
+func cognitoIdentityPoolGetFunc(ctx context.Context, client cognitoIdentityClient, _, query string) (*cognitoIdentityPool, error) {
+	out, err := client.DescribeIdentityPool(ctx, &cognitoidentity.DescribeIdentityPoolInput{
+		IdentityPoolId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := client.GetIdentityPoolRoles(ctx, &cognitoidentity.GetIdentityPoolRolesInput{
+		IdentityPoolId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cognitoIdentityPool{
+		IdentityPoolId:                 out.IdentityPoolId,
+		IdentityPoolName:               out.IdentityPoolName,
+		AllowUnauthenticatedIdentities: out.AllowUnauthenticatedIdentities,
+		AllowClassicFlow:               out.AllowClassicFlow,
+		CognitoIdentityProviders:       out.CognitoIdentityProviders,
+		DeveloperProviderName:          out.DeveloperProviderName,
+		IdentityPoolTags:               out.IdentityPoolTags,
+		OpenIdConnectProviderARNs:      out.OpenIdConnectProviderARNs,
+		SamlProviderARNs:               out.SamlProviderARNs,
+		SupportedLoginProviders:        out.SupportedLoginProviders,
+		Roles:                          roles.Roles,
+		RoleMappings:                   roles.RoleMappings,
+	}, nil
+}
+
+func cognitoIdentityPoolListFunc(ctx context.Context, client cognitoIdentityClient, scope string) ([]*cognitoIdentityPool, error) {
+	identityPools := make([]*cognitoIdentityPool, 0)
+	paginator := cognitoidentity.NewListIdentityPoolsPaginator(client, &cognitoidentity.ListIdentityPoolsInput{
+		MaxResults: adapterhelpers.PtrInt32(cognitoMaxResults),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.IdentityPools {
+			identityPool, err := cognitoIdentityPoolGetFunc(ctx, client, scope, *summary.IdentityPoolId)
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			identityPools = append(identityPools, identityPool)
+		}
+	}
+
+	return identityPools, nil
+}
+
+func cognitoIdentityPoolOutputMapper(_, scope string, awsItem *cognitoIdentityPool) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "IdentityPoolTags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cognito-identity-pool",
+		UniqueAttribute: "IdentityPoolId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.IdentityPoolTags,
+	}
+
+	// The same role is often used by several mappings, each is only linked
+	// once
+	roleARNs := make([]string, 0)
+	seen := make(map[string]bool)
+	addRole := func(roleARN *string) {
+		if roleARN != nil && !seen[*roleARN] {
+			seen[*roleARN] = true
+			roleARNs = append(roleARNs, *roleARN)
+		}
+	}
+
+	for _, roleARN := range awsItem.Roles {
+		addRole(&roleARN)
+	}
+	for _, mapping := range awsItem.RoleMappings {
+		if mapping.RulesConfiguration != nil {
+			for _, rule := range mapping.RulesConfiguration.Rules {
+				addRole(rule.RoleARN)
+			}
+		}
+	}
+
+	// Maps are unordered, sort the roles so that the links are stable
+	slices.Sort(roleARNs)
+
+	for _, roleARN := range roleARNs {
+		if link := cognitoRoleLink(roleARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	for _, provider := range awsItem.CognitoIdentityProviders {
+		if provider.ProviderName == nil {
+			continue
+		}
+
+		// The provider name is in the format
+		// `cognito-idp.{region}.amazonaws.com/{user-pool-id}`. It doesn't
+		// include the account, we assume that the user pool is in the same
+		// account as the identity pool
+		region, userPoolID, ok := cognitoUserPoolFromIssuer("https://" + *provider.ProviderName)
+		if !ok {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cognito-idp-user-pool",
+				Method: sdp.QueryMethod_GET,
+				Query:  userPoolID,
+				Scope:  adapterhelpers.FormatScope(accountID, region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the user pool changes who can get credentials
+				In: true,
+				// The identity pool can't affect the user pool
+				Out: false,
+			},
+		})
+
+		if provider.ClientId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cognito-idp-user-pool-client",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", userPoolID, *provider.ClientId),
+					Scope:  adapterhelpers.FormatScope(accountID, region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the client stops its users from getting
+					// credentials
+					In: true,
+					// The identity pool can't affect the client
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewCognitoIdentityPoolAdapter(client cognitoIdentityClient, accountID string, region string) *adapterhelpers.GetListAdapter[*cognitoIdentityPool, cognitoIdentityClient, *cognitoidentity.Options] {
+	return &adapterhelpers.GetListAdapter[*cognitoIdentityPool, cognitoIdentityClient, *cognitoidentity.Options]{
+		ItemType:        "cognito-identity-pool",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cognitoIdentityPoolAdapterMetadata,
+		GetFunc:         cognitoIdentityPoolGetFunc,
+		ListFunc:        cognitoIdentityPoolListFunc,
+		ItemMapper:      cognitoIdentityPoolOutputMapper,
+	}
+}
+
+var cognitoIdentityPoolAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cognito-identity-pool",
+	DescriptiveName: "Cognito Identity Pool",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an identity pool by ID",
+		List:              true,
+		ListDescription:   "List all identity pools",
+		Search:            true,
+		SearchDescription: "Search for an identity pool by ARN",
+	},
+	PotentialLinks: []string{"iam-role", "cognito-idp-user-pool", "cognito-idp-user-pool-client"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cognito_identity_pool.id"},
+		{TerraformQueryMap: "aws_cognito_identity_pool_roles_attachment.identity_pool_id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(cognitoIdentityPoolAdapterMetadata, sdp.AttributeSchemaFor(&cognitoIdentityPool{}, "IdentityPoolTags"))
@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCognitoIdentityPoolGet(t *testing.T) {
+	adapter := NewCognitoIdentityPoolAdapter(testCognitoIdentityClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "eu-west-2:a1b2c3d4-5678-90ab-cdef-EXAMPLE33333", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["env"] != "prod" {
+		t.Errorf("expected the env tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/cognito-admin",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/cognito-authenticated",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/cognito-unauthenticated",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool-client",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_EXAMPLE/1example23456789",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The role that is used by both the default and a rule is only linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestCognitoIdentityPoolList(t *testing.T) {
+	adapter := NewCognitoIdentityPoolAdapter(testCognitoIdentityClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 identity pool, got %v", len(items))
+	}
+}
+
+func TestNewCognitoIdentityPoolAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cognitoidentity.NewFromConfig(config)
+
+	adapter := NewCognitoIdentityPoolAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// cognitoUserPoolClient is an app client, which is only unique within its user
+// pool
+type cognitoUserPoolClient struct {
+	// The ID of the user pool and the ID of the client separated by a slash
+	UniqueName string
+	types.UserPoolClientType
+}
+
+// Wraps the client and removes its secret, which must never be returned
+func newCognitoUserPoolClient(client types.UserPoolClientType) *cognitoUserPoolClient {
+	client.ClientSecret = nil
+
+	return &cognitoUserPoolClient{
+		UniqueName:         fmt.Sprintf("%s/%s", *client.UserPoolId, *client.ClientId),
+		UserPoolClientType: client,
+	}
+}
+
+func cognitoUserPoolClientGetFunc(ctx context.Context, client cognitoIdentityProviderClient, _, query string) (*cognitoUserPoolClient, error) {
+	userPoolID, clientID, err := parseCognitoUserPoolClientQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeUserPoolClient(ctx, &cognitoidentityprovider.DescribeUserPoolClientInput{
+		UserPoolId: &userPoolID,
+		ClientId:   &clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newCognitoUserPoolClient(*out.UserPoolClient), nil
+}
+
+func cognitoUserPoolClientSearchFunc(ctx context.Context, client cognitoIdentityProviderClient, scope, query string) ([]*cognitoUserPoolClient, error) {
+	userPoolClients := make([]*cognitoUserPoolClient, 0)
+	paginator := cognitoidentityprovider.NewListUserPoolClientsPaginator(client, &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: &query,
+		MaxResults: adapterhelpers.PtrInt32(cognitoMaxResults),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include the settings of the clients
+		for _, summary := range out.UserPoolClients {
+			userPoolClient, err := cognitoUserPoolClientGetFunc(ctx, client, scope, fmt.Sprintf("%s/%s", query, *summary.ClientId))
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			userPoolClients = append(userPoolClients, userPoolClient)
+		}
+	}
+
+	return userPoolClients, nil
+}
+
+func cognitoUserPoolClientOutputMapper(_, scope string, awsItem *cognitoUserPoolClient) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "ClientSecret")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cognito-idp-user-pool-client",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.UserPoolId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cognito-idp-user-pool",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.UserPoolId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the pool changes how the client signs users in
+				In: true,
+				// The client can't affect the pool
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.AnalyticsConfiguration != nil && awsItem.AnalyticsConfiguration.RoleArn != nil {
+		// The role that Cognito assumes to publish analytics events
+		if link := cognitoRoleLink(*awsItem.AnalyticsConfiguration.RoleArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// The applications that users are redirected to after signing in or out.
+	// Only web URLs are linked, apps can also use custom schemes
+	seen := make(map[string]bool)
+	for _, redirectURL := range append(append([]string{}, awsItem.CallbackURLs...), awsItem.LogoutURLs...) {
+		if seen[redirectURL] {
+			continue
+		}
+		seen[redirectURL] = true
+
+		if u, err := url.Parse(redirectURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  redirectURL,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The application can't affect the client
+				In: false,
+				// Removing the URL stops users from being redirected to it
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewCognitoUserPoolClientAdapter(client cognitoIdentityProviderClient, accountID string, region string) *adapterhelpers.GetListAdapter[*cognitoUserPoolClient, cognitoIdentityProviderClient, *cognitoidentityprovider.Options] {
+	return &adapterhelpers.GetListAdapter[*cognitoUserPoolClient, cognitoIdentityProviderClient, *cognitoidentityprovider.Options]{
+		ItemType:        "cognito-idp-user-pool-client",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cognitoUserPoolClientAdapterMetadata,
+		GetFunc:         cognitoUserPoolClientGetFunc,
+		DisableList:     true,
+		SearchFunc:      cognitoUserPoolClientSearchFunc,
+		ItemMapper:      cognitoUserPoolClientOutputMapper,
+	}
+}
+
+var cognitoUserPoolClientAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cognito-idp-user-pool-client",
+	DescriptiveName: "Cognito User Pool Client",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a user pool client by user pool ID and client ID: user-pool-id/client-id",
+		Search:            true,
+		SearchDescription: "Search for the clients of a user pool by user pool ID",
+	},
+	PotentialLinks: []string{"cognito-idp-user-pool", "iam-role", "http"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cognito_user_pool_client.user_pool_id",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(cognitoUserPoolClientAdapterMetadata, sdp.AttributeSchemaFor(&cognitoUserPoolClient{}, "ClientSecret"))
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCognitoUserPoolClientGet(t *testing.T) {
+	adapter := NewCognitoUserPoolClientAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "eu-west-2_EXAMPLE/1example23456789", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "eu-west-2_EXAMPLE/1example23456789" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	if _, err := item.GetAttributes().Get("ClientSecret"); err == nil {
+		t.Error("expected the client secret not to be returned")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/cognito-analytics",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "https://app.example.com/callback",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The custom scheme isn't linked and the logout URL is the same as the
+	// callback URL
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestCognitoUserPoolClientSearch(t *testing.T) {
+	adapter := NewCognitoUserPoolClientAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "eu-west-2_EXAMPLE", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 clients, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if _, err := item.GetAttributes().Get("ClientSecret"); err == nil {
+			t.Errorf("expected the client secret of %v not to be returned", item.UniqueAttributeValue())
+		}
+	}
+}
+
+func TestCognitoUserPoolClientGetBadQuery(t *testing.T) {
+	adapter := NewCognitoUserPoolClientAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "1example23456789", false)
+	if err == nil {
+		t.Error("expected an error for a query without a user pool")
+	}
+}
+
+func TestNewCognitoUserPoolClientAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cognitoidentityprovider.NewFromConfig(config)
+
+	adapter := NewCognitoUserPoolClientAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
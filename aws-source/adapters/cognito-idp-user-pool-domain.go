@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func cognitoUserPoolDomainGetFunc(ctx context.Context, client cognitoIdentityProviderClient, _, query string) (*types.DomainDescriptionType, error) {
+	out, err := client.DescribeUserPoolDomain(ctx, &cognitoidentityprovider.DescribeUserPoolDomainInput{
+		Domain: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty description is returned for domains that don't exist
+	if out.DomainDescription == nil || out.DomainDescription.Domain == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("user pool domain %v not found", query),
+		}
+	}
+
+	return out.DomainDescription, nil
+}
+
+// Searches for the prefix domain and custom domain of a user pool
+func cognitoUserPoolDomainSearchFunc(ctx context.Context, client cognitoIdentityProviderClient, scope, query string) ([]*types.DomainDescriptionType, error) {
+	userPool, err := cognitoUserPoolGetFunc(ctx, client, scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]*types.DomainDescriptionType, 0)
+	for _, domain := range []*string{userPool.Domain, userPool.CustomDomain} {
+		if domain == nil || *domain == "" {
+			continue
+		}
+
+		description, err := cognitoUserPoolDomainGetFunc(ctx, client, scope, *domain)
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, description)
+	}
+
+	return domains, nil
+}
+
+func cognitoUserPoolDomainOutputMapper(_, scope string, awsItem *types.DomainDescriptionType) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cognito-idp-user-pool-domain",
+		UniqueAttribute: "Domain",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.DomainStatusTypeActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DomainStatusTypeCreating, types.DomainStatusTypeUpdating, types.DomainStatusTypeDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DomainStatusTypeFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.UserPoolId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cognito-idp-user-pool",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.UserPoolId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// They are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	// Custom domains are full domain names, prefix domains are a subdomain of
+	// the Cognito domain for the region
+	var hostname string
+	if awsItem.CustomDomainConfig != nil {
+		hostname = *awsItem.Domain
+	} else if _, region, err := adapterhelpers.ParseScope(scope); err == nil {
+		hostname = fmt.Sprintf("%s.auth.%s.amazoncognito.com", *awsItem.Domain, region)
+	}
+
+	names := []string{hostname}
+	if awsItem.CloudFrontDistribution != nil {
+		names = append(names, *awsItem.CloudFrontDistribution)
+	}
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  name,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.CustomDomainConfig != nil && awsItem.CustomDomainConfig.CertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CustomDomainConfig.CertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.CustomDomainConfig.CertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// They are tightly linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewCognitoUserPoolDomainAdapter(client cognitoIdentityProviderClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DomainDescriptionType, cognitoIdentityProviderClient, *cognitoidentityprovider.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DomainDescriptionType, cognitoIdentityProviderClient, *cognitoidentityprovider.Options]{
+		ItemType:        "cognito-idp-user-pool-domain",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cognitoUserPoolDomainAdapterMetadata,
+		GetFunc:         cognitoUserPoolDomainGetFunc,
+		DisableList:     true,
+		SearchFunc:      cognitoUserPoolDomainSearchFunc,
+		ItemMapper:      cognitoUserPoolDomainOutputMapper,
+	}
+}
+
+var cognitoUserPoolDomainAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cognito-idp-user-pool-domain",
+	DescriptiveName: "Cognito User Pool Domain",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a user pool domain by its prefix or custom domain name",
+		Search:            true,
+		SearchDescription: "Search for the domains of a user pool by user pool ID",
+	},
+	PotentialLinks: []string{"cognito-idp-user-pool", "dns", "acm-certificate"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cognito_user_pool_domain.domain"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(cognitoUserPoolDomainAdapterMetadata, sdp.AttributeSchemaFor(&types.DomainDescriptionType{}))
@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCognitoUserPoolDomainGet(t *testing.T) {
+	adapter := NewCognitoUserPoolDomainAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	t.Run("prefix domain", func(t *testing.T) {
+		item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "example", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "cognito-idp-user-pool",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "eu-west-2_EXAMPLE",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "example.auth.eu-west-2.amazoncognito.com",
+				ExpectedScope:  "global",
+			},
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "d111111abcdef8.cloudfront.net",
+				ExpectedScope:  "global",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("custom domain", func(t *testing.T) {
+		item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "auth.example.com", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+			t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "auth.example.com",
+				ExpectedScope:  "global",
+			},
+			{
+				ExpectedType:   "acm-certificate",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  testCognitoCertificateARN,
+				ExpectedScope:  "123456789012.us-east-1",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("domain that doesn't exist", func(t *testing.T) {
+		_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "missing", false)
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestCognitoUserPoolDomainSearch(t *testing.T) {
+	adapter := NewCognitoUserPoolDomainAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "eu-west-2_EXAMPLE", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 domains, got %v", len(items))
+	}
+}
+
+func TestNewCognitoUserPoolDomainAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cognitoidentityprovider.NewFromConfig(config)
+
+	adapter := NewCognitoUserPoolDomainAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,213 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func cognitoUserPoolGetFunc(ctx context.Context, client cognitoIdentityProviderClient, _, query string) (*types.UserPoolType, error) {
+	out, err := client.DescribeUserPool(ctx, &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.UserPool, nil
+}
+
+func cognitoUserPoolListFunc(ctx context.Context, client cognitoIdentityProviderClient, scope string) ([]*types.UserPoolType, error) {
+	userPools := make([]*types.UserPoolType, 0)
+	paginator := cognitoidentityprovider.NewListUserPoolsPaginator(client, &cognitoidentityprovider.ListUserPoolsInput{
+		MaxResults: adapterhelpers.PtrInt32(cognitoMaxResults),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list doesn't include the full details of the pools
+		for _, summary := range out.UserPools {
+			userPool, err := cognitoUserPoolGetFunc(ctx, client, scope, *summary.Id)
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			userPools = append(userPools, userPool)
+		}
+	}
+
+	return userPools, nil
+}
+
+// The ARNs of the Lambda functions that a user pool triggers
+func cognitoUserPoolTriggers(config *types.LambdaConfigType) []*string {
+	if config == nil {
+		return nil
+	}
+
+	triggers := []*string{
+		config.CreateAuthChallenge,
+		config.CustomMessage,
+		config.DefineAuthChallenge,
+		config.PostAuthentication,
+		config.PostConfirmation,
+		config.PreAuthentication,
+		config.PreSignUp,
+		config.PreTokenGeneration,
+		config.UserMigration,
+		config.VerifyAuthChallengeResponse,
+	}
+
+	if config.CustomEmailSender != nil {
+		triggers = append(triggers, config.CustomEmailSender.LambdaArn)
+	}
+	if config.CustomSMSSender != nil {
+		triggers = append(triggers, config.CustomSMSSender.LambdaArn)
+	}
+	if config.InboundFederation != nil {
+		triggers = append(triggers, config.InboundFederation.LambdaArn)
+	}
+	if config.PreTokenGenerationConfig != nil {
+		triggers = append(triggers, config.PreTokenGenerationConfig.LambdaArn)
+	}
+
+	return triggers
+}
+
+func cognitoUserPoolOutputMapper(_, scope string, awsItem *types.UserPoolType) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "UserPoolTags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cognito-idp-user-pool",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.UserPoolTags,
+	}
+
+	// The user pool still works when it can't send messages, but users can't
+	// verify their details or reset their passwords
+	if awsItem.EmailConfigurationFailure != nil || awsItem.SmsConfigurationFailure != nil {
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	} else {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if awsItem.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cognito-idp-user-pool-client",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Clients can't affect the pool
+				In: false,
+				// Changing the pool changes how the clients sign users in
+				Out: true,
+			},
+		})
+	}
+
+	// Each trigger is only linked once, the same function is often used for
+	// several triggers
+	seen := make(map[string]bool)
+	for _, trigger := range cognitoUserPoolTriggers(awsItem.LambdaConfig) {
+		if trigger == nil || seen[*trigger] {
+			continue
+		}
+		seen[*trigger] = true
+
+		if link := cognitoLambdaLink(*trigger); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.LambdaConfig != nil && awsItem.LambdaConfig.KMSKeyID != nil {
+		// The key that is used to encrypt codes sent by the custom senders
+		if link := kmsKeyLink(scope, *awsItem.LambdaConfig.KMSKeyID); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.SmsConfiguration != nil && awsItem.SmsConfiguration.SnsCallerArn != nil {
+		// The role that Cognito assumes to send SMS messages
+		if link := cognitoRoleLink(*awsItem.SmsConfiguration.SnsCallerArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	for _, domain := range []*string{awsItem.Domain, awsItem.CustomDomain} {
+		if domain == nil || *domain == "" {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cognito-idp-user-pool-domain",
+				Method: sdp.QueryMethod_GET,
+				Query:  *domain,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// They are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewCognitoUserPoolAdapter(client cognitoIdentityProviderClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.UserPoolType, cognitoIdentityProviderClient, *cognitoidentityprovider.Options] {
+	return &adapterhelpers.GetListAdapter[*types.UserPoolType, cognitoIdentityProviderClient, *cognitoidentityprovider.Options]{
+		ItemType:        "cognito-idp-user-pool",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cognitoUserPoolAdapterMetadata,
+		GetFunc:         cognitoUserPoolGetFunc,
+		ListFunc:        cognitoUserPoolListFunc,
+		ItemMapper:      cognitoUserPoolOutputMapper,
+	}
+}
+
+var cognitoUserPoolAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cognito-idp-user-pool",
+	DescriptiveName: "Cognito User Pool",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a user pool by ID",
+		List:              true,
+		ListDescription:   "List all user pools",
+		Search:            true,
+		SearchDescription: "Search for a user pool by ARN",
+	},
+	PotentialLinks: []string{"cognito-idp-user-pool-client", "cognito-idp-user-pool-domain", "lambda-function", "kms-key", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cognito_user_pool.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(cognitoUserPoolAdapterMetadata, sdp.AttributeSchemaFor(&types.UserPoolType{}, "UserPoolTags"))
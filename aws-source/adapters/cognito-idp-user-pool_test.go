@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCognitoUserPoolGet(t *testing.T) {
+	adapter := NewCognitoUserPoolAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "eu-west-2_EXAMPLE", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["env"] != "prod" {
+		t.Errorf("expected the env tag, got %v", item.GetTags())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "cognito-idp-user-pool-client",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eu-west-2_EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testCognitoTriggerARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:email-sender",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE22222",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testCognitoSMSRoleARN,
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool-domain",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "example",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool-domain",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "auth.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The trigger that is used twice is only linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestCognitoUserPoolList(t *testing.T) {
+	adapter := NewCognitoUserPoolAdapter(testCognitoIdentityProviderClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 user pool, got %v", len(items))
+	}
+}
+
+func TestNewCognitoUserPoolAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cognitoidentityprovider.NewFromConfig(config)
+
+	adapter := NewCognitoUserPoolAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
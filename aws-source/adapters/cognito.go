@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// The user pool APIs that the adapters use. Note that none of the APIs that
+// read users are included, the adapters must never read user records
+type cognitoIdentityProviderClient interface {
+	DescribeUserPool(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolOutput, error)
+	DescribeUserPoolClient(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolClientInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolClientOutput, error)
+	DescribeUserPoolDomain(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolDomainInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolDomainOutput, error)
+	ListUserPoolClients(ctx context.Context, params *cognitoidentityprovider.ListUserPoolClientsInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolClientsOutput, error)
+	ListUserPools(ctx context.Context, params *cognitoidentityprovider.ListUserPoolsInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolsOutput, error)
+}
+
+type cognitoIdentityClient interface {
+	DescribeIdentityPool(ctx context.Context, params *cognitoidentity.DescribeIdentityPoolInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.DescribeIdentityPoolOutput, error)
+	GetIdentityPoolRoles(ctx context.Context, params *cognitoidentity.GetIdentityPoolRolesInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.GetIdentityPoolRolesOutput, error)
+	ListIdentityPools(ctx context.Context, params *cognitoidentity.ListIdentityPoolsInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.ListIdentityPoolsOutput, error)
+}
+
+// The maximum page size of the list APIs. ListUserPools and ListIdentityPools
+// require this to be set
+const cognitoMaxResults = 60
+
+// Returns the region and ID of the Cognito user pool that issues the tokens of
+// a JWT authorizer. The issuer is in the format
+// `https://cognito-idp.{region}.amazonaws.com/{user-pool-id}`
+func cognitoUserPoolFromIssuer(issuer string) (string, string, bool) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return "", "", false
+	}
+
+	region, found := strings.CutPrefix(u.Hostname(), "cognito-idp.")
+	if !found {
+		return "", "", false
+	}
+
+	region, found = strings.CutSuffix(region, ".amazonaws.com")
+	userPoolID := strings.Trim(u.Path, "/")
+	if !found || region == "" || userPoolID == "" {
+		return "", "", false
+	}
+
+	return region, userPoolID, true
+}
+
+// Parses a `{user-pool-id}/{client-id}` query
+func parseCognitoUserPoolClientQuery(query string) (string, string, error) {
+	userPoolID, clientID, found := strings.Cut(query, "/")
+	if !found || userPoolID == "" || clientID == "" || strings.Contains(clientID, "/") {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format user-pool-id/client-id, got %v", query),
+		}
+	}
+
+	return userPoolID, clientID, nil
+}
+
+// Links to a Lambda function that Cognito invokes, such as a user pool
+// trigger
+func cognitoLambdaLink(functionARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(functionARN)
+	if err != nil || a.Service != "lambda" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "lambda-function",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  functionARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// A broken trigger stops users from signing up or signing in
+			In: true,
+			// The user pool invokes the function
+			Out: true,
+		},
+	}
+}
+
+// Links to an IAM role that Cognito either assumes itself, or vends to users
+func cognitoRoleLink(roleARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(roleARN)
+	if err != nil || a.Service != "iam" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  roleARN,
+			Scope:  a.AccountID,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's permissions changes what can be done with
+			// it
+			In: true,
+			// Cognito can't affect the role
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	identitytypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	testCognitoTriggerARN     = "arn:aws:lambda:eu-west-2:123456789012:function:cognito-trigger"
+	testCognitoSMSRoleARN     = "arn:aws:iam::123456789012:role/cognito-sms"
+	testCognitoCertificateARN = "arn:aws:acm:us-east-1:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+)
+
+type testCognitoIdentityProviderClient struct{}
+
+func (t testCognitoIdentityProviderClient) DescribeUserPool(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolOutput, error) {
+	return &cognitoidentityprovider.DescribeUserPoolOutput{
+		UserPool: &types.UserPoolType{
+			Id:           params.UserPoolId,
+			Name:         aws.String("customers"),
+			Arn:          aws.String("arn:aws:cognito-idp:eu-west-2:123456789012:userpool/" + *params.UserPoolId),
+			Domain:       aws.String("example"),
+			CustomDomain: aws.String("auth.example.com"),
+			LambdaConfig: &types.LambdaConfigType{
+				PreSignUp: aws.String(testCognitoTriggerARN),
+				// The same function is used for several triggers
+				PostConfirmation: aws.String(testCognitoTriggerARN),
+				CustomEmailSender: &types.CustomEmailLambdaVersionConfigType{
+					LambdaArn:     aws.String("arn:aws:lambda:eu-west-2:123456789012:function:email-sender"),
+					LambdaVersion: types.CustomEmailSenderLambdaVersionTypeV10,
+				},
+				KMSKeyID: aws.String("arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE22222"),
+			},
+			SmsConfiguration: &types.SmsConfigurationType{
+				SnsCallerArn: aws.String(testCognitoSMSRoleARN),
+			},
+			UserPoolTags: map[string]string{
+				"env": "prod",
+			},
+		},
+	}, nil
+}
+
+func (t testCognitoIdentityProviderClient) DescribeUserPoolClient(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolClientInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolClientOutput, error) {
+	return &cognitoidentityprovider.DescribeUserPoolClientOutput{
+		UserPoolClient: &types.UserPoolClientType{
+			UserPoolId:   params.UserPoolId,
+			ClientId:     params.ClientId,
+			ClientName:   aws.String("web"),
+			ClientSecret: aws.String("very-secret"),
+			CallbackURLs: []string{"https://app.example.com/callback", "myapp://callback"},
+			LogoutURLs:   []string{"https://app.example.com/callback"},
+			AnalyticsConfiguration: &types.AnalyticsConfigurationType{
+				ApplicationId: aws.String("a1b2c3d4e5"),
+				RoleArn:       aws.String("arn:aws:iam::123456789012:role/cognito-analytics"),
+			},
+		},
+	}, nil
+}
+
+func (t testCognitoIdentityProviderClient) DescribeUserPoolDomain(ctx context.Context, params *cognitoidentityprovider.DescribeUserPoolDomainInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.DescribeUserPoolDomainOutput, error) {
+	switch *params.Domain {
+	case "example":
+		return &cognitoidentityprovider.DescribeUserPoolDomainOutput{
+			DomainDescription: &types.DomainDescriptionType{
+				Domain:                 params.Domain,
+				UserPoolId:             aws.String("eu-west-2_EXAMPLE"),
+				AWSAccountId:           aws.String("123456789012"),
+				CloudFrontDistribution: aws.String("d111111abcdef8.cloudfront.net"),
+				Status:                 types.DomainStatusTypeActive,
+			},
+		}, nil
+	case "auth.example.com":
+		return &cognitoidentityprovider.DescribeUserPoolDomainOutput{
+			DomainDescription: &types.DomainDescriptionType{
+				Domain:                 params.Domain,
+				UserPoolId:             aws.String("eu-west-2_EXAMPLE"),
+				AWSAccountId:           aws.String("123456789012"),
+				CloudFrontDistribution: aws.String("d222222abcdef8.cloudfront.net"),
+				CustomDomainConfig: &types.CustomDomainConfigType{
+					CertificateArn: aws.String(testCognitoCertificateARN),
+				},
+				Status: types.DomainStatusTypeCreating,
+			},
+		}, nil
+	}
+
+	// Domains that don't exist return an empty description rather than an
+	// error
+	return &cognitoidentityprovider.DescribeUserPoolDomainOutput{
+		DomainDescription: &types.DomainDescriptionType{},
+	}, nil
+}
+
+func (t testCognitoIdentityProviderClient) ListUserPoolClients(ctx context.Context, params *cognitoidentityprovider.ListUserPoolClientsInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolClientsOutput, error) {
+	return &cognitoidentityprovider.ListUserPoolClientsOutput{
+		UserPoolClients: []types.UserPoolClientDescription{
+			{UserPoolId: params.UserPoolId, ClientId: aws.String("1example23456789"), ClientName: aws.String("web")},
+			{UserPoolId: params.UserPoolId, ClientId: aws.String("2example23456789"), ClientName: aws.String("mobile")},
+		},
+	}, nil
+}
+
+func (t testCognitoIdentityProviderClient) ListUserPools(ctx context.Context, params *cognitoidentityprovider.ListUserPoolsInput, optFns ...func(*cognitoidentityprovider.Options)) (*cognitoidentityprovider.ListUserPoolsOutput, error) {
+	// The first page only contains a token, to check that we follow it
+	if params.NextToken == nil {
+		return &cognitoidentityprovider.ListUserPoolsOutput{
+			NextToken: aws.String("page2"),
+		}, nil
+	}
+
+	return &cognitoidentityprovider.ListUserPoolsOutput{
+		UserPools: []types.UserPoolDescriptionType{
+			{Id: aws.String("eu-west-2_EXAMPLE"), Name: aws.String("customers")},
+		},
+	}, nil
+}
+
+type testCognitoIdentityClient struct{}
+
+func (t testCognitoIdentityClient) DescribeIdentityPool(ctx context.Context, params *cognitoidentity.DescribeIdentityPoolInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.DescribeIdentityPoolOutput, error) {
+	return &cognitoidentity.DescribeIdentityPoolOutput{
+		IdentityPoolId:                 params.IdentityPoolId,
+		IdentityPoolName:               aws.String("customers"),
+		AllowUnauthenticatedIdentities: true,
+		CognitoIdentityProviders: []identitytypes.CognitoIdentityProvider{
+			{
+				ProviderName: aws.String("cognito-idp.eu-west-2.amazonaws.com/eu-west-2_EXAMPLE"),
+				ClientId:     aws.String("1example23456789"),
+			},
+		},
+		IdentityPoolTags: map[string]string{
+			"env": "prod",
+		},
+	}, nil
+}
+
+func (t testCognitoIdentityClient) GetIdentityPoolRoles(ctx context.Context, params *cognitoidentity.GetIdentityPoolRolesInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.GetIdentityPoolRolesOutput, error) {
+	return &cognitoidentity.GetIdentityPoolRolesOutput{
+		IdentityPoolId: params.IdentityPoolId,
+		Roles: map[string]string{
+			"authenticated":   "arn:aws:iam::123456789012:role/cognito-authenticated",
+			"unauthenticated": "arn:aws:iam::123456789012:role/cognito-unauthenticated",
+		},
+		RoleMappings: map[string]identitytypes.RoleMapping{
+			"cognito-idp.eu-west-2.amazonaws.com/eu-west-2_EXAMPLE:1example23456789": {
+				Type: identitytypes.RoleMappingTypeRules,
+				RulesConfiguration: &identitytypes.RulesConfigurationType{
+					Rules: []identitytypes.MappingRule{
+						{
+							Claim:     aws.String("cognito:groups"),
+							MatchType: identitytypes.MappingRuleMatchTypeContains,
+							Value:     aws.String("admins"),
+							RoleARN:   aws.String("arn:aws:iam::123456789012:role/cognito-admin"),
+						},
+						{
+							// The same role as the default
+							Claim:     aws.String("cognito:groups"),
+							MatchType: identitytypes.MappingRuleMatchTypeContains,
+							Value:     aws.String("users"),
+							RoleARN:   aws.String("arn:aws:iam::123456789012:role/cognito-authenticated"),
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (t testCognitoIdentityClient) ListIdentityPools(ctx context.Context, params *cognitoidentity.ListIdentityPoolsInput, optFns ...func(*cognitoidentity.Options)) (*cognitoidentity.ListIdentityPoolsOutput, error) {
+	return &cognitoidentity.ListIdentityPoolsOutput{
+		IdentityPools: []identitytypes.IdentityPoolShortDescription{
+			{IdentityPoolId: aws.String("eu-west-2:a1b2c3d4-5678-90ab-cdef-EXAMPLE33333"), IdentityPoolName: aws.String("customers")},
+		},
+	}, nil
+}
+
+func TestCognitoUserPoolFromIssuer(t *testing.T) {
+	region, userPoolID, ok := cognitoUserPoolFromIssuer("https://cognito-idp.eu-west-2.amazonaws.com/eu-west-2_EXAMPLE")
+	if !ok {
+		t.Fatal("expected a Cognito issuer")
+	}
+
+	if region != "eu-west-2" || userPoolID != "eu-west-2_EXAMPLE" {
+		t.Errorf("unexpected region %v and user pool %v", region, userPoolID)
+	}
+
+	if _, _, ok := cognitoUserPoolFromIssuer("https://example.auth0.com/"); ok {
+		t.Error("expected a non-Cognito issuer not to match")
+	}
+}
+
+func TestParseCognitoUserPoolClientQuery(t *testing.T) {
+	userPoolID, clientID, err := parseCognitoUserPoolClientQuery("eu-west-2_EXAMPLE/1example23456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userPoolID != "eu-west-2_EXAMPLE" || clientID != "1example23456789" {
+		t.Errorf("unexpected user pool %v and client %v", userPoolID, clientID)
+	}
+
+	for _, query := range []string{"eu-west-2_EXAMPLE", "eu-west-2_EXAMPLE/", "/1example23456789", "a/b/c"} {
+		_, _, err := parseCognitoUserPoolClientQuery(query)
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+			t.Errorf("expected a NOTFOUND error for %v, got %v", query, err)
+		}
+	}
+}
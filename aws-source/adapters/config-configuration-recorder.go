@@ -0,0 +1,249 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// configConfigurationRecorder is a recorder along with its status and the
+// delivery channels that it sends configuration snapshots to, which are
+// returned by separate APIs. There is at most one customer managed recorder
+// and one delivery channel in each region
+type configConfigurationRecorder struct {
+	types.ConfigurationRecorder
+	Status           *types.ConfigurationRecorderStatus
+	DeliveryChannels []types.DeliveryChannel
+}
+
+// Gets the recorders with the given names, or all of them if no names are
+// given
+func describeConfigConfigurationRecorders(ctx context.Context, client configClient, names []string) ([]*configConfigurationRecorder, error) {
+	out, err := client.DescribeConfigurationRecorders(ctx, &configservice.DescribeConfigurationRecordersInput{
+		ConfigurationRecorderNames: names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ConfigurationRecorders) == 0 {
+		return []*configConfigurationRecorder{}, nil
+	}
+
+	statuses, err := client.DescribeConfigurationRecorderStatus(ctx, &configservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := client.DescribeDeliveryChannels(ctx, &configservice.DescribeDeliveryChannelsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	recorders := make([]*configConfigurationRecorder, 0, len(out.ConfigurationRecorders))
+	for _, recorder := range out.ConfigurationRecorders {
+		r := &configConfigurationRecorder{
+			ConfigurationRecorder: recorder,
+		}
+
+		for _, status := range statuses.ConfigurationRecordersStatus {
+			if status.Name != nil && recorder.Name != nil && *status.Name == *recorder.Name {
+				r.Status = &status
+				break
+			}
+		}
+
+		// Service-linked recorders deliver to the service that owns them
+		// rather than the delivery channel
+		if recorder.ServicePrincipal == nil {
+			r.DeliveryChannels = channels.DeliveryChannels
+		}
+
+		recorders = append(recorders, r)
+	}
+
+	return recorders, nil
+}
+
+func configConfigurationRecorderGetFunc(ctx context.Context, client configClient, _, query string) (*configConfigurationRecorder, error) {
+	recorders, err := describeConfigConfigurationRecorders(ctx, client, []string{query})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recorders) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("configuration recorder %v not found", query),
+		}
+	}
+
+	return recorders[0], nil
+}
+
+// Recorder ARNs end in a generated ID rather than the name of the recorder, so
+// are looked up directly
+func configConfigurationRecorderSearchFunc(ctx context.Context, client configClient, _, query string) ([]*configConfigurationRecorder, error) {
+	out, err := client.DescribeConfigurationRecorders(ctx, &configservice.DescribeConfigurationRecordersInput{
+		Arn: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(out.ConfigurationRecorders))
+	for _, recorder := range out.ConfigurationRecorders {
+		if recorder.Name != nil {
+			names = append(names, *recorder.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return []*configConfigurationRecorder{}, nil
+	}
+
+	return describeConfigConfigurationRecorders(ctx, client, names)
+}
+
+func configConfigurationRecorderListFunc(ctx context.Context, client configClient, _ string) ([]*configConfigurationRecorder, error) {
+	return describeConfigConfigurationRecorders(ctx, client, nil)
+}
+
+func configConfigurationRecorderOutputMapper(_, scope string, awsItem *configConfigurationRecorder) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "config-configuration-recorder",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Status != nil {
+		// A recorder that has been stopped isn't recording any changes
+		switch {
+		case awsItem.Status.LastStatus == types.RecorderStatusFailure:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case !awsItem.Status.Recording:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case awsItem.Status.LastStatus == types.RecorderStatusPending:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		default:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		}
+	}
+
+	if awsItem.RoleARN != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.RoleARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.RoleARN,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The recorder uses the role to read the configuration of
+					// resources
+					In: true,
+					// The recorder can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	for _, channel := range awsItem.DeliveryChannels {
+		if channel.S3BucketName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  *channel.S3BucketName,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the bucket's policy can stop snapshots from
+					// being delivered
+					In: true,
+					// The recorder writes to the bucket
+					Out: true,
+				},
+			})
+		}
+
+		if channel.S3KmsKeyArn != nil {
+			if link := kmsKeyLink(scope, *channel.S3KmsKeyArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if channel.SnsTopicARN != nil {
+			if a, err := adapterhelpers.ParseARN(*channel.SnsTopicARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sns-topic",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *channel.SnsTopicARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The topic can't affect the recorder
+						In: false,
+						// The recorder sends notifications to the topic
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewConfigConfigurationRecorderAdapter(client configClient, accountID string, region string) *adapterhelpers.GetListAdapter[*configConfigurationRecorder, configClient, *configservice.Options] {
+	return &adapterhelpers.GetListAdapter[*configConfigurationRecorder, configClient, *configservice.Options]{
+		ItemType:        "config-configuration-recorder",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: configConfigurationRecorderAdapterMetadata,
+		GetFunc:         configConfigurationRecorderGetFunc,
+		ListFunc:        configConfigurationRecorderListFunc,
+		SearchFunc:      configConfigurationRecorderSearchFunc,
+		ItemMapper:      configConfigurationRecorderOutputMapper,
+	}
+}
+
+var configConfigurationRecorderAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "config-configuration-recorder",
+	DescriptiveName: "Config Configuration Recorder",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a configuration recorder by name",
+		List:              true,
+		ListDescription:   "List all configuration recorders",
+		Search:            true,
+		SearchDescription: "Search for a configuration recorder by ARN",
+	},
+	PotentialLinks: []string{"iam-role", "s3-bucket", "kms-key", "sns-topic"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_config_configuration_recorder.name"},
+		{TerraformQueryMap: "aws_config_configuration_recorder_status.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(configConfigurationRecorderAdapterMetadata, sdp.AttributeSchemaFor(&configConfigurationRecorder{}))
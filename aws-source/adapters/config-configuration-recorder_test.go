@@ -0,0 +1,126 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestConfigConfigurationRecorderGet(t *testing.T) {
+	adapter := NewConfigConfigurationRecorderAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "default", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	// The recorder has been stopped
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-service-role/config.amazonaws.com/AWSServiceRoleForConfig",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "config-snapshots",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:config",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	_, err = adapter.Get(context.Background(), "123456789012.eu-west-2", "missing", false)
+	if err == nil {
+		t.Error("expected an error for a recorder that doesn't exist")
+	}
+}
+
+func TestConfigConfigurationRecorderList(t *testing.T) {
+	adapter := NewConfigConfigurationRecorderAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 recorders, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if item.UniqueAttributeValue() != "AWSConfigurationRecorderForSecurityHub" {
+			continue
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+			t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+		}
+
+		// Service-linked recorders don't use the delivery channel
+		if len(item.GetLinkedItemQueries()) != 0 {
+			t.Errorf("expected no links for a service-linked recorder, got %v", item.GetLinkedItemQueries())
+		}
+	}
+}
+
+func TestConfigConfigurationRecorderSearch(t *testing.T) {
+	adapter := NewConfigConfigurationRecorderAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testConfigRecorderARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 recorder, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "default" {
+		t.Errorf("expected the default recorder, got %v", items[0].UniqueAttributeValue())
+	}
+}
+
+func TestNewConfigConfigurationRecorderAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := configservice.NewFromConfig(config)
+
+	adapter := NewConfigConfigurationRecorderAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,167 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func configRuleGetFunc(ctx context.Context, client configClient, _, query string) (*types.ConfigRule, error) {
+	out, err := client.DescribeConfigRules(ctx, &configservice.DescribeConfigRulesInput{
+		ConfigRuleNames: []string{query},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ConfigRules) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "config rule not found",
+		}
+	}
+
+	return &out.ConfigRules[0], nil
+}
+
+func configRuleListFunc(ctx context.Context, client configClient, _ string) ([]*types.ConfigRule, error) {
+	rules := make([]*types.ConfigRule, 0)
+	paginator := configservice.NewDescribeConfigRulesPaginator(client, &configservice.DescribeConfigRulesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range out.ConfigRules {
+			rules = append(rules, &rule)
+		}
+	}
+
+	return rules, nil
+}
+
+// Rule ARNs end in the ID of the rule rather than its name, and rules can only
+// be described by name, so all rules are listed and filtered
+func configRuleSearchFunc(ctx context.Context, client configClient, scope, query string) ([]*types.ConfigRule, error) {
+	rules, err := configRuleListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.ConfigRuleArn != nil && *rule.ConfigRuleArn == query {
+			return []*types.ConfigRule{rule}, nil
+		}
+	}
+
+	return []*types.ConfigRule{}, nil
+}
+
+func configRuleListTags(ctx context.Context, rule *types.ConfigRule, client configClient) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := configservice.NewListTagsForResourcePaginator(client, &configservice.ListTagsForResourceInput{
+		ResourceArn: rule.ConfigRuleArn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+func configRuleOutputMapper(_, scope string, awsItem *types.ConfigRule) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "config-rule",
+		UniqueAttribute: "ConfigRuleName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.ConfigRuleState {
+	case types.ConfigRuleStateActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ConfigRuleStateEvaluating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ConfigRuleStateDeleting, types.ConfigRuleStateDeletingResults:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	// Custom rules are evaluated by a Lambda function
+	if awsItem.Source != nil && awsItem.Source.Owner == types.OwnerCustomLambda && awsItem.Source.SourceIdentifier != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.Source.SourceIdentifier); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.Source.SourceIdentifier,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the function changes how resources are
+					// evaluated
+					In: true,
+					// The rule invokes the function
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewConfigRuleAdapter(client configClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ConfigRule, configClient, *configservice.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ConfigRule, configClient, *configservice.Options]{
+		ItemType:        "config-rule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: configRuleAdapterMetadata,
+		GetFunc:         configRuleGetFunc,
+		ListFunc:        configRuleListFunc,
+		SearchFunc:      configRuleSearchFunc,
+		ItemMapper:      configRuleOutputMapper,
+		ListTagsFunc:    configRuleListTags,
+	}
+}
+
+var configRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "config-rule",
+	DescriptiveName: "Config Rule",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a config rule by name",
+		List:              true,
+		ListDescription:   "List all config rules",
+		Search:            true,
+		SearchDescription: "Search for a config rule by ARN",
+	},
+	PotentialLinks: []string{"lambda-function"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_config_config_rule.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(configRuleAdapterMetadata, sdp.AttributeSchemaFor(&types.ConfigRule{}))
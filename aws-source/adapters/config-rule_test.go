@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestConfigRuleGet(t *testing.T) {
+	adapter := NewConfigRuleAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "required-tags", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "security" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:required-tags",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	_, err = adapter.Get(context.Background(), "123456789012.eu-west-2", "missing", false)
+	if err == nil {
+		t.Error("expected an error for a rule that doesn't exist")
+	}
+}
+
+func TestConfigRuleList(t *testing.T) {
+	adapter := NewConfigRuleAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 rules, got %v", len(items))
+	}
+
+	for _, item := range items {
+		// Managed rules aren't evaluated by a function
+		if item.UniqueAttributeValue() == "s3-bucket-versioning-enabled" && len(item.GetLinkedItemQueries()) != 0 {
+			t.Errorf("expected no links for a managed rule, got %v", item.GetLinkedItemQueries())
+		}
+	}
+}
+
+func TestConfigRuleSearch(t *testing.T) {
+	adapter := NewConfigRuleAdapter(testConfigClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testConfigRuleARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 rule, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "required-tags" {
+		t.Errorf("expected the required-tags rule, got %v", items[0].UniqueAttributeValue())
+	}
+}
+
+func TestNewConfigRuleAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := configservice.NewFromConfig(config)
+
+	adapter := NewConfigRuleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+)
+
+const (
+	testConfigRecorderARN = "arn:aws:config:eu-west-2:123456789012:configuration-recorder/default/a1b2c3d4e5f6a7b8"
+	testConfigRuleARN     = "arn:aws:config:eu-west-2:123456789012:config-rule/config-rule-ab12cd"
+)
+
+type testConfigClient struct{}
+
+func (t testConfigClient) DescribeConfigRules(ctx context.Context, params *configservice.DescribeConfigRulesInput, optFns ...func(*configservice.Options)) (*configservice.DescribeConfigRulesOutput, error) {
+	rules := []types.ConfigRule{
+		{
+			ConfigRuleName:  aws.String("s3-bucket-versioning-enabled"),
+			ConfigRuleArn:   aws.String("arn:aws:config:eu-west-2:123456789012:config-rule/config-rule-managed"),
+			ConfigRuleId:    aws.String("config-rule-managed"),
+			ConfigRuleState: types.ConfigRuleStateActive,
+			Source: &types.Source{
+				Owner:            types.OwnerAws,
+				SourceIdentifier: aws.String("S3_BUCKET_VERSIONING_ENABLED"),
+			},
+		},
+		{
+			ConfigRuleName:  aws.String("required-tags"),
+			ConfigRuleArn:   aws.String(testConfigRuleARN),
+			ConfigRuleId:    aws.String("config-rule-ab12cd"),
+			ConfigRuleState: types.ConfigRuleStateEvaluating,
+			Source: &types.Source{
+				Owner:            types.OwnerCustomLambda,
+				SourceIdentifier: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:required-tags"),
+				SourceDetails: []types.SourceDetail{
+					{
+						EventSource: types.EventSourceAwsConfig,
+						MessageType: types.MessageTypeConfigurationItemChangeNotification,
+					},
+				},
+			},
+			Scope: &types.Scope{
+				ComplianceResourceTypes: []string{"AWS::EC2::Instance"},
+			},
+		},
+	}
+
+	// The rules are split over two pages to check that we follow the token
+	if len(params.ConfigRuleNames) == 0 {
+		if params.NextToken == nil {
+			return &configservice.DescribeConfigRulesOutput{
+				ConfigRules: rules[:1],
+				NextToken:   aws.String("page2"),
+			}, nil
+		}
+
+		return &configservice.DescribeConfigRulesOutput{
+			ConfigRules: rules[1:],
+		}, nil
+	}
+
+	out := &configservice.DescribeConfigRulesOutput{}
+	for _, rule := range rules {
+		for _, name := range params.ConfigRuleNames {
+			if *rule.ConfigRuleName == name {
+				out.ConfigRules = append(out.ConfigRules, rule)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (t testConfigClient) DescribeConfigurationRecorders(ctx context.Context, params *configservice.DescribeConfigurationRecordersInput, optFns ...func(*configservice.Options)) (*configservice.DescribeConfigurationRecordersOutput, error) {
+	recorders := []types.ConfigurationRecorder{
+		{
+			Name:    aws.String("default"),
+			Arn:     aws.String(testConfigRecorderARN),
+			RoleARN: aws.String("arn:aws:iam::123456789012:role/aws-service-role/config.amazonaws.com/AWSServiceRoleForConfig"),
+			RecordingGroup: &types.RecordingGroup{
+				AllSupported:               true,
+				IncludeGlobalResourceTypes: true,
+			},
+			RecordingMode: &types.RecordingMode{
+				RecordingFrequency: types.RecordingFrequencyContinuous,
+			},
+			RecordingScope: types.RecordingScopePaid,
+		},
+		{
+			// Service-linked recorders are created by other services
+			Name:             aws.String("AWSConfigurationRecorderForSecurityHub"),
+			Arn:              aws.String("arn:aws:config:eu-west-2:123456789012:configuration-recorder/AWSConfigurationRecorderForSecurityHub/b1c2d3e4f5a6b7c8"),
+			ServicePrincipal: aws.String("securityhub.amazonaws.com"),
+			RecordingScope:   types.RecordingScopeInternal,
+		},
+	}
+
+	out := &configservice.DescribeConfigurationRecordersOutput{}
+	for _, recorder := range recorders {
+		switch {
+		case params.Arn != nil:
+			if *recorder.Arn == *params.Arn {
+				out.ConfigurationRecorders = append(out.ConfigurationRecorders, recorder)
+			}
+		case len(params.ConfigurationRecorderNames) > 0:
+			for _, name := range params.ConfigurationRecorderNames {
+				if *recorder.Name == name {
+					out.ConfigurationRecorders = append(out.ConfigurationRecorders, recorder)
+				}
+			}
+		default:
+			out.ConfigurationRecorders = append(out.ConfigurationRecorders, recorder)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testConfigClient) DescribeConfigurationRecorderStatus(ctx context.Context, params *configservice.DescribeConfigurationRecorderStatusInput, optFns ...func(*configservice.Options)) (*configservice.DescribeConfigurationRecorderStatusOutput, error) {
+	return &configservice.DescribeConfigurationRecorderStatusOutput{
+		ConfigurationRecordersStatus: []types.ConfigurationRecorderStatus{
+			{
+				Name:         aws.String("default"),
+				Recording:    false,
+				LastStatus:   types.RecorderStatusSuccess,
+				LastStopTime: aws.Time(time.Now()),
+			},
+			{
+				Name:       aws.String("AWSConfigurationRecorderForSecurityHub"),
+				Recording:  true,
+				LastStatus: types.RecorderStatusPending,
+			},
+		},
+	}, nil
+}
+
+func (t testConfigClient) DescribeDeliveryChannels(ctx context.Context, params *configservice.DescribeDeliveryChannelsInput, optFns ...func(*configservice.Options)) (*configservice.DescribeDeliveryChannelsOutput, error) {
+	return &configservice.DescribeDeliveryChannelsOutput{
+		DeliveryChannels: []types.DeliveryChannel{
+			{
+				Name:         aws.String("default"),
+				S3BucketName: aws.String("config-snapshots"),
+				S3KeyPrefix:  aws.String("eu-west-2"),
+				S3KmsKeyArn:  aws.String("arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+				SnsTopicARN:  aws.String("arn:aws:sns:eu-west-2:123456789012:config"),
+			},
+		},
+	}, nil
+}
+
+func (t testConfigClient) ListTagsForResource(ctx context.Context, params *configservice.ListTagsForResourceInput, optFns ...func(*configservice.Options)) (*configservice.ListTagsForResourceOutput, error) {
+	return &configservice.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("team"), Value: aws.String("security")},
+		},
+	}, nil
+}
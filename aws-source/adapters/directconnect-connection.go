@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -140,3 +141,5 @@ var directconnectConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(directconnectConnectionAdapterMetadata, sdp.AttributeSchemaFor(types.Connection{}, "tags"))
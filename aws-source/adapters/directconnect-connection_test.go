@@ -51,6 +51,8 @@ func TestDirectconnectConnectionOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
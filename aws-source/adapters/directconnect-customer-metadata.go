@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -66,3 +67,5 @@ var customerMetadataAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(customerMetadataAdapterMetadata, sdp.AttributeSchemaFor(types.CustomerAgreement{}, "tags"))
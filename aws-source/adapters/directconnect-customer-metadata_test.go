@@ -29,6 +29,8 @@ func TestCustomerMetadataOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
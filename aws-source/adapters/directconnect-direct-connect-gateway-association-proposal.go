@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -89,3 +90,5 @@ var directConnectGatewayAssociationProposalAdapterMetadata = Metadata.Register(&
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 	PotentialLinks: []string{"directconnect-direct-connect-gateway-association"},
 })
+
+var _ = Metadata.RegisterSchema(directConnectGatewayAssociationProposalAdapterMetadata, sdp.AttributeSchemaFor(types.DirectConnectGatewayAssociationProposal{}, "tags"))
@@ -53,6 +53,8 @@ func TestDirectConnectGatewayAssociationProposalOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -143,6 +144,8 @@ var directConnectGatewayAssociationAdapterMetadata = Metadata.Register(&sdp.Adap
 	PotentialLinks: []string{"directconnect-direct-connect-gateway"},
 })
 
+var _ = Metadata.RegisterSchema(directConnectGatewayAssociationAdapterMetadata, sdp.AttributeSchemaFor(types.DirectConnectGatewayAssociation{}, "tags"))
+
 // parseDirectConnectGatewayAssociationGetInputQuery expects a query:
 //   - in the format of "directConnectGatewayID/virtualGatewayID"
 //   - virtualGatewayID => associatedGatewayID
@@ -34,6 +34,8 @@ func TestDirectConnectGatewayAssociationOutputMapper_Health_OK(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -87,6 +89,8 @@ func TestDirectConnectGatewayAssociationOutputMapper_Health_Error(t *testing.T)
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -134,6 +135,8 @@ var directConnectGatewayAttachmentAdapterMetadata = Metadata.Register(&sdp.Adapt
 	PotentialLinks: []string{"directconnect-direct-connect-gateway", "directconnect-virtual-interface"},
 })
 
+var _ = Metadata.RegisterSchema(directConnectGatewayAttachmentAdapterMetadata, sdp.AttributeSchemaFor(types.DirectConnectGatewayAttachment{}, "tags").WithProperty("UniqueName", stringAttribute))
+
 // parseGatewayIDVirtualInterfaceID expects a query in the format of "gatewayID/virtualInterfaceID"
 // First returned item is gatewayID, second is virtualInterfaceID
 func parseGatewayIDVirtualInterfaceID(query string) (string, string, error) {
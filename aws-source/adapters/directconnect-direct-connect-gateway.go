@@ -114,3 +114,5 @@ var directConnectGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(directConnectGatewayAdapterMetadata, sdp.AttributeSchemaFor(types.DirectConnectGateway{}, "tags"))
@@ -34,6 +34,8 @@ func TestDirectConnectGatewayOutputMapper_Health_OK(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -68,6 +70,8 @@ func TestDirectConnectGatewayOutputMapper_Health_ERROR(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -155,3 +155,5 @@ var interconnectAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		SearchDescription: "Search Interconnects by ARN",
 	},
 })
+
+var _ = Metadata.RegisterSchema(interconnectAdapterMetadata, sdp.AttributeSchemaFor(types.Interconnect{}, "tags"))
@@ -144,3 +144,5 @@ var lagAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(lagAdapterMetadata, sdp.AttributeSchemaFor(types.Lag{}, "tags"))
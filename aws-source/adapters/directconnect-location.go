@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -69,3 +70,5 @@ var directconnectLocationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(directconnectLocationAdapterMetadata, sdp.AttributeSchemaFor(types.Location{}, "tags"))
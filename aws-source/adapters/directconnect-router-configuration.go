@@ -81,3 +81,5 @@ var routerConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_dx_router_configuration.virtual_interface_id"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(routerConfigurationAdapterMetadata, sdp.AttributeSchemaFor(&directconnect.DescribeRouterConfigurationOutput{}, "tags"))
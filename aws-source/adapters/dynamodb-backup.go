@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -125,6 +126,8 @@ var dynamodbBackupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
 
+var _ = Metadata.RegisterSchema(dynamodbBackupAdapterMetadata, sdp.AttributeSchemaFor(&types.BackupDetails{}))
+
 // Another AWS API that doesn't provide a paginator *and* does pagination
 // completely differently from everything else? You don't say.
 //
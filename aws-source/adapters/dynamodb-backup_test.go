@@ -99,6 +99,8 @@ func TestBackupGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "dynamodb-table",
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -217,3 +218,5 @@ var dynamodbTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformMethod: sdp.QueryMethod_SEARCH, TerraformQueryMap: "aws_dynamodb_table.arn"},
 	},
 })
+
+var _ = Metadata.RegisterSchema(dynamodbTableAdapterMetadata, sdp.AttributeSchemaFor(&types.TableDescription{}))
@@ -191,6 +191,8 @@ func TestTableGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "kinesis-stream",
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -169,3 +170,5 @@ var addressAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	PotentialLinks: []string{"ec2-instance", "ip", "ec2-network-interface"},
 })
+
+var _ = Metadata.RegisterSchema(addressAdapterMetadata, sdp.AttributeSchemaFor(types.Address{}, "tags"))
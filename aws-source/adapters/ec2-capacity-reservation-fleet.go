@@ -113,3 +113,5 @@ var capacityReservationFleetAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	},
 	PotentialLinks: []string{"ec2-capacity-reservation"},
 })
+
+var _ = Metadata.RegisterSchema(capacityReservationFleetAdapterMetadata, sdp.AttributeSchemaFor(types.CapacityReservationFleet{}, "tags"))
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCustomerGatewayInputMapperGet(t *testing.T) {
+	input, err := customerGatewayInputMapperGet("foo", "cgw-0e11f167EXAMPLE")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.CustomerGatewayIds) != 1 {
+		t.Fatalf("expected 1 customer gateway ID, got %v", len(input.CustomerGatewayIds))
+	}
+
+	if input.CustomerGatewayIds[0] != "cgw-0e11f167EXAMPLE" {
+		t.Errorf("expected customer gateway ID to be cgw-0e11f167EXAMPLE, got %v", input.CustomerGatewayIds[0])
+	}
+}
+
+func TestCustomerGatewayOutputMapper(t *testing.T) {
+	output := &ec2.DescribeCustomerGatewaysOutput{
+		CustomerGateways: []types.CustomerGateway{
+			{
+				CustomerGatewayId: adapterhelpers.PtrString("cgw-0e11f167EXAMPLE"),
+				BgpAsn:            adapterhelpers.PtrString("65534"),
+				IpAddress:         adapterhelpers.PtrString("203.0.113.12"),
+				CertificateArn:    adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+				State:             adapterhelpers.PtrString("available"),
+				Type:              adapterhelpers.PtrString("ipsec.1"),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("office"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := customerGatewayOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpn-connection",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "cgw-0e11f167EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.12",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2CustomerGatewayAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2CustomerGatewayAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
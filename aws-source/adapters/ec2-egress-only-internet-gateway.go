@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -106,3 +107,5 @@ var egressOnlyInternetGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMet
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(egressOnlyInternetGatewayAdapterMetadata, sdp.AttributeSchemaFor(types.EgressOnlyInternetGateway{}, "tags"))
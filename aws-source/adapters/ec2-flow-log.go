@@ -0,0 +1,233 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func flowLogInputMapperGet(scope string, query string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func flowLogInputMapperList(scope string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{}, nil
+}
+
+// Searches by ARN, or by the ID of the resource that the flow log is for
+func flowLogInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeFlowLogsInput, error) {
+	if strings.HasPrefix(query, "arn:") {
+		id, err := ec2ARNResourceID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return flowLogInputMapperGet(scope, id)
+	}
+
+	return &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("resource-id"),
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// Links a flow log to the resource that it captures traffic for
+func flowLogResourceLink(scope string, resourceID string) *sdp.LinkedItemQuery {
+	var queryType string
+	switch {
+	case strings.HasPrefix(resourceID, "vpc-"):
+		queryType = "ec2-vpc"
+	case strings.HasPrefix(resourceID, "subnet-"):
+		queryType = "ec2-subnet"
+	case strings.HasPrefix(resourceID, "eni-"):
+		queryType = "ec2-network-interface"
+	case strings.HasPrefix(resourceID, "tgw-attach-"):
+		queryType = "ec2-transit-gateway-attachment"
+	case strings.HasPrefix(resourceID, "tgw-"):
+		queryType = "ec2-transit-gateway"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_GET,
+			Query:  resourceID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The flow log can't exist without the resource
+			In: true,
+			// Flow logs only observe traffic
+			Out: false,
+		},
+	}
+}
+
+func flowLogOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeFlowLogsInput, output *ec2.DescribeFlowLogsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	for _, flowLog := range output.FlowLogs {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(flowLog, "tags")
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-flow-log",
+			UniqueAttribute: "FlowLogId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(flowLog.Tags),
+		}
+
+		if flowLog.DeliverLogsStatus != nil {
+			switch *flowLog.DeliverLogsStatus {
+			case "SUCCESS":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "FAILED":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		if flowLog.ResourceId != nil {
+			if link := flowLogResourceLink(scope, *flowLog.ResourceId); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if flowLog.LogDestination != nil {
+			if a, err := adapterhelpers.ParseARN(*flowLog.LogDestination); err == nil {
+				var query *sdp.Query
+				switch flowLog.LogDestinationType { //nolint:exhaustive
+				case types.LogDestinationTypeS3:
+					// The destination can include a prefix e.g.
+					// arn:aws:s3:::bucket/prefix
+					bucket, _, _ := strings.Cut(a.Resource, "/")
+					query = &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					}
+				case types.LogDestinationTypeCloudWatchLogs:
+					query = logsLogGroupQuery(*flowLog.LogDestination)
+				case types.LogDestinationTypeKinesisDataFirehose:
+					query = &sdp.Query{
+						Type:   "firehose-delivery-stream",
+						Method: sdp.QueryMethod_GET,
+						Query:  a.ResourceID(),
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					}
+				}
+
+				if query != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: query,
+						BlastPropagation: &sdp.BlastPropagation{
+							// Logs can't be delivered if the destination
+							// changes
+							In: true,
+							// The flow log sends logs to the destination
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		for _, roleARN := range []*string{flowLog.DeliverLogsPermissionArn, flowLog.DeliverCrossAccountRole} {
+			if roleARN == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*roleARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *roleARN,
+						Scope:  a.AccountID,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The role is used to deliver the logs
+						In: true,
+						// The flow log can't change the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2FlowLogAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-flow-log",
+		AdapterMetadata: flowLogAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error) {
+			return client.DescribeFlowLogs(ctx, input)
+		},
+		InputMapperGet:    flowLogInputMapperGet,
+		InputMapperList:   flowLogInputMapperList,
+		InputMapperSearch: flowLogInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeFlowLogsInput) adapterhelpers.Paginator[*ec2.DescribeFlowLogsOutput, *ec2.Options] {
+			return ec2.NewDescribeFlowLogsPaginator(client, params)
+		},
+		OutputMapper: flowLogOutputMapper,
+	}
+}
+
+var flowLogAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-flow-log",
+	DescriptiveName: "VPC Flow Log",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a flow log by ID",
+		ListDescription:   "List all flow logs",
+		SearchDescription: "Search for flow logs by ARN, or by the ID of the VPC, subnet, network interface or transit gateway that they capture traffic for",
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-network-interface", "ec2-transit-gateway", "ec2-transit-gateway-attachment", "s3-bucket", "firehose-delivery-stream", "logs-log-group", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_flow_log.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var _ = Metadata.RegisterSchema(flowLogAdapterMetadata, sdp.AttributeSchemaFor(types.FlowLog{}, "tags"))
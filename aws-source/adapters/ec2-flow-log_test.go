@@ -0,0 +1,174 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestFlowLogInputMapperSearch(t *testing.T) {
+	t.Run("resource", func(t *testing.T) {
+		input, err := flowLogInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", "vpc-0d7892e00e573e701")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filter) != 1 || *input.Filter[0].Name != "resource-id" || input.Filter[0].Values[0] != "vpc-0d7892e00e573e701" {
+			t.Errorf("unexpected filter %v", input.Filter)
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		input, err := flowLogInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", "arn:aws:ec2:eu-west-2:123456789012:vpc-flow-log/fl-0a1b2c3d4EXAMPLE")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.FlowLogIds) != 1 || input.FlowLogIds[0] != "fl-0a1b2c3d4EXAMPLE" {
+			t.Errorf("unexpected flow log IDs %v", input.FlowLogIds)
+		}
+	})
+}
+
+func TestFlowLogOutputMapper(t *testing.T) {
+	output := &ec2.DescribeFlowLogsOutput{
+		FlowLogs: []types.FlowLog{
+			{
+				FlowLogId:          adapterhelpers.PtrString("fl-0a1b2c3d4EXAMPLE"),
+				FlowLogStatus:      adapterhelpers.PtrString("ACTIVE"),
+				DeliverLogsStatus:  adapterhelpers.PtrString("SUCCESS"),
+				ResourceId:         adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				TrafficType:        types.TrafficTypeAll,
+				LogDestinationType: types.LogDestinationTypeS3,
+				LogDestination:     adapterhelpers.PtrString("arn:aws:s3:::flow-logs-bucket/vpc"),
+				LogFormat:          adapterhelpers.PtrString("${version} ${account-id} ${interface-id}"),
+				CreationTime:       adapterhelpers.PtrTime(time.Now()),
+			},
+			{
+				FlowLogId:                adapterhelpers.PtrString("fl-1a1b2c3d4EXAMPLE"),
+				FlowLogStatus:            adapterhelpers.PtrString("ACTIVE"),
+				DeliverLogsStatus:        adapterhelpers.PtrString("FAILED"),
+				DeliverLogsErrorMessage:  adapterhelpers.PtrString("Access error"),
+				ResourceId:               adapterhelpers.PtrString("tgw-attach-0b5968d3b6EXAMPLE"),
+				LogDestinationType:       types.LogDestinationTypeKinesisDataFirehose,
+				LogDestination:           adapterhelpers.PtrString("arn:aws:firehose:eu-west-2:123456789012:deliverystream/flow-logs"),
+				DeliverLogsPermissionArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/flow-logs"),
+			},
+			{
+				FlowLogId:                adapterhelpers.PtrString("fl-2a1b2c3d4EXAMPLE"),
+				FlowLogStatus:            adapterhelpers.PtrString("ACTIVE"),
+				DeliverLogsStatus:        adapterhelpers.PtrString("SUCCESS"),
+				ResourceId:               adapterhelpers.PtrString("subnet-0123456789abcdef0"),
+				LogDestinationType:       types.LogDestinationTypeCloudWatchLogs,
+				LogDestination:           adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:/vpc/flow-logs"),
+				LogGroupName:             adapterhelpers.PtrString("/vpc/flow-logs"),
+				DeliverLogsPermissionArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/flow-logs"),
+			},
+		},
+	}
+
+	items, err := flowLogOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "flow-logs-bucket",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item = items[1]
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health ERROR, got %v", item.GetHealth())
+	}
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-attach-0b5968d3b6EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "flow-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/flow-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/vpc/flow-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, items[2])
+}
+
+func TestNewEC2FlowLogAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2FlowLogAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
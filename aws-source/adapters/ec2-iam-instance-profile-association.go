@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -105,3 +106,5 @@ var iamInstanceProfileAssociationAdapterMetadata = Metadata.Register(&sdp.Adapte
 	PotentialLinks: []string{"iam-instance-profile", "ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(iamInstanceProfileAssociationAdapterMetadata, sdp.AttributeSchemaFor(types.IamInstanceProfileAssociation{}))
@@ -110,3 +110,5 @@ var instanceStatusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var _ = Metadata.RegisterSchema(instanceStatusAdapterMetadata, sdp.AttributeSchemaFor(types.InstanceStatus{}))
@@ -2,8 +2,10 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
@@ -290,6 +292,27 @@ func instanceOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2
 						},
 					})
 				}
+
+				// Instances that are nodes of an EKS cluster are linked to
+				// their node in the cluster by provider ID
+				if clusterName := eksClusterName(item.GetTags()); clusterName != "" && instance.Placement.AvailabilityZone != nil && instance.InstanceId != nil {
+					if clusterARN, err := eksClusterARN(scope, clusterName); err == nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+							Query: &sdp.Query{
+								Type:   "Node",
+								Method: sdp.QueryMethod_SEARCH,
+								Query:  fmt.Sprintf("aws:///%v/%v", *instance.Placement.AvailabilityZone, *instance.InstanceId),
+								Scope:  EKSKubernetesScope(clusterARN),
+							},
+							BlastPropagation: &sdp.BlastPropagation{
+								// Changing the node can't affect the instance
+								In: false,
+								// Changing the instance will affect the node
+								Out: true,
+							},
+						})
+					}
+				}
 			}
 
 			if instance.Ipv6Address != nil {
@@ -484,7 +507,7 @@ func NewEC2InstanceAdapter(client *ec2.Client, accountID string, region string)
 var ec2InstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Type:            "ec2-instance",
 	DescriptiveName: "EC2 Instance",
-	PotentialLinks:  []string{"ec2-instance-status", "iam-instance-profile", "ec2-capacity-reservation", "ec2-elastic-gpu", "elastic-inference-accelerator", "license-manager-license-configuration", "outposts-outpost", "ec2-spot-instance-request", "ec2-image", "ec2-key-pair", "ec2-placement-group", "ip", "ec2-subnet", "ec2-vpc", "dns", "ec2-security-group", "ec2-volume"},
+	PotentialLinks:  []string{"ec2-instance-status", "iam-instance-profile", "ec2-capacity-reservation", "ec2-elastic-gpu", "elastic-inference-accelerator", "license-manager-license-configuration", "outposts-outpost", "ec2-spot-instance-request", "ec2-image", "ec2-key-pair", "ec2-placement-group", "ip", "ec2-subnet", "ec2-vpc", "dns", "ec2-security-group", "ec2-volume", "Node"},
 	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
 		Get:               true,
 		List:              true,
@@ -505,3 +528,5 @@ var ec2InstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		},
 	},
 })
+
+var _ = Metadata.RegisterSchema(ec2InstanceAdapterMetadata, sdp.AttributeSchemaFor(types.Instance{}, "tags"))
@@ -231,6 +231,8 @@ func TestInstanceOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -350,6 +352,49 @@ func TestInstanceOutputMapper(t *testing.T) {
 
 }
 
+func TestInstanceOutputMapperEKSNode(t *testing.T) {
+	output := &ec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId: adapterhelpers.PtrString("i-0e853f4ac6b4d0f35"),
+						Placement: &types.Placement{
+							AvailabilityZone: adapterhelpers.PtrString("eu-west-2c"),
+						},
+						Tags: []types.Tag{
+							{
+								Key:   adapterhelpers.PtrString("eks:cluster-name"),
+								Value: adapterhelpers.PtrString("dylan"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := instanceOutputMapper(context.Background(), nil, "052392120703.eu-west-2", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "Node",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "aws:///eu-west-2c/i-0e853f4ac6b4d0f35",
+			ExpectedScope:  "arn:aws:eks:eu-west-2:052392120703:cluster/dylan",
+		},
+	}
+
+	tests.Execute(t, items[0])
+}
+
 func TestNewEC2InstanceAdapter(t *testing.T) {
 	client, account, region := ec2GetAutoConfig(t)
 
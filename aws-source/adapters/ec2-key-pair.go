@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -83,3 +84,5 @@ var keyPairAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(keyPairAdapterMetadata, sdp.AttributeSchemaFor(types.KeyPairInfo{}, "tags"))
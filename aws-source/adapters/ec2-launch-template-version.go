@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -332,3 +333,5 @@ var launchTemplateVersionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	PotentialLinks: []string{"ec2-network-interface", "ec2-subnet", "ec2-security-group", "ec2-image", "ec2-key-pair", "ec2-snapshot", "ec2-capacity-reservation", "ec2-placement-group", "ec2-host", "ip"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(launchTemplateVersionAdapterMetadata, sdp.AttributeSchemaFor(types.LaunchTemplateVersion{}).WithProperty("VersionIdCombo", stringAttribute))
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -99,3 +100,5 @@ var networkInterfacePermissionAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"ec2-network-interface"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(networkInterfacePermissionAdapterMetadata, sdp.AttributeSchemaFor(types.NetworkInterfacePermission{}))
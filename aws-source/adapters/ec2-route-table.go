@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -225,6 +226,22 @@ func routeTableOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *e
 					},
 				})
 			}
+			if route.DestinationPrefixListId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-managed-prefix-list",
+						Method: sdp.QueryMethod_GET,
+						Query:  *route.DestinationPrefixListId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the prefix list changes the destinations
+						// of the route
+						In:  true,
+						Out: false,
+					},
+				})
+			}
 		}
 
 		if rt.VpcId != nil {
@@ -278,7 +295,7 @@ var routeTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all route tables",
 		SearchDescription: "Search route tables by ARN",
 	},
-	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection"},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_route_table.id"},
 		{TerraformQueryMap: "aws_route_table_association.route_table_id"},
@@ -287,3 +304,5 @@ var routeTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(routeTableAdapterMetadata, sdp.AttributeSchemaFor(types.RouteTable{}, "tags"))
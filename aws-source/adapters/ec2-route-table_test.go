@@ -101,6 +101,8 @@ func TestRouteTableOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
@@ -190,6 +192,12 @@ func TestRouteTableOutputMapper(t *testing.T) {
 			ExpectedQuery:  "igw-12345",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "ec2-managed-prefix-list",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pl-7ca54015",
+			ExpectedScope:  "foo",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -61,6 +62,23 @@ func securityGroupRuleOutputMapper(_ context.Context, _ *ec2.Client, scope strin
 			})
 		}
 
+		if securityGroupRule.PrefixListId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-managed-prefix-list",
+					Method: sdp.QueryMethod_GET,
+					Query:  *securityGroupRule.PrefixListId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the prefix list changes what the rule allows
+					In: true,
+					// The rule can't change the prefix list
+					Out: false,
+				},
+			})
+		}
+
 		if rg := securityGroupRule.ReferencedGroupInfo; rg != nil {
 			if rg.GroupId != nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -115,7 +133,7 @@ var securityGroupRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all security group rules",
 		SearchDescription: "Search security group rules by ARN",
 	},
-	PotentialLinks: []string{"ec2-security-group"},
+	PotentialLinks: []string{"ec2-security-group", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_security_group_rule.security_group_rule_id"},
 		{TerraformQueryMap: "aws_vpc_security_group_ingress_rule.security_group_rule_id"},
@@ -123,3 +141,5 @@ var securityGroupRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(securityGroupRuleAdapterMetadata, sdp.AttributeSchemaFor(types.SecurityGroupRule{}, "tags"))
@@ -116,6 +116,8 @@ var securityGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
 
+var _ = Metadata.RegisterSchema(securityGroupAdapterMetadata, sdp.AttributeSchemaFor(types.SecurityGroup{}, "tags"))
+
 // extractLinkedSecurityGroups Extracts related security groups from IP
 // permissions
 func extractLinkedSecurityGroups(permissions []types.IpPermission, scope string) []*sdp.LinkedItemQuery {
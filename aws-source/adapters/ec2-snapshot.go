@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -112,3 +113,5 @@ var snapshotAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-volume"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var _ = Metadata.RegisterSchema(snapshotAdapterMetadata, sdp.AttributeSchemaFor(types.Snapshot{}, "tags"))
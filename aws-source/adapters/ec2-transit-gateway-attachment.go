@@ -0,0 +1,308 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func transitGatewayAttachmentInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	return &ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayAttachmentInputMapperList(scope string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	return &ec2.DescribeTransitGatewayAttachmentsInput{}, nil
+}
+
+// Searches by ARN, by the ID of a transit gateway or transit gateway route
+// table, or by the ID of the attached resource e.g. a VPC
+func transitGatewayAttachmentInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	var filter string
+	switch {
+	case strings.HasPrefix(query, "arn:"):
+		id, err := ec2ARNResourceID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return transitGatewayAttachmentInputMapperGet(scope, id)
+	case strings.HasPrefix(query, "tgw-rtb-"):
+		filter = "association.transit-gateway-route-table-id"
+	case strings.HasPrefix(query, "tgw-attach-"):
+		return transitGatewayAttachmentInputMapperGet(scope, query)
+	case strings.HasPrefix(query, "tgw-"):
+		filter = "transit-gateway-id"
+	default:
+		filter = "resource-id"
+	}
+
+	return &ec2.DescribeTransitGatewayAttachmentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   &filter,
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// Returns the scope of a resource that may be owned by another account, since
+// transit gateways and their attachments can be shared between accounts
+func transitGatewayOwnerScope(scope string, ownerID *string) string {
+	if ownerID == nil {
+		return scope
+	}
+
+	_, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return scope
+	}
+
+	return adapterhelpers.FormatScope(*ownerID, region)
+}
+
+// The subset of the EC2 API needed to look up the subnets of VPC attachments,
+// which aren't included in DescribeTransitGatewayAttachments
+type transitGatewayVpcAttachmentsDescriber interface {
+	DescribeTransitGatewayVpcAttachments(ctx context.Context, params *ec2.DescribeTransitGatewayVpcAttachmentsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error)
+}
+
+// Returns the subnet IDs of the given VPC attachments, keyed by attachment ID
+func transitGatewayAttachmentSubnets(ctx context.Context, client transitGatewayVpcAttachmentsDescriber, attachmentIDs []string) (map[string][]string, error) {
+	subnets := make(map[string][]string)
+	if len(attachmentIDs) == 0 {
+		return subnets, nil
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(client, &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: attachmentIDs,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attachment := range out.TransitGatewayVpcAttachments {
+			if attachment.TransitGatewayAttachmentId != nil {
+				subnets[*attachment.TransitGatewayAttachmentId] = attachment.SubnetIds
+			}
+		}
+	}
+
+	return subnets, nil
+}
+
+func transitGatewayAttachmentOutputMapper(ctx context.Context, client *ec2.Client, scope string, _ *ec2.DescribeTransitGatewayAttachmentsInput, output *ec2.DescribeTransitGatewayAttachmentsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	var subnets map[string][]string
+	if client != nil {
+		vpcAttachmentIDs := make([]string, 0)
+		for _, attachment := range output.TransitGatewayAttachments {
+			if attachment.ResourceType == types.TransitGatewayAttachmentResourceTypeVpc && attachment.TransitGatewayAttachmentId != nil {
+				vpcAttachmentIDs = append(vpcAttachmentIDs, *attachment.TransitGatewayAttachmentId)
+			}
+		}
+
+		var err error
+		subnets, err = transitGatewayAttachmentSubnets(ctx, client, vpcAttachmentIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attachment := range output.TransitGatewayAttachments {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(attachment, "tags")
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-attachment",
+			UniqueAttribute: "TransitGatewayAttachmentId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(attachment.Tags),
+		}
+
+		switch attachment.State {
+		case types.TransitGatewayAttachmentStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayAttachmentStateInitiating,
+			types.TransitGatewayAttachmentStateInitiatingRequest,
+			types.TransitGatewayAttachmentStatePendingAcceptance,
+			types.TransitGatewayAttachmentStatePending,
+			types.TransitGatewayAttachmentStateModifying,
+			types.TransitGatewayAttachmentStateDeleting,
+			types.TransitGatewayAttachmentStateRollingBack:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayAttachmentStateFailed,
+			types.TransitGatewayAttachmentStateFailing,
+			types.TransitGatewayAttachmentStateRejected,
+			types.TransitGatewayAttachmentStateRejecting:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case types.TransitGatewayAttachmentStateDeleted:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if attachment.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.TransitGatewayId,
+					Scope:  transitGatewayOwnerScope(scope, attachment.TransitGatewayOwnerId),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The attachment can't exist without the transit gateway
+					In: true,
+					// Changing the attachment changes what the transit
+					// gateway can route to
+					Out: true,
+				},
+			})
+		}
+
+		if attachment.Association != nil && attachment.Association.TransitGatewayRouteTableId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-route-table",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.Association.TransitGatewayRouteTableId,
+					Scope:  transitGatewayOwnerScope(scope, attachment.TransitGatewayOwnerId),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The associated route table decides where traffic from
+					// the attachment goes
+					In: true,
+					// Changing the attachment changes what the routes lead to
+					Out: true,
+				},
+			})
+		}
+
+		if attachment.ResourceId != nil {
+			resourceScope := transitGatewayOwnerScope(scope, attachment.ResourceOwnerId)
+
+			var query *sdp.Query
+			switch attachment.ResourceType { //nolint:exhaustive
+			case types.TransitGatewayAttachmentResourceTypeVpc:
+				query = &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.ResourceId,
+					Scope:  resourceScope,
+				}
+			case types.TransitGatewayAttachmentResourceTypeVpn:
+				query = &sdp.Query{
+					Type:   "ec2-vpn-connection",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.ResourceId,
+					Scope:  resourceScope,
+				}
+			case types.TransitGatewayAttachmentResourceTypeDirectConnectGateway:
+				query = &sdp.Query{
+					Type:   "directconnect-direct-connect-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.ResourceId,
+					Scope:  resourceScope,
+				}
+			}
+
+			if query != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: query,
+					BlastPropagation: &sdp.BlastPropagation{
+						// The attachment can't exist without the resource
+						In: true,
+						// The attachment is how the resource reaches the rest
+						// of the network
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if attachment.TransitGatewayAttachmentId != nil {
+			for _, subnetID := range subnets[*attachment.TransitGatewayAttachmentId] {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-subnet",
+						Method: sdp.QueryMethod_GET,
+						Query:  subnetID,
+						Scope:  transitGatewayOwnerScope(scope, attachment.ResourceOwnerId),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The attachment has a network interface in the
+						// subnet
+						In: true,
+						// The attachment doesn't change the subnet itself
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayAttachmentAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayAttachmentsInput, *ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayAttachmentsInput, *ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-attachment",
+		AdapterMetadata: transitGatewayAttachmentAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+			return client.DescribeTransitGatewayAttachments(ctx, input)
+		},
+		InputMapperGet:    transitGatewayAttachmentInputMapperGet,
+		InputMapperList:   transitGatewayAttachmentInputMapperList,
+		InputMapperSearch: transitGatewayAttachmentInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewayAttachmentsInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewayAttachmentsPaginator(client, params)
+		},
+		OutputMapper: transitGatewayAttachmentOutputMapper,
+	}
+}
+
+var transitGatewayAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-attachment",
+	DescriptiveName: "Transit Gateway Attachment",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway attachment by ID",
+		ListDescription:   "List all transit gateway attachments",
+		SearchDescription: "Search for transit gateway attachments by ARN, transit gateway ID, transit gateway route table ID, or the ID of the attached resource",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway", "ec2-transit-gateway-route-table", "ec2-vpc", "ec2-subnet", "ec2-vpn-connection", "directconnect-direct-connect-gateway"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_vpc_attachment.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_peering_attachment.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table_association.transit_gateway_attachment_id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table_propagation.transit_gateway_attachment_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(transitGatewayAttachmentAdapterMetadata, sdp.AttributeSchemaFor(types.TransitGatewayAttachment{}, "tags"))
@@ -0,0 +1,201 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testTransitGatewayVpcAttachmentsClient struct{}
+
+func (t testTransitGatewayVpcAttachmentsClient) DescribeTransitGatewayVpcAttachments(ctx context.Context, params *ec2.DescribeTransitGatewayVpcAttachmentsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return &ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+			{
+				TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0b5968d3b6EXAMPLE"),
+				SubnetIds:                  []string{"subnet-0187aff814EXAMPLE", "subnet-0ee7bdc6e3EXAMPLE"},
+			},
+		},
+	}, nil
+}
+
+func TestTransitGatewayAttachmentInputMapperSearch(t *testing.T) {
+	tests := []struct {
+		query  string
+		ids    []string
+		filter string
+	}{
+		{
+			query: "arn:aws:ec2:eu-west-2:123456789012:transit-gateway-attachment/tgw-attach-0b5968d3b6EXAMPLE",
+			ids:   []string{"tgw-attach-0b5968d3b6EXAMPLE"},
+		},
+		{
+			query: "tgw-attach-0b5968d3b6EXAMPLE",
+			ids:   []string{"tgw-attach-0b5968d3b6EXAMPLE"},
+		},
+		{
+			query:  "tgw-rtb-018774ad1fEXAMPLE",
+			filter: "association.transit-gateway-route-table-id",
+		},
+		{
+			query:  "tgw-0262a0e521EXAMPLE",
+			filter: "transit-gateway-id",
+		},
+		{
+			query:  "vpc-0065acced4EXAMPLE",
+			filter: "resource-id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			input, err := transitGatewayAttachmentInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.filter == "" {
+				if len(input.TransitGatewayAttachmentIds) != 1 || input.TransitGatewayAttachmentIds[0] != tt.ids[0] {
+					t.Errorf("expected IDs %v, got %v", tt.ids, input.TransitGatewayAttachmentIds)
+				}
+				return
+			}
+
+			if len(input.Filters) != 1 || *input.Filters[0].Name != tt.filter || input.Filters[0].Values[0] != tt.query {
+				t.Errorf("expected filter %v=%v, got %v", tt.filter, tt.query, input.Filters)
+			}
+		})
+	}
+
+	t.Run("ARN in another scope", func(t *testing.T) {
+		_, err := transitGatewayAttachmentInputMapperSearch(context.Background(), nil, "123456789012.eu-west-1", tests[0].query)
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestTransitGatewayAttachmentSubnets(t *testing.T) {
+	subnets, err := transitGatewayAttachmentSubnets(context.Background(), testTransitGatewayVpcAttachmentsClient{}, []string{"tgw-attach-0b5968d3b6EXAMPLE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subnets["tgw-attach-0b5968d3b6EXAMPLE"]) != 2 {
+		t.Errorf("expected 2 subnets, got %v", subnets)
+	}
+}
+
+func TestTransitGatewayAttachmentOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewayAttachmentsOutput{
+		TransitGatewayAttachments: []types.TransitGatewayAttachment{
+			{
+				TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0b5968d3b6EXAMPLE"),
+				TransitGatewayId:           adapterhelpers.PtrString("tgw-0262a0e521EXAMPLE"),
+				TransitGatewayOwnerId:      adapterhelpers.PtrString("123456789012"),
+				ResourceOwnerId:            adapterhelpers.PtrString("210987654321"),
+				ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+				ResourceId:                 adapterhelpers.PtrString("vpc-0065acced4EXAMPLE"),
+				State:                      types.TransitGatewayAttachmentStateAvailable,
+				Association: &types.TransitGatewayAttachmentAssociation{
+					TransitGatewayRouteTableId: adapterhelpers.PtrString("tgw-rtb-018774ad1fEXAMPLE"),
+					State:                      types.TransitGatewayAssociationStateAssociated,
+				},
+				CreationTime: adapterhelpers.PtrTime(time.Now()),
+			},
+			{
+				TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0e1f2a3b4cEXAMPLE"),
+				TransitGatewayId:           adapterhelpers.PtrString("tgw-0262a0e521EXAMPLE"),
+				TransitGatewayOwnerId:      adapterhelpers.PtrString("123456789012"),
+				ResourceOwnerId:            adapterhelpers.PtrString("123456789012"),
+				ResourceType:               types.TransitGatewayAttachmentResourceTypeDirectConnectGateway,
+				ResourceId:                 adapterhelpers.PtrString("5f6a7b8c-1234-5678-9abc-EXAMPLE11111"),
+				State:                      types.TransitGatewayAttachmentStatePendingAcceptance,
+			},
+		},
+	}
+
+	items, err := transitGatewayAttachmentOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-0262a0e521EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-rtb-018774ad1fEXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			// The VPC is shared from another account
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0065acced4EXAMPLE",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item = items[1]
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health PENDING, got %v", item.GetHealth())
+	}
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "directconnect-direct-connect-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "5f6a7b8c-1234-5678-9abc-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayAttachmentAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayAttachmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func transitGatewayRouteTableInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewayRouteTablesInput, error) {
+	return &ec2.DescribeTransitGatewayRouteTablesInput{
+		TransitGatewayRouteTableIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayRouteTableInputMapperList(scope string) (*ec2.DescribeTransitGatewayRouteTablesInput, error) {
+	return &ec2.DescribeTransitGatewayRouteTablesInput{}, nil
+}
+
+// Searches by ARN, or by the ID of the transit gateway
+func transitGatewayRouteTableInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeTransitGatewayRouteTablesInput, error) {
+	if strings.HasPrefix(query, "arn:") {
+		id, err := ec2ARNResourceID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return transitGatewayRouteTableInputMapperGet(scope, id)
+	}
+
+	return &ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("transit-gateway-id"),
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+func transitGatewayRouteTableOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeTransitGatewayRouteTablesInput, output *ec2.DescribeTransitGatewayRouteTablesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, routeTable := range output.TransitGatewayRouteTables {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(routeTable, "tags")
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-route-table",
+			UniqueAttribute: "TransitGatewayRouteTableId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(routeTable.Tags),
+		}
+
+		switch routeTable.State {
+		case types.TransitGatewayRouteTableStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayRouteTableStatePending, types.TransitGatewayRouteTableStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayRouteTableStateDeleted:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if routeTable.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *routeTable.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The route table can't exist without the transit gateway
+					In: true,
+					// The route table controls how the transit gateway routes
+					// traffic
+					Out: true,
+				},
+			})
+		}
+
+		if routeTable.TransitGatewayRouteTableId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-route",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *routeTable.TransitGatewayRouteTableId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Routes are part of the route table
+					In:  true,
+					Out: true,
+				},
+			})
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *routeTable.TransitGatewayRouteTableId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing an attachment changes where the routes lead
+					In: true,
+					// Changing the route table changes where traffic from
+					// the associated attachments goes
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayRouteTableAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayRouteTablesInput, *ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayRouteTablesInput, *ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-route-table",
+		AdapterMetadata: transitGatewayRouteTableAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.DescribeTransitGatewayRouteTablesOutput, error) {
+			return client.DescribeTransitGatewayRouteTables(ctx, input)
+		},
+		InputMapperGet:    transitGatewayRouteTableInputMapperGet,
+		InputMapperList:   transitGatewayRouteTableInputMapperList,
+		InputMapperSearch: transitGatewayRouteTableInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewayRouteTablesInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewayRouteTablesPaginator(client, params)
+		},
+		OutputMapper: transitGatewayRouteTableOutputMapper,
+	}
+}
+
+var transitGatewayRouteTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-route-table",
+	DescriptiveName: "Transit Gateway Route Table",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway route table by ID",
+		ListDescription:   "List all transit gateway route tables",
+		SearchDescription: "Search for transit gateway route tables by ARN, or by transit gateway ID",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway", "ec2-transit-gateway-route", "ec2-transit-gateway-attachment"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table_association.transit_gateway_route_table_id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table_propagation.transit_gateway_route_table_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(transitGatewayRouteTableAdapterMetadata, sdp.AttributeSchemaFor(types.TransitGatewayRouteTable{}, "tags"))
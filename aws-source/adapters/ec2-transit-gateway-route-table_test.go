@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestTransitGatewayRouteTableInputMapperSearch(t *testing.T) {
+	input, err := transitGatewayRouteTableInputMapperSearch(context.Background(), nil, "foo", "tgw-0262a0e521EXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(input.Filters) != 1 || *input.Filters[0].Name != "transit-gateway-id" {
+		t.Errorf("expected a transit-gateway-id filter, got %v", input.Filters)
+	}
+
+	input, err = transitGatewayRouteTableInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", "arn:aws:ec2:eu-west-2:123456789012:transit-gateway-route-table/tgw-rtb-018774ad1fEXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(input.TransitGatewayRouteTableIds) != 1 || input.TransitGatewayRouteTableIds[0] != "tgw-rtb-018774ad1fEXAMPLE" {
+		t.Errorf("expected the route table ID, got %v", input.TransitGatewayRouteTableIds)
+	}
+}
+
+func TestTransitGatewayRouteTableOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewayRouteTablesOutput{
+		TransitGatewayRouteTables: []types.TransitGatewayRouteTable{
+			{
+				TransitGatewayRouteTableId:   adapterhelpers.PtrString("tgw-rtb-018774ad1fEXAMPLE"),
+				TransitGatewayId:             adapterhelpers.PtrString("tgw-0262a0e521EXAMPLE"),
+				State:                        types.TransitGatewayRouteTableStateAvailable,
+				DefaultAssociationRouteTable: adapterhelpers.PtrBool(true),
+				DefaultPropagationRouteTable: adapterhelpers.PtrBool(true),
+				CreationTime:                 adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+	}
+
+	items, err := transitGatewayRouteTableOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-0262a0e521EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-rtb-018774ad1fEXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-rtb-018774ad1fEXAMPLE",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayRouteTableAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayRouteTableAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
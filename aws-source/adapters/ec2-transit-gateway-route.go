@@ -0,0 +1,242 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// transitGatewayRoute is a route along with the route table that it is in,
+// since routes don't have IDs of their own
+type transitGatewayRoute struct {
+	// The ID of the route, in the same format as Terraform:
+	// {route table ID}_{destination CIDR or prefix list ID}
+	Id                         string
+	TransitGatewayRouteTableId string
+
+	types.TransitGatewayRoute
+}
+
+// Formats the ID of a route
+func transitGatewayRouteID(routeTableID string, route types.TransitGatewayRoute) string {
+	destination := ""
+	switch {
+	case route.DestinationCidrBlock != nil:
+		destination = *route.DestinationCidrBlock
+	case route.PrefixListId != nil:
+		destination = *route.PrefixListId
+	}
+
+	return routeTableID + "_" + destination
+}
+
+func transitGatewayRouteInputMapperGet(scope string, query string) (*ec2.SearchTransitGatewayRoutesInput, error) {
+	routeTableID, destination, found := strings.Cut(query, "_")
+	if !found || routeTableID == "" || destination == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {route table ID}_{destination}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	filter := "route-search.exact-match"
+	if strings.HasPrefix(destination, "pl-") {
+		filter = "prefix-list-id"
+	}
+
+	return &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: &routeTableID,
+		Filters: []types.Filter{
+			{
+				Name:   &filter,
+				Values: []string{destination},
+			},
+		},
+	}, nil
+}
+
+// Searches for all of the routes in a route table, by the ID of the route
+// table
+func transitGatewayRouteInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.SearchTransitGatewayRoutesInput, error) {
+	return &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: &query,
+		// At least one filter is required, so filter on every state that a
+		// route can be in while it's in use
+		Filters: []types.Filter{
+			{
+				Name: adapterhelpers.PtrString("state"),
+				Values: []string{
+					string(types.TransitGatewayRouteStateActive),
+					string(types.TransitGatewayRouteStateBlackhole),
+					string(types.TransitGatewayRouteStatePending),
+				},
+			},
+		},
+	}, nil
+}
+
+func transitGatewayRouteOutputMapper(_ context.Context, _ *ec2.Client, scope string, input *ec2.SearchTransitGatewayRoutesInput, output *ec2.SearchTransitGatewayRoutesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	if input.TransitGatewayRouteTableId == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "route table ID is required",
+			Scope:       scope,
+		}
+	}
+	routeTableID := *input.TransitGatewayRouteTableId
+
+	for _, awsRoute := range output.Routes {
+		route := transitGatewayRoute{
+			Id:                         transitGatewayRouteID(routeTableID, awsRoute),
+			TransitGatewayRouteTableId: routeTableID,
+			TransitGatewayRoute:        awsRoute,
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(route)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-route",
+			UniqueAttribute: "Id",
+			Scope:           scope,
+			Attributes:      attrs,
+		}
+
+		switch awsRoute.State {
+		case types.TransitGatewayRouteStateActive:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayRouteStatePending, types.TransitGatewayRouteStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayRouteStateBlackhole:
+			// Traffic to the destination is dropped, usually because the
+			// attachment was deleted
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-transit-gateway-route-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  routeTableID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Routes are part of the route table
+				In:  true,
+				Out: true,
+			},
+		})
+
+		if awsRoute.PrefixListId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-managed-prefix-list",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsRoute.PrefixListId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the prefix list changes the destinations
+					In: true,
+					// The route can't change the prefix list
+					Out: false,
+				},
+			})
+		}
+
+		for _, attachment := range awsRoute.TransitGatewayAttachments {
+			if attachment.TransitGatewayAttachmentId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-transit-gateway-attachment",
+						Method: sdp.QueryMethod_GET,
+						Query:  *attachment.TransitGatewayAttachmentId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the attachment goes away the route becomes a
+						// blackhole
+						In: true,
+						// Changing the route changes what traffic reaches
+						// the attachment
+						Out: true,
+					},
+				})
+			}
+
+			if attachment.ResourceId != nil && attachment.ResourceType == types.TransitGatewayAttachmentResourceTypeVpc {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-vpc",
+						Method: sdp.QueryMethod_GET,
+						Query:  *attachment.ResourceId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The VPC is where the traffic ends up
+						In: true,
+						// Changing the route changes what traffic reaches
+						// the VPC
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayRouteAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.SearchTransitGatewayRoutesInput, *ec2.SearchTransitGatewayRoutesOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.SearchTransitGatewayRoutesInput, *ec2.SearchTransitGatewayRoutesOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-route",
+		AdapterMetadata: transitGatewayRouteAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.SearchTransitGatewayRoutesInput) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+			return client.SearchTransitGatewayRoutes(ctx, input)
+		},
+		// Routes can only be found via their route table, so list isn't
+		// supported
+		InputMapperGet:    transitGatewayRouteInputMapperGet,
+		InputMapperSearch: transitGatewayRouteInputMapperSearch,
+		OutputMapper:      transitGatewayRouteOutputMapper,
+	}
+}
+
+var transitGatewayRouteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-route",
+	DescriptiveName: "Transit Gateway Route",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway route by {route table ID}_{destination CIDR or prefix list ID}",
+		SearchDescription: "Search for the routes in a transit gateway route table by the ID of the route table",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway-route-table", "ec2-transit-gateway-attachment", "ec2-vpc", "ec2-managed-prefix-list"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_prefix_list_reference.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(transitGatewayRouteAdapterMetadata, sdp.AttributeSchemaFor(transitGatewayRoute{}))
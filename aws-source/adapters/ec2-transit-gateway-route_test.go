@@ -0,0 +1,160 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestTransitGatewayRouteInputMapperGet(t *testing.T) {
+	t.Run("CIDR", func(t *testing.T) {
+		input, err := transitGatewayRouteInputMapperGet("foo", "tgw-rtb-018774ad1fEXAMPLE_10.0.0.0/16")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *input.TransitGatewayRouteTableId != "tgw-rtb-018774ad1fEXAMPLE" {
+			t.Errorf("expected route table tgw-rtb-018774ad1fEXAMPLE, got %v", *input.TransitGatewayRouteTableId)
+		}
+
+		if *input.Filters[0].Name != "route-search.exact-match" || input.Filters[0].Values[0] != "10.0.0.0/16" {
+			t.Errorf("unexpected filter %v", input.Filters)
+		}
+	})
+
+	t.Run("prefix list", func(t *testing.T) {
+		input, err := transitGatewayRouteInputMapperGet("foo", "tgw-rtb-018774ad1fEXAMPLE_pl-7ca54015")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *input.Filters[0].Name != "prefix-list-id" || input.Filters[0].Values[0] != "pl-7ca54015" {
+			t.Errorf("unexpected filter %v", input.Filters)
+		}
+	})
+
+	t.Run("bad query", func(t *testing.T) {
+		if _, err := transitGatewayRouteInputMapperGet("foo", "tgw-rtb-018774ad1fEXAMPLE"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestTransitGatewayRouteOutputMapper(t *testing.T) {
+	input, err := transitGatewayRouteInputMapperSearch(context.Background(), nil, "foo", "tgw-rtb-018774ad1fEXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := &ec2.SearchTransitGatewayRoutesOutput{
+		Routes: []types.TransitGatewayRoute{
+			{
+				DestinationCidrBlock: adapterhelpers.PtrString("10.0.0.0/16"),
+				State:                types.TransitGatewayRouteStateActive,
+				Type:                 types.TransitGatewayRouteTypePropagated,
+				TransitGatewayAttachments: []types.TransitGatewayRouteAttachment{
+					{
+						TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0b5968d3b6EXAMPLE"),
+						ResourceId:                 adapterhelpers.PtrString("vpc-0065acced4EXAMPLE"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+					},
+				},
+			},
+			{
+				PrefixListId: adapterhelpers.PtrString("pl-7ca54015"),
+				State:        types.TransitGatewayRouteStateBlackhole,
+				Type:         types.TransitGatewayRouteTypeStatic,
+			},
+		},
+	}
+
+	items, err := transitGatewayRouteOutputMapper(context.Background(), nil, "foo", input, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// The ID is in the same format as Terraform
+	if item.UniqueAttributeValue() != "tgw-rtb-018774ad1fEXAMPLE_10.0.0.0/16" {
+		t.Errorf("unexpected ID %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway-route-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-rtb-018774ad1fEXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-attach-0b5968d3b6EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0065acced4EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item = items[1]
+
+	if item.UniqueAttributeValue() != "tgw-rtb-018774ad1fEXAMPLE_pl-7ca54015" {
+		t.Errorf("unexpected ID %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected a blackhole route to have a warning, got %v", item.GetHealth())
+	}
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-managed-prefix-list",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pl-7ca54015",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayRouteAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayRouteAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+		// Routes can only be found through their route table
+		SkipList: true,
+		SkipGet:  true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,151 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func transitGatewayInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewaysInput, error) {
+	return &ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayInputMapperList(scope string) (*ec2.DescribeTransitGatewaysInput, error) {
+	return &ec2.DescribeTransitGatewaysInput{}, nil
+}
+
+func transitGatewayOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeTransitGatewaysInput, output *ec2.DescribeTransitGatewaysOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, tgw := range output.TransitGateways {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(tgw, "tags")
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway",
+			UniqueAttribute: "TransitGatewayId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(tgw.Tags),
+		}
+
+		switch tgw.State {
+		case types.TransitGatewayStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayStatePending, types.TransitGatewayStateModifying, types.TransitGatewayStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayStateDeleted:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if tgw.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *tgw.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Attachments can't exist without the transit gateway,
+					// and they are what connect it to everything else
+					In:  true,
+					Out: true,
+				},
+			})
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-route-table",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *tgw.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Route tables control how the transit gateway routes
+					// traffic
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if tgw.Options != nil {
+			for _, routeTableID := range []*string{tgw.Options.AssociationDefaultRouteTableId, tgw.Options.PropagationDefaultRouteTableId} {
+				if routeTableID != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-transit-gateway-route-table",
+							Method: sdp.QueryMethod_GET,
+							Query:  *routeTableID,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// New attachments are associated with, and
+							// propagate to, the default route tables
+							In:  true,
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewaysInput, *ec2.DescribeTransitGatewaysOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewaysInput, *ec2.DescribeTransitGatewaysOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway",
+		AdapterMetadata: transitGatewayAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewaysInput) (*ec2.DescribeTransitGatewaysOutput, error) {
+			return client.DescribeTransitGateways(ctx, input)
+		},
+		InputMapperGet:  transitGatewayInputMapperGet,
+		InputMapperList: transitGatewayInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewaysInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewaysOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewaysPaginator(client, params)
+		},
+		OutputMapper: transitGatewayOutputMapper,
+	}
+}
+
+var transitGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway",
+	DescriptiveName: "Transit Gateway",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway by ID",
+		ListDescription:   "List all transit gateways",
+		SearchDescription: "Search for transit gateways by ARN",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway-attachment", "ec2-transit-gateway-route-table"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(transitGatewayAdapterMetadata, sdp.AttributeSchemaFor(types.TransitGateway{}, "tags"))
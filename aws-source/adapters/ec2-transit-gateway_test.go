@@ -0,0 +1,135 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestTransitGatewayInputMapperGet(t *testing.T) {
+	input, err := transitGatewayInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.TransitGatewayIds) != 1 {
+		t.Fatalf("expected 1 TransitGateway ID, got %v", len(input.TransitGatewayIds))
+	}
+
+	if input.TransitGatewayIds[0] != "bar" {
+		t.Errorf("expected TransitGateway ID to be bar, got %v", input.TransitGatewayIds[0])
+	}
+}
+
+func TestTransitGatewayInputMapperList(t *testing.T) {
+	input, err := transitGatewayInputMapperList("foo")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.Filters) != 0 || len(input.TransitGatewayIds) != 0 {
+		t.Errorf("non-empty input: %v", input)
+	}
+}
+
+func TestTransitGatewayOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewaysOutput{
+		TransitGateways: []types.TransitGateway{
+			{
+				TransitGatewayId:  adapterhelpers.PtrString("tgw-0262a0e521EXAMPLE"),
+				TransitGatewayArn: adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:transit-gateway/tgw-0262a0e521EXAMPLE"),
+				State:             types.TransitGatewayStateAvailable,
+				OwnerId:           adapterhelpers.PtrString("123456789012"),
+				Description:       adapterhelpers.PtrString("hub"),
+				CreationTime:      adapterhelpers.PtrTime(time.Now()),
+				Options: &types.TransitGatewayOptions{
+					AmazonSideAsn:                  adapterhelpers.PtrInt64(64512),
+					AutoAcceptSharedAttachments:    types.AutoAcceptSharedAttachmentsValueDisable,
+					DefaultRouteTableAssociation:   types.DefaultRouteTableAssociationValueEnable,
+					AssociationDefaultRouteTableId: adapterhelpers.PtrString("tgw-rtb-018774ad1fEXAMPLE"),
+					DefaultRouteTablePropagation:   types.DefaultRouteTablePropagationValueEnable,
+					PropagationDefaultRouteTableId: adapterhelpers.PtrString("tgw-rtb-018774ad1fEXAMPLE"),
+					DnsSupport:                     types.DnsSupportValueEnable,
+				},
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("hub"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := transitGatewayOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["Name"] != "hub" {
+		t.Errorf("expected Name tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-0262a0e521EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route-table",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-0262a0e521EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-rtb-018774ad1fEXAMPLE",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
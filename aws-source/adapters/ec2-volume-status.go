@@ -131,3 +131,5 @@ var volumeStatusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var _ = Metadata.RegisterSchema(volumeStatusAdapterMetadata, sdp.AttributeSchemaFor(types.VolumeStatusItem{}))
@@ -24,6 +24,13 @@ func vpcEndpointInputMapperList(scope string) (*ec2.DescribeVpcEndpointsInput, e
 	return &ec2.DescribeVpcEndpointsInput{}, nil
 }
 
+// A type that we use to override the PolicyDocument with the parsed
+// version
+type endpointParsedPolicy struct {
+	types.VpcEndpoint
+	PolicyDocument *policy.Policy
+}
+
 func vpcEndpointOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeVpcEndpointsInput, output *ec2.DescribeVpcEndpointsOutput) ([]*sdp.Item, error) {
 	items := make([]*sdp.Item, 0)
 
@@ -31,12 +38,6 @@ func vpcEndpointOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *
 		var err error
 		var attrs *sdp.ItemAttributes
 
-		// A type that we use to override the PolicyDocument with the parsed
-		// version
-		type endpointParsedPolicy struct {
-			types.VpcEndpoint
-			PolicyDocument *policy.Policy
-		}
 		endpointWithPolicy := endpointParsedPolicy{
 			VpcEndpoint: endpoint,
 		}
@@ -248,3 +249,5 @@ var vpcEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(vpcEndpointAdapterMetadata, sdp.AttributeSchemaFor(endpointParsedPolicy{}, "tags"))
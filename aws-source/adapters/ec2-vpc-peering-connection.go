@@ -148,3 +148,5 @@ var vpcPeeringConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(vpcPeeringConnectionAdapterMetadata, sdp.AttributeSchemaFor(types.VpcPeeringConnection{}, "tags"))
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -45,6 +46,23 @@ func vpcOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.Desc
 			Tags:            ec2TagsToMap(vpc.Tags),
 		}
 
+		if vpc.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53resolver-dns-resolution",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *vpc.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the VPC removes its Resolver configuration
+					In: false,
+					// Resources in the VPC depend on how names are resolved
+					Out: true,
+				},
+			})
+		}
+
 		items = append(items, &item)
 	}
 
@@ -82,5 +100,8 @@ var vpcAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_vpc.id"},
 	},
-	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	PotentialLinks: []string{"route53resolver-dns-resolution"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(vpcAdapterMetadata, sdp.AttributeSchemaFor(types.Vpc{}, "tags"))
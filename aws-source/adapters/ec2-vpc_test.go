@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
 )
 
 func TestVpcInputMapperGet(t *testing.T) {
@@ -90,11 +91,24 @@ func TestVpcOutputMapper(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
+
+		validateAttributeSchema(t, item)
 	}
 
 	if len(items) != 1 {
 		t.Fatalf("expected 1 item, got %v", len(items))
 	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53resolver-dns-resolution",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, items[0])
 }
 
 func TestNewEC2VpcAdapter(t *testing.T) {
@@ -0,0 +1,253 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func vpnConnectionInputMapperGet(scope string, query string) (*ec2.DescribeVpnConnectionsInput, error) {
+	return &ec2.DescribeVpnConnectionsInput{
+		VpnConnectionIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func vpnConnectionInputMapperList(scope string) (*ec2.DescribeVpnConnectionsInput, error) {
+	return &ec2.DescribeVpnConnectionsInput{}, nil
+}
+
+// Searches by ARN, or by the ID of the customer gateway, transit gateway or
+// virtual private gateway that the connection uses
+func vpnConnectionInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeVpnConnectionsInput, error) {
+	var filter string
+	switch {
+	case strings.HasPrefix(query, "arn:"):
+		id, err := ec2ARNResourceID(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return vpnConnectionInputMapperGet(scope, id)
+	case strings.HasPrefix(query, "cgw-"):
+		filter = "customer-gateway-id"
+	case strings.HasPrefix(query, "tgw-"):
+		filter = "transit-gateway-id"
+	case strings.HasPrefix(query, "vgw-"):
+		filter = "vpn-gateway-id"
+	default:
+		return vpnConnectionInputMapperGet(scope, query)
+	}
+
+	return &ec2.DescribeVpnConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   &filter,
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// Removes the pre-shared keys of the tunnels, which are secrets. These are
+// also included in the customer gateway configuration
+func redactVpnConnection(connection types.VpnConnection) types.VpnConnection {
+	connection.CustomerGatewayConfiguration = nil
+
+	if connection.Options != nil {
+		options := *connection.Options
+		options.TunnelOptions = make([]types.TunnelOption, len(connection.Options.TunnelOptions))
+
+		for i, tunnel := range connection.Options.TunnelOptions {
+			tunnel.PreSharedKey = nil
+			options.TunnelOptions[i] = tunnel
+		}
+
+		connection.Options = &options
+	}
+
+	return connection
+}
+
+func vpnConnectionOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeVpnConnectionsInput, output *ec2.DescribeVpnConnectionsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, connection := range output.VpnConnections {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(redactVpnConnection(connection), "tags", "CustomerGatewayConfiguration")
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-vpn-connection",
+			UniqueAttribute: "VpnConnectionId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(connection.Tags),
+		}
+
+		switch connection.State {
+		case types.VpnStateAvailable:
+			var up, down int
+			for _, telemetry := range connection.VgwTelemetry {
+				switch telemetry.Status {
+				case types.TelemetryStatusUp:
+					up++
+				case types.TelemetryStatusDown:
+					down++
+				}
+			}
+
+			switch {
+			case down > 0 && up == 0:
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			case down > 0:
+				// Each connection has two tunnels for redundancy
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			default:
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			}
+		case types.VpnStatePending, types.VpnStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.VpnStateDeleted:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if connection.CustomerGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-customer-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.CustomerGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connection terminates at the customer gateway
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if connection.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connection can't exist without the transit gateway
+					In: true,
+					// Changing the connection changes what the transit
+					// gateway can route to
+					Out: true,
+				},
+			})
+
+			// The connection is attached to the transit gateway
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *connection.VpnConnectionId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if connection.VpnGatewayId != nil {
+			// Virtual private gateways are discovered by the Direct Connect
+			// adapters
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "directconnect-virtual-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.VpnGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connection can't exist without the gateway
+					In: true,
+					// Changing the connection changes what the VPC can reach
+					Out: true,
+				},
+			})
+		}
+
+		for _, telemetry := range connection.VgwTelemetry {
+			if telemetry.OutsideIpAddress != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ip",
+						Method: sdp.QueryMethod_GET,
+						Query:  *telemetry.OutsideIpAddress,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// IPs always link
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2VpnConnectionAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnConnectionsInput, *ec2.DescribeVpnConnectionsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnConnectionsInput, *ec2.DescribeVpnConnectionsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-vpn-connection",
+		AdapterMetadata: vpnConnectionAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+			return client.DescribeVpnConnections(ctx, input)
+		},
+		InputMapperGet:    vpnConnectionInputMapperGet,
+		InputMapperList:   vpnConnectionInputMapperList,
+		InputMapperSearch: vpnConnectionInputMapperSearch,
+		OutputMapper:      vpnConnectionOutputMapper,
+	}
+}
+
+var vpnConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-vpn-connection",
+	DescriptiveName: "VPN Connection",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a site-to-site VPN connection by ID",
+		ListDescription:   "List all site-to-site VPN connections",
+		SearchDescription: "Search for VPN connections by ARN, or by the ID of their customer gateway, transit gateway or virtual private gateway",
+	},
+	PotentialLinks: []string{"ec2-customer-gateway", "ec2-transit-gateway", "ec2-transit-gateway-attachment", "directconnect-virtual-gateway", "ip"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpn_connection.id"},
+		{TerraformQueryMap: "aws_vpn_connection_route.vpn_connection_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(vpnConnectionAdapterMetadata, sdp.AttributeSchemaFor(types.VpnConnection{}, "tags", "CustomerGatewayConfiguration"))
@@ -0,0 +1,158 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestVpnConnectionInputMapperSearch(t *testing.T) {
+	tests := map[string]string{
+		"cgw-0e11f167EXAMPLE":   "customer-gateway-id",
+		"tgw-0262a0e521EXAMPLE": "transit-gateway-id",
+		"vgw-9a4cacf3EXAMPLE":   "vpn-gateway-id",
+	}
+
+	for query, filter := range tests {
+		input, err := vpnConnectionInputMapperSearch(context.Background(), nil, "foo", query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filters) != 1 || *input.Filters[0].Name != filter || input.Filters[0].Values[0] != query {
+			t.Errorf("expected filter %v=%v, got %v", filter, query, input.Filters)
+		}
+	}
+}
+
+func TestVpnConnectionOutputMapper(t *testing.T) {
+	output := &ec2.DescribeVpnConnectionsOutput{
+		VpnConnections: []types.VpnConnection{
+			{
+				VpnConnectionId:              adapterhelpers.PtrString("vpn-40f41529"),
+				CustomerGatewayId:            adapterhelpers.PtrString("cgw-0e11f167EXAMPLE"),
+				TransitGatewayId:             adapterhelpers.PtrString("tgw-0262a0e521EXAMPLE"),
+				Type:                         types.GatewayTypeIpsec1,
+				State:                        types.VpnStateAvailable,
+				Category:                     adapterhelpers.PtrString("VPN"),
+				CustomerGatewayConfiguration: adapterhelpers.PtrString("<vpn_connection><pre_shared_key>secret</pre_shared_key></vpn_connection>"),
+				Options: &types.VpnConnectionOptions{
+					StaticRoutesOnly: adapterhelpers.PtrBool(false),
+					TunnelOptions: []types.TunnelOption{
+						{
+							OutsideIpAddress: adapterhelpers.PtrString("203.0.113.10"),
+							PreSharedKey:     adapterhelpers.PtrString("secret"),
+						},
+						{
+							OutsideIpAddress: adapterhelpers.PtrString("203.0.113.11"),
+							PreSharedKey:     adapterhelpers.PtrString("secret"),
+						},
+					},
+				},
+				VgwTelemetry: []types.VgwTelemetry{
+					{
+						OutsideIpAddress: adapterhelpers.PtrString("203.0.113.10"),
+						Status:           types.TelemetryStatusUp,
+						LastStatusChange: adapterhelpers.PtrTime(time.Now()),
+					},
+					{
+						OutsideIpAddress: adapterhelpers.PtrString("203.0.113.11"),
+						Status:           types.TelemetryStatusDown,
+						LastStatusChange: adapterhelpers.PtrTime(time.Now()),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := vpnConnectionOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// One of the two tunnels is down
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health WARNING, got %v", item.GetHealth())
+	}
+
+	if _, err := item.GetAttributes().Get("CustomerGatewayConfiguration"); err == nil {
+		t.Error("the customer gateway configuration contains secrets and must not be included")
+	}
+
+	if key, err := item.GetAttributes().Get("Options.TunnelOptions.0.PreSharedKey"); err == nil && key != nil {
+		t.Errorf("pre-shared keys must not be included, got %v", key)
+	}
+
+	// The output must not be modified
+	if output.VpnConnections[0].Options.TunnelOptions[0].PreSharedKey == nil {
+		t.Error("the output was modified")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-customer-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "cgw-0e11f167EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-0262a0e521EXAMPLE",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpn-40f41529",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.11",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2VpnConnectionAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2VpnConnectionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
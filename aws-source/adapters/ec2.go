@@ -1,6 +1,13 @@
 package adapters
 
-import "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
 
 // Converts a slice of tags to a map
 func ec2TagsToMap(tags []types.Tag) map[string]string {
@@ -14,3 +21,23 @@ func ec2TagsToMap(tags []types.Tag) map[string]string {
 
 	return tagsMap
 }
+
+// Returns the resource ID from an EC2 ARN, checking that it's in the given
+// scope. Adapters with custom search logic use this so that searching by ARN
+// still works
+func ec2ARNResourceID(scope string, query string) (string, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return "", err
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	return a.ResourceID(), nil
+}
@@ -0,0 +1,199 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// ecrImageAttributes are the attributes of an ecr-image item. Images don't have
+// a name of their own, so we use the repository name and digest in the same
+// format as an image reference: {repositoryName}@{digest}
+type ecrImageAttributes struct {
+	*types.ImageDetail
+	UniqueName string
+}
+
+func ecrImageGetFunc(ctx context.Context, client ecrClient, scope, query string) (*types.ImageDetail, error) {
+	repository, digest, found := strings.Cut(query, "@")
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {repositoryName}@{digest}, but found: %s", query),
+		}
+	}
+
+	images, err := ecrDescribeImages(ctx, client, &ecr.DescribeImagesInput{
+		RepositoryName: &repository,
+		ImageIds: []types.ImageIdentifier{
+			{ImageDigest: &digest},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(images) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "image not found",
+		}
+	}
+
+	return images[0], nil
+}
+
+// Searches for images by repository name, repository ARN or image URI. An
+// image URI returns the single image it refers to, the others return all
+// images in the repository
+func ecrImageSearchFunc(ctx context.Context, client ecrClient, scope string, query string) ([]*types.ImageDetail, error) {
+	input := &ecr.DescribeImagesInput{}
+
+	if image, ok := parseECRImageURI(query); ok {
+		if adapterhelpers.FormatScope(image.AccountID, image.Region) != scope {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOSCOPE,
+				ErrorString: fmt.Sprintf("image %s is not in scope %s", query, scope),
+			}
+		}
+
+		input.RegistryId = &image.AccountID
+		input.RepositoryName = &image.RepositoryName
+
+		if image.Digest != "" {
+			input.ImageIds = []types.ImageIdentifier{{ImageDigest: &image.Digest}}
+		} else {
+			input.ImageIds = []types.ImageIdentifier{{ImageTag: &image.Tag}}
+		}
+	} else if a, err := adapterhelpers.ParseARN(query); err == nil {
+		repository := a.ResourceID()
+		input.RepositoryName = &repository
+	} else {
+		input.RepositoryName = &query
+	}
+
+	return ecrDescribeImages(ctx, client, input)
+}
+
+func ecrDescribeImages(ctx context.Context, client ecrClient, input *ecr.DescribeImagesInput) ([]*types.ImageDetail, error) {
+	images := make([]*types.ImageDetail, 0)
+
+	paginator := ecr.NewDescribeImagesPaginator(client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.ImageDetails {
+			images = append(images, &out.ImageDetails[i])
+		}
+	}
+
+	return images, nil
+}
+
+// Works out the health of an image from the summary of its latest scan.
+// Images that haven't been scanned have no health
+func ecrImageScanToHealth(image *types.ImageDetail) *sdp.Health {
+	if image.ImageScanStatus != nil && image.ImageScanStatus.Status == types.ScanStatusFailed {
+		return sdp.Health_HEALTH_UNKNOWN.Enum()
+	}
+
+	if image.ImageScanFindingsSummary == nil {
+		return nil
+	}
+
+	counts := image.ImageScanFindingsSummary.FindingSeverityCounts
+	switch {
+	case counts[string(types.FindingSeverityCritical)] > 0:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case counts[string(types.FindingSeverityHigh)] > 0:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	default:
+		return sdp.Health_HEALTH_OK.Enum()
+	}
+}
+
+func ecrImageItemMapper(_, scope string, awsItem *types.ImageDetail) (*sdp.Item, error) {
+	attrs := ecrImageAttributes{
+		ImageDetail: awsItem,
+	}
+
+	if awsItem.RepositoryName != nil && awsItem.ImageDigest != nil {
+		attrs.UniqueName = *awsItem.RepositoryName + "@" + *awsItem.ImageDigest
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-image",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          ecrImageScanToHealth(awsItem),
+	}
+
+	if awsItem.RepositoryName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ecr-repository",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.RepositoryName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the repository deletes the image
+				In: true,
+				// Images can't affect the repository
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewECRImageAdapter(client ecrClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ImageDetail, ecrClient, *ecr.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ImageDetail, ecrClient, *ecr.Options]{
+		ItemType:        "ecr-image",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrImageAdapterMetadata,
+		GetFunc:         ecrImageGetFunc,
+		DisableList:     true, // There are too many images to list, search by repository instead
+		SearchFunc:      ecrImageSearchFunc,
+		ItemMapper:      ecrImageItemMapper,
+	}
+}
+
+var ecrImageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-image",
+	DescriptiveName: "ECR Image",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an image by repository name and digest: {repositoryName}@{digest}",
+		SearchDescription: "Search for images by repository name or ARN, or for a single image by URI e.g. 123456789012.dkr.ecr.eu-west-2.amazonaws.com/repo:tag",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_ecr_image.image_uri",
+		},
+	},
+	PotentialLinks: []string{"ecr-repository"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var _ = Metadata.RegisterSchema(ecrImageAdapterMetadata, sdp.AttributeSchemaFor(ecrImageAttributes{}))
@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestECRImageGetFunc(t *testing.T) {
+	image, err := ecrImageGetFunc(context.Background(), testECRClient{}, "123456789012.eu-west-2", "team/app@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := ecrImageItemMapper("", "123456789012.eu-west-2", image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "team/app@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	// The image has high severity findings
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "team/app",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if _, err := ecrImageGetFunc(context.Background(), testECRClient{}, "123456789012.eu-west-2", "team/app"); err == nil {
+		t.Error("expected an error for a query without a digest")
+	}
+}
+
+func TestECRImageSearchFunc(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	t.Run("by repository name", func(t *testing.T) {
+		images, err := ecrImageSearchFunc(context.Background(), testECRClient{}, scope, "team/app")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(images) != 2 {
+			t.Errorf("expected 2 images, got %v", len(images))
+		}
+	})
+
+	t.Run("by repository ARN", func(t *testing.T) {
+		images, err := ecrImageSearchFunc(context.Background(), testECRClient{}, scope, "arn:aws:ecr:eu-west-2:123456789012:repository/team/app")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(images) != 2 {
+			t.Errorf("expected 2 images, got %v", len(images))
+		}
+	})
+
+	t.Run("by image URI with a tag", func(t *testing.T) {
+		images, err := ecrImageSearchFunc(context.Background(), testECRClient{}, scope, "123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/app:v1.2.2")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(images) != 1 || *images[0].ImageDigest != "sha256:0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9" {
+			t.Errorf("expected the v1.2.2 image, got %v", images)
+		}
+	})
+
+	t.Run("by image URI in another scope", func(t *testing.T) {
+		_, err := ecrImageSearchFunc(context.Background(), testECRClient{}, scope, "210987654321.dkr.ecr.eu-west-2.amazonaws.com/team/app:v1.2.2")
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestECRImageScanToHealth(t *testing.T) {
+	images, err := ecrImageSearchFunc(context.Background(), testECRClient{}, "123456789012.eu-west-2", "team/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The second image has never been scanned
+	if health := ecrImageScanToHealth(images[1]); health != nil {
+		t.Errorf("expected no health for an unscanned image, got %v", health)
+	}
+
+	images[0].ImageScanFindingsSummary.FindingSeverityCounts["CRITICAL"] = 1
+	if health := ecrImageScanToHealth(images[0]); health.String() != sdp.Health_HEALTH_ERROR.String() {
+		t.Errorf("expected critical findings to be an error, got %v", health)
+	}
+}
+
+func TestNewECRImageAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ecr.NewFromConfig(config)
+
+	adapter := NewECRImageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func ecrRepositoryGetFunc(ctx context.Context, client ecrClient, scope, query string) (*types.Repository, error) {
+	out, err := client.DescribeRepositories(ctx, &ecr.DescribeRepositoriesInput{
+		RepositoryNames: []string{query},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Repositories) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "repository not found",
+		}
+	}
+
+	return &out.Repositories[0], nil
+}
+
+func ecrRepositoryItemMapper(_ *string, scope string, awsItem *types.Repository) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-repository",
+		UniqueAttribute: "RepositoryName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.RepositoryName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ecr-image",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.RepositoryName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Images can't affect the repository
+				In: false,
+				// Deleting the repository deletes the images, and the
+				// lifecycle policy can expire them
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.EncryptionConfiguration != nil && awsItem.EncryptionConfiguration.KmsKey != nil {
+		if link := kmsKeyLink(scope, *awsItem.EncryptionConfiguration.KmsKey); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func ecrRepositoryListTagsFunc(ctx context.Context, repository *types.Repository, client ecrClient) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &ecr.ListTagsForResourceInput{
+		ResourceArn: repository.RepositoryArn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	return ecrTagsToMap(out.Tags), nil
+}
+
+func NewECRRepositoryAdapter(client ecrClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*ecr.DescribeRepositoriesInput, *ecr.DescribeRepositoriesOutput, *types.Repository, ecrClient, *ecr.Options] {
+	return &adapterhelpers.GetListAdapterV2[*ecr.DescribeRepositoriesInput, *ecr.DescribeRepositoriesOutput, *types.Repository, ecrClient, *ecr.Options]{
+		ItemType:        "ecr-repository",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrRepositoryAdapterMetadata,
+		GetFunc:         ecrRepositoryGetFunc,
+		InputMapperList: func(scope string) (*ecr.DescribeRepositoriesInput, error) {
+			return &ecr.DescribeRepositoriesInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client ecrClient, params *ecr.DescribeRepositoriesInput) adapterhelpers.Paginator[*ecr.DescribeRepositoriesOutput, *ecr.Options] {
+			return ecr.NewDescribeRepositoriesPaginator(client, params)
+		},
+		ListExtractor: func(_ context.Context, output *ecr.DescribeRepositoriesOutput, _ ecrClient) ([]*types.Repository, error) {
+			repositories := make([]*types.Repository, 0, len(output.Repositories))
+			for i := range output.Repositories {
+				repositories = append(repositories, &output.Repositories[i])
+			}
+			return repositories, nil
+		},
+		ItemMapper:   ecrRepositoryItemMapper,
+		ListTagsFunc: ecrRepositoryListTagsFunc,
+	}
+}
+
+var ecrRepositoryAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-repository",
+	DescriptiveName: "ECR Repository",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a repository by name",
+		ListDescription:   "List all repositories",
+		SearchDescription: "Search for a repository by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_ecr_repository.name",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_ecr_repository_policy.repository",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_ecr_lifecycle_policy.repository",
+		},
+	},
+	PotentialLinks: []string{"ecr-image", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var _ = Metadata.RegisterSchema(ecrRepositoryAdapterMetadata, sdp.AttributeSchemaFor(types.Repository{}))
@@ -0,0 +1,198 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testECRClient struct{}
+
+func (t testECRClient) DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	repositories := []types.Repository{
+		{
+			RepositoryArn:  aws.String("arn:aws:ecr:eu-west-2:123456789012:repository/team/app"),
+			RegistryId:     aws.String("123456789012"),
+			RepositoryName: aws.String("team/app"),
+			RepositoryUri:  aws.String("123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/app"),
+			CreatedAt:      aws.Time(time.Now()),
+			ImageScanningConfiguration: &types.ImageScanningConfiguration{
+				ScanOnPush: true,
+			},
+			ImageTagMutability: types.ImageTagMutabilityImmutable,
+			EncryptionConfiguration: &types.EncryptionConfiguration{
+				EncryptionType: types.EncryptionTypeKms,
+				KmsKey:         aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+		},
+	}
+
+	if len(params.RepositoryNames) > 0 {
+		repositories = slices.DeleteFunc(repositories, func(r types.Repository) bool {
+			return !slices.Contains(params.RepositoryNames, *r.RepositoryName)
+		})
+
+		if len(repositories) == 0 {
+			return nil, &types.RepositoryNotFoundException{
+				Message: aws.String("repository not found"),
+			}
+		}
+	}
+
+	return &ecr.DescribeRepositoriesOutput{
+		Repositories: repositories,
+	}, nil
+}
+
+func (t testECRClient) DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	images := []types.ImageDetail{
+		{
+			RegistryId:     aws.String("123456789012"),
+			RepositoryName: aws.String("team/app"),
+			ImageDigest:    aws.String("sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"),
+			ImageTags:      []string{"v1.2.3", "latest"},
+			ImagePushedAt:  aws.Time(time.Now()),
+			ImageScanStatus: &types.ImageScanStatus{
+				Status: types.ScanStatusComplete,
+			},
+			ImageScanFindingsSummary: &types.ImageScanFindingsSummary{
+				FindingSeverityCounts: map[string]int32{
+					"HIGH":   2,
+					"MEDIUM": 5,
+				},
+			},
+		},
+		{
+			RegistryId:     aws.String("123456789012"),
+			RepositoryName: aws.String("team/app"),
+			ImageDigest:    aws.String("sha256:0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"),
+			ImageTags:      []string{"v1.2.2"},
+			ImagePushedAt:  aws.Time(time.Now()),
+		},
+	}
+
+	if params.RepositoryName == nil || *params.RepositoryName != "team/app" {
+		return nil, &types.RepositoryNotFoundException{
+			Message: aws.String("repository not found"),
+		}
+	}
+
+	for _, id := range params.ImageIds {
+		images = slices.DeleteFunc(images, func(i types.ImageDetail) bool {
+			if id.ImageDigest != nil {
+				return *i.ImageDigest != *id.ImageDigest
+			}
+			return !slices.Contains(i.ImageTags, *id.ImageTag)
+		})
+	}
+
+	return &ecr.DescribeImagesOutput{
+		ImageDetails: images,
+	}, nil
+}
+
+func (t testECRClient) ListTagsForResource(ctx context.Context, params *ecr.ListTagsForResourceInput, optFns ...func(*ecr.Options)) (*ecr.ListTagsForResourceOutput, error) {
+	return &ecr.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("platform"),
+			},
+		},
+	}, nil
+}
+
+func TestECRRepositoryItemMapper(t *testing.T) {
+	repository, err := ecrRepositoryGetFunc(context.Background(), testECRClient{}, "123456789012.eu-west-2", "team/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := ecrRepositoryItemMapper(nil, "123456789012.eu-west-2", repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "team/app",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestECRRepositoryListFunc(t *testing.T) {
+	adapter := NewECRRepositoryAdapter(testECRClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].GetTags()["team"] != "platform" {
+		t.Errorf("expected team tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestECRRepositorySearchByARN(t *testing.T) {
+	adapter := NewECRRepositoryAdapter(testECRClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.SearchStream(context.Background(), "123456789012.eu-west-2", "arn:aws:ecr:eu-west-2:123456789012:repository/team/app", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+
+	if len(items) != 1 || items[0].UniqueAttributeValue() != "team/app" {
+		t.Errorf("expected to find team/app, got %v", items)
+	}
+}
+
+func TestNewECRRepositoryAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ecr.NewFromConfig(config)
+
+	adapter := NewECRRepositoryAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
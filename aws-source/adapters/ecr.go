@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type ecrClient interface {
+	ListTagsForResource(ctx context.Context, params *ecr.ListTagsForResourceInput, optFns ...func(*ecr.Options)) (*ecr.ListTagsForResourceOutput, error)
+
+	ecr.DescribeRepositoriesAPIClient
+	ecr.DescribeImagesAPIClient
+}
+
+func ecrTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// Matches the registry part of an ECR image URI e.g.
+// 123456789012.dkr.ecr.eu-west-2.amazonaws.com/
+var ecrRegistryRegex = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/(.+)$`)
+
+// ecrImageURI is the parsed form of an image URI that points to ECR. Exactly
+// one of Tag or Digest will be set
+type ecrImageURI struct {
+	AccountID      string
+	Region         string
+	RepositoryName string
+	Tag            string
+	Digest         string
+}
+
+// Parses an image URI such as
+// 123456789012.dkr.ecr.eu-west-2.amazonaws.com/repo:tag or
+// 123456789012.dkr.ecr.eu-west-2.amazonaws.com/repo@sha256:abc. Returns false
+// if the image isn't stored in ECR. Images without a tag or digest use the
+// `latest` tag, the same as Docker
+func parseECRImageURI(uri string) (ecrImageURI, bool) {
+	matches := ecrRegistryRegex.FindStringSubmatch(uri)
+	if matches == nil {
+		return ecrImageURI{}, false
+	}
+
+	image := ecrImageURI{
+		AccountID: matches[1],
+		Region:    matches[2],
+	}
+
+	reference := matches[3]
+	if repository, digest, found := strings.Cut(reference, "@"); found {
+		image.RepositoryName = repository
+		image.Digest = digest
+	} else if i := strings.LastIndex(reference, ":"); i > strings.LastIndex(reference, "/") {
+		image.RepositoryName = reference[:i]
+		image.Tag = reference[i+1:]
+	} else {
+		image.RepositoryName = reference
+		image.Tag = "latest"
+	}
+
+	if image.RepositoryName == "" || (image.Tag == "" && image.Digest == "") {
+		return ecrImageURI{}, false
+	}
+
+	return image, true
+}
+
+// Links a resource to the ECR image that it runs. Images that aren't stored in
+// ECR aren't linked
+func ecrImageLink(uri string) *sdp.LinkedItemQuery {
+	image, ok := parseECRImageURI(uri)
+	if !ok {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ecr-image",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  uri,
+			Scope:  adapterhelpers.FormatScope(image.AccountID, image.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Pushing a new image will affect what is running
+			In: true,
+			// Changing what runs the image won't affect the image
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestParseECRImageURI(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected ecrImageURI
+		ok       bool
+	}{
+		{
+			uri: "123456789012.dkr.ecr.eu-west-2.amazonaws.com/app:v1.2.3",
+			expected: ecrImageURI{
+				AccountID:      "123456789012",
+				Region:         "eu-west-2",
+				RepositoryName: "app",
+				Tag:            "v1.2.3",
+			},
+			ok: true,
+		},
+		{
+			uri: "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/app@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			expected: ecrImageURI{
+				AccountID:      "123456789012",
+				Region:         "us-east-1",
+				RepositoryName: "team/app",
+				Digest:         "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			},
+			ok: true,
+		},
+		{
+			uri: "123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/app",
+			expected: ecrImageURI{
+				AccountID:      "123456789012",
+				Region:         "eu-west-2",
+				RepositoryName: "team/app",
+				Tag:            "latest",
+			},
+			ok: true,
+		},
+		{
+			uri: "public.ecr.aws/nginx/nginx:latest",
+			ok:  false,
+		},
+		{
+			uri: "httpd:2.4",
+			ok:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			image, ok := parseECRImageURI(tt.uri)
+
+			if ok != tt.ok {
+				t.Fatalf("expected ok to be %v, got %v", tt.ok, ok)
+			}
+
+			if image != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, image)
+			}
+		})
+	}
+}
+
+func TestECRImageLink(t *testing.T) {
+	link := ecrImageLink("123456789012.dkr.ecr.eu-west-2.amazonaws.com/app:v1.2.3")
+	if link == nil {
+		t.Fatal("expected a link")
+	}
+
+	if link.GetQuery().GetType() != "ecr-image" || link.GetQuery().GetMethod() != sdp.QueryMethod_SEARCH {
+		t.Errorf("expected an ecr-image search, got %v", link.GetQuery())
+	}
+
+	if link.GetQuery().GetScope() != "123456789012.eu-west-2" {
+		t.Errorf("expected scope 123456789012.eu-west-2, got %v", link.GetQuery().GetScope())
+	}
+
+	if ecrImageLink("nginx:latest") != nil {
+		t.Error("expected images outside ECR not to be linked")
+	}
+}
@@ -110,6 +110,8 @@ var capacityProviderAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
 
+var _ = Metadata.RegisterSchema(capacityProviderAdapterMetadata, sdp.AttributeSchemaFor(types.CapacityProvider{}, "tags"))
+
 // Incredibly annoyingly the go package adapters't provide a paginator builder for
 // DescribeCapacityProviders despite the fact that it's paginated, so I'm going
 // to create one myself below
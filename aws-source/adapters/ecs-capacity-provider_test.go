@@ -111,6 +111,8 @@ func TestCapacityProviderOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "autoscaling-auto-scaling-group",
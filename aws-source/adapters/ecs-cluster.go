@@ -270,3 +270,5 @@ var ecsClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-container-instance", "ecs-service", "ecs-task", "ecs-capacity-provider"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(ecsClusterAdapterMetadata, sdp.AttributeSchemaFor(types.Cluster{}, "tags"))
@@ -104,6 +104,8 @@ func TestECSClusterGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "kms-key",
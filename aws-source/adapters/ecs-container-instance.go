@@ -167,3 +167,5 @@ var containerInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(containerInstanceAdapterMetadata, sdp.AttributeSchemaFor(types.ContainerInstance{}, "tags").WithProperty("Id", stringAttribute))
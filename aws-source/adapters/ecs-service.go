@@ -17,6 +17,31 @@ var ServiceIncludeFields = []types.ServiceField{
 	types.ServiceFieldTags,
 }
 
+// Calculates the health of an active service from its deployments. A failed
+// rollout means that the service couldn't be updated, and a rollout in
+// progress means that tasks are being replaced
+func ecsServiceDeploymentHealth(service types.Service) *sdp.Health {
+	for _, deployment := range service.Deployments {
+		if deployment.RolloutState == types.DeploymentRolloutStateFailed {
+			return sdp.Health_HEALTH_ERROR.Enum()
+		}
+	}
+
+	for _, deployment := range service.Deployments {
+		if deployment.RolloutState == types.DeploymentRolloutStateInProgress {
+			return sdp.Health_HEALTH_PENDING.Enum()
+		}
+	}
+
+	// A service that isn't running all of its tasks can't handle the load it
+	// was sized for
+	if service.RunningCount < service.DesiredCount {
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	return sdp.Health_HEALTH_OK.Enum()
+}
+
 func serviceGetFunc(ctx context.Context, client ECSClient, scope string, input *ecs.DescribeServicesInput) (*sdp.Item, error) {
 	if input == nil {
 		return nil, &sdp.QueryError{
@@ -72,7 +97,7 @@ func serviceGetFunc(ctx context.Context, client ECSClient, scope string, input *
 	if service.Status != nil {
 		switch *service.Status {
 		case "ACTIVE":
-			item.Health = sdp.Health_HEALTH_OK.Enum()
+			item.Health = ecsServiceDeploymentHealth(service)
 		case "DRAINING":
 			item.Health = sdp.Health_HEALTH_WARNING.Enum()
 		case "INACTIVE":
@@ -426,3 +451,5 @@ var ecsServiceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-cluster", "elbv2-target-group", "servicediscovery-service", "ecs-task-definition", "ecs-capacity-provider", "ec2-subnet", "ecs-security-group", "dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(ecsServiceAdapterMetadata, sdp.AttributeSchemaFor(types.Service{}, "tags").WithProperty("ServiceFullName", stringAttribute))
@@ -226,6 +226,12 @@ func TestServiceGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "ecs-cluster",
@@ -310,6 +316,65 @@ func TestServiceGetFunc(t *testing.T) {
 	tests.Execute(t, item)
 }
 
+func TestECSServiceDeploymentHealth(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Service  types.Service
+		Expected sdp.Health
+	}{
+		{
+			Name: "failed rollout",
+			Service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 2,
+				Deployments: []types.Deployment{
+					{RolloutState: types.DeploymentRolloutStateFailed},
+					{RolloutState: types.DeploymentRolloutStateCompleted},
+				},
+			},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+		{
+			Name: "rollout in progress",
+			Service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 1,
+				Deployments: []types.Deployment{
+					{RolloutState: types.DeploymentRolloutStateInProgress},
+				},
+			},
+			Expected: sdp.Health_HEALTH_PENDING,
+		},
+		{
+			Name: "missing tasks",
+			Service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 1,
+				Deployments: []types.Deployment{
+					{RolloutState: types.DeploymentRolloutStateCompleted},
+				},
+			},
+			Expected: sdp.Health_HEALTH_WARNING,
+		},
+		{
+			Name: "steady",
+			Service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 2,
+			},
+			Expected: sdp.Health_HEALTH_OK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if health := ecsServiceDeploymentHealth(test.Service); *health != test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, health)
+			}
+		})
+	}
+}
+
 func TestNewECSServiceAdapter(t *testing.T) {
 	client, account, region := ecsGetAutoConfig(t)
 
@@ -61,7 +61,16 @@ func taskDefinitionGetFunc(ctx context.Context, client ECSClient, scope string,
 	var a *adapterhelpers.ARN
 	var link *sdp.LinkedItemQuery
 
+	// Containers often share a log group, each log group is only linked once
+	logGroups := make(map[string]bool)
+
 	for _, cd := range td.ContainerDefinitions {
+		if cd.Image != nil {
+			if link = ecrImageLink(*cd.Image); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
 		for _, secret := range cd.Secrets {
 			link = getSecretLinkedItem(secret)
 
@@ -78,6 +87,14 @@ func taskDefinitionGetFunc(ctx context.Context, client ECSClient, scope string,
 					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
 				}
 			}
+
+			if link = ecsLogGroupLink(scope, cd.LogConfiguration); link != nil {
+				key := link.GetQuery().GetScope() + "/" + link.GetQuery().GetQuery()
+				if !logGroups[key] {
+					logGroups[key] = true
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
 		}
 
 		newQueries, err := sdp.ExtractLinksFrom(cd.Environment)
@@ -174,6 +191,44 @@ func getSecretLinkedItem(secret types.Secret) *sdp.LinkedItemQuery {
 	return nil
 }
 
+// Returns a link to the log group that a container logs to with the awslogs
+// driver. The log group is in the region of the task unless the awslogs-region
+// option is set
+func ecsLogGroupLink(scope string, config *types.LogConfiguration) *sdp.LinkedItemQuery {
+	if config.LogDriver != types.LogDriverAwslogs {
+		return nil
+	}
+
+	logGroupName, ok := config.Options["awslogs-group"]
+	if !ok || logGroupName == "" {
+		return nil
+	}
+
+	if region, ok := config.Options["awslogs-region"]; ok && region != "" {
+		accountID, _, err := adapterhelpers.ParseScope(scope)
+		if err != nil {
+			return nil
+		}
+
+		scope = adapterhelpers.FormatScope(accountID, region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "logs-log-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  logGroupName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The containers can't log if the log group changes
+			In: true,
+			// The containers write to the log group
+			Out: true,
+		},
+	}
+}
+
 func NewECSTaskDefinitionAdapter(client ECSClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*ecs.ListTaskDefinitionsInput, *ecs.ListTaskDefinitionsOutput, *ecs.DescribeTaskDefinitionInput, *ecs.DescribeTaskDefinitionOutput, ECSClient, *ecs.Options] {
 	return &adapterhelpers.AlwaysGetAdapter[*ecs.ListTaskDefinitionsInput, *ecs.ListTaskDefinitionsOutput, *ecs.DescribeTaskDefinitionInput, *ecs.DescribeTaskDefinitionOutput, ECSClient, *ecs.Options]{
 		ItemType:        "ecs-task-definition",
@@ -223,6 +278,8 @@ var taskDefinitionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_ecs_task_definition.family"},
 	},
-	PotentialLinks: []string{"iam-role", "secretsmanager-secret", "ssm-parameter"},
+	PotentialLinks: []string{"iam-role", "secretsmanager-secret", "ssm-parameter", "ecr-image", "logs-log-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(taskDefinitionAdapterMetadata, sdp.AttributeSchemaFor(&types.TaskDefinition{}))
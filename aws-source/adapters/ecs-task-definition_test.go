@@ -116,7 +116,7 @@ func (t *ecsTestClient) DescribeTaskDefinition(ctx context.Context, params *ecs.
 				},
 				{
 					Name:      adapterhelpers.PtrString("busybox"),
-					Image:     adapterhelpers.PtrString("busybox"),
+					Image:     adapterhelpers.PtrString("052392120703.dkr.ecr.eu-west-1.amazonaws.com/busybox:1.36"),
 					Cpu:       10,
 					Memory:    adapterhelpers.PtrInt32(200),
 					Essential: adapterhelpers.PtrBool(false),
@@ -218,7 +218,15 @@ func TestTaskDefinitionGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "052392120703.dkr.ecr.eu-west-1.amazonaws.com/busybox:1.36",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
 		{
 			ExpectedType:   "secretsmanager-secret",
 			ExpectedMethod: sdp.QueryMethod_SEARCH,
@@ -254,6 +262,47 @@ func TestTaskDefinitionGetFunc(t *testing.T) {
 	tests.Execute(t, item)
 }
 
+func TestECSLogGroupLink(t *testing.T) {
+	link := ecsLogGroupLink("052392120703.eu-west-2", &types.LogConfiguration{
+		LogDriver: types.LogDriverAwslogs,
+		Options: map[string]string{
+			"awslogs-group":  "ECSLogGroup-ecs-template",
+			"awslogs-region": "eu-west-1",
+		},
+	})
+
+	if link == nil {
+		t.Fatal("expected a link to the log group")
+	}
+
+	// The log group is in the region from the options
+	if link.GetQuery().GetQuery() != "ECSLogGroup-ecs-template" || link.GetQuery().GetScope() != "052392120703.eu-west-1" {
+		t.Errorf("unexpected query %v", link.GetQuery())
+	}
+
+	link = ecsLogGroupLink("052392120703.eu-west-2", &types.LogConfiguration{
+		LogDriver: types.LogDriverAwslogs,
+		Options: map[string]string{
+			"awslogs-group": "ECSLogGroup-ecs-template",
+		},
+	})
+
+	if link == nil || link.GetQuery().GetScope() != "052392120703.eu-west-2" {
+		t.Errorf("expected a link to the log group in the region of the task, got %v", link)
+	}
+
+	link = ecsLogGroupLink("052392120703.eu-west-2", &types.LogConfiguration{
+		LogDriver: types.LogDriverFluentd,
+		Options: map[string]string{
+			"awslogs-group": "ECSLogGroup-ecs-template",
+		},
+	})
+
+	if link != nil {
+		t.Errorf("expected no link for other log drivers, got %v", link)
+	}
+}
+
 func TestNewECSTaskDefinitionAdapter(t *testing.T) {
 	client, account, region := ecsGetAutoConfig(t)
 
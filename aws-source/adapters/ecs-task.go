@@ -264,3 +264,5 @@ var ecsTaskAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-cluster", "ecs-container-instance", "ecs-task-definition", "ec2-network-interface", "ip"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(ecsTaskAdapterMetadata, sdp.AttributeSchemaFor(types.Task{}, "tags").WithProperty("Id", stringAttribute))
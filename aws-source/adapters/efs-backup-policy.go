@@ -83,3 +83,5 @@ var backupPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var _ = Metadata.RegisterSchema(backupPolicyAdapterMetadata, sdp.AttributeSchemaFor(&efs.DescribeBackupPolicyOutput{}).WithProperty("FileSystemId", stringAttribute))
@@ -162,3 +162,5 @@ var replicationConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var _ = Metadata.RegisterSchema(replicationConfigurationAdapterMetadata, sdp.AttributeSchemaFor(types.ReplicationConfigurationDescription{}))
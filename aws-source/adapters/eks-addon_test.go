@@ -45,6 +45,8 @@ func TestAddonGetFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewEKSAddonAdapter(t *testing.T) {
@@ -142,6 +142,38 @@ func clusterGetFunc(ctx context.Context, client EKSClient, scope string, input *
 		}
 	}
 
+	if cluster.Arn != nil {
+		// The resources inside the cluster. These are only found if
+		// aws-source discovers Kubernetes resources, or k8s-source is
+		// running with the ARN as its cluster name
+		kubernetesScope := EKSKubernetesScope(*cluster.Arn)
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "Node",
+				Method: sdp.QueryMethod_LIST,
+				Scope:  kubernetesScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The nodes are part of the cluster
+				In:  true,
+				Out: true,
+			},
+		}, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "Namespace",
+				Method: sdp.QueryMethod_LIST,
+				Scope:  kubernetesScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Namespaces can't affect the cluster
+				In: false,
+				// Changes to the cluster affect everything running in it
+				Out: true,
+			},
+		})
+	}
+
 	if cluster.Endpoint != nil {
 		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
 			Query: &sdp.Query{
@@ -301,3 +333,5 @@ var eksClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(eksClusterAdapterMetadata, sdp.AttributeSchemaFor(&types.Cluster{}, "clientRequestToken"))
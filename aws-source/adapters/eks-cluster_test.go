@@ -119,6 +119,8 @@ func TestClusterGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	// It doesn't really make sense to test anything other than the linked items
 	// since the attributes are converted automatically
 	tests := adapterhelpers.QueryTests{
@@ -200,6 +202,18 @@ func TestClusterGetFunc(t *testing.T) {
 			ExpectedQuery:  "dylan",
 			ExpectedScope:  item.GetScope(),
 		},
+		{
+			ExpectedType:   "Node",
+			ExpectedMethod: sdp.QueryMethod_LIST,
+			ExpectedQuery:  "",
+			ExpectedScope:  "arn:aws:eks:eu-west-2:801795385023:cluster/dylan",
+		},
+		{
+			ExpectedType:   "Namespace",
+			ExpectedMethod: sdp.QueryMethod_LIST,
+			ExpectedQuery:  "",
+			ExpectedScope:  "arn:aws:eks:eu-west-2:801795385023:cluster/dylan",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -150,3 +151,5 @@ var fargateProfileAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-role", "ec2-subnet"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(fargateProfileAdapterMetadata, sdp.AttributeSchemaFor(&types.FargateProfile{}).WithProperty("UniqueName", stringAttribute))
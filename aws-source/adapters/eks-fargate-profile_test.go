@@ -46,6 +46,8 @@ func TestFargateProfileGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	// It doesn't really make sense to test anything other than the linked items
 	// since the attributes are converted automatically
 	tests := adapterhelpers.QueryTests{
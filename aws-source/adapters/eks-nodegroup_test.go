@@ -87,6 +87,8 @@ func TestNodegroupGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	// It doesn't really make sense to test anything other than the linked items
 	// since the attributes are converted automatically
 	tests := adapterhelpers.QueryTests{
@@ -2,8 +2,12 @@ package adapters
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 )
 
 type EKSClient interface {
@@ -18,3 +22,38 @@ type EKSClient interface {
 	ListNodegroups(ctx context.Context, params *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error)
 	DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
 }
+
+// EKSKubernetesScope returns the scope of the Kubernetes resources inside an
+// EKS cluster, which is the ARN of the cluster since this is unique across
+// accounts and regions. Clusters discovered by aws-source use this scope, and
+// k8s-source uses it too if its cluster name is set to the ARN
+func EKSKubernetesScope(clusterARN string) string {
+	return clusterARN
+}
+
+// eksClusterARN returns the ARN of a cluster in the account and region of the
+// scope
+func eksClusterARN(scope string, name string) (string, error) {
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("arn:%v:eks:%v:%v:cluster/%v", adapterhelpers.PartitionFromRegion(region), region, accountID, name), nil
+}
+
+// eksClusterName returns the name of the EKS cluster that an instance is a
+// node of from its tags, or an empty string if it isn't a node
+func eksClusterName(tags map[string]string) string {
+	if name := tags["eks:cluster-name"]; name != "" {
+		return name
+	}
+
+	for key, value := range tags {
+		if name, ok := strings.CutPrefix(key, "kubernetes.io/cluster/"); ok && value == "owned" {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -67,3 +67,44 @@ func eksGetAutoConfig(t *testing.T) (*eks.Client, string, string) {
 
 	return client, account, region
 }
+
+func TestEKSClusterARN(t *testing.T) {
+	tests := map[string]string{
+		"123456789012.eu-west-2":     "arn:aws:eks:eu-west-2:123456789012:cluster/prod",
+		"123456789012.cn-north-1":    "arn:aws-cn:eks:cn-north-1:123456789012:cluster/prod",
+		"123456789012.us-gov-west-1": "arn:aws-us-gov:eks:us-gov-west-1:123456789012:cluster/prod",
+	}
+
+	for scope, expected := range tests {
+		arn, err := eksClusterARN(scope, "prod")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if arn != expected {
+			t.Errorf("expected %v, got %v", expected, arn)
+		}
+	}
+
+	if _, err := eksClusterARN("123456789012", "prod"); err == nil {
+		t.Error("expected error for scope without a region")
+	}
+}
+
+func TestEKSClusterName(t *testing.T) {
+	tests := []struct {
+		tags     map[string]string
+		expected string
+	}{
+		{map[string]string{"eks:cluster-name": "prod"}, "prod"},
+		{map[string]string{"kubernetes.io/cluster/prod": "owned"}, "prod"},
+		{map[string]string{"kubernetes.io/cluster/prod": "shared"}, ""},
+		{map[string]string{"Name": "prod"}, ""},
+	}
+
+	for _, test := range tests {
+		if name := eksClusterName(test.tags); name != test.expected {
+			t.Errorf("expected %q for %v, got %q", test.expected, test.tags, name)
+		}
+	}
+}
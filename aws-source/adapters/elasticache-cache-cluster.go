@@ -0,0 +1,191 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// Converts the status of a cache cluster or replication group to a health.
+// Both use the same set of statuses
+func elasticacheStatusToHealth(status *string) *sdp.Health {
+	if status == nil {
+		return nil
+	}
+
+	switch *status {
+	case "available":
+		return sdp.Health_HEALTH_OK.Enum()
+	case "creating", "modifying", "rebooting cluster nodes", "snapshotting":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case "deleting", "deleted":
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case "create-failed", "incompatible-network", "restore-failed":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return sdp.Health_HEALTH_UNKNOWN.Enum()
+}
+
+func cacheClusterOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeCacheClustersInput, output *elasticache.DescribeCacheClustersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, cluster := range output.CacheClusters {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-cache-cluster",
+			UniqueAttribute: "CacheClusterId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheTags(ctx, client, cluster.ARN),
+			Health:          elasticacheStatusToHealth(cluster.CacheClusterStatus),
+		}
+
+		if cluster.ReplicationGroupId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-replication-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.ReplicationGroupId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Tightly coupled
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if cluster.CacheSubnetGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-subnet-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.CacheSubnetGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet group can affect the cluster
+					In: true,
+					// The cluster won't affect the subnet group
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			if sg.SecurityGroupId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-security-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *sg.SecurityGroupId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the security group can affect the cluster
+						In: true,
+						// The cluster won't affect the security group
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if link := elasticacheEndpointLink(cluster.ConfigurationEndpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		for _, node := range cluster.CacheNodes {
+			if link := elasticacheEndpointLink(node.Endpoint); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if cluster.NotificationConfiguration != nil && cluster.NotificationConfiguration.TopicArn != nil {
+			if a, err := adapterhelpers.ParseARN(*cluster.NotificationConfiguration.TopicArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sns-topic",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *cluster.NotificationConfiguration.TopicArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The topic can't affect the cluster
+						In: false,
+						// The cluster sends notifications to the topic
+						Out: true,
+					},
+				})
+			}
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheLogDeliveryLinks(scope, cluster.LogDeliveryConfigurations)...)
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheCacheClusterAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheClustersInput, *elasticache.DescribeCacheClustersOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheClustersInput, *elasticache.DescribeCacheClustersOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-cache-cluster",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: cacheClusterAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeCacheClustersInput) adapterhelpers.Paginator[*elasticache.DescribeCacheClustersOutput, *elasticache.Options] {
+			return elasticache.NewDescribeCacheClustersPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+			return client.DescribeCacheClusters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeCacheClustersInput, error) {
+			return &elasticache.DescribeCacheClustersInput{
+				CacheClusterId: &query,
+				// Without this the node endpoints aren't returned
+				ShowCacheNodeInfo: adapterhelpers.PtrBool(true),
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeCacheClustersInput, error) {
+			return &elasticache.DescribeCacheClustersInput{
+				ShowCacheNodeInfo: adapterhelpers.PtrBool(true),
+			}, nil
+		},
+		OutputMapper: cacheClusterOutputMapper,
+	}
+}
+
+var cacheClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-cache-cluster",
+	DescriptiveName: "ElastiCache Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cache cluster by ID",
+		ListDescription:   "List all cache clusters",
+		SearchDescription: "Search for cache clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_elasticache_cluster.cluster_id",
+		},
+	},
+	PotentialLinks: []string{"elasticache-replication-group", "elasticache-subnet-group", "ec2-security-group", "dns", "sns-topic", "logs-log-group", "firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var _ = Metadata.RegisterSchema(cacheClusterAdapterMetadata, sdp.AttributeSchemaFor(types.CacheCluster{}))
@@ -0,0 +1,153 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCacheClusterOutputMapper(t *testing.T) {
+	output := elasticache.DescribeCacheClustersOutput{
+		CacheClusters: []types.CacheCluster{
+			{
+				CacheClusterId:       adapterhelpers.PtrString("sessions-001"),
+				CacheClusterStatus:   adapterhelpers.PtrString("available"),
+				CacheNodeType:        adapterhelpers.PtrString("cache.t4g.micro"),
+				Engine:               adapterhelpers.PtrString("redis"),
+				EngineVersion:        adapterhelpers.PtrString("7.1.0"),
+				NumCacheNodes:        adapterhelpers.PtrInt32(1),
+				ReplicationGroupId:   adapterhelpers.PtrString("sessions"),      // link
+				CacheSubnetGroupName: adapterhelpers.PtrString("redis-subnets"), // link
+				SecurityGroups: []types.SecurityGroupMembership{
+					{
+						SecurityGroupId: adapterhelpers.PtrString("sg-0b5b3b0d2e1f0a1b2"), // link
+						Status:          adapterhelpers.PtrString("active"),
+					},
+				},
+				CacheNodes: []types.CacheNode{
+					{
+						CacheNodeId:     adapterhelpers.PtrString("0001"),
+						CacheNodeStatus: adapterhelpers.PtrString("available"),
+						Endpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("sessions-001.abc123.0001.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+					},
+				},
+				NotificationConfiguration: &types.NotificationConfiguration{
+					TopicArn:    adapterhelpers.PtrString("arn:aws:sns:eu-west-2:052392120703:cache-events"), // link
+					TopicStatus: adapterhelpers.PtrString("active"),
+				},
+				LogDeliveryConfigurations: []types.LogDeliveryConfiguration{
+					{
+						DestinationType: types.DestinationTypeCloudWatchLogs,
+						DestinationDetails: &types.DestinationDetails{
+							CloudWatchLogsDetails: &types.CloudWatchLogsDestinationDetails{
+								LogGroup: adapterhelpers.PtrString("/elasticache/sessions/slow"), // link
+							},
+						},
+						LogType: types.LogTypeSlowLog,
+					},
+					{
+						DestinationType: types.DestinationTypeKinesisFirehose,
+						DestinationDetails: &types.DestinationDetails{
+							KinesisFirehoseDetails: &types.KinesisFirehoseDestinationDetails{
+								DeliveryStream: adapterhelpers.PtrString("cache-engine-logs"), // link
+							},
+						},
+						LogType: types.LogTypeEngineLog,
+					},
+				},
+				ARN:                    adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:cluster:sessions-001"),
+				CacheClusterCreateTime: adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+	}
+
+	items, err := cacheClusterOutputMapper(context.Background(), mockElastiCacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticache-replication-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sessions",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-subnet-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis-subnets",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b5b3b0d2e1f0a1b2",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sessions-001.abc123.0001.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:052392120703:cache-events",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/elasticache/sessions/slow",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "cache-engine-logs",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheCacheClusterAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheCacheClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func replicationGroupOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeReplicationGroupsInput, output *elasticache.DescribeReplicationGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, group := range output.ReplicationGroups {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(group)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-replication-group",
+			UniqueAttribute: "ReplicationGroupId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheTags(ctx, client, group.ARN),
+			Health:          elasticacheStatusToHealth(group.Status),
+		}
+
+		// The security groups and subnets are configured on the member
+		// clusters rather than the group itself
+		for _, member := range group.MemberClusters {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-cache-cluster",
+					Method: sdp.QueryMethod_GET,
+					Query:  member,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Tightly coupled
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if link := elasticacheEndpointLink(group.ConfigurationEndpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		for _, nodeGroup := range group.NodeGroups {
+			for _, endpoint := range []*types.Endpoint{nodeGroup.PrimaryEndpoint, nodeGroup.ReaderEndpoint} {
+				if link := elasticacheEndpointLink(endpoint); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+
+			for _, member := range nodeGroup.NodeGroupMembers {
+				if link := elasticacheEndpointLink(member.ReadEndpoint); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+
+		if group.KmsKeyId != nil {
+			if link := kmsKeyLink(scope, *group.KmsKeyId); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheLogDeliveryLinks(scope, group.LogDeliveryConfigurations)...)
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheReplicationGroupAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeReplicationGroupsInput, *elasticache.DescribeReplicationGroupsOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeReplicationGroupsInput, *elasticache.DescribeReplicationGroupsOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-replication-group",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: replicationGroupAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeReplicationGroupsInput) adapterhelpers.Paginator[*elasticache.DescribeReplicationGroupsOutput, *elasticache.Options] {
+			return elasticache.NewDescribeReplicationGroupsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
+			return client.DescribeReplicationGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeReplicationGroupsInput, error) {
+			return &elasticache.DescribeReplicationGroupsInput{
+				ReplicationGroupId: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeReplicationGroupsInput, error) {
+			return &elasticache.DescribeReplicationGroupsInput{}, nil
+		},
+		OutputMapper: replicationGroupOutputMapper,
+	}
+}
+
+var replicationGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-replication-group",
+	DescriptiveName: "ElastiCache Replication Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a replication group by ID",
+		ListDescription:   "List all replication groups",
+		SearchDescription: "Search for replication groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_elasticache_replication_group.id",
+		},
+	},
+	PotentialLinks: []string{"elasticache-cache-cluster", "dns", "kms-key", "logs-log-group", "firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var _ = Metadata.RegisterSchema(replicationGroupAdapterMetadata, sdp.AttributeSchemaFor(types.ReplicationGroup{}))
@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestReplicationGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []types.ReplicationGroup{
+			{
+				ReplicationGroupId: adapterhelpers.PtrString("sessions"),
+				Description:        adapterhelpers.PtrString("Session store"),
+				Status:             adapterhelpers.PtrString("modifying"),
+				MemberClusters: []string{
+					"sessions-001", // link
+					"sessions-002", // link
+				},
+				NodeGroups: []types.NodeGroup{
+					{
+						NodeGroupId: adapterhelpers.PtrString("0001"),
+						Status:      adapterhelpers.PtrString("available"),
+						PrimaryEndpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("master.sessions.abc123.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+						ReaderEndpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("replica.sessions.abc123.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+						NodeGroupMembers: []types.NodeGroupMember{
+							{
+								CacheClusterId: adapterhelpers.PtrString("sessions-001"),
+								CacheNodeId:    adapterhelpers.PtrString("0001"),
+								ReadEndpoint: &types.Endpoint{
+									Address: adapterhelpers.PtrString("sessions-001.sessions.abc123.euw2.cache.amazonaws.com"), // link
+									Port:    adapterhelpers.PtrInt32(6379),
+								},
+								CurrentRole: adapterhelpers.PtrString("primary"),
+							},
+						},
+					},
+				},
+				AtRestEncryptionEnabled: adapterhelpers.PtrBool(true),
+				KmsKeyId:                adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+				LogDeliveryConfigurations: []types.LogDeliveryConfiguration{
+					{
+						DestinationType: types.DestinationTypeCloudWatchLogs,
+						DestinationDetails: &types.DestinationDetails{
+							CloudWatchLogsDetails: &types.CloudWatchLogsDestinationDetails{
+								LogGroup: adapterhelpers.PtrString("/elasticache/sessions/engine"), // link
+							},
+						},
+						LogType: types.LogTypeEngineLog,
+					},
+				},
+				ARN:                        adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:replicationgroup:sessions"),
+				ReplicationGroupCreateTime: adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+	}
+
+	items, err := replicationGroupOutputMapper(context.Background(), mockElastiCacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticache-cache-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sessions-001",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-cache-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sessions-002",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "master.sessions.abc123.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "replica.sessions.abc123.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sessions-001.sessions.abc123.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/elasticache/sessions/engine",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheReplicationGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheReplicationGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
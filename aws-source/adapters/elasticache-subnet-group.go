@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func cacheSubnetGroupOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeCacheSubnetGroupsInput, output *elasticache.DescribeCacheSubnetGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, group := range output.CacheSubnetGroups {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(group)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-subnet-group",
+			UniqueAttribute: "CacheSubnetGroupName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheTags(ctx, client, group.ARN),
+		}
+
+		if group.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *group.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the VPC can affect the subnet group
+					In: true,
+					// The subnet group won't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnet := range group.Subnets {
+			if subnet.SubnetIdentifier != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-subnet",
+						Method: sdp.QueryMethod_GET,
+						Query:  *subnet.SubnetIdentifier,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the subnet can affect the subnet group
+						In: true,
+						// The subnet group won't affect the subnet
+						Out: false,
+					},
+				})
+			}
+
+			if subnet.SubnetOutpost != nil && subnet.SubnetOutpost.SubnetOutpostArn != nil {
+				if a, err := adapterhelpers.ParseARN(*subnet.SubnetOutpost.SubnetOutpostArn); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "outposts-outpost",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *subnet.SubnetOutpost.SubnetOutpostArn,
+							Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changing the outpost can affect the subnet group
+							In: true,
+							// The subnet group won't affect the outpost
+							Out: false,
+						},
+					})
+				}
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheSubnetGroupAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheSubnetGroupsInput, *elasticache.DescribeCacheSubnetGroupsOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheSubnetGroupsInput, *elasticache.DescribeCacheSubnetGroupsOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-subnet-group",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: cacheSubnetGroupAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeCacheSubnetGroupsInput) adapterhelpers.Paginator[*elasticache.DescribeCacheSubnetGroupsOutput, *elasticache.Options] {
+			return elasticache.NewDescribeCacheSubnetGroupsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeCacheSubnetGroupsInput) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+			return client.DescribeCacheSubnetGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeCacheSubnetGroupsInput, error) {
+			return &elasticache.DescribeCacheSubnetGroupsInput{
+				CacheSubnetGroupName: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeCacheSubnetGroupsInput, error) {
+			return &elasticache.DescribeCacheSubnetGroupsInput{}, nil
+		},
+		OutputMapper: cacheSubnetGroupOutputMapper,
+	}
+}
+
+var cacheSubnetGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-subnet-group",
+	DescriptiveName: "ElastiCache Subnet Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a subnet group by name",
+		ListDescription:   "List all subnet groups",
+		SearchDescription: "Search for subnet groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_elasticache_subnet_group.name",
+		},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "outposts-outpost"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(cacheSubnetGroupAdapterMetadata, sdp.AttributeSchemaFor(types.CacheSubnetGroup{}))
@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestCacheSubnetGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeCacheSubnetGroupsOutput{
+		CacheSubnetGroups: []types.CacheSubnetGroup{
+			{
+				CacheSubnetGroupName:        adapterhelpers.PtrString("redis-subnets"),
+				CacheSubnetGroupDescription: adapterhelpers.PtrString("Subnets for redis"),
+				VpcId:                       adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+				Subnets: []types.Subnet{
+					{
+						SubnetIdentifier: adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+						SubnetAvailabilityZone: &types.AvailabilityZone{
+							Name: adapterhelpers.PtrString("eu-west-2c"),
+						},
+						SubnetOutpost: &types.SubnetOutpost{
+							SubnetOutpostArn: adapterhelpers.PtrString("arn:aws:outposts:eu-west-2:052392120703:outpost/op-0ab1234567890abcd"), // link
+						},
+						SupportedNetworkTypes: []types.NetworkType{
+							types.NetworkTypeIpv4,
+						},
+					},
+				},
+				ARN: adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:subnetgroup:redis-subnets"),
+			},
+		},
+	}
+
+	items, err := cacheSubnetGroupOutputMapper(context.Background(), mockElastiCacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["key"] != "value" {
+		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "outposts-outpost",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:outposts:eu-west-2:052392120703:outpost/op-0ab1234567890abcd",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheSubnetGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheSubnetGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
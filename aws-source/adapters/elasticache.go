@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type elasticacheClient interface {
+	DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+	DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error)
+	DescribeReplicationGroups(ctx context.Context, params *elasticache.DescribeReplicationGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error)
+	ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error)
+}
+
+// Gets the tags for an ElastiCache resource. Tags can't be listed for clusters
+// that aren't available, in which case we return the error as a tag like
+// everywhere else
+func elasticacheTags(ctx context.Context, client elasticacheClient, arn *string) map[string]string {
+	out, err := client.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
+		ResourceName: arn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err)
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.TagList {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags
+}
+
+func elasticacheEndpointLink(endpoint *types.Endpoint) *sdp.LinkedItemQuery {
+	if endpoint == nil || endpoint.Address == nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "dns",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *endpoint.Address,
+			Scope:  "global",
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// DNS always linked
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+// Links to the destinations that engine and slow logs are delivered to
+func elasticacheLogDeliveryLinks(scope string, configs []types.LogDeliveryConfiguration) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, config := range configs {
+		if config.DestinationDetails == nil {
+			continue
+		}
+
+		var query *sdp.Query
+		switch config.DestinationType {
+		case types.DestinationTypeCloudWatchLogs:
+			if details := config.DestinationDetails.CloudWatchLogsDetails; details != nil && details.LogGroup != nil {
+				query = &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *details.LogGroup,
+					Scope:  scope,
+				}
+			}
+		case types.DestinationTypeKinesisFirehose:
+			if details := config.DestinationDetails.KinesisFirehoseDetails; details != nil && details.DeliveryStream != nil {
+				query = &sdp.Query{
+					Type:   "firehose-delivery-stream",
+					Method: sdp.QueryMethod_GET,
+					Query:  *details.DeliveryStream,
+					Scope:  scope,
+				}
+			}
+		}
+
+		if query == nil {
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: query,
+			BlastPropagation: &sdp.BlastPropagation{
+				// The destination can't affect the cache
+				In: false,
+				// The cache sends logs to the destination
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+)
+
+type mockElastiCacheClient struct{}
+
+func (m mockElastiCacheClient) DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+	return nil, nil
+}
+
+func (m mockElastiCacheClient) DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElastiCacheClient) DescribeReplicationGroups(ctx context.Context, params *elasticache.DescribeReplicationGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElastiCacheClient) ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error) {
+	return &elasticache.ListTagsForResourceOutput{
+		TagList: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("key"),
+				Value: adapterhelpers.PtrString("value"),
+			},
+		},
+	}, nil
+}
+
+func elasticacheGetAutoConfig(t *testing.T) (*elasticache.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := elasticache.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -136,3 +136,5 @@ var instanceHealthAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var _ = Metadata.RegisterSchema(instanceHealthAdapterMetadata, sdp.AttributeSchemaFor(types.InstanceState{}))
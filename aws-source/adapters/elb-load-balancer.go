@@ -222,3 +222,5 @@ var elbLoadBalancerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"dns", "route53-hosted-zone", "ec2-subnet", "ec2-vpc", "ec2-instance", "elb-instance-health", "ec2-security-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(elbLoadBalancerAdapterMetadata, sdp.AttributeSchemaFor(types.LoadBalancerDescription{}))
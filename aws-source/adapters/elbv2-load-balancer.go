@@ -4,6 +4,7 @@ import (
 	"context"
 
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -72,6 +73,26 @@ func elbv2LoadBalancerOutputMapper(ctx context.Context, client elbv2Client, scop
 					Out: true,
 				},
 			})
+
+			if lb.Type == types.LoadBalancerTypeEnumApplication {
+				// Only application load balancers can have a web ACL, which
+				// isn't returned by the ELB API so we search for it
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "wafv2-web-acl",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *lb.LoadBalancerArn,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the ACL changes which requests reach the
+						// load balancer
+						In: true,
+						// The load balancer can't affect the ACL
+						Out: false,
+					},
+				})
+			}
 		}
 
 		if lb.DNSName != nil {
@@ -301,6 +322,8 @@ var loadBalancerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_GET,
 		},
 	},
-	PotentialLinks: []string{"elbv2-target-group", "elbv2-listener", "dns", "route53-hosted-zone", "ec2-vpc", "ec2-subnet", "ec2-address", "ip", "ec2-security-group", "ec2-coip-pool"},
+	PotentialLinks: []string{"elbv2-target-group", "elbv2-listener", "dns", "route53-hosted-zone", "ec2-vpc", "ec2-subnet", "ec2-address", "ip", "ec2-security-group", "ec2-coip-pool", "wafv2-web-acl"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(loadBalancerAdapterMetadata, sdp.AttributeSchemaFor(types.LoadBalancer{}))
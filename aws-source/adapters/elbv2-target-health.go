@@ -268,3 +268,5 @@ var targetHealthAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance", "lambda-function", "ip", "elbv2-load-balancer"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var _ = Metadata.RegisterSchema(targetHealthAdapterMetadata, sdp.AttributeSchemaFor(types.TargetHealthDescription{}).WithProperty("UniqueId", stringAttribute))
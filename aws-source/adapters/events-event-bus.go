@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func eventBusGetFunc(ctx context.Context, client eventsClient, scope, query string) (*eventbridge.DescribeEventBusOutput, error) {
+	return client.DescribeEventBus(ctx, &eventbridge.DescribeEventBusInput{
+		Name: &query,
+	})
+}
+
+func eventBusListFunc(ctx context.Context, client eventsClient, scope string) ([]*eventbridge.DescribeEventBusOutput, error) {
+	names, err := listEventBusNames(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	buses := make([]*eventbridge.DescribeEventBusOutput, 0, len(names))
+	for _, name := range names {
+		bus, err := eventBusGetFunc(ctx, client, scope, name)
+		if err != nil {
+			return nil, err
+		}
+
+		buses = append(buses, bus)
+	}
+
+	return buses, nil
+}
+
+func eventBusItemMapper(_, scope string, awsItem *eventbridge.DescribeEventBusOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "events-event-bus",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-rule",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Rules can't affect the bus
+				In: false,
+				// Deleting the bus deletes its rules
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.KmsKeyIdentifier != nil {
+		if link := kmsKeyLink(scope, *awsItem.KmsKeyIdentifier); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.DeadLetterConfig != nil && awsItem.DeadLetterConfig.Arn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.DeadLetterConfig.Arn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sqs-queue",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.DeadLetterConfig.Arn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Events that can't be delivered are sent to the queue
+					In:  false,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.Policy != nil && awsItem.Arn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, linksFromResourcePolicy(*awsItem.Policy, *awsItem.Arn)...)
+	}
+
+	return &item, nil
+}
+
+func eventBusListTagsFunc(ctx context.Context, bus *eventbridge.DescribeEventBusOutput, client eventsClient) (map[string]string, error) {
+	tags, err := eventsTags(ctx, client, bus.Arn)
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	return tags, nil
+}
+
+func NewEventsEventBusAdapter(client eventsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*eventbridge.DescribeEventBusOutput, eventsClient, *eventbridge.Options] {
+	return &adapterhelpers.GetListAdapter[*eventbridge.DescribeEventBusOutput, eventsClient, *eventbridge.Options]{
+		ItemType:        "events-event-bus",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: eventBusAdapterMetadata,
+		GetFunc:         eventBusGetFunc,
+		ListFunc:        eventBusListFunc,
+		ItemMapper:      eventBusItemMapper,
+		ListTagsFunc:    eventBusListTagsFunc,
+	}
+}
+
+var eventBusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "events-event-bus",
+	DescriptiveName: "EventBridge Event Bus",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an event bus by name",
+		ListDescription:   "List all event buses",
+		SearchDescription: "Search for an event bus by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_cloudwatch_event_bus.name",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_cloudwatch_event_bus_policy.event_bus_name",
+		},
+	},
+	PotentialLinks: []string{"events-rule", "kms-key", "sqs-queue", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var _ = Metadata.RegisterSchema(eventBusAdapterMetadata, sdp.AttributeSchemaFor(eventbridge.DescribeEventBusOutput{}))
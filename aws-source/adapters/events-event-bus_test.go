@@ -0,0 +1,251 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testEventsClient struct{}
+
+func (t testEventsClient) DescribeEventBus(ctx context.Context, params *eventbridge.DescribeEventBusInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeEventBusOutput, error) {
+	switch *params.Name {
+	case "default":
+		return &eventbridge.DescribeEventBusOutput{
+			Name: aws.String("default"),
+			Arn:  aws.String("arn:aws:events:eu-west-2:123456789012:event-bus/default"),
+		}, nil
+	case "orders":
+		return &eventbridge.DescribeEventBusOutput{
+			Name:             aws.String("orders"),
+			Arn:              aws.String("arn:aws:events:eu-west-2:123456789012:event-bus/orders"),
+			Description:      aws.String("Order events"),
+			KmsKeyIdentifier: aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			DeadLetterConfig: &types.DeadLetterConfig{
+				Arn: aws.String("arn:aws:sqs:eu-west-2:123456789012:orders-dlq"),
+			},
+			Policy:       aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:role/publisher"},"Action":"events:PutEvents","Resource":"arn:aws:events:eu-west-2:123456789012:event-bus/orders"}]}`),
+			CreationTime: aws.Time(time.Now()),
+		}, nil
+	default:
+		return nil, &types.ResourceNotFoundException{
+			Message: aws.String("event bus not found"),
+		}
+	}
+}
+
+func (t testEventsClient) DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error) {
+	for _, rule := range testEventsRules(*params.EventBusName) {
+		if *rule.Name == *params.Name {
+			return &eventbridge.DescribeRuleOutput{
+				Arn:          rule.Arn,
+				Name:         rule.Name,
+				EventBusName: rule.EventBusName,
+				EventPattern: rule.EventPattern,
+				RoleArn:      rule.RoleArn,
+				State:        rule.State,
+			}, nil
+		}
+	}
+
+	return nil, &types.ResourceNotFoundException{
+		Message: aws.String("rule not found"),
+	}
+}
+
+func (t testEventsClient) ListEventBuses(ctx context.Context, params *eventbridge.ListEventBusesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListEventBusesOutput, error) {
+	// Return the buses over two pages
+	if params.NextToken == nil {
+		return &eventbridge.ListEventBusesOutput{
+			EventBuses: []types.EventBus{
+				{Name: aws.String("default")},
+			},
+			NextToken: aws.String("page2"),
+		}, nil
+	}
+
+	return &eventbridge.ListEventBusesOutput{
+		EventBuses: []types.EventBus{
+			{Name: aws.String("orders")},
+		},
+	}, nil
+}
+
+func testEventsRules(busName string) []types.Rule {
+	switch busName {
+	case "default":
+		return []types.Rule{
+			{
+				Name:               aws.String("nightly"),
+				Arn:                aws.String("arn:aws:events:eu-west-2:123456789012:rule/nightly"),
+				EventBusName:       aws.String("default"),
+				ScheduleExpression: aws.String("cron(0 2 * * ? *)"),
+				State:              types.RuleStateDisabled,
+			},
+		}
+	case "orders":
+		return []types.Rule{
+			{
+				Name:         aws.String("order-placed"),
+				Arn:          aws.String("arn:aws:events:eu-west-2:123456789012:rule/orders/order-placed"),
+				EventBusName: aws.String("orders"),
+				EventPattern: aws.String(`{"detail-type":["OrderPlaced"]}`),
+				RoleArn:      aws.String("arn:aws:iam::123456789012:role/orders-forwarder"),
+				State:        types.RuleStateEnabled,
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+func (t testEventsClient) ListRules(ctx context.Context, params *eventbridge.ListRulesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRulesOutput, error) {
+	return &eventbridge.ListRulesOutput{
+		Rules: testEventsRules(*params.EventBusName),
+	}, nil
+}
+
+func (t testEventsClient) ListTargetsByRule(ctx context.Context, params *eventbridge.ListTargetsByRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTargetsByRuleOutput, error) {
+	if *params.Rule != "order-placed" {
+		return &eventbridge.ListTargetsByRuleOutput{}, nil
+	}
+
+	return &eventbridge.ListTargetsByRuleOutput{
+		Targets: []types.Target{
+			{
+				Id:  aws.String("lambda"),
+				Arn: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:process-order"),
+				DeadLetterConfig: &types.DeadLetterConfig{
+					Arn: aws.String("arn:aws:sqs:eu-west-2:123456789012:orders-dlq"),
+				},
+			},
+			{
+				Id:  aws.String("queue"),
+				Arn: aws.String("arn:aws:sqs:eu-west-2:123456789012:fulfilment"),
+			},
+			{
+				Id:  aws.String("topic"),
+				Arn: aws.String("arn:aws:sns:eu-west-2:123456789012:order-notifications"),
+			},
+			{
+				Id:      aws.String("workflow"),
+				Arn:     aws.String("arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow"),
+				RoleArn: aws.String("arn:aws:iam::123456789012:role/events-invoke-sfn"),
+			},
+			{
+				Id:  aws.String("logs"),
+				Arn: aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/events/orders:*"),
+			},
+			{
+				Id:      aws.String("task"),
+				Arn:     aws.String("arn:aws:ecs:eu-west-2:123456789012:cluster/prod"),
+				RoleArn: aws.String("arn:aws:iam::123456789012:role/events-run-task"),
+				EcsParameters: &types.EcsParameters{
+					TaskDefinitionArn: aws.String("arn:aws:ecs:eu-west-2:123456789012:task-definition/invoice:4"),
+				},
+			},
+			{
+				// Not a service that we have an adapter for
+				Id:  aws.String("api"),
+				Arn: aws.String("arn:aws:events:eu-west-2:123456789012:api-destination/partner/abc"),
+			},
+		},
+	}, nil
+}
+
+func (t testEventsClient) ListTagsForResource(ctx context.Context, params *eventbridge.ListTagsForResourceInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTagsForResourceOutput, error) {
+	return &eventbridge.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("orders"),
+			},
+		},
+	}, nil
+}
+
+func TestEventBusItemMapper(t *testing.T) {
+	bus, err := eventBusGetFunc(context.Background(), testEventsClient{}, "123456789012.eu-west-2", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := eventBusItemMapper("", "123456789012.eu-west-2", bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "events-rule",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:orders-dlq",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			// From the resource policy
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::210987654321:role/publisher",
+			ExpectedScope:  "210987654321",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestEventBusListFunc(t *testing.T) {
+	adapter := NewEventsEventBusAdapter(testEventsClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	if items[0].GetTags()["team"] != "orders" {
+		t.Errorf("expected team tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewEventsEventBusAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := eventbridge.NewFromConfig(config)
+
+	adapter := NewEventsEventBusAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
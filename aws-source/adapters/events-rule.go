@@ -0,0 +1,351 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// eventsRule is a rule along with its targets, which need to be fetched
+// separately
+type eventsRule struct {
+	*types.Rule
+	Targets []types.Target
+
+	// Rule names are only unique within a bus, so rules on any bus other
+	// than the default use the same {eventBusName}/{ruleName} format as the
+	// ARN
+	UniqueName string
+}
+
+// Splits a rule query in the format {eventBusName}/{ruleName} into its parts.
+// Bus names can contain slashes, rule names can't, so we split on the last one
+func parseEventsRuleQuery(query string) (busName string, ruleName string) {
+	i := strings.LastIndex(query, "/")
+	if i == -1 {
+		return defaultEventBusName, query
+	}
+
+	return query[:i], query[i+1:]
+}
+
+func eventsRuleUniqueName(busName, ruleName string) string {
+	if busName == "" || busName == defaultEventBusName {
+		return ruleName
+	}
+
+	return busName + "/" + ruleName
+}
+
+func eventsRuleWithTargets(ctx context.Context, client eventsClient, rule *types.Rule) (*eventsRule, error) {
+	targets := make([]types.Target, 0)
+	input := &eventbridge.ListTargetsByRuleInput{
+		Rule:         rule.Name,
+		EventBusName: rule.EventBusName,
+	}
+
+	for {
+		out, err := client.ListTargetsByRule(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, out.Targets...)
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	var busName, ruleName string
+	if rule.EventBusName != nil {
+		busName = *rule.EventBusName
+	}
+	if rule.Name != nil {
+		ruleName = *rule.Name
+	}
+
+	return &eventsRule{
+		Rule:       rule,
+		Targets:    targets,
+		UniqueName: eventsRuleUniqueName(busName, ruleName),
+	}, nil
+}
+
+func eventsRuleGetFunc(ctx context.Context, client eventsClient, scope, query string) (*eventsRule, error) {
+	busName, ruleName := parseEventsRuleQuery(query)
+
+	out, err := client.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name:         &ruleName,
+		EventBusName: &busName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsRuleWithTargets(ctx, client, &types.Rule{
+		Arn:                out.Arn,
+		Description:        out.Description,
+		EventBusName:       out.EventBusName,
+		EventPattern:       out.EventPattern,
+		ManagedBy:          out.ManagedBy,
+		Name:               out.Name,
+		RoleArn:            out.RoleArn,
+		ScheduleExpression: out.ScheduleExpression,
+		State:              out.State,
+	})
+}
+
+// Lists all of the rules on a single bus
+func eventsRulesOnBus(ctx context.Context, client eventsClient, busName string) ([]*eventsRule, error) {
+	rules := make([]*eventsRule, 0)
+	input := &eventbridge.ListRulesInput{
+		EventBusName: &busName,
+	}
+
+	for {
+		out, err := client.ListRules(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.Rules {
+			rule, err := eventsRuleWithTargets(ctx, client, &out.Rules[i])
+			if err != nil {
+				return nil, err
+			}
+
+			rules = append(rules, rule)
+		}
+
+		if out.NextToken == nil {
+			return rules, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func eventsRuleListFunc(ctx context.Context, client eventsClient, scope string) ([]*eventsRule, error) {
+	busNames, err := listEventBusNames(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*eventsRule, 0)
+	for _, busName := range busNames {
+		busRules, err := eventsRulesOnBus(ctx, client, busName)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, busRules...)
+	}
+
+	return rules, nil
+}
+
+// Searches for a rule by ARN, or for all of the rules on a bus by the bus name
+func eventsRuleSearchFunc(ctx context.Context, client eventsClient, scope, query string) ([]*eventsRule, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		if adapterhelpers.FormatScope(a.AccountID, a.Region) != scope {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOSCOPE,
+				ErrorString: "ARN is not in scope " + scope,
+			}
+		}
+
+		rule, err := eventsRuleGetFunc(ctx, client, scope, a.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*eventsRule{rule}, nil
+	}
+
+	return eventsRulesOnBus(ctx, client, query)
+}
+
+func eventsRuleItemMapper(_, scope string, awsItem *eventsRule) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "events-rule",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Disabled rules are deliberately switched off so we don't report a health
+	// for them
+	switch awsItem.State {
+	case types.RuleStateEnabled, types.RuleStateEnabledWithAllCloudtrailManagementEvents:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if awsItem.EventBusName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-event-bus",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.EventBusName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The rule only sees the events that are sent to the bus
+				In: true,
+				// The rule can't affect the bus
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.RoleArn != nil {
+		if link := eventsRoleLink(*awsItem.RoleArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	for _, target := range awsItem.Targets {
+		if link := eventsTargetLink(target); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		if target.RoleArn != nil {
+			if link := eventsRoleLink(*target.RoleArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.DeadLetterConfig != nil && target.DeadLetterConfig.Arn != nil {
+			if a, err := adapterhelpers.ParseARN(*target.DeadLetterConfig.Arn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sqs-queue",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *target.DeadLetterConfig.Arn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Events that can't be delivered to the target are
+						// sent to the queue
+						In:  false,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if target.EcsParameters != nil && target.EcsParameters.TaskDefinitionArn != nil {
+			if a, err := adapterhelpers.ParseARN(*target.EcsParameters.TaskDefinitionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-task-definition",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *target.EcsParameters.TaskDefinitionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The rule starts tasks from the task definition
+						In:  false,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+// Links to a role that EventBridge assumes, either to send events to another
+// bus or to invoke a target
+func eventsRoleLink(roleARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(roleARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  roleARN,
+			Scope:  a.AccountID,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's permissions can stop the rule delivering
+			// events
+			In: true,
+			// The rule can't affect the role
+			Out: false,
+		},
+	}
+}
+
+func eventsRuleListTagsFunc(ctx context.Context, rule *eventsRule, client eventsClient) (map[string]string, error) {
+	tags, err := eventsTags(ctx, client, rule.Arn)
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	return tags, nil
+}
+
+func NewEventsRuleAdapter(client eventsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*eventsRule, eventsClient, *eventbridge.Options] {
+	return &adapterhelpers.GetListAdapter[*eventsRule, eventsClient, *eventbridge.Options]{
+		ItemType:        "events-rule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: eventsRuleAdapterMetadata,
+		GetFunc:         eventsRuleGetFunc,
+		ListFunc:        eventsRuleListFunc,
+		SearchFunc:      eventsRuleSearchFunc,
+		ItemMapper:      eventsRuleItemMapper,
+		ListTagsFunc:    eventsRuleListTagsFunc,
+	}
+}
+
+var eventsRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "events-rule",
+	DescriptiveName: "EventBridge Rule",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a rule by name, or by {eventBusName}/{ruleName} for rules that aren't on the default bus",
+		ListDescription:   "List all rules on all event buses",
+		SearchDescription: "Search for a rule by ARN, or for all rules on an event bus by the bus name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_event_rule.arn",
+		},
+	},
+	PotentialLinks: []string{
+		"events-event-bus",
+		"iam-role",
+		"lambda-function",
+		"sqs-queue",
+		"sns-topic",
+		"states-state-machine",
+		"kinesis-stream",
+		"firehose-delivery-stream",
+		"logs-log-group",
+		"ecs-cluster",
+		"ecs-task-definition",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var _ = Metadata.RegisterSchema(eventsRuleAdapterMetadata, sdp.AttributeSchemaFor(eventsRule{}))
@@ -0,0 +1,211 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestParseEventsRuleQuery(t *testing.T) {
+	tests := []struct {
+		Query string
+		Bus   string
+		Rule  string
+	}{
+		{Query: "nightly", Bus: "default", Rule: "nightly"},
+		{Query: "orders/order-placed", Bus: "orders", Rule: "order-placed"},
+		{Query: "aws.partner/example.com/123/events/sync", Bus: "aws.partner/example.com/123/events", Rule: "sync"},
+	}
+
+	for _, test := range tests {
+		bus, rule := parseEventsRuleQuery(test.Query)
+		if bus != test.Bus || rule != test.Rule {
+			t.Errorf("expected %v to parse to %v and %v, got %v and %v", test.Query, test.Bus, test.Rule, bus, rule)
+		}
+	}
+}
+
+func TestEventsRuleItemMapper(t *testing.T) {
+	rule, err := eventsRuleGetFunc(context.Background(), testEventsClient{}, "123456789012.eu-west-2", "orders/order-placed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := eventsRuleItemMapper("", "123456789012.eu-west-2", rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "orders/order-placed" {
+		t.Errorf("expected unique attribute value orders/order-placed, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/orders-forwarder",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:process-order",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:orders-dlq",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:fulfilment",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:order-notifications",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "states-state-machine",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/events-invoke-sfn",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/events/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-cluster",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:cluster/prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-task-definition",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:task-definition/invoice:4",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/events-run-task",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The API destination target isn't linked
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestEventsRuleSearchFunc(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	t.Run("by bus name", func(t *testing.T) {
+		rules, err := eventsRuleSearchFunc(context.Background(), testEventsClient{}, scope, "orders")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(rules) != 1 || rules[0].UniqueName != "orders/order-placed" {
+			t.Errorf("expected the order-placed rule, got %v", rules)
+		}
+	})
+
+	t.Run("by ARN on the default bus", func(t *testing.T) {
+		rules, err := eventsRuleSearchFunc(context.Background(), testEventsClient{}, scope, "arn:aws:events:eu-west-2:123456789012:rule/nightly")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(rules) != 1 || rules[0].UniqueName != "nightly" {
+			t.Errorf("expected the nightly rule, got %v", rules)
+		}
+	})
+
+	t.Run("by ARN on a custom bus", func(t *testing.T) {
+		rules, err := eventsRuleSearchFunc(context.Background(), testEventsClient{}, scope, "arn:aws:events:eu-west-2:123456789012:rule/orders/order-placed")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(rules) != 1 || len(rules[0].Targets) != 7 {
+			t.Errorf("expected the order-placed rule with its targets, got %v", rules)
+		}
+	})
+
+	t.Run("by ARN in another scope", func(t *testing.T) {
+		_, err := eventsRuleSearchFunc(context.Background(), testEventsClient{}, scope, "arn:aws:events:us-east-1:123456789012:rule/nightly")
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestEventsRuleListFunc(t *testing.T) {
+	adapter := NewEventsRuleAdapter(testEventsClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// One rule on each bus
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+}
+
+func TestNewEventsRuleAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := eventbridge.NewFromConfig(config)
+
+	adapter := NewEventsRuleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
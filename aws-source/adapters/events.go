@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type eventsClient interface {
+	DescribeEventBus(ctx context.Context, params *eventbridge.DescribeEventBusInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeEventBusOutput, error)
+	DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)
+	ListEventBuses(ctx context.Context, params *eventbridge.ListEventBusesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListEventBusesOutput, error)
+	ListRules(ctx context.Context, params *eventbridge.ListRulesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRulesOutput, error)
+	ListTargetsByRule(ctx context.Context, params *eventbridge.ListTargetsByRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTargetsByRuleOutput, error)
+	ListTagsForResource(ctx context.Context, params *eventbridge.ListTagsForResourceInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTagsForResourceOutput, error)
+}
+
+// The name of the event bus that every account has, which rules are created on
+// if no other bus is specified
+const defaultEventBusName = "default"
+
+func eventsTags(ctx context.Context, client eventsClient, arn *string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &eventbridge.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+// Lists the names of all event buses in the account, including the default
+// bus. The API doesn't have a paginator so we need to handle the tokens
+// ourselves
+func listEventBusNames(ctx context.Context, client eventsClient) ([]string, error) {
+	names := make([]string, 0)
+	input := &eventbridge.ListEventBusesInput{}
+
+	for {
+		out, err := client.ListEventBuses(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bus := range out.EventBuses {
+			if bus.Name != nil {
+				names = append(names, *bus.Name)
+			}
+		}
+
+		if out.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// Links from a rule to one of its targets. Targets are always ARNs, and only
+// the services that we have adapters for are linked
+func eventsTargetLink(target types.Target) *sdp.LinkedItemQuery {
+	if target.Arn == nil {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(*target.Arn)
+	if err != nil {
+		return nil
+	}
+
+	var queryType string
+	switch a.Service {
+	case "lambda":
+		queryType = "lambda-function"
+	case "sqs":
+		queryType = "sqs-queue"
+	case "sns":
+		queryType = "sns-topic"
+	case "states":
+		queryType = "states-state-machine"
+	case "events":
+		if a.Type() != "event-bus" {
+			return nil
+		}
+		queryType = "events-event-bus"
+	case "kinesis":
+		queryType = "kinesis-stream"
+	case "firehose":
+		queryType = "firehose-delivery-stream"
+	case "logs":
+		// Log groups are looked up by name rather than ARN
+		name := strings.TrimSuffix(strings.TrimPrefix(a.Resource, "log-group:"), ":*")
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  name,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				In:  false,
+				Out: true,
+			},
+		}
+	case "ecs":
+		queryType = "ecs-cluster"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *target.Arn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The target can't affect the rule
+			In: false,
+			// Changing the rule changes what is sent to the target
+			Out: true,
+		},
+	}
+}
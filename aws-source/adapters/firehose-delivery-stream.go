@@ -0,0 +1,457 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type firehoseClient interface {
+	DescribeDeliveryStream(ctx context.Context, params *firehose.DescribeDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error)
+	ListDeliveryStreams(ctx context.Context, params *firehose.ListDeliveryStreamsInput, optFns ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error)
+	ListTagsForDeliveryStream(ctx context.Context, params *firehose.ListTagsForDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.ListTagsForDeliveryStreamOutput, error)
+}
+
+func firehoseDeliveryStreamGetFunc(ctx context.Context, client firehoseClient, scope, query string) (*types.DeliveryStreamDescription, error) {
+	out, err := client.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DeliveryStreamDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "delivery stream not found",
+		}
+	}
+
+	return out.DeliveryStreamDescription, nil
+}
+
+// The API doesn't have a paginator, instead it pages using the name of the
+// last stream that was returned
+func firehoseDeliveryStreamListFunc(ctx context.Context, client firehoseClient, scope string) ([]*types.DeliveryStreamDescription, error) {
+	streams := make([]*types.DeliveryStreamDescription, 0)
+	input := &firehose.ListDeliveryStreamsInput{}
+
+	for {
+		out, err := client.ListDeliveryStreams(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range out.DeliveryStreamNames {
+			stream, err := firehoseDeliveryStreamGetFunc(ctx, client, scope, name)
+			if err != nil {
+				return nil, err
+			}
+
+			streams = append(streams, stream)
+		}
+
+		if out.HasMoreDeliveryStreams == nil || !*out.HasMoreDeliveryStreams || len(out.DeliveryStreamNames) == 0 {
+			return streams, nil
+		}
+		input.ExclusiveStartDeliveryStreamName = &out.DeliveryStreamNames[len(out.DeliveryStreamNames)-1]
+	}
+}
+
+// The parts of a destination that link to other resources. Every destination
+// type has its own struct but they share these fields
+type firehoseDestinationLinks struct {
+	RoleARN    *string
+	DomainARN  *string
+	S3         []*types.S3DestinationDescription
+	Logging    *types.CloudWatchLoggingOptions
+	Processing *types.ProcessingConfiguration
+}
+
+func firehoseDestinations(destination types.DestinationDescription) []firehoseDestinationLinks {
+	destinations := make([]firehoseDestinationLinks, 0)
+
+	if d := destination.S3DestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			S3: []*types.S3DestinationDescription{d},
+		})
+	}
+	if d := destination.ExtendedS3DestinationDescription; d != nil {
+		// The extended destination has the same fields as a plain S3
+		// destination, plus a backup bucket
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN: d.RoleARN,
+			S3: []*types.S3DestinationDescription{
+				{
+					BucketARN:               d.BucketARN,
+					EncryptionConfiguration: d.EncryptionConfiguration,
+				},
+				d.S3BackupDescription,
+			},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.RedshiftDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription, d.S3BackupDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.ElasticsearchDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			DomainARN:  d.DomainARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.AmazonopensearchserviceDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			DomainARN:  d.DomainARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.AmazonOpenSearchServerlessDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.SplunkDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.HttpEndpointDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.SnowflakeDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+	if d := destination.IcebergDestinationDescription; d != nil {
+		destinations = append(destinations, firehoseDestinationLinks{
+			RoleARN:    d.RoleARN,
+			S3:         []*types.S3DestinationDescription{d.S3DestinationDescription},
+			Logging:    d.CloudWatchLoggingOptions,
+			Processing: d.ProcessingConfiguration,
+		})
+	}
+
+	return destinations
+}
+
+func firehoseRoleLink(roleARN *string) *sdp.LinkedItemQuery {
+	if roleARN == nil {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(*roleARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *roleARN,
+			Scope:  a.AccountID,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's permissions can stop delivery
+			In: true,
+			// The stream can't affect the role
+			Out: false,
+		},
+	}
+}
+
+func firehoseDestinationItemLinks(accountID string, scope string, destination firehoseDestinationLinks) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if link := firehoseRoleLink(destination.RoleARN); link != nil {
+		links = append(links, link)
+	}
+
+	if destination.DomainARN != nil {
+		if a, err := adapterhelpers.ParseARN(*destination.DomainARN); err == nil {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "opensearch-domain",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *destination.DomainARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the domain is unavailable delivery will fail
+					In: true,
+					// The stream writes to the domain
+					Out: true,
+				},
+			})
+		}
+	}
+
+	for _, s3 := range destination.S3 {
+		if s3 == nil {
+			continue
+		}
+
+		if s3.BucketARN != nil {
+			if a, err := adapterhelpers.ParseARN(*s3.BucketARN); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  a.Resource,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the bucket is deleted or its policy changes
+						// delivery will fail
+						In: true,
+						// The stream writes to the bucket
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if link := firehoseRoleLink(s3.RoleARN); link != nil {
+			links = append(links, link)
+		}
+
+		if s3.EncryptionConfiguration != nil && s3.EncryptionConfiguration.KMSEncryptionConfig != nil && s3.EncryptionConfiguration.KMSEncryptionConfig.AWSKMSKeyARN != nil {
+			if link := kmsKeyLink(scope, *s3.EncryptionConfiguration.KMSEncryptionConfig.AWSKMSKeyARN); link != nil {
+				links = append(links, link)
+			}
+		}
+
+		if s3.CloudWatchLoggingOptions != nil {
+			if link := firehoseLoggingLink(scope, s3.CloudWatchLoggingOptions); link != nil {
+				links = append(links, link)
+			}
+		}
+	}
+
+	if destination.Logging != nil {
+		if link := firehoseLoggingLink(scope, destination.Logging); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if destination.Processing != nil && destination.Processing.Enabled != nil && *destination.Processing.Enabled {
+		for _, processor := range destination.Processing.Processors {
+			if processor.Type != types.ProcessorTypeLambda {
+				continue
+			}
+
+			for _, param := range processor.Parameters {
+				if param.ParameterName != types.ProcessorParameterNameLambdaArn || param.ParameterValue == nil {
+					continue
+				}
+
+				a, err := adapterhelpers.ParseARN(*param.ParameterValue)
+				if err != nil {
+					continue
+				}
+
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *param.ParameterValue,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The function transforms the records, so if it
+						// changes or breaks then delivery is affected
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return links
+}
+
+func firehoseLoggingLink(scope string, options *types.CloudWatchLoggingOptions) *sdp.LinkedItemQuery {
+	if options.Enabled == nil || !*options.Enabled || options.LogGroupName == nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "logs-log-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  *options.LogGroupName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The log group can't affect the stream
+			In: false,
+			// The stream logs delivery errors to the group
+			Out: true,
+		},
+	}
+}
+
+func firehoseDeliveryStreamItemMapper(_, scope string, awsItem *types.DeliveryStreamDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "firehose-delivery-stream",
+		UniqueAttribute: "DeliveryStreamName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.DeliveryStreamStatus {
+	case types.DeliveryStreamStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DeliveryStreamStatusCreating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DeliveryStreamStatusDeleting:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.DeliveryStreamStatusCreatingFailed, types.DeliveryStreamStatusDeletingFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.Source != nil && awsItem.Source.KinesisStreamSourceDescription != nil {
+		source := awsItem.Source.KinesisStreamSourceDescription
+
+		if source.KinesisStreamARN != nil {
+			if a, err := adapterhelpers.ParseARN(*source.KinesisStreamARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "kinesis-stream",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *source.KinesisStreamARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The delivery stream reads from the source stream
+						In: true,
+						// The delivery stream can't affect the source
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if link := firehoseRoleLink(source.RoleARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if config := awsItem.DeliveryStreamEncryptionConfiguration; config != nil && config.KeyType == types.KeyTypeCustomerManagedCmk && config.KeyARN != nil {
+		if link := kmsKeyLink(scope, *config.KeyARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	for _, destination := range awsItem.Destinations {
+		for _, d := range firehoseDestinations(destination) {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, firehoseDestinationItemLinks(accountID, scope, d)...)
+		}
+	}
+
+	return &item, nil
+}
+
+func firehoseDeliveryStreamListTagsFunc(ctx context.Context, stream *types.DeliveryStreamDescription, client firehoseClient) (map[string]string, error) {
+	tags := make(map[string]string)
+	input := &firehose.ListTagsForDeliveryStreamInput{
+		DeliveryStreamName: stream.DeliveryStreamName,
+	}
+
+	for {
+		out, err := client.ListTagsForDeliveryStream(ctx, input)
+		if err != nil {
+			return adapterhelpers.HandleTagsError(ctx, err), nil
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+
+		if out.HasMoreTags == nil || !*out.HasMoreTags || len(out.Tags) == 0 {
+			return tags, nil
+		}
+		input.ExclusiveStartTagKey = out.Tags[len(out.Tags)-1].Key
+	}
+}
+
+func NewFirehoseDeliveryStreamAdapter(client firehoseClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DeliveryStreamDescription, firehoseClient, *firehose.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DeliveryStreamDescription, firehoseClient, *firehose.Options]{
+		ItemType:        "firehose-delivery-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: firehoseDeliveryStreamAdapterMetadata,
+		GetFunc:         firehoseDeliveryStreamGetFunc,
+		ListFunc:        firehoseDeliveryStreamListFunc,
+		ItemMapper:      firehoseDeliveryStreamItemMapper,
+		ListTagsFunc:    firehoseDeliveryStreamListTagsFunc,
+	}
+}
+
+var firehoseDeliveryStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "firehose-delivery-stream",
+	DescriptiveName: "Firehose Delivery Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a delivery stream by name",
+		ListDescription:   "List all delivery streams",
+		SearchDescription: "Search for a delivery stream by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_kinesis_firehose_delivery_stream.name",
+		},
+	},
+	PotentialLinks: []string{"kinesis-stream", "s3-bucket", "iam-role", "kms-key", "logs-log-group", "lambda-function", "opensearch-domain"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var _ = Metadata.RegisterSchema(firehoseDeliveryStreamAdapterMetadata, sdp.AttributeSchemaFor(types.DeliveryStreamDescription{}))
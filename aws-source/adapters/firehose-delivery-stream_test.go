@@ -0,0 +1,327 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testFirehoseClient struct{}
+
+func (t testFirehoseClient) DescribeDeliveryStream(ctx context.Context, params *firehose.DescribeDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error) {
+	switch *params.DeliveryStreamName {
+	case "clicks-to-s3":
+		return &firehose.DescribeDeliveryStreamOutput{
+			DeliveryStreamDescription: &types.DeliveryStreamDescription{
+				DeliveryStreamName:   aws.String("clicks-to-s3"),
+				DeliveryStreamARN:    aws.String("arn:aws:firehose:eu-west-2:123456789012:deliverystream/clicks-to-s3"),
+				DeliveryStreamStatus: types.DeliveryStreamStatusActive,
+				DeliveryStreamType:   types.DeliveryStreamTypeKinesisStreamAsSource,
+				VersionId:            aws.String("3"),
+				HasMoreDestinations:  aws.Bool(false),
+				CreateTimestamp:      aws.Time(time.Now()),
+				Source: &types.SourceDescription{
+					KinesisStreamSourceDescription: &types.KinesisStreamSourceDescription{
+						KinesisStreamARN: aws.String("arn:aws:kinesis:eu-west-2:123456789012:stream/clicks"),
+						RoleARN:          aws.String("arn:aws:iam::123456789012:role/firehose-read-clicks"),
+					},
+				},
+				Destinations: []types.DestinationDescription{
+					{
+						DestinationId: aws.String("destinationId-000000000001"),
+						ExtendedS3DestinationDescription: &types.ExtendedS3DestinationDescription{
+							BucketARN:         aws.String("arn:aws:s3:::clicks-archive"),
+							RoleARN:           aws.String("arn:aws:iam::123456789012:role/firehose-write-clicks"),
+							CompressionFormat: types.CompressionFormatGzip,
+							BufferingHints: &types.BufferingHints{
+								IntervalInSeconds: aws.Int32(300),
+								SizeInMBs:         aws.Int32(5),
+							},
+							EncryptionConfiguration: &types.EncryptionConfiguration{
+								KMSEncryptionConfig: &types.KMSEncryptionConfig{
+									AWSKMSKeyARN: aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+								},
+							},
+							CloudWatchLoggingOptions: &types.CloudWatchLoggingOptions{
+								Enabled:       aws.Bool(true),
+								LogGroupName:  aws.String("/aws/kinesisfirehose/clicks-to-s3"),
+								LogStreamName: aws.String("DestinationDelivery"),
+							},
+							ProcessingConfiguration: &types.ProcessingConfiguration{
+								Enabled: aws.Bool(true),
+								Processors: []types.Processor{
+									{
+										Type: types.ProcessorTypeLambda,
+										Parameters: []types.ProcessorParameter{
+											{
+												ParameterName:  types.ProcessorParameterNameLambdaArn,
+												ParameterValue: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:enrich-clicks:$LATEST"),
+											},
+										},
+									},
+								},
+							},
+							S3BackupMode: types.S3BackupModeEnabled,
+							S3BackupDescription: &types.S3DestinationDescription{
+								BucketARN:         aws.String("arn:aws:s3:::clicks-raw"),
+								RoleARN:           aws.String("arn:aws:iam::123456789012:role/firehose-write-clicks"),
+								CompressionFormat: types.CompressionFormatUncompressed,
+								BufferingHints: &types.BufferingHints{
+									IntervalInSeconds: aws.Int32(300),
+									SizeInMBs:         aws.Int32(5),
+								},
+								EncryptionConfiguration: &types.EncryptionConfiguration{
+									NoEncryptionConfig: types.NoEncryptionConfigNoEncryption,
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	case "logs-to-splunk":
+		return &firehose.DescribeDeliveryStreamOutput{
+			DeliveryStreamDescription: &types.DeliveryStreamDescription{
+				DeliveryStreamName:   aws.String("logs-to-splunk"),
+				DeliveryStreamARN:    aws.String("arn:aws:firehose:eu-west-2:123456789012:deliverystream/logs-to-splunk"),
+				DeliveryStreamStatus: types.DeliveryStreamStatusCreatingFailed,
+				DeliveryStreamType:   types.DeliveryStreamTypeDirectPut,
+				VersionId:            aws.String("1"),
+				HasMoreDestinations:  aws.Bool(false),
+				Destinations: []types.DestinationDescription{
+					{
+						DestinationId: aws.String("destinationId-000000000001"),
+						SplunkDestinationDescription: &types.SplunkDestinationDescription{
+							HECEndpoint: aws.String("https://splunk.example.com:8088"),
+							S3DestinationDescription: &types.S3DestinationDescription{
+								BucketARN: aws.String("arn:aws:s3:::splunk-failed-events"),
+								RoleARN:   aws.String("arn:aws:iam::123456789012:role/firehose-splunk"),
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	case "logs-to-opensearch":
+		return &firehose.DescribeDeliveryStreamOutput{
+			DeliveryStreamDescription: &types.DeliveryStreamDescription{
+				DeliveryStreamName:   aws.String("logs-to-opensearch"),
+				DeliveryStreamARN:    aws.String("arn:aws:firehose:eu-west-2:123456789012:deliverystream/logs-to-opensearch"),
+				DeliveryStreamStatus: types.DeliveryStreamStatusActive,
+				DeliveryStreamType:   types.DeliveryStreamTypeDirectPut,
+				VersionId:            aws.String("1"),
+				HasMoreDestinations:  aws.Bool(false),
+				Destinations: []types.DestinationDescription{
+					{
+						DestinationId: aws.String("destinationId-000000000001"),
+						AmazonopensearchserviceDestinationDescription: &types.AmazonopensearchserviceDestinationDescription{
+							DomainARN: aws.String("arn:aws:es:eu-west-2:123456789012:domain/logs"),
+							IndexName: aws.String("logs"),
+							RoleARN:   aws.String("arn:aws:iam::123456789012:role/firehose-opensearch"),
+						},
+					},
+				},
+			},
+		}, nil
+	default:
+		return nil, &types.ResourceNotFoundException{
+			Message: aws.String("delivery stream not found"),
+		}
+	}
+}
+
+func (t testFirehoseClient) ListDeliveryStreams(ctx context.Context, params *firehose.ListDeliveryStreamsInput, optFns ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error) {
+	// Return the streams over two pages
+	if params.ExclusiveStartDeliveryStreamName == nil {
+		return &firehose.ListDeliveryStreamsOutput{
+			DeliveryStreamNames:    []string{"clicks-to-s3"},
+			HasMoreDeliveryStreams: aws.Bool(true),
+		}, nil
+	}
+
+	return &firehose.ListDeliveryStreamsOutput{
+		DeliveryStreamNames:    []string{"logs-to-splunk"},
+		HasMoreDeliveryStreams: aws.Bool(false),
+	}, nil
+}
+
+func (t testFirehoseClient) ListTagsForDeliveryStream(ctx context.Context, params *firehose.ListTagsForDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.ListTagsForDeliveryStreamOutput, error) {
+	return &firehose.ListTagsForDeliveryStreamOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("team"), Value: aws.String("analytics")},
+		},
+		HasMoreTags: aws.Bool(false),
+	}, nil
+}
+
+func TestFirehoseDeliveryStreamItemMapper(t *testing.T) {
+	stream, err := firehoseDeliveryStreamGetFunc(context.Background(), testFirehoseClient{}, "123456789012.eu-west-2", "clicks-to-s3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := firehoseDeliveryStreamItemMapper("", "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kinesis-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kinesis:eu-west-2:123456789012:stream/clicks",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-read-clicks",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-write-clicks",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "clicks-archive",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "clicks-raw",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/kinesisfirehose/clicks-to-s3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:enrich-clicks:$LATEST",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestFirehoseDeliveryStreamBackupBucket(t *testing.T) {
+	stream, err := firehoseDeliveryStreamGetFunc(context.Background(), testFirehoseClient{}, "123456789012.eu-west-2", "logs-to-splunk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := firehoseDeliveryStreamItemMapper("", "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			// Events that can't be sent to Splunk are backed up to S3
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "splunk-failed-events",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-splunk",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestFirehoseDeliveryStreamOpenSearchDomain(t *testing.T) {
+	stream, err := firehoseDeliveryStreamGetFunc(context.Background(), testFirehoseClient{}, "123456789012.eu-west-2", "logs-to-opensearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := firehoseDeliveryStreamItemMapper("", "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "opensearch-domain",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:es:eu-west-2:123456789012:domain/logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-opensearch",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestFirehoseDeliveryStreamListFunc(t *testing.T) {
+	adapter := NewFirehoseDeliveryStreamAdapter(testFirehoseClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+}
+
+func TestNewFirehoseDeliveryStreamAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := firehose.NewFromConfig(config)
+
+	adapter := NewFirehoseDeliveryStreamAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func globalacceleratorAcceleratorGetFunc(ctx context.Context, client globalacceleratorClient, scope string, query string) (*types.Accelerator, error) {
+	if err := checkGlobalAcceleratorARN(query, "accelerator", scope); err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeAccelerator(ctx, &globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Accelerator, nil
+}
+
+func globalacceleratorAcceleratorListFunc(ctx context.Context, client globalacceleratorClient, scope string) ([]*types.Accelerator, error) {
+	accelerators, err := listGlobalAccelerators(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*types.Accelerator, 0, len(accelerators))
+	for i := range accelerators {
+		items = append(items, &accelerators[i])
+	}
+
+	return items, nil
+}
+
+func globalacceleratorAcceleratorItemMapper(_, scope string, awsItem *types.Accelerator) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-accelerator",
+		UniqueAttribute: "AcceleratorArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.AcceleratorStatusDeployed:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.AcceleratorStatusInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if awsItem.AcceleratorArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-listener",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.AcceleratorArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Accelerators and their listeners are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, name := range []*string{awsItem.DnsName, awsItem.DualStackDnsName} {
+		if name != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *name,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	for _, ipSet := range awsItem.IpSets {
+		for _, ip := range ipSet.IpAddresses {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  ip,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func globalacceleratorAcceleratorListTagsFunc(ctx context.Context, accelerator *types.Accelerator, client globalacceleratorClient) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: accelerator.AcceleratorArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewGlobalAcceleratorAcceleratorAdapter(client globalacceleratorClient, accountID string) *adapterhelpers.GetListAdapter[*types.Accelerator, globalacceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Accelerator, globalacceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-accelerator",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Accelerators aren't tied to a region
+		AdapterMetadata: globalacceleratorAcceleratorAdapterMetadata,
+		GetFunc:         globalacceleratorAcceleratorGetFunc,
+		ListFunc:        globalacceleratorAcceleratorListFunc,
+		SearchFunc: func(ctx context.Context, client globalacceleratorClient, scope, query string) ([]*types.Accelerator, error) {
+			// Accelerators are identified by their ARN, so searching by ARN
+			// is the same as a Get
+			accelerator, err := globalacceleratorAcceleratorGetFunc(ctx, client, scope, query)
+			if err != nil {
+				return nil, err
+			}
+
+			return []*types.Accelerator{accelerator}, nil
+		},
+		ItemMapper:   globalacceleratorAcceleratorItemMapper,
+		ListTagsFunc: globalacceleratorAcceleratorListTagsFunc,
+	}
+}
+
+var globalacceleratorAcceleratorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-accelerator",
+	DescriptiveName: "Global Accelerator",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an accelerator by ARN",
+		ListDescription:   "List all accelerators",
+		SearchDescription: "Search for an accelerator by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_globalaccelerator_accelerator.id",
+		},
+	},
+	PotentialLinks: []string{"globalaccelerator-listener", "dns", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(globalacceleratorAcceleratorAdapterMetadata, sdp.AttributeSchemaFor(types.Accelerator{}))
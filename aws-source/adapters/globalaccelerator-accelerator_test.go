@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestGlobalAcceleratorAcceleratorItemMapper(t *testing.T) {
+	accelerator, err := globalacceleratorAcceleratorGetFunc(context.Background(), testGlobalAcceleratorClient{}, "123456789012", testAcceleratorARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := globalacceleratorAcceleratorItemMapper("", "123456789012", accelerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "globalaccelerator-listener",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testAcceleratorARN,
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1234567890abcdef.awsglobalaccelerator.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1234567890abcdef.dualstack.awsglobalaccelerator.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.0.2.250",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "198.51.100.52",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlobalAcceleratorAcceleratorList(t *testing.T) {
+	adapter := NewGlobalAcceleratorAcceleratorAdapter(testGlobalAcceleratorClient{}, "123456789012")
+
+	items, err := adapter.List(context.Background(), "123456789012", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].GetTags()["env"] != "prod" {
+		t.Errorf("expected env tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewGlobalAcceleratorAcceleratorAdapter(t *testing.T) {
+	config, account, _ := adapterhelpers.GetAutoConfig(t)
+	client := globalaccelerator.NewFromConfig(config, func(o *globalaccelerator.Options) {
+		o.Region = "us-west-2"
+	})
+
+	adapter := NewGlobalAcceleratorAcceleratorAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
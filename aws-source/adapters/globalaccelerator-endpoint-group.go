@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func globalacceleratorEndpointGroupGetFunc(ctx context.Context, client globalacceleratorClient, scope string, query string) (*types.EndpointGroup, error) {
+	if err := checkGlobalAcceleratorARN(query, "endpoint-group", scope); err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeEndpointGroup(ctx, &globalaccelerator.DescribeEndpointGroupInput{
+		EndpointGroupArn: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.EndpointGroup, nil
+}
+
+// Lists the endpoint groups of every listener of every accelerator
+func globalacceleratorEndpointGroupListFunc(ctx context.Context, client globalacceleratorClient, scope string) ([]*types.EndpointGroup, error) {
+	listeners, err := globalacceleratorListenerListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*types.EndpointGroup, 0)
+	for _, listener := range listeners {
+		if listener.ListenerArn == nil {
+			continue
+		}
+
+		groups, err := listGlobalAcceleratorEndpointGroups(ctx, client, *listener.ListenerArn)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range groups {
+			items = append(items, &groups[i])
+		}
+	}
+
+	return items, nil
+}
+
+// Searches by the ARN of a listener, or of an endpoint group
+func globalacceleratorEndpointGroupSearchFunc(ctx context.Context, client globalacceleratorClient, scope string, query string) ([]*types.EndpointGroup, error) {
+	if checkGlobalAcceleratorARN(query, "endpoint-group", scope) == nil {
+		group, err := globalacceleratorEndpointGroupGetFunc(ctx, client, scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.EndpointGroup{group}, nil
+	}
+
+	if err := checkGlobalAcceleratorARN(query, "listener", scope); err != nil {
+		return nil, err
+	}
+
+	groups, err := listGlobalAcceleratorEndpointGroups(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*types.EndpointGroup, 0, len(groups))
+	for i := range groups {
+		items = append(items, &groups[i])
+	}
+
+	return items, nil
+}
+
+// Links an endpoint group to one of its endpoints, which can be a load
+// balancer, an elastic IP or an EC2 instance in the region of the group
+func globalacceleratorEndpointLink(accountID string, region string, endpointID string) *sdp.LinkedItemQuery {
+	scope := adapterhelpers.FormatScope(accountID, region)
+
+	var query *sdp.Query
+	switch {
+	case strings.HasPrefix(endpointID, "arn:"):
+		query = &sdp.Query{
+			Type:   "elbv2-load-balancer",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  endpointID,
+			Scope:  scope,
+		}
+	case strings.HasPrefix(endpointID, "eipalloc-"):
+		query = &sdp.Query{
+			Type:   "ec2-address",
+			Method: sdp.QueryMethod_GET,
+			Query:  endpointID,
+			Scope:  scope,
+		}
+	case strings.HasPrefix(endpointID, "i-"):
+		query = &sdp.Query{
+			Type:   "ec2-instance",
+			Method: sdp.QueryMethod_GET,
+			Query:  endpointID,
+			Scope:  scope,
+		}
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the endpoint is unavailable traffic can't be routed to it
+			In: true,
+			// The accelerator sends traffic to the endpoint
+			Out: true,
+		},
+	}
+}
+
+func globalacceleratorEndpointGroupItemMapper(_, scope string, awsItem *types.EndpointGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-endpoint-group",
+		UniqueAttribute: "EndpointGroupArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	var healthy, unhealthy, initial int
+	for _, endpoint := range awsItem.EndpointDescriptions {
+		switch endpoint.HealthState {
+		case types.HealthStateHealthy:
+			healthy++
+		case types.HealthStateUnhealthy:
+			unhealthy++
+		case types.HealthStateInitial:
+			initial++
+		}
+	}
+
+	switch {
+	case unhealthy > 0 && healthy == 0:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case unhealthy > 0:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case initial > 0:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case healthy > 0:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if awsItem.EndpointGroupArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-listener",
+				Method: sdp.QueryMethod_GET,
+				Query:  globalacceleratorParentARN(*awsItem.EndpointGroupArn, "endpoint-group"),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Listeners and their endpoint groups are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.EndpointGroupRegion != nil {
+		// Accelerators are global, so their scope is the account ID
+		for _, endpoint := range awsItem.EndpointDescriptions {
+			if endpoint.EndpointId == nil {
+				continue
+			}
+
+			if link := globalacceleratorEndpointLink(scope, *awsItem.EndpointGroupRegion, *endpoint.EndpointId); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewGlobalAcceleratorEndpointGroupAdapter(client globalacceleratorClient, accountID string) *adapterhelpers.GetListAdapter[*types.EndpointGroup, globalacceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.GetListAdapter[*types.EndpointGroup, globalacceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-endpoint-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Accelerators aren't tied to a region
+		AdapterMetadata: globalacceleratorEndpointGroupAdapterMetadata,
+		GetFunc:         globalacceleratorEndpointGroupGetFunc,
+		ListFunc:        globalacceleratorEndpointGroupListFunc,
+		SearchFunc:      globalacceleratorEndpointGroupSearchFunc,
+		ItemMapper:      globalacceleratorEndpointGroupItemMapper,
+	}
+}
+
+var globalacceleratorEndpointGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-endpoint-group",
+	DescriptiveName: "Global Accelerator Endpoint Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an endpoint group by ARN",
+		ListDescription:   "List the endpoint groups of all accelerators",
+		SearchDescription: "Search for endpoint groups by listener ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_globalaccelerator_endpoint_group.id",
+		},
+	},
+	PotentialLinks: []string{"globalaccelerator-listener", "elbv2-load-balancer", "ec2-address", "ec2-instance"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(globalacceleratorEndpointGroupAdapterMetadata, sdp.AttributeSchemaFor(types.EndpointGroup{}))
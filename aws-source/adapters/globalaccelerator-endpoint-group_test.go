@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestGlobalAcceleratorEndpointGroupItemMapper(t *testing.T) {
+	group, err := globalacceleratorEndpointGroupGetFunc(context.Background(), testGlobalAcceleratorClient{}, "123456789012", testEndpointGroupARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := globalacceleratorEndpointGroupItemMapper("", "123456789012", group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	// One of the endpoints is unhealthy
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "globalaccelerator-listener",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testListenerARN,
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/api/50dc6c495c0c9188",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-address",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eipalloc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlobalAcceleratorEndpointGroupList(t *testing.T) {
+	adapter := NewGlobalAcceleratorEndpointGroupAdapter(testGlobalAcceleratorClient{}, "123456789012")
+
+	items, err := adapter.List(context.Background(), "123456789012", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+}
+
+func TestNewGlobalAcceleratorEndpointGroupAdapter(t *testing.T) {
+	config, account, _ := adapterhelpers.GetAutoConfig(t)
+	client := globalaccelerator.NewFromConfig(config, func(o *globalaccelerator.Options) {
+		o.Region = "us-west-2"
+	})
+
+	adapter := NewGlobalAcceleratorEndpointGroupAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
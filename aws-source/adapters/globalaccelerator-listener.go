@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func globalacceleratorListenerGetFunc(ctx context.Context, client globalacceleratorClient, scope string, query string) (*types.Listener, error) {
+	if err := checkGlobalAcceleratorARN(query, "listener", scope); err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeListener(ctx, &globalaccelerator.DescribeListenerInput{
+		ListenerArn: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Listener, nil
+}
+
+// Lists the listeners of every accelerator
+func globalacceleratorListenerListFunc(ctx context.Context, client globalacceleratorClient, scope string) ([]*types.Listener, error) {
+	accelerators, err := listGlobalAccelerators(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*types.Listener, 0)
+	for _, accelerator := range accelerators {
+		if accelerator.AcceleratorArn == nil {
+			continue
+		}
+
+		listeners, err := listGlobalAcceleratorListeners(ctx, client, *accelerator.AcceleratorArn)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range listeners {
+			items = append(items, &listeners[i])
+		}
+	}
+
+	return items, nil
+}
+
+// Searches by the ARN of an accelerator, or of a listener
+func globalacceleratorListenerSearchFunc(ctx context.Context, client globalacceleratorClient, scope string, query string) ([]*types.Listener, error) {
+	if checkGlobalAcceleratorARN(query, "listener", scope) == nil {
+		listener, err := globalacceleratorListenerGetFunc(ctx, client, scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.Listener{listener}, nil
+	}
+
+	if err := checkGlobalAcceleratorARN(query, "accelerator", scope); err != nil {
+		return nil, err
+	}
+
+	listeners, err := listGlobalAcceleratorListeners(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*types.Listener, 0, len(listeners))
+	for i := range listeners {
+		items = append(items, &listeners[i])
+	}
+
+	return items, nil
+}
+
+func globalacceleratorListenerItemMapper(_, scope string, awsItem *types.Listener) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-listener",
+		UniqueAttribute: "ListenerArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.ListenerArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-accelerator",
+				Method: sdp.QueryMethod_GET,
+				Query:  globalacceleratorParentARN(*awsItem.ListenerArn, "listener"),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Accelerators and their listeners are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-endpoint-group",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.ListenerArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Listeners and their endpoint groups are tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlobalAcceleratorListenerAdapter(client globalacceleratorClient, accountID string) *adapterhelpers.GetListAdapter[*types.Listener, globalacceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Listener, globalacceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-listener",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Accelerators aren't tied to a region
+		AdapterMetadata: globalacceleratorListenerAdapterMetadata,
+		GetFunc:         globalacceleratorListenerGetFunc,
+		ListFunc:        globalacceleratorListenerListFunc,
+		SearchFunc:      globalacceleratorListenerSearchFunc,
+		ItemMapper:      globalacceleratorListenerItemMapper,
+	}
+}
+
+var globalacceleratorListenerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-listener",
+	DescriptiveName: "Global Accelerator Listener",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a listener by ARN",
+		ListDescription:   "List the listeners of all accelerators",
+		SearchDescription: "Search for listeners by accelerator ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_globalaccelerator_listener.id",
+		},
+	},
+	PotentialLinks: []string{"globalaccelerator-accelerator", "globalaccelerator-endpoint-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var _ = Metadata.RegisterSchema(globalacceleratorListenerAdapterMetadata, sdp.AttributeSchemaFor(types.Listener{}))
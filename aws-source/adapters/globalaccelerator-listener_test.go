@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestGlobalAcceleratorListenerItemMapper(t *testing.T) {
+	listener, err := globalacceleratorListenerGetFunc(context.Background(), testGlobalAcceleratorClient{}, "123456789012", testListenerARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := globalacceleratorListenerItemMapper("", "123456789012", listener)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "globalaccelerator-accelerator",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testAcceleratorARN,
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "globalaccelerator-endpoint-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testListenerARN,
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlobalAcceleratorListenerSearchFunc(t *testing.T) {
+	t.Run("by accelerator ARN", func(t *testing.T) {
+		listeners, err := globalacceleratorListenerSearchFunc(context.Background(), testGlobalAcceleratorClient{}, "123456789012", testAcceleratorARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(listeners) != 1 {
+			t.Errorf("expected 1 listener, got %v", len(listeners))
+		}
+	})
+
+	t.Run("by endpoint group ARN", func(t *testing.T) {
+		if _, err := globalacceleratorListenerSearchFunc(context.Background(), testGlobalAcceleratorClient{}, "123456789012", testEndpointGroupARN); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestNewGlobalAcceleratorListenerAdapter(t *testing.T) {
+	config, account, _ := adapterhelpers.GetAutoConfig(t)
+	client := globalaccelerator.NewFromConfig(config, func(o *globalaccelerator.Options) {
+		o.Region = "us-west-2"
+	})
+
+	adapter := NewGlobalAcceleratorListenerAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
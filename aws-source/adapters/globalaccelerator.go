@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type globalacceleratorClient interface {
+	DescribeAccelerator(ctx context.Context, params *globalaccelerator.DescribeAcceleratorInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeAcceleratorOutput, error)
+	DescribeEndpointGroup(ctx context.Context, params *globalaccelerator.DescribeEndpointGroupInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeEndpointGroupOutput, error)
+	DescribeListener(ctx context.Context, params *globalaccelerator.DescribeListenerInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeListenerOutput, error)
+	ListTagsForResource(ctx context.Context, params *globalaccelerator.ListTagsForResourceInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListTagsForResourceOutput, error)
+
+	globalaccelerator.ListAcceleratorsAPIClient
+	globalaccelerator.ListEndpointGroupsAPIClient
+	globalaccelerator.ListListenersAPIClient
+}
+
+// Global Accelerator ARNs are nested, so the parent of a listener or endpoint
+// group can be found by trimming its ARN, e.g.
+// `arn:aws:globalaccelerator::123456789012:accelerator/{id}/listener/{id}/endpoint-group/{id}`
+func globalacceleratorParentARN(arn string, childType string) string {
+	parent, _, _ := strings.Cut(arn, "/"+childType+"/")
+	return parent
+}
+
+func listGlobalAccelerators(ctx context.Context, client globalacceleratorClient) ([]types.Accelerator, error) {
+	accelerators := make([]types.Accelerator, 0)
+	paginator := globalaccelerator.NewListAcceleratorsPaginator(client, &globalaccelerator.ListAcceleratorsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		accelerators = append(accelerators, out.Accelerators...)
+	}
+
+	return accelerators, nil
+}
+
+func listGlobalAcceleratorListeners(ctx context.Context, client globalacceleratorClient, acceleratorARN string) ([]types.Listener, error) {
+	listeners := make([]types.Listener, 0)
+	paginator := globalaccelerator.NewListListenersPaginator(client, &globalaccelerator.ListListenersInput{
+		AcceleratorArn: &acceleratorARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		listeners = append(listeners, out.Listeners...)
+	}
+
+	return listeners, nil
+}
+
+func listGlobalAcceleratorEndpointGroups(ctx context.Context, client globalacceleratorClient, listenerARN string) ([]types.EndpointGroup, error) {
+	groups := make([]types.EndpointGroup, 0)
+	paginator := globalaccelerator.NewListEndpointGroupsPaginator(client, &globalaccelerator.ListEndpointGroupsInput{
+		ListenerArn: &listenerARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, out.EndpointGroups...)
+	}
+
+	return groups, nil
+}
+
+// Checks that an ARN is a Global Accelerator ARN of the given type in the given
+// scope, since everything is looked up by ARN
+func checkGlobalAcceleratorARN(arn string, resourceType string, scope string) error {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return err
+	}
+
+	if a.Service != "globalaccelerator" || path.Base(path.Dir(a.Resource)) != resourceType {
+		return &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not the ARN of a Global Accelerator %v", arn, resourceType),
+			Scope:       scope,
+		}
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	return nil
+}
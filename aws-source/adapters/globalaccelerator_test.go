@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+)
+
+const (
+	testAcceleratorARN   = "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	testListenerARN      = testAcceleratorARN + "/listener/0123vxyz"
+	testEndpointGroupARN = testListenerARN + "/endpoint-group/098765zyxwvu"
+)
+
+type testGlobalAcceleratorClient struct{}
+
+func (t testGlobalAcceleratorClient) DescribeAccelerator(ctx context.Context, params *globalaccelerator.DescribeAcceleratorInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	return &globalaccelerator.DescribeAcceleratorOutput{
+		Accelerator: &types.Accelerator{
+			AcceleratorArn:   params.AcceleratorArn,
+			Name:             aws.String("api"),
+			DnsName:          aws.String("a1234567890abcdef.awsglobalaccelerator.com"),
+			DualStackDnsName: aws.String("a1234567890abcdef.dualstack.awsglobalaccelerator.com"),
+			Enabled:          aws.Bool(true),
+			IpAddressType:    types.IpAddressTypeDualStack,
+			IpSets: []types.IpSet{
+				{
+					IpAddressFamily: types.IpAddressFamilyIPv4,
+					IpAddresses:     []string{"192.0.2.250", "198.51.100.52"},
+				},
+			},
+			Status: types.AcceleratorStatusDeployed,
+		},
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) DescribeEndpointGroup(ctx context.Context, params *globalaccelerator.DescribeEndpointGroupInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeEndpointGroupOutput, error) {
+	return &globalaccelerator.DescribeEndpointGroupOutput{
+		EndpointGroup: &testEndpointGroup,
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) DescribeListener(ctx context.Context, params *globalaccelerator.DescribeListenerInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeListenerOutput, error) {
+	return &globalaccelerator.DescribeListenerOutput{
+		Listener: &testListener,
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) ListTagsForResource(ctx context.Context, params *globalaccelerator.ListTagsForResourceInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListTagsForResourceOutput, error) {
+	return &globalaccelerator.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("env"), Value: aws.String("prod")},
+		},
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) ListAccelerators(ctx context.Context, params *globalaccelerator.ListAcceleratorsInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	out, err := t.DescribeAccelerator(ctx, &globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(testAcceleratorARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &globalaccelerator.ListAcceleratorsOutput{
+		Accelerators: []types.Accelerator{*out.Accelerator},
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) ListEndpointGroups(ctx context.Context, params *globalaccelerator.ListEndpointGroupsInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	return &globalaccelerator.ListEndpointGroupsOutput{
+		EndpointGroups: []types.EndpointGroup{testEndpointGroup},
+	}, nil
+}
+
+func (t testGlobalAcceleratorClient) ListListeners(ctx context.Context, params *globalaccelerator.ListListenersInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListListenersOutput, error) {
+	return &globalaccelerator.ListListenersOutput{
+		Listeners: []types.Listener{testListener},
+	}, nil
+}
+
+var testListener = types.Listener{
+	ListenerArn:    aws.String(testListenerARN),
+	Protocol:       types.ProtocolTcp,
+	ClientAffinity: types.ClientAffinityNone,
+	PortRanges: []types.PortRange{
+		{FromPort: aws.Int32(443), ToPort: aws.Int32(443)},
+	},
+}
+
+var testEndpointGroup = types.EndpointGroup{
+	EndpointGroupArn:    aws.String(testEndpointGroupARN),
+	EndpointGroupRegion: aws.String("eu-west-2"),
+	EndpointDescriptions: []types.EndpointDescription{
+		{
+			EndpointId:  aws.String("arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/api/50dc6c495c0c9188"),
+			HealthState: types.HealthStateHealthy,
+			Weight:      aws.Int32(128),
+		},
+		{
+			EndpointId:  aws.String("eipalloc-0123456789abcdef0"),
+			HealthState: types.HealthStateUnhealthy,
+			Weight:      aws.Int32(128),
+		},
+		{
+			EndpointId:  aws.String("i-0123456789abcdef0"),
+			HealthState: types.HealthStateHealthy,
+			Weight:      aws.Int32(128),
+		},
+	},
+	HealthCheckPort:     aws.Int32(443),
+	HealthCheckProtocol: types.HealthCheckProtocolTcp,
+}
+
+func TestGlobalAcceleratorParentARN(t *testing.T) {
+	if parent := globalacceleratorParentARN(testEndpointGroupARN, "endpoint-group"); parent != testListenerARN {
+		t.Errorf("expected %v, got %v", testListenerARN, parent)
+	}
+
+	if parent := globalacceleratorParentARN(testListenerARN, "listener"); parent != testAcceleratorARN {
+		t.Errorf("expected %v, got %v", testAcceleratorARN, parent)
+	}
+}
+
+func TestCheckGlobalAcceleratorARN(t *testing.T) {
+	if err := checkGlobalAcceleratorARN(testListenerARN, "listener", "123456789012"); err != nil {
+		t.Error(err)
+	}
+
+	if err := checkGlobalAcceleratorARN(testListenerARN, "accelerator", "123456789012"); err == nil {
+		t.Error("expected an error for the wrong type")
+	}
+
+	if err := checkGlobalAcceleratorARN(testListenerARN, "listener", "210987654321"); err == nil {
+		t.Error("expected an error for the wrong scope")
+	}
+}
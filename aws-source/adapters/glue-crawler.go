@@ -0,0 +1,226 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func glueCrawlerGetFunc(ctx context.Context, client glueClient, _, query string) (*types.Crawler, error) {
+	out, err := client.GetCrawler(ctx, &glue.GetCrawlerInput{
+		Name: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Crawler, nil
+}
+
+func glueCrawlerListFunc(ctx context.Context, client glueClient, _ string) ([]*types.Crawler, error) {
+	crawlers := make([]*types.Crawler, 0)
+	paginator := glue.NewGetCrawlersPaginator(client, &glue.GetCrawlersInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, crawler := range out.Crawlers {
+			crawlers = append(crawlers, &crawler)
+		}
+	}
+
+	return crawlers, nil
+}
+
+// Links to the data stores that a crawler crawls. JDBC and MongoDB targets are
+// reached through connections and aren't linked
+func glueCrawlerTargetLinks(accountID string, scope string, targets *types.CrawlerTargets) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	locations := make([]string, 0)
+	queues := make([]*string, 0)
+
+	for _, target := range targets.S3Targets {
+		if target.Path != nil {
+			locations = append(locations, *target.Path)
+		}
+		queues = append(queues, target.EventQueueArn, target.DlqEventQueueArn)
+	}
+
+	for _, target := range targets.DeltaTargets {
+		locations = append(locations, target.DeltaTables...)
+	}
+
+	for _, target := range targets.IcebergTargets {
+		locations = append(locations, target.Paths...)
+	}
+
+	for _, target := range targets.HudiTargets {
+		locations = append(locations, target.Paths...)
+	}
+
+	links = append(links, glueS3Links(accountID, locations)...)
+
+	for _, target := range targets.DynamoDBTargets {
+		if target.Path == nil {
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.Path,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The crawler reads the table
+				In: true,
+				// Scans consume the table's read capacity
+				Out: true,
+			},
+		})
+	}
+
+	for _, target := range targets.CatalogTargets {
+		if target.DatabaseName == nil {
+			continue
+		}
+
+		for _, table := range target.Tables {
+			links = append(links, glueTableLink(scope, *target.DatabaseName, table))
+		}
+		queues = append(queues, target.EventQueueArn, target.DlqEventQueueArn)
+	}
+
+	for _, queue := range queues {
+		if queue == nil {
+			continue
+		}
+
+		if link := glueEventQueueLink(*queue); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+func glueCrawlerItemMapper(_, scope string, awsItem *types.Crawler) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-crawler",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.LastCrawl != nil {
+		switch awsItem.LastCrawl.Status {
+		case types.LastCrawlStatusSucceeded:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.LastCrawlStatusCancelled:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.LastCrawlStatusFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if awsItem.LastCrawl.LogGroup != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.LastCrawl.LogGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The log group can't affect the crawler
+					In: false,
+					// The crawler writes to the log group
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.Role != nil {
+		if link := iamRoleLink(accountID, *awsItem.Role); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.DatabaseName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-database",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.DatabaseName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The crawler can't write its tables if the database is
+				// deleted
+				In: true,
+				// The crawler creates and updates tables in the database
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.Targets != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, glueCrawlerTargetLinks(accountID, scope, awsItem.Targets)...)
+	}
+
+	return &item, nil
+}
+
+func NewGlueCrawlerAdapter(client glueClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Crawler, glueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Crawler, glueClient, *glue.Options]{
+		ItemType:        "glue-crawler",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueCrawlerAdapterMetadata,
+		GetFunc:         glueCrawlerGetFunc,
+		ListFunc:        glueCrawlerListFunc,
+		ItemMapper:      glueCrawlerItemMapper,
+		ListTagsFunc: func(ctx context.Context, crawler *types.Crawler, client glueClient) (map[string]string, error) {
+			return glueTags(ctx, client, glueARN(accountID, region, "crawler/"+*crawler.Name))
+		},
+	}
+}
+
+var glueCrawlerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-crawler",
+	DescriptiveName: "Glue Crawler",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a crawler by name",
+		List:              true,
+		ListDescription:   "List all crawlers",
+		Search:            true,
+		SearchDescription: "Search for a crawler by ARN",
+	},
+	PotentialLinks: []string{"iam-role", "glue-database", "glue-table", "s3-bucket", "dynamodb-table", "sqs-queue", "logs-log-group"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_crawler.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(glueCrawlerAdapterMetadata, sdp.AttributeSchemaFor(&types.Crawler{}))
@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func glueDatabaseGetFunc(ctx context.Context, client glueClient, _, query string) (*types.Database, error) {
+	out, err := client.GetDatabase(ctx, &glue.GetDatabaseInput{
+		Name: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Database, nil
+}
+
+func glueDatabaseListFunc(ctx context.Context, client glueClient, _ string) ([]*types.Database, error) {
+	databases := make([]*types.Database, 0)
+	paginator := glue.NewGetDatabasesPaginator(client, &glue.GetDatabasesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, database := range out.DatabaseList {
+			databases = append(databases, &database)
+		}
+	}
+
+	return databases, nil
+}
+
+func glueDatabaseItemMapper(_, scope string, awsItem *types.Database) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-database",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-table",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The tables can't affect the database
+				In: false,
+				// Deleting the database deletes its tables
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.LocationUri != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, glueS3Links(accountID, []string{*awsItem.LocationUri})...)
+	}
+
+	// Resource links point to a database that is shared from another catalog
+	if target := awsItem.TargetDatabase; target != nil && target.DatabaseName != nil && target.CatalogId != nil {
+		targetRegion := region
+		if target.Region != nil {
+			targetRegion = *target.Region
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-database",
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.DatabaseName,
+				Scope:  adapterhelpers.FormatScope(*target.CatalogId, targetRegion),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The link resolves to the shared database
+				In: true,
+				// The link can't affect the shared database
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlueDatabaseAdapter(client glueClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Database, glueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Database, glueClient, *glue.Options]{
+		ItemType:        "glue-database",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueDatabaseAdapterMetadata,
+		GetFunc:         glueDatabaseGetFunc,
+		ListFunc:        glueDatabaseListFunc,
+		ItemMapper:      glueDatabaseItemMapper,
+	}
+}
+
+var glueDatabaseAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-database",
+	DescriptiveName: "Glue Database",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Data Catalog database by name",
+		List:              true,
+		ListDescription:   "List all Data Catalog databases",
+		Search:            true,
+		SearchDescription: "Search for a Data Catalog database by ARN",
+	},
+	PotentialLinks: []string{"glue-table", "glue-database", "s3-bucket"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_catalog_database.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(glueDatabaseAdapterMetadata, sdp.AttributeSchemaFor(&types.Database{}))
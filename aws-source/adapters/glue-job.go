@@ -0,0 +1,141 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func glueJobGetFunc(ctx context.Context, client glueClient, _, query string) (*types.Job, error) {
+	out, err := client.GetJob(ctx, &glue.GetJobInput{
+		JobName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Job, nil
+}
+
+func glueJobListFunc(ctx context.Context, client glueClient, _ string) ([]*types.Job, error) {
+	jobs := make([]*types.Job, 0)
+	paginator := glue.NewGetJobsPaginator(client, &glue.GetJobsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range out.Jobs {
+			jobs = append(jobs, &job)
+		}
+	}
+
+	return jobs, nil
+}
+
+// Returns the S3 locations that a job uses. As well as the script, jobs are
+// configured with special arguments such as --TempDir and --extra-py-files,
+// which can contain comma-separated lists of S3 URIs
+func glueJobLocations(job *types.Job) []string {
+	locations := make([]string, 0)
+
+	if job.Command != nil && job.Command.ScriptLocation != nil {
+		locations = append(locations, *job.Command.ScriptLocation)
+	}
+
+	if job.LogUri != nil {
+		locations = append(locations, *job.LogUri)
+	}
+
+	for _, arguments := range []map[string]string{job.DefaultArguments, job.NonOverridableArguments} {
+		// Sort the keys so that the links are in a consistent order
+		keys := make([]string, 0, len(arguments))
+		for key := range arguments {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			for _, value := range strings.Split(arguments[key], ",") {
+				locations = append(locations, strings.TrimSpace(value))
+			}
+		}
+	}
+
+	return locations
+}
+
+func glueJobItemMapper(_, scope string, awsItem *types.Job) (*sdp.Item, error) {
+	// The visual editor's graph of the job is large and doesn't contain
+	// anything that isn't also in the script
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "CodeGenConfigurationNodes")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-job",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Role != nil {
+		if link := iamRoleLink(accountID, *awsItem.Role); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, glueS3Links(accountID, glueJobLocations(awsItem))...)
+
+	return &item, nil
+}
+
+func NewGlueJobAdapter(client glueClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Job, glueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Job, glueClient, *glue.Options]{
+		ItemType:        "glue-job",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueJobAdapterMetadata,
+		GetFunc:         glueJobGetFunc,
+		ListFunc:        glueJobListFunc,
+		ItemMapper:      glueJobItemMapper,
+		ListTagsFunc: func(ctx context.Context, job *types.Job, client glueClient) (map[string]string, error) {
+			return glueTags(ctx, client, glueARN(accountID, region, "job/"+*job.Name))
+		},
+	}
+}
+
+var glueJobAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-job",
+	DescriptiveName: "Glue Job",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a job by name",
+		List:              true,
+		ListDescription:   "List all jobs",
+		Search:            true,
+		SearchDescription: "Search for a job by ARN",
+	},
+	PotentialLinks: []string{"iam-role", "s3-bucket"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_job.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(glueJobAdapterMetadata, sdp.AttributeSchemaFor(&types.Job{}, "CodeGenConfigurationNodes"))
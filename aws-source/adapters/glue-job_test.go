@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestGlueJobGet(t *testing.T) {
+	adapter := NewGlueJobAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "orders-etl", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/service-role/AWSGlueServiceRole-orders",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "aws-glue-assets-123456789012-eu-west-2",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "shared-libraries",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "spark-ui-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The script and temporary directory are in the same bucket, and the
+	// extra files are both in another bucket
+	if len(item.GetLinkedItemQueries()) != 4 {
+		t.Errorf("expected 4 links, got %v", len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestGlueJobGetRoleName(t *testing.T) {
+	adapter := NewGlueJobAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "cleanup", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "GlueCleanupRole",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlueJobList(t *testing.T) {
+	adapter := NewGlueJobAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 jobs, got %v", len(items))
+	}
+}
+
+func TestGlueJobSearch(t *testing.T) {
+	adapter := NewGlueJobAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:glue:eu-west-2:123456789012:job/cleanup", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 job, got %v", len(items))
+	}
+}
+
+func TestNewGlueJobAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := glue.NewFromConfig(config)
+
+	adapter := NewGlueJobAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
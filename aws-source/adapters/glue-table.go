@@ -0,0 +1,203 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// glueTable is a Data Catalog table along with a name that is unique within
+// the region, since table names are only unique within their database
+type glueTable struct {
+	// The database name and table name separated by a slash
+	UniqueName string
+	types.Table
+}
+
+func newGlueTable(table types.Table) *glueTable {
+	t := &glueTable{
+		Table: table,
+	}
+
+	if table.DatabaseName != nil && table.Name != nil {
+		t.UniqueName = *table.DatabaseName + "/" + *table.Name
+	}
+
+	return t
+}
+
+func glueTableGetFunc(ctx context.Context, client glueClient, _, query string) (*glueTable, error) {
+	databaseName, tableName, err := parseGlueTableQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetTable(ctx, &glue.GetTableInput{
+		DatabaseName: &databaseName,
+		Name:         &tableName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Table == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("table %v not found", query),
+		}
+	}
+
+	return newGlueTable(*out.Table), nil
+}
+
+// Searches for a table by ARN, or for the tables in a database by the name of
+// the database
+func glueTableSearchFunc(ctx context.Context, client glueClient, scope, query string) ([]*glueTable, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOSCOPE,
+				ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+				Scope:       scope,
+			}
+		}
+
+		table, err := glueTableGetFunc(ctx, client, scope, a.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*glueTable{table}, nil
+	}
+
+	tables := make([]*glueTable, 0)
+	paginator := glue.NewGetTablesPaginator(client, &glue.GetTablesInput{
+		DatabaseName: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, table := range out.TableList {
+			tables = append(tables, newGlueTable(table))
+		}
+	}
+
+	return tables, nil
+}
+
+func glueTableItemMapper(_, scope string, awsItem *glueTable) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-table",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.DatabaseName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-database",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.DatabaseName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the database deletes the table
+				In: true,
+				// The table can't affect the database
+				Out: false,
+			},
+		})
+	}
+
+	// The data that the table describes
+	if descriptor := awsItem.StorageDescriptor; descriptor != nil {
+		locations := make([]string, 0, len(descriptor.AdditionalLocations)+1)
+		if descriptor.Location != nil {
+			locations = append(locations, *descriptor.Location)
+		}
+		locations = append(locations, descriptor.AdditionalLocations...)
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, glueS3Links(accountID, locations)...)
+	}
+
+	// Resource links point to a table that is shared from another catalog
+	if target := awsItem.TargetTable; target != nil && target.DatabaseName != nil && target.Name != nil && target.CatalogId != nil {
+		targetRegion := region
+		if target.Region != nil {
+			targetRegion = *target.Region
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.DatabaseName + "/" + *target.Name,
+				Scope:  adapterhelpers.FormatScope(*target.CatalogId, targetRegion),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The link resolves to the shared table
+				In: true,
+				// The link can't affect the shared table
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlueTableAdapter(client glueClient, accountID string, region string) *adapterhelpers.GetListAdapter[*glueTable, glueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapter[*glueTable, glueClient, *glue.Options]{
+		ItemType:        "glue-table",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueTableAdapterMetadata,
+		GetFunc:         glueTableGetFunc,
+		SearchFunc:      glueTableSearchFunc,
+		ItemMapper:      glueTableItemMapper,
+		// Tables can only be listed per database
+		DisableList: true,
+	}
+}
+
+var glueTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-table",
+	DescriptiveName: "Glue Table",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Data Catalog table by {database}/{table}",
+		Search:            true,
+		SearchDescription: "Search for a Data Catalog table by ARN, or for the tables in a database by database name",
+	},
+	PotentialLinks: []string{"glue-database", "glue-table", "s3-bucket"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_glue_catalog_table.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(glueTableAdapterMetadata, sdp.AttributeSchemaFor(&glueTable{}))
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestGlueTableGet(t *testing.T) {
+	adapter := NewGlueTableAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "sales/orders", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "sales/orders" {
+		t.Errorf("expected sales/orders, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "glue-database",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "data-lake",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "data-lake-archive",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Both locations in the data-lake bucket are linked once
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("expected 3 links, got %v", len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestGlueTableGetResourceLink(t *testing.T) {
+	adapter := NewGlueTableAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "sales/refunds", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "glue-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "finance/refunds",
+			ExpectedScope:  "210987654321.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlueTableSearch(t *testing.T) {
+	adapter := NewGlueTableAdapter(testGlueClient{}, "123456789012", "eu-west-2")
+
+	t.Run("database", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "sales", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 2 {
+			t.Fatalf("expected 2 tables, got %v", len(items))
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:glue:eu-west-2:123456789012:table/sales/orders", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "sales/orders" {
+			t.Fatalf("expected sales/orders, got %v", items)
+		}
+	})
+
+	t.Run("ARN in another scope", func(t *testing.T) {
+		_, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:glue:us-east-1:123456789012:table/sales/orders", false)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestNewGlueTableAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := glue.NewFromConfig(config)
+
+	adapter := NewGlueTableAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
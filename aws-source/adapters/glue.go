@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type glueClient interface {
+	GetCrawler(ctx context.Context, params *glue.GetCrawlerInput, optFns ...func(*glue.Options)) (*glue.GetCrawlerOutput, error)
+	GetCrawlers(ctx context.Context, params *glue.GetCrawlersInput, optFns ...func(*glue.Options)) (*glue.GetCrawlersOutput, error)
+	GetDatabase(ctx context.Context, params *glue.GetDatabaseInput, optFns ...func(*glue.Options)) (*glue.GetDatabaseOutput, error)
+	GetDatabases(ctx context.Context, params *glue.GetDatabasesInput, optFns ...func(*glue.Options)) (*glue.GetDatabasesOutput, error)
+	GetJob(ctx context.Context, params *glue.GetJobInput, optFns ...func(*glue.Options)) (*glue.GetJobOutput, error)
+	GetJobs(ctx context.Context, params *glue.GetJobsInput, optFns ...func(*glue.Options)) (*glue.GetJobsOutput, error)
+	GetTable(ctx context.Context, params *glue.GetTableInput, optFns ...func(*glue.Options)) (*glue.GetTableOutput, error)
+	GetTables(ctx context.Context, params *glue.GetTablesInput, optFns ...func(*glue.Options)) (*glue.GetTablesOutput, error)
+	GetTags(ctx context.Context, params *glue.GetTagsInput, optFns ...func(*glue.Options)) (*glue.GetTagsOutput, error)
+}
+
+// Glue items don't include their ARN, which is needed to get their tags, so
+// it is built from the account and region of the adapter
+func glueARN(accountID string, region string, resource string) string {
+	return fmt.Sprintf("arn:aws:glue:%v:%v:%v", region, accountID, resource)
+}
+
+func glueTags(ctx context.Context, client glueClient, resourceARN string) (map[string]string, error) {
+	out, err := client.GetTags(ctx, &glue.GetTagsInput{
+		ResourceArn: &resourceARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// Table queries are in the format database/table since table names are only
+// unique within their database. Neither name can contain a slash
+func parseGlueTableQuery(query string) (string, string, error) {
+	databaseName, tableName, found := strings.Cut(query, "/")
+	if !found || databaseName == "" || tableName == "" {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format database/table, got %v", query),
+		}
+	}
+
+	return databaseName, tableName, nil
+}
+
+// Links to a table in the Data Catalog, e.g. one that a crawler updates
+func glueTableLink(scope string, databaseName string, tableName string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "glue-table",
+			Method: sdp.QueryMethod_GET,
+			Query:  databaseName + "/" + tableName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The table describes the data that is read
+			In: true,
+			// Changes to the data are reflected in the table
+			Out: true,
+		},
+	}
+}
+
+// Links to the SQS queue that a crawler reads S3 event notifications from
+func glueEventQueueLink(queueARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(queueARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "sqs-queue",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  queueARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The crawler only crawls the objects that are in the queue
+			In: true,
+			// The crawler consumes the messages
+			Out: true,
+		},
+	}
+}
+
+// Links to the buckets of a set of S3 URIs, linking each bucket once. Values
+// that aren't S3 URIs are ignored
+func glueS3Links(accountID string, locations []string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	seen := make(map[string]bool)
+
+	for _, location := range locations {
+		link := s3URILink(accountID, location)
+		if link == nil || seen[link.GetQuery().GetQuery()] {
+			continue
+		}
+		seen[link.GetQuery().GetQuery()] = true
+
+		links = append(links, link)
+	}
+
+	return links
+}
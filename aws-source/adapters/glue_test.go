@@ -0,0 +1,243 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+type testGlueClient struct{}
+
+func testGlueNotFound(what string) error {
+	return &types.EntityNotFoundException{Message: aws.String(what + " not found")}
+}
+
+var testGlueJobs = []types.Job{
+	{
+		Name:        aws.String("orders-etl"),
+		Role:        aws.String("arn:aws:iam::123456789012:role/service-role/AWSGlueServiceRole-orders"),
+		GlueVersion: aws.String("4.0"),
+		WorkerType:  types.WorkerTypeG1x,
+		Command: &types.JobCommand{
+			Name:           aws.String("glueetl"),
+			PythonVersion:  aws.String("3"),
+			ScriptLocation: aws.String("s3://aws-glue-assets-123456789012-eu-west-2/scripts/orders-etl.py"),
+		},
+		DefaultArguments: map[string]string{
+			"--TempDir":                   "s3://aws-glue-assets-123456789012-eu-west-2/temporary/",
+			"--extra-py-files":            "s3://shared-libraries/utils.zip, s3://shared-libraries/schemas.zip",
+			"--spark-event-logs-path":     "s3://spark-ui-logs/orders-etl/",
+			"--enable-metrics":            "true",
+			"--job-bookmark-option":       "job-bookmark-enable",
+			"--additional-python-modules": "pyarrow==14.0.0",
+		},
+		CreatedOn: aws.Time(time.Now()),
+	},
+	{
+		Name: aws.String("cleanup"),
+		Role: aws.String("GlueCleanupRole"),
+		Command: &types.JobCommand{
+			Name:           aws.String("pythonshell"),
+			ScriptLocation: aws.String("s3://aws-glue-assets-123456789012-eu-west-2/scripts/cleanup.py"),
+		},
+	},
+}
+
+var testGlueCrawlers = []types.Crawler{
+	{
+		Name:         aws.String("orders"),
+		Role:         aws.String("AWSGlueServiceRole-orders"),
+		DatabaseName: aws.String("sales"),
+		State:        types.CrawlerStateReady,
+		Targets: &types.CrawlerTargets{
+			S3Targets: []types.S3Target{
+				{
+					Path:             aws.String("s3://data-lake/raw/orders/"),
+					EventQueueArn:    aws.String("arn:aws:sqs:eu-west-2:123456789012:orders-events"),
+					DlqEventQueueArn: aws.String("arn:aws:sqs:eu-west-2:123456789012:orders-events-dlq"),
+				},
+				{
+					Path: aws.String("s3://data-lake/raw/refunds/"),
+				},
+			},
+			DynamoDBTargets: []types.DynamoDBTarget{
+				{Path: aws.String("customers")},
+			},
+		},
+		LastCrawl: &types.LastCrawlInfo{
+			Status:    types.LastCrawlStatusSucceeded,
+			LogGroup:  aws.String("/aws-glue/crawlers"),
+			LogStream: aws.String("orders"),
+			StartTime: aws.Time(time.Now()),
+		},
+	},
+	{
+		Name:  aws.String("catalog"),
+		Role:  aws.String("arn:aws:iam::123456789012:role/AWSGlueServiceRole-catalog"),
+		State: types.CrawlerStateRunning,
+		Targets: &types.CrawlerTargets{
+			CatalogTargets: []types.CatalogTarget{
+				{
+					DatabaseName: aws.String("sales"),
+					Tables:       []string{"orders", "refunds"},
+				},
+			},
+		},
+		LastCrawl: &types.LastCrawlInfo{
+			Status:       types.LastCrawlStatusFailed,
+			ErrorMessage: aws.String("Service Principal: glue.amazonaws.com is not authorized to perform: glue:GetTable"),
+		},
+	},
+}
+
+var testGlueDatabases = []types.Database{
+	{
+		Name:        aws.String("sales"),
+		CatalogId:   aws.String("123456789012"),
+		LocationUri: aws.String("s3://data-lake/sales/"),
+		CreateTime:  aws.Time(time.Now()),
+	},
+	{
+		// A resource link to a database that is shared from another account
+		Name:      aws.String("shared-marketing"),
+		CatalogId: aws.String("123456789012"),
+		TargetDatabase: &types.DatabaseIdentifier{
+			CatalogId:    aws.String("210987654321"),
+			DatabaseName: aws.String("marketing"),
+		},
+	},
+}
+
+var testGlueTables = []types.Table{
+	{
+		Name:         aws.String("orders"),
+		DatabaseName: aws.String("sales"),
+		CatalogId:    aws.String("123456789012"),
+		TableType:    aws.String("EXTERNAL_TABLE"),
+		StorageDescriptor: &types.StorageDescriptor{
+			Location:            aws.String("s3://data-lake/sales/orders/"),
+			AdditionalLocations: []string{"s3://data-lake-archive/sales/orders/", "s3://data-lake/sales/orders-backfill/"},
+			InputFormat:         aws.String("org.apache.hadoop.hive.ql.io.parquet.MapredParquetInputFormat"),
+			Columns: []types.Column{
+				{Name: aws.String("order_id"), Type: aws.String("string")},
+				{Name: aws.String("total"), Type: aws.String("decimal(10,2)")},
+			},
+		},
+		PartitionKeys: []types.Column{
+			{Name: aws.String("dt"), Type: aws.String("string")},
+		},
+		CreateTime: aws.Time(time.Now()),
+	},
+	{
+		Name:         aws.String("refunds"),
+		DatabaseName: aws.String("sales"),
+		CatalogId:    aws.String("123456789012"),
+		TargetTable: &types.TableIdentifier{
+			CatalogId:    aws.String("210987654321"),
+			DatabaseName: aws.String("finance"),
+			Name:         aws.String("refunds"),
+			Region:       aws.String("us-east-1"),
+		},
+	},
+}
+
+func (t testGlueClient) GetCrawler(ctx context.Context, params *glue.GetCrawlerInput, optFns ...func(*glue.Options)) (*glue.GetCrawlerOutput, error) {
+	for _, crawler := range testGlueCrawlers {
+		if *crawler.Name == *params.Name {
+			return &glue.GetCrawlerOutput{Crawler: &crawler}, nil
+		}
+	}
+
+	return nil, testGlueNotFound("crawler")
+}
+
+func (t testGlueClient) GetCrawlers(ctx context.Context, params *glue.GetCrawlersInput, optFns ...func(*glue.Options)) (*glue.GetCrawlersOutput, error) {
+	return &glue.GetCrawlersOutput{Crawlers: testGlueCrawlers}, nil
+}
+
+func (t testGlueClient) GetDatabase(ctx context.Context, params *glue.GetDatabaseInput, optFns ...func(*glue.Options)) (*glue.GetDatabaseOutput, error) {
+	for _, database := range testGlueDatabases {
+		if *database.Name == *params.Name {
+			return &glue.GetDatabaseOutput{Database: &database}, nil
+		}
+	}
+
+	return nil, testGlueNotFound("database")
+}
+
+func (t testGlueClient) GetDatabases(ctx context.Context, params *glue.GetDatabasesInput, optFns ...func(*glue.Options)) (*glue.GetDatabasesOutput, error) {
+	return &glue.GetDatabasesOutput{DatabaseList: testGlueDatabases}, nil
+}
+
+func (t testGlueClient) GetJob(ctx context.Context, params *glue.GetJobInput, optFns ...func(*glue.Options)) (*glue.GetJobOutput, error) {
+	for _, job := range testGlueJobs {
+		if *job.Name == *params.JobName {
+			return &glue.GetJobOutput{Job: &job}, nil
+		}
+	}
+
+	return nil, testGlueNotFound("job")
+}
+
+// Returns the jobs a page at a time to test pagination
+func (t testGlueClient) GetJobs(ctx context.Context, params *glue.GetJobsInput, optFns ...func(*glue.Options)) (*glue.GetJobsOutput, error) {
+	if params.NextToken == nil {
+		return &glue.GetJobsOutput{
+			Jobs:      testGlueJobs[:1],
+			NextToken: aws.String("page-2"),
+		}, nil
+	}
+
+	return &glue.GetJobsOutput{Jobs: testGlueJobs[1:]}, nil
+}
+
+func (t testGlueClient) GetTable(ctx context.Context, params *glue.GetTableInput, optFns ...func(*glue.Options)) (*glue.GetTableOutput, error) {
+	for _, table := range testGlueTables {
+		if *table.DatabaseName == *params.DatabaseName && *table.Name == *params.Name {
+			return &glue.GetTableOutput{Table: &table}, nil
+		}
+	}
+
+	return nil, testGlueNotFound("table")
+}
+
+func (t testGlueClient) GetTables(ctx context.Context, params *glue.GetTablesInput, optFns ...func(*glue.Options)) (*glue.GetTablesOutput, error) {
+	out := &glue.GetTablesOutput{}
+	for _, table := range testGlueTables {
+		if *table.DatabaseName == *params.DatabaseName {
+			out.TableList = append(out.TableList, table)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testGlueClient) GetTags(ctx context.Context, params *glue.GetTagsInput, optFns ...func(*glue.Options)) (*glue.GetTagsOutput, error) {
+	switch *params.ResourceArn {
+	case "arn:aws:glue:eu-west-2:123456789012:job/orders-etl", "arn:aws:glue:eu-west-2:123456789012:crawler/orders":
+		return &glue.GetTagsOutput{Tags: map[string]string{"team": "data"}}, nil
+	}
+
+	return &glue.GetTagsOutput{}, nil
+}
+
+func TestParseGlueTableQuery(t *testing.T) {
+	databaseName, tableName, err := parseGlueTableQuery("sales/orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if databaseName != "sales" || tableName != "orders" {
+		t.Errorf("expected sales and orders, got %v and %v", databaseName, tableName)
+	}
+
+	for _, query := range []string{"orders", "sales/", "/orders"} {
+		if _, _, err := parseGlueTableQuery(query); err == nil {
+			t.Errorf("expected an error for %q", query)
+		}
+	}
+}
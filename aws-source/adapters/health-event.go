@@ -0,0 +1,310 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// healthEvent is an AWS Health event along with its details and the resources
+// that it affects, which are returned by separate requests
+type healthEvent struct {
+	types.Event
+
+	// The latest description of the event
+	Description *string
+
+	// Additional information that depends on the type of event
+	EventMetadata map[string]string
+
+	// The resources that the event affects
+	AffectedEntities []types.AffectedEntity
+}
+
+// Adds the details and affected entities to events. Both requests accept
+// several events at once, so the events are described in batches
+func describeHealthEvents(ctx context.Context, client healthClient, events []types.Event) ([]*healthEvent, error) {
+	described := make([]*healthEvent, 0, len(events))
+
+	for batch := range slices.Chunk(events, healthEventBatchSize) {
+		arns := make([]string, 0, len(batch))
+		byARN := make(map[string]*healthEvent, len(batch))
+
+		for _, event := range batch {
+			if event.Arn == nil {
+				continue
+			}
+
+			e := &healthEvent{
+				Event: event,
+			}
+
+			arns = append(arns, *event.Arn)
+			byARN[*event.Arn] = e
+			described = append(described, e)
+		}
+
+		if len(arns) == 0 {
+			continue
+		}
+
+		details, err := client.DescribeEventDetails(ctx, &health.DescribeEventDetailsInput{
+			EventArns: arns,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detail := range details.SuccessfulSet {
+			if detail.Event == nil || detail.Event.Arn == nil {
+				continue
+			}
+
+			if e, ok := byARN[*detail.Event.Arn]; ok {
+				e.Event = *detail.Event
+				e.EventMetadata = detail.EventMetadata
+
+				if detail.EventDescription != nil {
+					e.Description = detail.EventDescription.LatestDescription
+				}
+			}
+		}
+
+		paginator := health.NewDescribeAffectedEntitiesPaginator(client, &health.DescribeAffectedEntitiesInput{
+			Filter: &types.EntityFilter{
+				EventArns: arns,
+			},
+		})
+
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, entity := range out.Entities {
+				if entity.EventArn == nil {
+					continue
+				}
+
+				if e, ok := byARN[*entity.EventArn]; ok {
+					e.AffectedEntities = append(e.AffectedEntities, entity)
+				}
+			}
+		}
+	}
+
+	return described, nil
+}
+
+// The Health API can only be used by accounts with a Business, Enterprise
+// On-Ramp or Enterprise support plan. Other accounts don't have any events
+// that we can see, so this isn't treated as an error. The SDK doesn't model
+// this error, so it is matched by its code
+func isHealthSubscriptionRequired(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "SubscriptionRequiredException"
+}
+
+// Lists the events of the account that match the filter. Closed events are
+// excluded since they no longer affect their resources
+func listHealthEvents(ctx context.Context, client healthClient, filter types.EventFilter) ([]*healthEvent, error) {
+	filter.EventStatusCodes = []types.EventStatusCode{
+		types.EventStatusCodeOpen,
+		types.EventStatusCodeUpcoming,
+	}
+
+	events := make([]types.Event, 0)
+	paginator := health.NewDescribeEventsPaginator(client, &health.DescribeEventsInput{
+		Filter: &filter,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isHealthSubscriptionRequired(err) {
+				return []*healthEvent{}, nil
+			}
+
+			return nil, err
+		}
+
+		events = append(events, out.Events...)
+	}
+
+	return describeHealthEvents(ctx, client, events)
+}
+
+func healthEventGetFunc(ctx context.Context, client healthClient, scope, query string) (*healthEvent, error) {
+	events, err := describeHealthEvents(ctx, client, []types.Event{{Arn: &query}})
+	if err != nil && !isHealthSubscriptionRequired(err) {
+		return nil, err
+	}
+
+	// Events that can't be found are returned in the failed set rather than
+	// as an error, so they don't have any details
+	if err != nil || len(events) == 0 || events[0].Region == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("health event %v not found", query),
+			Scope:       scope,
+		}
+	}
+
+	return events[0], nil
+}
+
+func healthEventListFunc(ctx context.Context, client healthClient, scope string) ([]*healthEvent, error) {
+	return listHealthEvents(ctx, client, types.EventFilter{})
+}
+
+// Searches for an event by ARN, or for the events that affect a resource by
+// the ARN or ID of the resource
+func healthEventSearchFunc(ctx context.Context, client healthClient, scope, query string) ([]*healthEvent, error) {
+	filter := types.EventFilter{}
+
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		if a.Service == "health" {
+			event, err := healthEventGetFunc(ctx, client, scope, query)
+			if err != nil {
+				return nil, err
+			}
+
+			return []*healthEvent{event}, nil
+		}
+
+		filter.EntityArns = []string{query}
+	} else {
+		filter.EntityValues = []string{query}
+	}
+
+	return listHealthEvents(ctx, client, filter)
+}
+
+func healthEventItemMapper(_, scope string, awsItem *healthEvent) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "health-event",
+		UniqueAttribute: "Arn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch {
+	case awsItem.StatusCode == types.EventStatusCodeClosed:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case awsItem.EventTypeCategory == types.EventTypeCategoryIssue:
+		// An open issue means that AWS is having problems that affect the
+		// resources right now
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	default:
+		// Scheduled changes, investigations and notifications need action
+		// but aren't affecting the resources yet
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	service := ""
+	if awsItem.Service != nil {
+		service = *awsItem.Service
+	}
+
+	// Events are listed for the whole account, so resources that are only
+	// identified by their ID are in the region of the event
+	entityScope := scope
+	if awsItem.Region != nil && *awsItem.Region != "global" {
+		accountID, _, _ := strings.Cut(scope, ".")
+		entityScope = adapterhelpers.FormatScope(accountID, *awsItem.Region)
+	}
+
+	for _, entity := range awsItem.AffectedEntities {
+		if entity.StatusCode == types.EntityStatusCodeImpaired {
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		// Resolved entities are no longer affected by the event
+		if entity.StatusCode == types.EntityStatusCodeResolved || entity.StatusCode == types.EntityStatusCodeUnimpaired {
+			continue
+		}
+
+		if query := healthEntityQuery(entityScope, service, entity); query != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: query,
+				BlastPropagation: &sdp.BlastPropagation{
+					// The resource can't affect the event
+					In: false,
+					// The event degrades the resource, or will when the
+					// scheduled change happens
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+// NewHealthEventAdapter returns an adapter for the Health events of the
+// account. The Health API is global, so this should only be added once per
+// account rather than for every region
+func NewHealthEventAdapter(client healthClient, accountID string) *adapterhelpers.GetListAdapter[*healthEvent, healthClient, *health.Options] {
+	return &adapterhelpers.GetListAdapter[*healthEvent, healthClient, *health.Options]{
+		ItemType:        "health-event",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Health events are listed for the whole account
+		AdapterMetadata: healthEventAdapterMetadata,
+		GetFunc:         healthEventGetFunc,
+		ListFunc:        healthEventListFunc,
+		SearchFunc:      healthEventSearchFunc,
+		ItemMapper:      healthEventItemMapper,
+	}
+}
+
+var healthEventAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "health-event",
+	DescriptiveName: "Health Event",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Health event by ARN",
+		List:              true,
+		ListDescription:   "List all open and upcoming Health events in the account",
+		Search:            true,
+		SearchDescription: "Search for a Health event by ARN, or for the open and upcoming events that affect a resource by the ARN or ID of the resource",
+	},
+	PotentialLinks: []string{
+		"ec2-instance",
+		"ec2-volume",
+		"ec2-nat-gateway",
+		"ec2-vpc",
+		"ec2-subnet",
+		"rds-db-instance",
+		"rds-db-cluster",
+		"lambda-function",
+		"dynamodb-table",
+		"efs-file-system",
+		"elbv2-load-balancer",
+		"ecs-cluster",
+		"ecs-service",
+		"eks-cluster",
+		"elasticache-cache-cluster",
+		"sqs-queue",
+		"sns-topic",
+		"s3-bucket",
+	},
+})
+
+var _ = Metadata.RegisterSchema(healthEventAdapterMetadata, sdp.AttributeSchemaFor(&healthEvent{}))
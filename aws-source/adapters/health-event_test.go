@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestHealthEventGet(t *testing.T) {
+	adapter := NewHealthEventAdapter(testHealthClient{}, "123456789012")
+
+	item, err := adapter.Get(context.Background(), "123456789012", testHealthIssueARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health ERROR, got %v", item.GetHealth())
+	}
+
+	// The resolved instance isn't linked, and the other one is in the region
+	// of the event
+	if len(item.GetLinkedItemQueries()) != 1 {
+		t.Errorf("expected 1 linked item query, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if _, err := adapter.Get(context.Background(), "123456789012", "arn:aws:health:eu-west-2::event/EC2/AWS_EC2_OPERATIONAL_ISSUE/unknown", false); err == nil {
+		t.Error("expected an error for an unknown event")
+	}
+}
+
+func TestHealthEventList(t *testing.T) {
+	adapter := NewHealthEventAdapter(testHealthClient{}, "123456789012")
+
+	items, err := adapter.List(context.Background(), "123456789012", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if item.UniqueAttributeValue() != testHealthScheduledARN {
+			continue
+		}
+
+		// Scheduled changes haven't affected anything yet
+		if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Errorf("expected health WARNING, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "rds-db-instance",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "orders",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, item)
+	}
+}
+
+func TestHealthEventSearch(t *testing.T) {
+	adapter := NewHealthEventAdapter(testHealthClient{}, "123456789012")
+
+	tests := map[string]string{
+		"i-0123456789abcdef0":                          testHealthIssueARN,
+		"arn:aws:rds:eu-west-2:123456789012:db:orders": testHealthScheduledARN,
+		testHealthScheduledARN:                         testHealthScheduledARN,
+	}
+
+	for query, expected := range tests {
+		items, err := adapter.Search(context.Background(), "123456789012", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != expected {
+			t.Errorf("expected %v for %v, got %v", expected, query, items)
+		}
+	}
+}
+
+func TestHealthEventSubscriptionRequired(t *testing.T) {
+	adapter := NewHealthEventAdapter(testHealthUnsubscribedClient{}, "123456789012")
+
+	items, err := adapter.List(context.Background(), "123456789012", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 0 {
+		t.Errorf("expected no items, got %v", len(items))
+	}
+
+	_, err = adapter.Get(context.Background(), "123456789012", testHealthIssueARN, false)
+
+	var qErr *sdp.QueryError
+	if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+		t.Errorf("expected a NOTFOUND error, got %v", err)
+	}
+}
+
+func TestNewHealthEventAdapter(t *testing.T) {
+	client, account, _ := healthGetAutoConfig(t)
+
+	adapter := NewHealthEventAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type healthClient interface {
+	DescribeAffectedEntities(ctx context.Context, params *health.DescribeAffectedEntitiesInput, optFns ...func(*health.Options)) (*health.DescribeAffectedEntitiesOutput, error)
+	DescribeEventDetails(ctx context.Context, params *health.DescribeEventDetailsInput, optFns ...func(*health.Options)) (*health.DescribeEventDetailsOutput, error)
+	DescribeEvents(ctx context.Context, params *health.DescribeEventsInput, optFns ...func(*health.Options)) (*health.DescribeEventsOutput, error)
+}
+
+// The AWS Health API only accepts this many event ARNs per request
+const healthEventBatchSize = 10
+
+// Returns a query for a resource that is affected by a Health event. Entities
+// are usually ARNs, but some services report IDs or names instead, in which
+// case the service of the event is used to work out the type. Entities of
+// types that don't have an adapter aren't linked
+func healthEntityQuery(scope string, service string, entity types.AffectedEntity) *sdp.Query {
+	if entity.EntityArn != nil {
+		if query := healthEntityARNQuery(scope, *entity.EntityArn); query != nil {
+			return query
+		}
+	}
+
+	if entity.EntityValue == nil {
+		return nil
+	}
+
+	value := *entity.EntityValue
+
+	if strings.HasPrefix(value, "arn:") {
+		return healthEntityARNQuery(scope, value)
+	}
+
+	var queryType string
+
+	switch strings.ToUpper(service) {
+	case "EC2", "EBS":
+		switch {
+		case strings.HasPrefix(value, "i-"):
+			queryType = "ec2-instance"
+		case strings.HasPrefix(value, "vol-"):
+			queryType = "ec2-volume"
+		case strings.HasPrefix(value, "nat-"):
+			queryType = "ec2-nat-gateway"
+		}
+	case "RDS":
+		queryType = "rds-db-instance"
+	case "LAMBDA":
+		queryType = "lambda-function"
+	case "DYNAMODB":
+		queryType = "dynamodb-table"
+	case "ELASTICACHE":
+		queryType = "elasticache-cache-cluster"
+	case "EKS":
+		queryType = "eks-cluster"
+	}
+
+	if queryType == "" {
+		return nil
+	}
+
+	return &sdp.Query{
+		Type:   queryType,
+		Method: sdp.QueryMethod_GET,
+		Query:  value,
+		Scope:  scope,
+	}
+}
+
+func healthEntityARNQuery(scope string, entityARN string) *sdp.Query {
+	a, err := adapterhelpers.ParseARN(entityARN)
+	if err != nil {
+		return nil
+	}
+
+	// The scope can be for the account or one of its regions
+	accountID, _, _ := strings.Cut(scope, ".")
+	if a.AccountID != "" {
+		accountID = a.AccountID
+	}
+
+	query := &sdp.Query{
+		Method: sdp.QueryMethod_GET,
+		Query:  a.ResourceID(),
+		Scope:  adapterhelpers.FormatScope(accountID, a.Region),
+	}
+
+	switch a.Service {
+	case "ec2":
+		switch a.Type() {
+		case "instance":
+			query.Type = "ec2-instance"
+		case "volume":
+			query.Type = "ec2-volume"
+		case "natgateway":
+			query.Type = "ec2-nat-gateway"
+		case "vpc":
+			query.Type = "ec2-vpc"
+		case "subnet":
+			query.Type = "ec2-subnet"
+		}
+	case "rds":
+		switch a.Type() {
+		case "db":
+			query.Type = "rds-db-instance"
+		case "cluster":
+			query.Type = "rds-db-cluster"
+		}
+	case "lambda":
+		if a.Type() == "function" {
+			// Remove the version or alias if there is one
+			query.Type = "lambda-function"
+			query.Query, _, _ = strings.Cut(a.ResourceID(), ":")
+		}
+	case "dynamodb":
+		if a.Type() == "table" {
+			query.Type = "dynamodb-table"
+		}
+	case "elasticfilesystem":
+		if a.Type() == "file-system" {
+			query.Type = "efs-file-system"
+		}
+	case "elasticloadbalancing":
+		// loadbalancer/{type}/{name}/{id}
+		if sections := strings.Split(a.Resource, "/"); len(sections) == 4 && sections[0] == "loadbalancer" {
+			query.Type = "elbv2-load-balancer"
+			query.Query = sections[2]
+		}
+	case "ecs":
+		switch a.Type() {
+		case "cluster":
+			query.Type = "ecs-cluster"
+		case "service":
+			query.Type = "ecs-service"
+		}
+	case "eks":
+		if a.Type() == "cluster" {
+			query.Type = "eks-cluster"
+		}
+	case "sqs":
+		query.Type = "sqs-queue"
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Query = entityARN
+	case "sns":
+		query.Type = "sns-topic"
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Query = entityARN
+	case "s3":
+		// Bucket ARNs don't contain the account or region
+		query.Type = "s3-bucket"
+		query.Query = a.Resource
+		query.Scope = adapterhelpers.FormatScope(accountID, "")
+	}
+
+	if query.Type == "" {
+		return nil
+	}
+
+	return query
+}
@@ -0,0 +1,241 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+)
+
+const (
+	testHealthIssueARN     = "arn:aws:health:eu-west-2::event/EC2/AWS_EC2_OPERATIONAL_ISSUE/AWS_EC2_OPERATIONAL_ISSUE_0a1b2c3d4e5f"
+	testHealthScheduledARN = "arn:aws:health:eu-west-2::event/RDS/AWS_RDS_MAINTENANCE_SCHEDULED/AWS_RDS_MAINTENANCE_SCHEDULED_6a7b8c9d0e1f"
+)
+
+type testHealthClient struct{}
+
+var testHealthEvents = []types.Event{
+	{
+		Arn:               aws.String(testHealthIssueARN),
+		Service:           aws.String("EC2"),
+		EventTypeCode:     aws.String("AWS_EC2_OPERATIONAL_ISSUE"),
+		EventTypeCategory: types.EventTypeCategoryIssue,
+		EventScopeCode:    types.EventScopeCodeAccountSpecific,
+		Region:            aws.String("eu-west-2"),
+		AvailabilityZone:  aws.String("eu-west-2a"),
+		StatusCode:        types.EventStatusCodeOpen,
+		StartTime:         aws.Time(time.Now().Add(-time.Hour)),
+		LastUpdatedTime:   aws.Time(time.Now()),
+	},
+	{
+		Arn:               aws.String(testHealthScheduledARN),
+		Service:           aws.String("RDS"),
+		EventTypeCode:     aws.String("AWS_RDS_MAINTENANCE_SCHEDULED"),
+		EventTypeCategory: types.EventTypeCategoryScheduledChange,
+		EventScopeCode:    types.EventScopeCodeAccountSpecific,
+		Region:            aws.String("eu-west-2"),
+		StatusCode:        types.EventStatusCodeUpcoming,
+		StartTime:         aws.Time(time.Now().Add(24 * time.Hour)),
+		LastUpdatedTime:   aws.Time(time.Now()),
+	},
+}
+
+var testHealthEntities = []types.AffectedEntity{
+	{
+		EventArn:     aws.String(testHealthIssueARN),
+		EntityArn:    aws.String("arn:aws:health:eu-west-2:123456789012:entity/0a1b2c3d"),
+		EntityValue:  aws.String("i-0123456789abcdef0"),
+		AwsAccountId: aws.String("123456789012"),
+		StatusCode:   types.EntityStatusCodeImpaired,
+	},
+	{
+		EventArn:     aws.String(testHealthIssueARN),
+		EntityArn:    aws.String("arn:aws:health:eu-west-2:123456789012:entity/1b2c3d4e"),
+		EntityValue:  aws.String("i-0fedcba9876543210"),
+		AwsAccountId: aws.String("123456789012"),
+		StatusCode:   types.EntityStatusCodeResolved,
+	},
+	{
+		EventArn:     aws.String(testHealthScheduledARN),
+		EntityArn:    aws.String("arn:aws:health:eu-west-2:123456789012:entity/2c3d4e5f"),
+		EntityValue:  aws.String("arn:aws:rds:eu-west-2:123456789012:db:orders"),
+		AwsAccountId: aws.String("123456789012"),
+		StatusCode:   types.EntityStatusCodePending,
+	},
+}
+
+func (t testHealthClient) DescribeEvents(ctx context.Context, params *health.DescribeEventsInput, optFns ...func(*health.Options)) (*health.DescribeEventsOutput, error) {
+	events := make([]types.Event, 0)
+
+	for _, event := range testHealthEvents {
+		filter := params.Filter
+
+		if len(filter.Regions) > 0 && !slices.Contains(filter.Regions, *event.Region) {
+			continue
+		}
+
+		if len(filter.EventStatusCodes) > 0 && !slices.Contains(filter.EventStatusCodes, event.StatusCode) {
+			continue
+		}
+
+		if len(filter.EntityValues) > 0 || len(filter.EntityArns) > 0 {
+			affected := slices.ContainsFunc(testHealthEntities, func(entity types.AffectedEntity) bool {
+				return *entity.EventArn == *event.Arn && (slices.Contains(filter.EntityValues, *entity.EntityValue) || slices.Contains(filter.EntityArns, *entity.EntityValue))
+			})
+
+			if !affected {
+				continue
+			}
+		}
+
+		// Events are returned without their details
+		events = append(events, event)
+	}
+
+	return &health.DescribeEventsOutput{
+		Events: events,
+	}, nil
+}
+
+func (t testHealthClient) DescribeEventDetails(ctx context.Context, params *health.DescribeEventDetailsInput, optFns ...func(*health.Options)) (*health.DescribeEventDetailsOutput, error) {
+	out := &health.DescribeEventDetailsOutput{}
+
+	for _, arn := range params.EventArns {
+		i := slices.IndexFunc(testHealthEvents, func(event types.Event) bool {
+			return *event.Arn == arn
+		})
+
+		if i < 0 {
+			out.FailedSet = append(out.FailedSet, types.EventDetailsErrorItem{
+				EventArn:     aws.String(arn),
+				ErrorName:    aws.String("NotFound"),
+				ErrorMessage: aws.String("Event not found"),
+			})
+
+			continue
+		}
+
+		out.SuccessfulSet = append(out.SuccessfulSet, types.EventDetails{
+			Event: &testHealthEvents[i],
+			EventDescription: &types.EventDescription{
+				LatestDescription: aws.String("We are investigating increased error rates"),
+			},
+		})
+	}
+
+	return out, nil
+}
+
+func (t testHealthClient) DescribeAffectedEntities(ctx context.Context, params *health.DescribeAffectedEntitiesInput, optFns ...func(*health.Options)) (*health.DescribeAffectedEntitiesOutput, error) {
+	entities := make([]types.AffectedEntity, 0)
+
+	for _, entity := range testHealthEntities {
+		if slices.Contains(params.Filter.EventArns, *entity.EventArn) {
+			entities = append(entities, entity)
+		}
+	}
+
+	return &health.DescribeAffectedEntitiesOutput{
+		Entities: entities,
+	}, nil
+}
+
+func TestHealthEntityQuery(t *testing.T) {
+	tests := []struct {
+		Service string
+		Entity  types.AffectedEntity
+		Type    string
+		Query   string
+		Scope   string
+	}{
+		{
+			Service: "EC2",
+			Entity:  types.AffectedEntity{EntityValue: aws.String("i-0123456789abcdef0")},
+			Type:    "ec2-instance",
+			Query:   "i-0123456789abcdef0",
+			Scope:   "123456789012.eu-west-2",
+		},
+		{
+			Service: "EBS",
+			Entity:  types.AffectedEntity{EntityValue: aws.String("vol-0123456789abcdef0")},
+			Type:    "ec2-volume",
+			Query:   "vol-0123456789abcdef0",
+			Scope:   "123456789012.eu-west-2",
+		},
+		{
+			Service: "RDS",
+			Entity:  types.AffectedEntity{EntityValue: aws.String("arn:aws:rds:eu-west-2:123456789012:cluster:aurora")},
+			Type:    "rds-db-cluster",
+			Query:   "aurora",
+			Scope:   "123456789012.eu-west-2",
+		},
+		{
+			Service: "LAMBDA",
+			Entity:  types.AffectedEntity{EntityArn: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:processor:live")},
+			Type:    "lambda-function",
+			Query:   "processor",
+			Scope:   "123456789012.eu-west-2",
+		},
+		{
+			Service: "ELASTICLOADBALANCING",
+			Entity:  types.AffectedEntity{EntityValue: aws.String("arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/web/50dc6c495c0c9188")},
+			Type:    "elbv2-load-balancer",
+			Query:   "web",
+			Scope:   "123456789012.eu-west-2",
+		},
+		{
+			Service: "S3",
+			Entity:  types.AffectedEntity{EntityValue: aws.String("arn:aws:s3:::assets")},
+			Type:    "s3-bucket",
+			Query:   "assets",
+			Scope:   "123456789012",
+		},
+	}
+
+	for _, test := range tests {
+		query := healthEntityQuery("123456789012.eu-west-2", test.Service, test.Entity)
+		if query == nil {
+			t.Fatalf("expected a query for %v", test.Entity)
+		}
+
+		if query.GetType() != test.Type || query.GetQuery() != test.Query || query.GetScope() != test.Scope {
+			t.Errorf("unexpected query for %v: %v", test.Type, query)
+		}
+	}
+
+	if query := healthEntityQuery("123456789012.eu-west-2", "CLOUDFRONT", types.AffectedEntity{EntityValue: aws.String("E2QWRUHEXAMPLE")}); query != nil {
+		t.Errorf("expected no query for a service without an adapter, got %v", query)
+	}
+}
+
+// testHealthUnsubscribedClient fails like the Health API does for accounts
+// without a support plan that includes it
+type testHealthUnsubscribedClient struct {
+	testHealthClient
+}
+
+func (t testHealthUnsubscribedClient) DescribeEvents(ctx context.Context, params *health.DescribeEventsInput, optFns ...func(*health.Options)) (*health.DescribeEventsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "SubscriptionRequiredException"}
+}
+
+func (t testHealthUnsubscribedClient) DescribeEventDetails(ctx context.Context, params *health.DescribeEventDetailsInput, optFns ...func(*health.Options)) (*health.DescribeEventDetailsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "SubscriptionRequiredException"}
+}
+
+func healthGetAutoConfig(t *testing.T) (*health.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	// The Health API is only available in us-east-1
+	client := health.NewFromConfig(config, func(o *health.Options) {
+		o.Region = "us-east-1"
+	})
+
+	return client, account, region
+}
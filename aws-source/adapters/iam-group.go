@@ -86,3 +86,5 @@ var iamGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(iamGroupAdapterMetadata, sdp.AttributeSchemaFor(&types.Group{}))
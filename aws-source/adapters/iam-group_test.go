@@ -29,6 +29,8 @@ func TestGroupItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 }
 
 func TestNewIAMGroupAdapter(t *testing.T) {
@@ -152,3 +152,5 @@ var instanceProfileAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-role", "iam-policy"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(instanceProfileAdapterMetadata, sdp.AttributeSchemaFor(&types.InstanceProfile{}))
@@ -49,6 +49,8 @@ func TestInstanceProfileItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 }
 
 func TestNewIAMInstanceProfileAdapter(t *testing.T) {
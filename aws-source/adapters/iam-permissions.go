@@ -0,0 +1,365 @@
+package adapters
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// ResourcePermissions are the actions that a principal is allowed to perform on
+// a resource. The resource is an ARN which may contain IAM wildcards
+type ResourcePermissions struct {
+	Resource string
+	Actions  []string
+}
+
+// PrincipalPermissions are the actions that a resource policy allows a
+// principal to perform on the resource that the policy is attached to
+type PrincipalPermissions struct {
+	Principal string
+	Actions   []string
+}
+
+// A single action that is allowed or denied on a resource. Both may contain
+// IAM wildcards
+type grant struct {
+	resource string
+	action   string
+}
+
+// EvaluateIdentityPolicies works out which actions the policies attached to a
+// user or role allow on which resources. Explicit denies in any of the policies
+// remove the actions they match, and if a permissions boundary is provided the
+// result is limited to what the boundary also allows.
+//
+// The evaluation is deliberately an over-approximation since we care about
+// what a principal *could* do: conditions on allow statements are ignored, and
+// deny statements are only applied if they have no conditions. Statements that
+// use NotAction or NotResource are skipped as they can't be expressed as a
+// list of resources
+func EvaluateIdentityPolicies(policies []*policy.Policy, boundary *policy.Policy) []ResourcePermissions {
+	var allowed, denied []grant
+
+	for _, document := range policies {
+		allowed = append(allowed, statementGrants(document, "Allow")...)
+		denied = append(denied, statementGrants(document, "Deny")...)
+	}
+
+	allowed = removeDenied(allowed, denied)
+
+	if boundary != nil {
+		allowed = intersectGrants(allowed, statementGrants(boundary, "Allow"))
+		allowed = removeDenied(allowed, statementGrants(boundary, "Deny"))
+	}
+
+	return groupGrants(allowed)
+}
+
+// EvaluateResourcePolicy works out which actions a resource policy allows each
+// AWS principal to perform on the resource with the given ARN. Statements
+// that refer to objects within the resource, such as
+// `arn:aws:s3:::bucket/*`, count towards the resource itself. The same
+// over-approximation as `EvaluateIdentityPolicies` applies, and anonymous
+// (`*`) principals are ignored since they don't refer to anything we can link
+// to
+func EvaluateResourcePolicy(document *policy.Policy, resourceARN string) []PrincipalPermissions {
+	if document == nil || document.Statements == nil {
+		return nil
+	}
+
+	allowed := make(map[string][]string)
+	var deniedAll []string
+	denied := make(map[string][]string)
+
+	for _, statement := range document.Statements.Values() {
+		if statement.Action == nil || statement.NotAction != nil || statement.NotResource != nil {
+			continue
+		}
+		if statement.Resource != nil && !slices.ContainsFunc(statement.Resource.Values(), func(resource string) bool {
+			return resourceApplies(resource, resourceARN)
+		}) {
+			continue
+		}
+		if statement.Principal == nil {
+			continue
+		}
+
+		switch statement.Effect {
+		case "Allow":
+			if statement.Principal.AWS() == nil {
+				continue
+			}
+			for _, principal := range statement.Principal.AWS().Values() {
+				if principal == policy.PrincipalAll {
+					continue
+				}
+				allowed[principal] = append(allowed[principal], statement.Action.Values()...)
+			}
+		case "Deny":
+			if len(statement.Condition) > 0 {
+				continue
+			}
+			if slices.Contains(statement.Principal.Kinds(), policy.PrincipalKindAll) {
+				deniedAll = append(deniedAll, statement.Action.Values()...)
+				continue
+			}
+			if statement.Principal.AWS() == nil {
+				continue
+			}
+			for _, principal := range statement.Principal.AWS().Values() {
+				if principal == policy.PrincipalAll {
+					deniedAll = append(deniedAll, statement.Action.Values()...)
+				} else {
+					denied[principal] = append(denied[principal], statement.Action.Values()...)
+				}
+			}
+		}
+	}
+
+	permissions := make([]PrincipalPermissions, 0, len(allowed))
+	for principal, actions := range allowed {
+		deniedActions := slices.Concat(deniedAll, denied[principal])
+		actions = slices.DeleteFunc(actions, func(action string) bool {
+			return slices.ContainsFunc(deniedActions, func(pattern string) bool {
+				return iamActionMatches(pattern, action)
+			})
+		})
+		if len(actions) == 0 {
+			continue
+		}
+
+		slices.Sort(actions)
+		permissions = append(permissions, PrincipalPermissions{
+			Principal: principal,
+			Actions:   slices.Compact(actions),
+		})
+	}
+
+	slices.SortFunc(permissions, func(a, b PrincipalPermissions) int {
+		return strings.Compare(a.Principal, b.Principal)
+	})
+
+	return permissions
+}
+
+// LinksFromPermissions links a user or role to the resources that it is allowed
+// to act on. Resources that can't be resolved to a specific item, such as `*`,
+// aren't linked since that would link to everything
+func LinksFromPermissions(permissions []ResourcePermissions) []*sdp.LinkedItemQuery {
+	queries := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, p := range permissions {
+		queries = append(queries, linksFromResource(p.Resource, p.Actions)...)
+	}
+
+	return queries
+}
+
+// LinksFromPrincipalPermissions links a resource to the users and roles that
+// its resource policy allows to act on it, so that the blast radius of a change
+// to the resource includes who can break it
+func LinksFromPrincipalPermissions(permissions []PrincipalPermissions) []*sdp.LinkedItemQuery {
+	queries := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, p := range permissions {
+		a, err := adapterhelpers.ParseARN(p.Principal)
+		if err != nil || a.ContainsWildcard() {
+			// This will be an account ID or a wildcard
+			continue
+		}
+
+		var typ string
+		switch a.Type() {
+		case "role":
+			typ = "iam-role"
+		case "user":
+			typ = "iam-user"
+		default:
+			continue
+		}
+
+		queries = append(queries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   typ,
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  a.String(),
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the principal's permissions can affect the resource
+				In: true,
+				// Changing the resource won't affect the principal
+				Out: false,
+			},
+		})
+	}
+
+	return queries
+}
+
+// Links a resource to the principals allowed by its resource policy. Unlike
+// IAM, services such as S3, SQS and SNS return the policy as plain JSON rather
+// than URL-encoded. Policies that can't be parsed produce no links
+func linksFromResourcePolicy(document string, resourceARN string) []*sdp.LinkedItemQuery {
+	parsed := policy.Policy{}
+	if err := json.Unmarshal([]byte(document), &parsed); err != nil {
+		return nil
+	}
+
+	return LinksFromPrincipalPermissions(EvaluateResourcePolicy(&parsed, resourceARN))
+}
+
+// Returns all of the resource/action combinations from statements with the
+// given effect
+func statementGrants(document *policy.Policy, effect string) []grant {
+	if document == nil || document.Statements == nil {
+		return nil
+	}
+
+	grants := make([]grant, 0)
+	for _, statement := range document.Statements.Values() {
+		if statement.Effect != effect || statement.Action == nil || statement.Resource == nil {
+			continue
+		}
+		if statement.NotAction != nil || statement.NotResource != nil {
+			continue
+		}
+		if effect == "Deny" && len(statement.Condition) > 0 {
+			continue
+		}
+
+		for _, resource := range statement.Resource.Values() {
+			for _, action := range statement.Action.Values() {
+				grants = append(grants, grant{
+					resource: resource,
+					action:   action,
+				})
+			}
+		}
+	}
+
+	return grants
+}
+
+// Removes any grants that are completely covered by a deny. A deny that only
+// covers part of an allowed wildcard, for example denying `s3:Delete*` when
+// `s3:*` is allowed, leaves the allow in place
+func removeDenied(allowed, denied []grant) []grant {
+	return slices.DeleteFunc(allowed, func(g grant) bool {
+		return slices.ContainsFunc(denied, func(d grant) bool {
+			return iamActionMatches(d.action, g.action) && iamResourceMatches(d.resource, g.resource)
+		})
+	})
+}
+
+// Limits the allowed grants to those also allowed by the permissions boundary.
+// Where one side uses a wildcard that covers the other, the more specific of
+// the two is kept
+func intersectGrants(allowed, boundary []grant) []grant {
+	intersection := make([]grant, 0)
+
+	for _, a := range allowed {
+		for _, b := range boundary {
+			action, ok := narrowest(a.action, b.action, iamActionMatches)
+			if !ok {
+				continue
+			}
+			resource, ok := narrowest(a.resource, b.resource, iamResourceMatches)
+			if !ok {
+				continue
+			}
+
+			intersection = append(intersection, grant{
+				resource: resource,
+				action:   action,
+			})
+		}
+	}
+
+	return intersection
+}
+
+// Returns whichever of the two patterns is covered by the other
+func narrowest(a, b string, matches func(pattern, value string) bool) (string, bool) {
+	switch {
+	case matches(b, a):
+		return a, true
+	case matches(a, b):
+		return b, true
+	default:
+		return "", false
+	}
+}
+
+// Groups grants by resource with sorted, de-duplicated actions
+func groupGrants(grants []grant) []ResourcePermissions {
+	byResource := make(map[string][]string)
+	for _, g := range grants {
+		byResource[g.resource] = append(byResource[g.resource], g.action)
+	}
+
+	permissions := make([]ResourcePermissions, 0, len(byResource))
+	for resource, actions := range byResource {
+		slices.Sort(actions)
+		permissions = append(permissions, ResourcePermissions{
+			Resource: resource,
+			Actions:  slices.Compact(actions),
+		})
+	}
+
+	slices.SortFunc(permissions, func(a, b ResourcePermissions) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+
+	return permissions
+}
+
+// actionPatterns caches the compiled expressions of IAM action patterns, since
+// the same patterns are compared against many actions
+var actionPatterns sync.Map
+
+// Checks whether an IAM action pattern such as `s3:Get*` matches an action.
+// Actions are case-insensitive
+func iamActionMatches(pattern, action string) bool {
+	expression, ok := actionPatterns.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile("(?i)^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(pattern)) + "$")
+		if err != nil {
+			return false
+		}
+
+		expression, _ = actionPatterns.LoadOrStore(pattern, compiled)
+	}
+
+	return expression.(*regexp.Regexp).MatchString(action)
+}
+
+// Checks whether an IAM resource pattern matches a resource, which may itself
+// be a pattern
+func iamResourceMatches(pattern, resource string) bool {
+	if pattern == "*" || pattern == resource {
+		return true
+	}
+
+	a, err := adapterhelpers.ParseARN(pattern)
+	if err != nil {
+		return false
+	}
+
+	return a.IAMWildcardMatches(resource)
+}
+
+// Checks whether a resource in a resource policy applies to the resource the
+// policy is attached to, or to something within it
+func resourceApplies(resource, resourceARN string) bool {
+	return iamResourceMatches(resource, resourceARN) ||
+		strings.HasPrefix(resource, resourceARN+"/") ||
+		strings.HasPrefix(resource, resourceARN+":")
+}
@@ -0,0 +1,235 @@
+package adapters
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func mustParsePolicy(t *testing.T, document string) *policy.Policy {
+	t.Helper()
+
+	parsed := policy.Policy{}
+	err := json.Unmarshal([]byte(document), &parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &parsed
+}
+
+func TestEvaluateIdentityPolicies(t *testing.T) {
+	identity := mustParsePolicy(t, `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Action": ["s3:GetObject", "s3:PutObject", "s3:DeleteObject"],
+				"Resource": "arn:aws:s3:::orders/*"
+			},
+			{
+				"Effect": "Allow",
+				"Action": "sqs:*",
+				"Resource": "arn:aws:sqs:eu-west-2:123456789012:orders"
+			},
+			{
+				"Effect": "Allow",
+				"Action": "kms:Decrypt",
+				"Resource": "*"
+			},
+			{
+				"Effect": "Allow",
+				"NotAction": "iam:*",
+				"Resource": "*"
+			}
+		]
+	}`)
+	deny := mustParsePolicy(t, `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Deny",
+				"Action": "s3:Delete*",
+				"Resource": "arn:aws:s3:::*"
+			},
+			{
+				"Effect": "Deny",
+				"Action": "s3:PutObject",
+				"Resource": "*",
+				"Condition": {"Bool": {"aws:SecureTransport": "false"}}
+			}
+		]
+	}`)
+
+	t.Run("without a boundary", func(t *testing.T) {
+		permissions := EvaluateIdentityPolicies([]*policy.Policy{identity, deny}, nil)
+
+		expected := []ResourcePermissions{
+			{Resource: "*", Actions: []string{"kms:Decrypt"}},
+			{Resource: "arn:aws:s3:::orders/*", Actions: []string{"s3:GetObject", "s3:PutObject"}},
+			{Resource: "arn:aws:sqs:eu-west-2:123456789012:orders", Actions: []string{"sqs:*"}},
+		}
+
+		if !slices.EqualFunc(permissions, expected, func(a, b ResourcePermissions) bool {
+			return a.Resource == b.Resource && slices.Equal(a.Actions, b.Actions)
+		}) {
+			t.Errorf("expected %v, got %v", expected, permissions)
+		}
+	})
+
+	t.Run("with a boundary", func(t *testing.T) {
+		boundary := mustParsePolicy(t, `{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Action": ["s3:Get*", "sqs:SendMessage"],
+					"Resource": "*"
+				},
+				{
+					"Effect": "Allow",
+					"Action": "kms:*",
+					"Resource": "arn:aws:kms:eu-west-2:123456789012:key/abc"
+				}
+			]
+		}`)
+
+		permissions := EvaluateIdentityPolicies([]*policy.Policy{identity, deny}, boundary)
+
+		expected := []ResourcePermissions{
+			{Resource: "arn:aws:kms:eu-west-2:123456789012:key/abc", Actions: []string{"kms:Decrypt"}},
+			{Resource: "arn:aws:s3:::orders/*", Actions: []string{"s3:GetObject"}},
+			{Resource: "arn:aws:sqs:eu-west-2:123456789012:orders", Actions: []string{"sqs:SendMessage"}},
+		}
+
+		if !slices.EqualFunc(permissions, expected, func(a, b ResourcePermissions) bool {
+			return a.Resource == b.Resource && slices.Equal(a.Actions, b.Actions)
+		}) {
+			t.Errorf("expected %v, got %v", expected, permissions)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "kms-key",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/abc",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "s3-bucket",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "orders",
+				ExpectedScope:  sdp.WILDCARD,
+			},
+			{
+				ExpectedType:   "sqs-queue",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:orders",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: LinksFromPermissions(permissions)})
+	})
+}
+
+func TestEvaluateResourcePolicy(t *testing.T) {
+	bucketPolicy := mustParsePolicy(t, `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Principal": {"AWS": ["arn:aws:iam::123456789012:role/writer", "arn:aws:iam::123456789012:root"]},
+				"Action": ["s3:PutObject", "s3:DeleteObject"],
+				"Resource": "arn:aws:s3:::orders/*"
+			},
+			{
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::123456789012:user/reader"},
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::orders/*"
+			},
+			{
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::123456789012:user/other"},
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::other-bucket/*"
+			},
+			{
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::orders/public/*"
+			},
+			{
+				"Effect": "Deny",
+				"Principal": "*",
+				"Action": "s3:Delete*",
+				"Resource": "arn:aws:s3:::orders/*"
+			}
+		]
+	}`)
+
+	permissions := EvaluateResourcePolicy(bucketPolicy, "arn:aws:s3:::orders")
+
+	expected := []PrincipalPermissions{
+		{Principal: "arn:aws:iam::123456789012:role/writer", Actions: []string{"s3:PutObject"}},
+		{Principal: "arn:aws:iam::123456789012:root", Actions: []string{"s3:PutObject"}},
+		{Principal: "arn:aws:iam::123456789012:user/reader", Actions: []string{"s3:GetObject"}},
+	}
+
+	if !slices.EqualFunc(permissions, expected, func(a, b PrincipalPermissions) bool {
+		return a.Principal == b.Principal && slices.Equal(a.Actions, b.Actions)
+	}) {
+		t.Errorf("expected %v, got %v", expected, permissions)
+	}
+
+	links := LinksFromPrincipalPermissions(permissions)
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %v", len(links))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/writer",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-user",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:user/reader",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+}
+
+func TestIAMActionMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		action  string
+		match   bool
+	}{
+		{"*", "s3:GetObject", true},
+		{"s3:*", "s3:GetObject", true},
+		{"s3:get*", "s3:GetObject", true},
+		{"s3:Get?bject", "s3:GetObject", true},
+		{"s3:Put*", "s3:GetObject", false},
+		{"s3:GetObject", "s3:*", false},
+		{"sqs:*", "s3:GetObject", false},
+	}
+
+	for _, tt := range tests {
+		if iamActionMatches(tt.pattern, tt.action) != tt.match {
+			t.Errorf("expected iamActionMatches(%v, %v) to be %v", tt.pattern, tt.action, tt.match)
+		}
+	}
+}
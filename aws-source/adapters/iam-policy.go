@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -96,6 +97,87 @@ func addPolicyDocument(ctx context.Context, client IAMClient, pol *PolicyDetails
 	return nil
 }
 
+// policyDocumentCache holds the policy documents that have already been
+// fetched, keyed by policy ARN and version. Policy versions can't be edited, so
+// these never go stale
+var policyDocumentCache sync.Map
+
+// policyVersionCacheDuration is how long the default version of a managed
+// policy is cached for. This only needs to cover a single LIST of users or
+// roles, while still picking up new default versions soon after they are set
+const policyVersionCacheDuration = 5 * time.Minute
+
+type cachedPolicyVersion struct {
+	versionID string
+	expires   time.Time
+}
+
+// policyVersionCache holds the default version ID of each managed policy,
+// keyed by policy ARN
+var policyVersionCache sync.Map
+
+// getDefaultPolicyVersion returns the ID of the default version of the
+// managed policy with the given ARN
+func getDefaultPolicyVersion(ctx context.Context, client IAMClient, policyArn string) (string, error) {
+	if cached, ok := policyVersionCache.Load(policyArn); ok {
+		version := cached.(cachedPolicyVersion)
+		if time.Now().Before(version.expires) {
+			return version.versionID, nil
+		}
+	}
+
+	out, err := client.GetPolicy(ctx, &iam.GetPolicyInput{
+		PolicyArn: &policyArn,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if out.Policy == nil || out.Policy.DefaultVersionId == nil {
+		return "", errors.New("policy or default version ID is nil")
+	}
+
+	policyVersionCache.Store(policyArn, cachedPolicyVersion{
+		versionID: *out.Policy.DefaultVersionId,
+		expires:   time.Now().Add(policyVersionCacheDuration),
+	})
+
+	return *out.Policy.DefaultVersionId, nil
+}
+
+// Gets the default version of the document for the managed policy with the
+// given ARN. Both the default version and the document are cached, so a
+// managed policy is only fetched once for all of the users and roles that it
+// is attached to
+func getPolicyDocument(ctx context.Context, client IAMClient, policyArn string) (*policy.Policy, error) {
+	versionID, err := getDefaultPolicyVersion(ctx, client, policyArn)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := policyArn + ":" + versionID
+
+	if document, ok := policyDocumentCache.Load(cacheKey); ok {
+		return document.(*policy.Policy), nil
+	}
+
+	details := PolicyDetails{
+		Policy: &types.Policy{
+			Arn:              &policyArn,
+			DefaultVersionId: &versionID,
+		},
+	}
+
+	err = addPolicyDocument(ctx, client, &details)
+	if err != nil {
+		return nil, err
+	}
+
+	policyDocumentCache.Store(cacheKey, details.Document)
+
+	return details.Document, nil
+}
+
 func addPolicyEntities(ctx context.Context, client IAMClient, details *PolicyDetails) error {
 	var span trace.Span
 	if log.GetLevel() == log.TraceLevel {
@@ -130,11 +212,15 @@ func addPolicyEntities(ctx context.Context, client IAMClient, details *PolicyDet
 	return nil
 }
 
+// policyAttributes are the attributes of an iam-policy item, which include the
+// default version of the policy document
+type policyAttributes struct {
+	*types.Policy
+	Document *policy.Policy
+}
+
 func policyItemMapper(_ *string, scope string, awsItem *PolicyDetails) (*sdp.Item, error) {
-	finalAttributes := struct {
-		*types.Policy
-		Document *policy.Policy
-	}{
+	finalAttributes := policyAttributes{
 		Policy:   awsItem.Policy,
 		Document: awsItem.Document,
 	}
@@ -326,3 +412,5 @@ var policyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-group", "iam-user", "iam-role"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(policyAdapterMetadata, sdp.AttributeSchemaFor(policyAttributes{}).WithProperty("PolicyFullName", stringAttribute))
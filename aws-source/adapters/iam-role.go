@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
+	log "github.com/sirupsen/logrus"
 	"github.com/sourcegraph/conc/iter"
 )
 
@@ -19,6 +22,15 @@ type RoleDetails struct {
 	Role             *types.Role
 	EmbeddedPolicies []embeddedPolicy
 	AttachedPolicies []types.AttachedPolicy
+	// The documents of the attached policies, used to evaluate the role's
+	// effective permissions
+	AttachedPolicyDocuments []*policy.Policy
+	// The document of the permissions boundary, if the role has one. Note
+	// that this is only returned by GetRole, not ListRoles
+	PermissionsBoundary *policy.Policy
+	// Whether some of the policy documents couldn't be fetched, meaning
+	// that the effective permissions are incomplete
+	PermissionsIncomplete bool
 }
 
 func roleGetFunc(ctx context.Context, client IAMClient, _, query string) (*RoleDetails, error) {
@@ -43,6 +55,78 @@ func roleGetFunc(ctx context.Context, client IAMClient, _, query string) (*RoleD
 	return &details, nil
 }
 
+// The path of the roles that IAM Identity Center creates in member accounts
+// for its permission sets
+const identityCenterRolePath = "/aws-reserved/sso.amazonaws.com/"
+
+// Returns whether the role was created by IAM Identity Center for the
+// permission set with the given name. These roles are named
+// `AWSReservedSSO_{permission-set-name}_{random-suffix}`
+func isIdentityCenterRole(role types.Role, permissionSetName string) bool {
+	if role.Path == nil || role.RoleName == nil || !strings.HasPrefix(*role.Path, identityCenterRolePath) {
+		return false
+	}
+
+	suffix, found := strings.CutPrefix(*role.RoleName, "AWSReservedSSO_"+permissionSetName+"_")
+
+	return found && suffix != "" && !strings.Contains(suffix, "_")
+}
+
+// Searches for a role by ARN, or for the roles that IAM Identity Center has
+// created for a permission set by the name of the permission set
+func roleSearchFunc(ctx context.Context, client IAMClient, scope, query string) ([]*RoleDetails, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOSCOPE,
+				ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+				Scope:       scope,
+			}
+		}
+
+		// The name of the role is the last section, the rest is its path
+		sections := strings.Split(a.Resource, "/")
+
+		role, err := roleGetFunc(ctx, client, scope, sections[len(sections)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		return []*RoleDetails{role}, nil
+	}
+
+	roles := make([]*RoleDetails, 0)
+	paginator := iam.NewListRolesPaginator(client, &iam.ListRolesInput{
+		PathPrefix: adapterhelpers.PtrString(identityCenterRolePath),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.Roles {
+			if !isIdentityCenterRole(out.Roles[i], query) {
+				continue
+			}
+
+			details := RoleDetails{
+				Role: &out.Roles[i],
+			}
+
+			err = enrichRole(ctx, client, &details)
+			if err != nil {
+				return nil, err
+			}
+
+			roles = append(roles, &details)
+		}
+	}
+
+	return roles, nil
+}
+
 func enrichRole(ctx context.Context, client IAMClient, roleDetails *RoleDetails) error {
 	var err error
 
@@ -63,9 +147,48 @@ func enrichRole(ctx context.Context, client IAMClient, roleDetails *RoleDetails)
 		return err
 	}
 
+	roleDetails.AttachedPolicyDocuments, roleDetails.PermissionsBoundary, roleDetails.PermissionsIncomplete = getPermissionDocuments(ctx, client, roleDetails.AttachedPolicies, roleDetails.Role.PermissionsBoundary)
+
 	return nil
 }
 
+// getPermissionDocuments gets the documents of the attached managed policies
+// and of the permissions boundary so that the effective permissions of a user
+// or role can be evaluated. Policies that can't be fetched are logged and left
+// out, in which case the returned bool is true to show that the permissions
+// are incomplete
+func getPermissionDocuments(ctx context.Context, client IAMClient, attached []types.AttachedPolicy, boundary *types.AttachedPermissionsBoundary) ([]*policy.Policy, *policy.Policy, bool) {
+	documents := make([]*policy.Policy, 0, len(attached))
+	incomplete := false
+
+	for _, attachedPolicy := range attached {
+		if attachedPolicy.PolicyArn == nil {
+			continue
+		}
+
+		document, err := getPolicyDocument(ctx, client, *attachedPolicy.PolicyArn)
+		if err != nil {
+			log.WithError(err).WithField("policyArn", *attachedPolicy.PolicyArn).Warn("Failed to get attached policy document, effective permissions will be incomplete")
+			incomplete = true
+			continue
+		}
+
+		documents = append(documents, document)
+	}
+
+	var boundaryDocument *policy.Policy
+	if boundary != nil && boundary.PermissionsBoundaryArn != nil {
+		var err error
+		boundaryDocument, err = getPolicyDocument(ctx, client, *boundary.PermissionsBoundaryArn)
+		if err != nil {
+			log.WithError(err).WithField("policyArn", *boundary.PermissionsBoundaryArn).Warn("Failed to get permissions boundary document, effective permissions will be incomplete")
+			incomplete = true
+		}
+	}
+
+	return documents, boundaryDocument, incomplete
+}
+
 type embeddedPolicy struct {
 	Name     string
 	Document *policy.Policy
@@ -156,17 +279,33 @@ func getAttachedPolicies(ctx context.Context, client IAMClient, roleName string)
 	return attachedPolicies, nil
 }
 
+// roleAttributes are the attributes of an iam-role item
+type roleAttributes struct {
+	*types.Role
+	EmbeddedPolicies []embeddedPolicy
+	// This is a replacement for the URL-encoded policy document so that the
+	// user can see the policy
+	AssumeRolePolicyDocument *policy.Policy
+	// The actions that the role is allowed to perform on each resource once
+	// denies and the permissions boundary have been taken into account
+	EffectivePermissions []ResourcePermissions
+	// Whether some of the role's policies couldn't be fetched and are missing
+	// from the effective permissions
+	EffectivePermissionsIncomplete bool
+}
+
 func roleItemMapper(_ *string, scope string, awsItem *RoleDetails) (*sdp.Item, error) {
-	enrichedRole := struct {
-		*types.Role
-		EmbeddedPolicies []embeddedPolicy
-		// This is a replacement for the URL-encoded policy document so that the
-		// user can see the policy
-		AssumeRolePolicyDocument *policy.Policy
-	}{
-		Role:             awsItem.Role,
-		EmbeddedPolicies: awsItem.EmbeddedPolicies,
+	enrichedRole := roleAttributes{
+		Role:                           awsItem.Role,
+		EmbeddedPolicies:               awsItem.EmbeddedPolicies,
+		EffectivePermissionsIncomplete: awsItem.PermissionsIncomplete,
+	}
+
+	identityPolicies := slices.Clone(awsItem.AttachedPolicyDocuments)
+	for _, policy := range awsItem.EmbeddedPolicies {
+		identityPolicies = append(identityPolicies, policy.Document)
 	}
+	enrichedRole.EffectivePermissions = EvaluateIdentityPolicies(identityPolicies, awsItem.PermissionsBoundary)
 
 	// Parse the encoded policy document
 	if awsItem.Role.AssumeRolePolicyDocument != nil {
@@ -212,10 +351,8 @@ func roleItemMapper(_ *string, scope string, awsItem *RoleDetails) (*sdp.Item, e
 		}
 	}
 
-	// Extract links from policy documents
-	for _, policy := range awsItem.EmbeddedPolicies {
-		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(policy.Document)...)
-	}
+	// Link to the resources that the role can act on
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPermissions(enrichedRole.EffectivePermissions)...)
 
 	// Extract links from the assume role policy document
 	if enrichedRole.AssumeRolePolicyDocument != nil {
@@ -290,6 +427,7 @@ func NewIAMRoleAdapter(client IAMClient, accountID string) *adapterhelpers.GetLi
 			roles = append(roles, newRoles...)
 			return roles, nil
 		},
+		SearchFunc:      roleSearchFunc,
 		ItemMapper:      roleItemMapper,
 		ListTagsFunc:    roleListTagsFunc,
 		AdapterMetadata: roleAdapterMetadata,
@@ -305,7 +443,7 @@ var roleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		Search:            true,
 		GetDescription:    "Get an IAM role by name",
 		ListDescription:   "List all IAM roles",
-		SearchDescription: "Search for IAM roles by ARN",
+		SearchDescription: "Search for IAM roles by ARN, or for the roles that IAM Identity Center has created for a permission set by the name of the permission set",
 	},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{
@@ -313,6 +451,8 @@ var roleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_SEARCH,
 		},
 	},
-	PotentialLinks: []string{"iam-policy"},
+	PotentialLinks: []string{"iam-policy", "iam-role", "iam-user", "s3-bucket", "sqs-queue", "sns-topic", "kms-key", "ssm-parameter"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(roleAdapterMetadata, sdp.AttributeSchemaFor(roleAttributes{}))
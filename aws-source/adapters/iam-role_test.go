@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -158,6 +159,84 @@ func TestRoleListFunc(t *testing.T) {
 	}
 }
 
+func TestRoleSearchFunc(t *testing.T) {
+	t.Run("ARN", func(t *testing.T) {
+		roles, err := roleSearchFunc(context.Background(), &TestIAMClient{}, "801795385023", "arn:aws:iam::801795385023:role/service-role/AWSControlTowerConfigAggregatorRoleForOrganizations")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(roles) != 1 {
+			t.Errorf("expected 1 role, got %v", len(roles))
+		}
+	})
+
+	t.Run("ARN in another account", func(t *testing.T) {
+		_, err := roleSearchFunc(context.Background(), &TestIAMClient{}, "801795385023", "arn:aws:iam::123456789012:role/example")
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOSCOPE {
+			t.Errorf("expected a NOSCOPE error, got %v", err)
+		}
+	})
+
+	t.Run("permission set name", func(t *testing.T) {
+		// The test client only returns a service role
+		roles, err := roleSearchFunc(context.Background(), &TestIAMClient{}, "801795385023", "AdministratorAccess")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(roles) != 0 {
+			t.Errorf("expected no roles, got %v", len(roles))
+		}
+	})
+}
+
+func TestIsIdentityCenterRole(t *testing.T) {
+	role := func(path, name string) types.Role {
+		return types.Role{
+			Path:     &path,
+			RoleName: &name,
+		}
+	}
+
+	tests := []struct {
+		Name     string
+		Role     types.Role
+		Expected bool
+	}{
+		{
+			Name:     "matching role",
+			Role:     role("/aws-reserved/sso.amazonaws.com/", "AWSReservedSSO_AdministratorAccess_0123456789abcdef"),
+			Expected: true,
+		},
+		{
+			Name:     "matching role with a regional path",
+			Role:     role("/aws-reserved/sso.amazonaws.com/eu-west-2/", "AWSReservedSSO_AdministratorAccess_0123456789abcdef"),
+			Expected: true,
+		},
+		{
+			Name:     "different permission set with the same prefix",
+			Role:     role("/aws-reserved/sso.amazonaws.com/", "AWSReservedSSO_AdministratorAccess_Extra_0123456789abcdef"),
+			Expected: false,
+		},
+		{
+			Name:     "role outside the Identity Center path",
+			Role:     role("/", "AWSReservedSSO_AdministratorAccess_0123456789abcdef"),
+			Expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if actual := isIdentityCenterRole(test.Role, "AdministratorAccess"); actual != test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, actual)
+			}
+		})
+	}
+}
+
 func TestRoleListTagsFunc(t *testing.T) {
 	tags, err := roleListTagsFunc(context.Background(), &RoleDetails{
 		Role: &types.Role{
@@ -234,6 +313,8 @@ func TestRoleItemMapper(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "iam-policy",
@@ -248,6 +329,83 @@ func TestRoleItemMapper(t *testing.T) {
 	fmt.Println(item.ToMap())
 }
 
+// countingIAMClient counts the policies and policy versions that are fetched,
+// and fails to get the policy with ARN `missing`
+type countingIAMClient struct {
+	TestIAMClient
+	missing  string
+	policies int
+	versions int
+}
+
+func (c *countingIAMClient) GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error) {
+	c.policies++
+
+	if *params.PolicyArn == c.missing {
+		return nil, errors.New("access denied")
+	}
+
+	return c.TestIAMClient.GetPolicy(ctx, params, optFns...)
+}
+
+func (c *countingIAMClient) GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error) {
+	c.versions++
+
+	return c.TestIAMClient.GetPolicyVersion(ctx, params, optFns...)
+}
+
+func TestGetPermissionDocuments(t *testing.T) {
+	client := &countingIAMClient{
+		missing: "arn:aws:iam::801795385023:policy/missing",
+	}
+
+	attached := []types.AttachedPolicy{
+		{
+			PolicyArn: adapterhelpers.PtrString("arn:aws:iam::801795385023:policy/permission-documents"),
+		},
+	}
+
+	t.Run("caches documents", func(t *testing.T) {
+		for range 2 {
+			documents, _, incomplete := getPermissionDocuments(context.Background(), client, attached, nil)
+
+			if len(documents) != 1 {
+				t.Errorf("expected 1 document, got %v", len(documents))
+			}
+
+			if incomplete {
+				t.Error("expected permissions to be complete")
+			}
+		}
+
+		if client.policies != 1 {
+			t.Errorf("expected the policy to be fetched once, got %v", client.policies)
+		}
+
+		if client.versions != 1 {
+			t.Errorf("expected the policy version to be fetched once, got %v", client.versions)
+		}
+	})
+
+	t.Run("marks permissions as incomplete", func(t *testing.T) {
+		documents, boundary, incomplete := getPermissionDocuments(context.Background(), client, attached, &types.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn: &client.missing,
+		})
+
+		if len(documents) != 1 {
+			t.Errorf("expected 1 document, got %v", len(documents))
+		}
+
+		if boundary != nil {
+			t.Error("expected no boundary document")
+		}
+
+		if !incomplete {
+			t.Error("expected permissions to be incomplete")
+		}
+	})
+}
+
 func TestNewIAMRoleAdapter(t *testing.T) {
 	config, account, _ := adapterhelpers.GetAutoConfig(t)
 	client := iam.NewFromConfig(config, func(o *iam.Options) {
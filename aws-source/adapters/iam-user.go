@@ -2,19 +2,37 @@ package adapters
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
+	log "github.com/sirupsen/logrus"
 )
 
 type UserDetails struct {
-	User       *types.User
-	UserGroups []types.Group
+	User             *types.User
+	UserGroups       []types.Group
+	EmbeddedPolicies []embeddedPolicy
+	AttachedPolicies []types.AttachedPolicy
+	// The documents of the attached policies, used to evaluate the user's
+	// effective permissions
+	AttachedPolicyDocuments []*policy.Policy
+	// The documents of the inline and managed policies of the user's groups,
+	// which the user inherits
+	GroupPolicyDocuments []*policy.Policy
+	// The document of the permissions boundary, if the user has one. Note
+	// that this is only returned by GetUser, not ListUsers
+	PermissionsBoundary *policy.Policy
+	// Whether some of the policy documents couldn't be fetched, meaning
+	// that the effective permissions are incomplete
+	PermissionsIncomplete bool
 }
 
 func userGetFunc(ctx context.Context, client IAMClient, _, query string) (*UserDetails, error) {
@@ -50,9 +68,162 @@ func enrichUser(ctx context.Context, client IAMClient, userDetails *UserDetails)
 		return err
 	}
 
+	userDetails.EmbeddedPolicies, err = getUserEmbeddedPolicies(ctx, client, userDetails.User.UserName)
+
+	if err != nil {
+		return err
+	}
+
+	userDetails.AttachedPolicies, err = getUserAttachedPolicies(ctx, client, userDetails.User.UserName)
+
+	if err != nil {
+		return err
+	}
+
+	var attachedIncomplete, groupsIncomplete bool
+
+	userDetails.AttachedPolicyDocuments, userDetails.PermissionsBoundary, attachedIncomplete = getPermissionDocuments(ctx, client, userDetails.AttachedPolicies, userDetails.User.PermissionsBoundary)
+
+	userDetails.GroupPolicyDocuments, groupsIncomplete, err = getGroupPolicyDocuments(ctx, client, userDetails.UserGroups)
+
+	if err != nil {
+		return err
+	}
+
+	userDetails.PermissionsIncomplete = attachedIncomplete || groupsIncomplete
+
 	return nil
 }
 
+// Gets the documents of the inline and managed policies of the groups that a
+// user is in. The returned bool is true if some of the documents couldn't be
+// fetched
+func getGroupPolicyDocuments(ctx context.Context, client IAMClient, groups []types.Group) ([]*policy.Policy, bool, error) {
+	documents := make([]*policy.Policy, 0)
+	incomplete := false
+
+	for _, group := range groups {
+		if group.GroupName == nil {
+			continue
+		}
+
+		policiesPaginator := iam.NewListGroupPoliciesPaginator(client, &iam.ListGroupPoliciesInput{
+			GroupName: group.GroupName,
+		})
+
+		for policiesPaginator.HasMorePages() {
+			out, err := policiesPaginator.NextPage(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, policyName := range out.PolicyNames {
+				groupPolicy, err := client.GetGroupPolicy(ctx, &iam.GetGroupPolicyInput{
+					GroupName:  group.GroupName,
+					PolicyName: &policyName,
+				})
+				if err == nil && groupPolicy.PolicyDocument == nil {
+					err = errors.New("policy document not found")
+				}
+				if err != nil {
+					log.WithError(err).WithField("groupName", *group.GroupName).WithField("policyName", policyName).Warn("Failed to get group policy document, effective permissions will be incomplete")
+					incomplete = true
+					continue
+				}
+
+				document, err := ParsePolicyDocument(*groupPolicy.PolicyDocument)
+				if err != nil {
+					log.WithError(err).WithField("groupName", *group.GroupName).WithField("policyName", policyName).Warn("Failed to parse group policy document, effective permissions will be incomplete")
+					incomplete = true
+					continue
+				}
+
+				documents = append(documents, document)
+			}
+		}
+
+		attachedPolicies := make([]types.AttachedPolicy, 0)
+
+		attachedPaginator := iam.NewListAttachedGroupPoliciesPaginator(client, &iam.ListAttachedGroupPoliciesInput{
+			GroupName: group.GroupName,
+		})
+
+		for attachedPaginator.HasMorePages() {
+			out, err := attachedPaginator.NextPage(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+
+			attachedPolicies = append(attachedPolicies, out.AttachedPolicies...)
+		}
+
+		attachedDocuments, _, attachedIncomplete := getPermissionDocuments(ctx, client, attachedPolicies, nil)
+		documents = append(documents, attachedDocuments...)
+		incomplete = incomplete || attachedIncomplete
+	}
+
+	return documents, incomplete, nil
+}
+
+// Gets the inline policies embedded in a user
+func getUserEmbeddedPolicies(ctx context.Context, client IAMClient, userName *string) ([]embeddedPolicy, error) {
+	policies := make([]embeddedPolicy, 0)
+
+	paginator := iam.NewListUserPoliciesPaginator(client, &iam.ListUserPoliciesInput{
+		UserName: userName,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policyName := range out.PolicyNames {
+			userPolicy, err := client.GetUserPolicy(ctx, &iam.GetUserPolicyInput{
+				UserName:   userName,
+				PolicyName: &policyName,
+			})
+			if err != nil || userPolicy.PolicyDocument == nil {
+				// Ignore these errors
+				continue
+			}
+
+			document, err := ParsePolicyDocument(*userPolicy.PolicyDocument)
+			if err != nil {
+				continue
+			}
+
+			policies = append(policies, embeddedPolicy{
+				Name:     policyName,
+				Document: document,
+			})
+		}
+	}
+
+	return policies, nil
+}
+
+// Gets the managed policies attached directly to a user
+func getUserAttachedPolicies(ctx context.Context, client IAMClient, userName *string) ([]types.AttachedPolicy, error) {
+	attachedPolicies := make([]types.AttachedPolicy, 0)
+
+	paginator := iam.NewListAttachedUserPoliciesPaginator(client, &iam.ListAttachedUserPoliciesInput{
+		UserName: userName,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		attachedPolicies = append(attachedPolicies, out.AttachedPolicies...)
+	}
+
+	return attachedPolicies, nil
+}
+
 // Gets all of the groups that a user is in
 func getUserGroups(ctx context.Context, client IAMClient, userName *string) ([]types.Group, error) {
 	var out *iam.ListGroupsForUserOutput
@@ -77,8 +248,33 @@ func getUserGroups(ctx context.Context, client IAMClient, userName *string) ([]t
 	return groups, nil
 }
 
+// userAttributes are the attributes of an iam-user item
+type userAttributes struct {
+	*types.User
+	EmbeddedPolicies []embeddedPolicy
+	// The actions that the user is allowed to perform on each resource once
+	// denies and the permissions boundary have been taken into account,
+	// including the policies inherited from groups
+	EffectivePermissions []ResourcePermissions
+	// Whether some of the user's policies couldn't be fetched and are missing
+	// from the effective permissions
+	EffectivePermissionsIncomplete bool
+}
+
 func userItemMapper(_ *string, scope string, awsItem *UserDetails) (*sdp.Item, error) {
-	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem.User)
+	enrichedUser := userAttributes{
+		User:                           awsItem.User,
+		EmbeddedPolicies:               awsItem.EmbeddedPolicies,
+		EffectivePermissionsIncomplete: awsItem.PermissionsIncomplete,
+	}
+
+	identityPolicies := slices.Concat(awsItem.AttachedPolicyDocuments, awsItem.GroupPolicyDocuments)
+	for _, policy := range awsItem.EmbeddedPolicies {
+		identityPolicies = append(identityPolicies, policy.Document)
+	}
+	enrichedUser.EffectivePermissions = EvaluateIdentityPolicies(identityPolicies, awsItem.PermissionsBoundary)
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(enrichedUser)
 
 	if err != nil {
 		return nil, err
@@ -108,6 +304,30 @@ func userItemMapper(_ *string, scope string, awsItem *UserDetails) (*sdp.Item, e
 		})
 	}
 
+	for _, policy := range awsItem.AttachedPolicies {
+		if policy.PolicyArn != nil {
+			if a, err := adapterhelpers.ParseARN(*policy.PolicyArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-policy",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *policy.PolicyArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the policy will affect the user
+						In: true,
+						// Changing the user won't affect the policy
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	// Link to the resources that the user can act on
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPermissions(enrichedUser.EffectivePermissions)...)
+
 	return &item, nil
 }
 
@@ -195,6 +415,8 @@ var iamUserAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_GET,
 		},
 	},
-	PotentialLinks: []string{"iam-group"},
+	PotentialLinks: []string{"iam-group", "iam-policy", "s3-bucket", "sqs-queue", "sns-topic", "kms-key", "ssm-parameter"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(iamUserAdapterMetadata, sdp.AttributeSchemaFor(userAttributes{}))
@@ -113,6 +113,78 @@ func (t *TestIAMClient) ListUserTags(context.Context, *iam.ListUserTagsInput, ..
 	}, nil
 }
 
+func (t *TestIAMClient) ListUserPolicies(ctx context.Context, params *iam.ListUserPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error) {
+	return &iam.ListUserPoliciesOutput{
+		PolicyNames: []string{
+			"inline",
+		},
+	}, nil
+}
+
+func (t *TestIAMClient) GetUserPolicy(ctx context.Context, params *iam.GetUserPolicyInput, optFns ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error) {
+	return &iam.GetUserPolicyOutput{
+		PolicyName: params.PolicyName,
+		PolicyDocument: adapterhelpers.PtrString(`{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Action": "sqs:SendMessage",
+					"Resource": "arn:aws:sqs:eu-west-2:801795385023:orders"
+				}
+			]
+		}`),
+		UserName: params.UserName,
+	}, nil
+}
+
+func (t *TestIAMClient) ListAttachedUserPolicies(ctx context.Context, params *iam.ListAttachedUserPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error) {
+	return &iam.ListAttachedUserPoliciesOutput{
+		AttachedPolicies: []types.AttachedPolicy{
+			{
+				PolicyArn:  adapterhelpers.PtrString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				PolicyName: adapterhelpers.PtrString("ReadOnlyAccess"),
+			},
+		},
+	}, nil
+}
+
+func (t *TestIAMClient) ListGroupPolicies(ctx context.Context, params *iam.ListGroupPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error) {
+	return &iam.ListGroupPoliciesOutput{
+		PolicyNames: []string{
+			"group-inline",
+		},
+	}, nil
+}
+
+func (t *TestIAMClient) GetGroupPolicy(ctx context.Context, params *iam.GetGroupPolicyInput, optFns ...func(*iam.Options)) (*iam.GetGroupPolicyOutput, error) {
+	return &iam.GetGroupPolicyOutput{
+		GroupName:  params.GroupName,
+		PolicyName: params.PolicyName,
+		PolicyDocument: adapterhelpers.PtrString(`{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Action": "sns:Publish",
+					"Resource": "arn:aws:sns:eu-west-2:801795385023:alerts"
+				}
+			]
+		}`),
+	}, nil
+}
+
+func (t *TestIAMClient) ListAttachedGroupPolicies(ctx context.Context, params *iam.ListAttachedGroupPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedGroupPoliciesOutput, error) {
+	return &iam.ListAttachedGroupPoliciesOutput{
+		AttachedPolicies: []types.AttachedPolicy{
+			{
+				PolicyArn:  adapterhelpers.PtrString("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+				PolicyName: adapterhelpers.PtrString("ReadOnlyAccess"),
+			},
+		},
+	}, nil
+}
+
 func TestGetUserGroups(t *testing.T) {
 	groups, err := getUserGroups(context.Background(), &TestIAMClient{}, adapterhelpers.PtrString("foo"))
 	if err != nil {
@@ -137,6 +209,11 @@ func TestUserGetFunc(t *testing.T) {
 	if len(user.UserGroups) != 3 {
 		t.Errorf("expected 3 groups, got %v", len(user.UserGroups))
 	}
+
+	// An inline and a managed policy for each group
+	if len(user.GroupPolicyDocuments) != 6 {
+		t.Errorf("expected 6 group policy documents, got %v", len(user.GroupPolicyDocuments))
+	}
 }
 
 func TestUserListFunc(t *testing.T) {
@@ -159,8 +236,13 @@ func TestUserListFunc(t *testing.T) {
 		if err := item.Validate(); err != nil {
 			t.Error(err)
 		}
-		if len(item.GetLinkedItemQueries()) != 3 {
-			t.Errorf("expected 3 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+
+		validateAttributeSchema(t, item)
+		// 3 groups, 1 attached policy, 1 queue from the inline policy, 3
+		// resources from the attached policy and 1 topic from the groups'
+		// inline policy
+		if len(item.GetLinkedItemQueries()) != 9 {
+			t.Errorf("expected 9 linked item queries, got %v", len(item.GetLinkedItemQueries()))
 		}
 	}
 }
@@ -209,6 +291,8 @@ func TestUserItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "iam-group",
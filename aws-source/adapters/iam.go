@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/micahhausler/aws-iam-policy/policy"
@@ -15,20 +16,26 @@ import (
 )
 
 type IAMClient interface {
+	GetGroupPolicy(ctx context.Context, params *iam.GetGroupPolicyInput, optFns ...func(*iam.Options)) (*iam.GetGroupPolicyOutput, error)
 	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
 	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
 	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
 	GetRolePolicy(ctx context.Context, params *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
 	GetUser(ctx context.Context, params *iam.GetUserInput, optFns ...func(*iam.Options)) (*iam.GetUserOutput, error)
+	GetUserPolicy(ctx context.Context, params *iam.GetUserPolicyInput, optFns ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error)
 	ListPolicyTags(ctx context.Context, params *iam.ListPolicyTagsInput, optFns ...func(*iam.Options)) (*iam.ListPolicyTagsOutput, error)
 	ListRoleTags(ctx context.Context, params *iam.ListRoleTagsInput, optFns ...func(*iam.Options)) (*iam.ListRoleTagsOutput, error)
 
+	iam.ListAttachedGroupPoliciesAPIClient
 	iam.ListAttachedRolePoliciesAPIClient
+	iam.ListAttachedUserPoliciesAPIClient
 	iam.ListEntitiesForPolicyAPIClient
+	iam.ListGroupPoliciesAPIClient
 	iam.ListGroupsForUserAPIClient
 	iam.ListPoliciesAPIClient
 	iam.ListRolePoliciesAPIClient
 	iam.ListRolesAPIClient
+	iam.ListUserPoliciesAPIClient
 	iam.ListUsersAPIClient
 	iam.ListUserTagsAPIClient
 }
@@ -128,10 +135,79 @@ var fallbackQueryExtractor = QueryExtractor{
 	},
 }
 
+// S3 ARNs don't contain the account or region, and the resource is the bucket
+// name optionally followed by an object key. Since permissions on the objects
+// also allow changes to the bucket's contents we link to the bucket itself
+var s3QueryExtractor = QueryExtractor{
+	RelevantResources: regexp.MustCompile("^arn:[^:]+:s3:::"),
+	ExtractorFunc: func(resource string, actions []string) []*sdp.LinkedItemQuery {
+		a, err := adapterhelpers.ParseARN(resource)
+		if err != nil {
+			return nil
+		}
+
+		bucket, _, _ := strings.Cut(a.Resource, "/")
+		if bucket == "" || strings.ContainsAny(bucket, "*?") {
+			return nil
+		}
+
+		return []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  bucket,
+					// The ARN doesn't tell us which account the bucket is in
+					Scope: sdp.WILDCARD,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  false,
+					Out: true,
+				},
+			},
+		}
+	},
+}
+
+// SQS queue and SNS topic ARNs don't have a resource type, the resource is
+// just the name of the queue or topic, so the fallback extractor can't work
+// out what type they are
+var sqsSNSQueryExtractor = QueryExtractor{
+	RelevantResources: regexp.MustCompile("^arn:[^:]+:(sqs|sns):"),
+	ExtractorFunc: func(resource string, actions []string) []*sdp.LinkedItemQuery {
+		a, err := adapterhelpers.ParseARN(resource)
+		if err != nil || a.ContainsWildcard() {
+			return nil
+		}
+
+		typ := "sqs-queue"
+		if a.Service == "sns" {
+			typ = "sns-topic"
+		}
+
+		return []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   typ,
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  a.String(),
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  false,
+					Out: true,
+				},
+			},
+		}
+	},
+}
+
 // The ordered list of extractors to use. The first one that matches will be
 // used
 var extractors = []QueryExtractor{
 	ssmQueryExtractor,
+	s3QueryExtractor,
+	sqsSNSQueryExtractor,
 	fallbackQueryExtractor,
 }
 
@@ -188,21 +264,12 @@ func LinksFromPolicy(document *policy.Policy) []*sdp.LinkedItemQuery {
 
 		if statement.Resource != nil {
 			for _, resource := range statement.Resource.Values() {
-				// Try to extract links from the references resource using the
-				// configurable extractors
-				for _, extractor := range extractors {
-					if extractor.RelevantResources != nil && extractor.RelevantResources.MatchString(resource) {
-						if statement.Action == nil || len(statement.Action.Values()) == 0 {
-							// If there is no action, then we can't extract
-							// anything from this resource
-							continue
-						}
-						queries = append(queries, extractor.ExtractorFunc(resource, statement.Action.Values())...)
-
-						// Only use the first one that matches
-						break
-					}
+				if statement.Action == nil || len(statement.Action.Values()) == 0 {
+					// If there is no action, then we can't extract
+					// anything from this resource
+					continue
 				}
+				queries = append(queries, linksFromResource(resource, statement.Action.Values())...)
 			}
 		}
 	}
@@ -210,6 +277,18 @@ func LinksFromPolicy(document *policy.Policy) []*sdp.LinkedItemQuery {
 	return queries
 }
 
+// Extracts linked item queries for a resource referenced in a policy using the
+// first of the configurable extractors that matches
+func linksFromResource(resource string, actions []string) []*sdp.LinkedItemQuery {
+	for _, extractor := range extractors {
+		if extractor.RelevantResources != nil && extractor.RelevantResources.MatchString(resource) {
+			return extractor.ExtractorFunc(resource, actions)
+		}
+	}
+
+	return nil
+}
+
 // Parses an IAM policy in it's URL-encoded embedded form
 func ParsePolicyDocument(encoded string) (*policy.Policy, error) {
 	// Decode the policy document which is RFC 3986 URL encoded
@@ -227,3 +306,37 @@ func ParsePolicyDocument(encoded string) (*policy.Policy, error) {
 
 	return &policyDocument, nil
 }
+
+// Links to a role that a resource assumes, which some services accept as
+// either a name or an ARN. Names are assumed to be in the given account
+func iamRoleLink(accountID string, role string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   "iam-role",
+		Method: sdp.QueryMethod_GET,
+		Query:  role,
+		Scope:  accountID,
+	}
+
+	if a, err := adapterhelpers.ParseARN(role); err == nil {
+		if a.Service != "iam" {
+			return nil
+		}
+
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = a.AccountID
+	}
+
+	if query.GetQuery() == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's permissions can break the resource
+			In: true,
+			// The resource can't affect the role
+			Out: false,
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/micahhausler/aws-iam-policy/policy"
+	"github.com/overmindtech/cli/sdp-go"
 	"github.com/overmindtech/cli/tracing"
 )
 
@@ -202,3 +203,31 @@ func TestLinksFromPolicy_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestIAMRoleLink(t *testing.T) {
+	link := iamRoleLink("123456789012", "arn:aws:iam::210987654321:role/service-role/Example")
+	if link == nil {
+		t.Fatal("expected a link")
+	}
+
+	if link.GetQuery().GetMethod() != sdp.QueryMethod_SEARCH || link.GetQuery().GetScope() != "210987654321" {
+		t.Errorf("expected a search in the role's account, got %v", link.GetQuery())
+	}
+
+	link = iamRoleLink("123456789012", "Example")
+	if link == nil {
+		t.Fatal("expected a link")
+	}
+
+	if link.GetQuery().GetMethod() != sdp.QueryMethod_GET || link.GetQuery().GetQuery() != "Example" || link.GetQuery().GetScope() != "123456789012" {
+		t.Errorf("expected a get in the given account, got %v", link.GetQuery())
+	}
+
+	if iamRoleLink("123456789012", "arn:aws:s3:::example") != nil {
+		t.Error("expected no link for a non-IAM ARN")
+	}
+
+	if iamRoleLink("123456789012", "") != nil {
+		t.Error("expected no link for an empty role")
+	}
+}
@@ -0,0 +1,329 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type kafkaClient interface {
+	GetBootstrapBrokers(ctx context.Context, params *kafka.GetBootstrapBrokersInput, optFns ...func(*kafka.Options)) (*kafka.GetBootstrapBrokersOutput, error)
+
+	kafka.ListClustersV2APIClient
+}
+
+// kafkaCluster is an MSK cluster along with the addresses of its brokers, which
+// need to be fetched separately
+type kafkaCluster struct {
+	*types.Cluster
+	BootstrapBrokers []string
+}
+
+// Gets the broker addresses for a cluster. Clusters that are still being
+// created don't have any brokers yet, which is an error rather than an empty
+// response, so errors are ignored
+func kafkaBootstrapBrokers(ctx context.Context, client kafkaClient, cluster *types.Cluster) []string {
+	out, err := client.GetBootstrapBrokers(ctx, &kafka.GetBootstrapBrokersInput{
+		ClusterArn: cluster.ClusterArn,
+	})
+	if err != nil {
+		return nil
+	}
+
+	brokers := make([]string, 0)
+	for _, brokerString := range []*string{
+		out.BootstrapBrokerString,
+		out.BootstrapBrokerStringTls,
+		out.BootstrapBrokerStringSaslScram,
+		out.BootstrapBrokerStringSaslIam,
+		out.BootstrapBrokerStringPublicTls,
+		out.BootstrapBrokerStringPublicSaslScram,
+		out.BootstrapBrokerStringPublicSaslIam,
+		out.BootstrapBrokerStringVpcConnectivityTls,
+		out.BootstrapBrokerStringVpcConnectivitySaslScram,
+		out.BootstrapBrokerStringVpcConnectivitySaslIam,
+	} {
+		if brokerString == nil {
+			continue
+		}
+
+		for broker := range strings.SplitSeq(*brokerString, ",") {
+			if broker != "" && !slices.Contains(brokers, broker) {
+				brokers = append(brokers, broker)
+			}
+		}
+	}
+
+	return brokers
+}
+
+// Gets a cluster by name. The query can also be in the format
+// {clusterName}/{clusterUUID} since that is the resource ID in the ARN
+func kafkaClusterGetFunc(ctx context.Context, client kafkaClient, scope, query string) (*kafkaCluster, error) {
+	name, uuid, _ := strings.Cut(query, "/")
+
+	paginator := kafka.NewListClustersV2Paginator(client, &kafka.ListClustersV2Input{
+		ClusterNameFilter: &name,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The filter is a prefix match so we need to find the exact one
+		for i := range out.ClusterInfoList {
+			cluster := &out.ClusterInfoList[i]
+			if cluster.ClusterName == nil || *cluster.ClusterName != name {
+				continue
+			}
+			if uuid != "" && (cluster.ClusterArn == nil || !strings.HasSuffix(*cluster.ClusterArn, "/"+uuid)) {
+				continue
+			}
+
+			return &kafkaCluster{
+				Cluster:          cluster,
+				BootstrapBrokers: kafkaBootstrapBrokers(ctx, client, cluster),
+			}, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "cluster not found",
+	}
+}
+
+func kafkaClusterItemMapper(_ *string, scope string, awsItem *kafkaCluster) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kafka-cluster",
+		UniqueAttribute: "ClusterName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.State {
+	case types.ClusterStateActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ClusterStateCreating, types.ClusterStateUpdating, types.ClusterStateRebootingBroker, types.ClusterStateMaintenance, types.ClusterStateHealing:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ClusterStateDeleting:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.ClusterStateFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	var subnets, securityGroups []string
+
+	if provisioned := awsItem.Provisioned; provisioned != nil {
+		if provisioned.BrokerNodeGroupInfo != nil {
+			subnets = append(subnets, provisioned.BrokerNodeGroupInfo.ClientSubnets...)
+			securityGroups = append(securityGroups, provisioned.BrokerNodeGroupInfo.SecurityGroups...)
+		}
+
+		if provisioned.EncryptionInfo != nil && provisioned.EncryptionInfo.EncryptionAtRest != nil && provisioned.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyId != nil {
+			if link := kmsKeyLink(scope, *provisioned.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyId); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if provisioned.LoggingInfo != nil && provisioned.LoggingInfo.BrokerLogs != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kafkaBrokerLogsLinks(scope, provisioned.LoggingInfo.BrokerLogs)...)
+		}
+	}
+
+	if serverless := awsItem.Serverless; serverless != nil {
+		for _, config := range serverless.VpcConfigs {
+			subnets = append(subnets, config.SubnetIds...)
+			securityGroups = append(securityGroups, config.SecurityGroupIds...)
+		}
+	}
+
+	for _, subnet := range subnets {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnet,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the subnet can affect the cluster
+				In: true,
+				// The cluster won't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, sg := range securityGroups {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  sg,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the security group can affect the cluster
+				In: true,
+				// The cluster won't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	// Each broker is listed once per authentication method with a different
+	// port, but we only need to link the host once
+	hosts := make([]string, 0)
+	for _, broker := range awsItem.BootstrapBrokers {
+		host, _, err := net.SplitHostPort(broker)
+		if err != nil {
+			host = broker
+		}
+
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+
+	for _, host := range hosts {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  host,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+// Links to the destinations that broker logs are delivered to
+func kafkaBrokerLogsLinks(scope string, logs *types.BrokerLogs) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	blastPropagation := &sdp.BlastPropagation{
+		// The destination can't affect the cluster
+		In: false,
+		// The cluster sends logs to the destination
+		Out: true,
+	}
+
+	if logs.CloudWatchLogs != nil && logs.CloudWatchLogs.Enabled != nil && *logs.CloudWatchLogs.Enabled && logs.CloudWatchLogs.LogGroup != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *logs.CloudWatchLogs.LogGroup,
+				Scope:  scope,
+			},
+			BlastPropagation: blastPropagation,
+		})
+	}
+
+	if logs.Firehose != nil && logs.Firehose.Enabled != nil && *logs.Firehose.Enabled && logs.Firehose.DeliveryStream != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "firehose-delivery-stream",
+				Method: sdp.QueryMethod_GET,
+				Query:  *logs.Firehose.DeliveryStream,
+				Scope:  scope,
+			},
+			BlastPropagation: blastPropagation,
+		})
+	}
+
+	if logs.S3 != nil && logs.S3.Enabled != nil && *logs.S3.Enabled && logs.S3.Bucket != nil {
+		accountID, _, err := adapterhelpers.ParseScope(scope)
+		if err == nil {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logs.S3.Bucket,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: blastPropagation,
+			})
+		}
+	}
+
+	return links
+}
+
+func NewKafkaClusterAdapter(client kafkaClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*kafka.ListClustersV2Input, *kafka.ListClustersV2Output, *kafkaCluster, kafkaClient, *kafka.Options] {
+	return &adapterhelpers.GetListAdapterV2[*kafka.ListClustersV2Input, *kafka.ListClustersV2Output, *kafkaCluster, kafkaClient, *kafka.Options]{
+		ItemType:        "kafka-cluster",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kafkaClusterAdapterMetadata,
+		GetFunc:         kafkaClusterGetFunc,
+		InputMapperList: func(scope string) (*kafka.ListClustersV2Input, error) {
+			return &kafka.ListClustersV2Input{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client kafkaClient, params *kafka.ListClustersV2Input) adapterhelpers.Paginator[*kafka.ListClustersV2Output, *kafka.Options] {
+			return kafka.NewListClustersV2Paginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *kafka.ListClustersV2Output, client kafkaClient) ([]*kafkaCluster, error) {
+			clusters := make([]*kafkaCluster, 0, len(output.ClusterInfoList))
+			for i := range output.ClusterInfoList {
+				cluster := &output.ClusterInfoList[i]
+				clusters = append(clusters, &kafkaCluster{
+					Cluster:          cluster,
+					BootstrapBrokers: kafkaBootstrapBrokers(ctx, client, cluster),
+				})
+			}
+			return clusters, nil
+		},
+		ItemMapper: kafkaClusterItemMapper,
+	}
+}
+
+var kafkaClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kafka-cluster",
+	DescriptiveName: "MSK Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an MSK cluster by name",
+		ListDescription:   "List all MSK clusters",
+		SearchDescription: "Search for an MSK cluster by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_msk_cluster.arn",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_msk_serverless_cluster.arn",
+		},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group", "kms-key", "dns", "logs-log-group", "firehose-delivery-stream", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var _ = Metadata.RegisterSchema(kafkaClusterAdapterMetadata, sdp.AttributeSchemaFor(kafkaCluster{}, "tags"))
@@ -0,0 +1,248 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testKafkaClient struct{}
+
+func testKafkaClusters() []types.Cluster {
+	return []types.Cluster{
+		{
+			ClusterName: aws.String("orders"),
+			ClusterArn:  aws.String("arn:aws:kafka:eu-west-2:123456789012:cluster/orders/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"),
+			ClusterType: types.ClusterTypeProvisioned,
+			State:       types.ClusterStateActive,
+			Provisioned: &types.Provisioned{
+				BrokerNodeGroupInfo: &types.BrokerNodeGroupInfo{
+					InstanceType:   aws.String("kafka.m5.large"),
+					ClientSubnets:  []string{"subnet-0450a637af9984235", "subnet-0d8ae4b4e07647efa"},
+					SecurityGroups: []string{"sg-0b5b3b0d2e1f0a1b2"},
+				},
+				NumberOfBrokerNodes: aws.Int32(2),
+				EncryptionInfo: &types.EncryptionInfo{
+					EncryptionAtRest: &types.EncryptionAtRest{
+						DataVolumeKMSKeyId: aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+					},
+				},
+				LoggingInfo: &types.LoggingInfo{
+					BrokerLogs: &types.BrokerLogs{
+						CloudWatchLogs: &types.CloudWatchLogs{
+							Enabled:  aws.Bool(true),
+							LogGroup: aws.String("/msk/orders"),
+						},
+						Firehose: &types.Firehose{
+							Enabled: aws.Bool(false),
+						},
+						S3: &types.S3{
+							Enabled: aws.Bool(true),
+							Bucket:  aws.String("msk-broker-logs"),
+						},
+					},
+				},
+			},
+			CreationTime: aws.Time(time.Now()),
+			Tags: map[string]string{
+				"team": "orders",
+			},
+		},
+		{
+			// A cluster with a name that starts with the same prefix
+			ClusterName: aws.String("orders-events"),
+			ClusterArn:  aws.String("arn:aws:kafka:eu-west-2:123456789012:cluster/orders-events/9f8e7d6c-5b4a-3f2e-1d0c-9b8a7f6e5d4c-s1"),
+			ClusterType: types.ClusterTypeServerless,
+			State:       types.ClusterStateCreating,
+			Serverless: &types.Serverless{
+				VpcConfigs: []types.VpcConfig{
+					{
+						SubnetIds:        []string{"subnet-0450a637af9984235"},
+						SecurityGroupIds: []string{"sg-0c6c4c1e3f2a1b2c3"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t testKafkaClient) ListClustersV2(ctx context.Context, params *kafka.ListClustersV2Input, optFns ...func(*kafka.Options)) (*kafka.ListClustersV2Output, error) {
+	out := &kafka.ListClustersV2Output{}
+	for _, cluster := range testKafkaClusters() {
+		if params.ClusterNameFilter == nil || strings.HasPrefix(*cluster.ClusterName, *params.ClusterNameFilter) {
+			out.ClusterInfoList = append(out.ClusterInfoList, cluster)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testKafkaClient) GetBootstrapBrokers(ctx context.Context, params *kafka.GetBootstrapBrokersInput, optFns ...func(*kafka.Options)) (*kafka.GetBootstrapBrokersOutput, error) {
+	if !strings.Contains(*params.ClusterArn, "cluster/orders/") {
+		return nil, errors.New("the cluster is in the CREATING state")
+	}
+
+	return &kafka.GetBootstrapBrokersOutput{
+		BootstrapBrokerStringTls:     aws.String("b-1.orders.abc123.c2.kafka.eu-west-2.amazonaws.com:9094,b-2.orders.abc123.c2.kafka.eu-west-2.amazonaws.com:9094"),
+		BootstrapBrokerStringSaslIam: aws.String("b-1.orders.abc123.c2.kafka.eu-west-2.amazonaws.com:9098,b-2.orders.abc123.c2.kafka.eu-west-2.amazonaws.com:9098"),
+	}, nil
+}
+
+func TestKafkaClusterItemMapper(t *testing.T) {
+	cluster, err := kafkaClusterGetFunc(context.Background(), testKafkaClient{}, "123456789012.eu-west-2", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *cluster.ClusterName != "orders" {
+		t.Fatalf("expected cluster orders, got %v", *cluster.ClusterName)
+	}
+
+	if len(cluster.BootstrapBrokers) != 4 {
+		t.Errorf("expected 4 bootstrap brokers, got %v", cluster.BootstrapBrokers)
+	}
+
+	item, err := kafkaClusterItemMapper(nil, "123456789012.eu-west-2", cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "orders" {
+		t.Errorf("expected team tag to be orders, got %v", item.GetTags()["team"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0d8ae4b4e07647efa",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b5b3b0d2e1f0a1b2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/msk/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "msk-broker-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-1.orders.abc123.c2.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-2.orders.abc123.c2.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Each broker host should only be linked once, and the disabled Firehose
+	// destination shouldn't be linked at all
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestKafkaClusterGetFunc(t *testing.T) {
+	t.Run("with the resource ID from an ARN", func(t *testing.T) {
+		cluster, err := kafkaClusterGetFunc(context.Background(), testKafkaClient{}, "123456789012.eu-west-2", "orders-events/9f8e7d6c-5b4a-3f2e-1d0c-9b8a7f6e5d4c-s1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *cluster.ClusterName != "orders-events" {
+			t.Errorf("expected cluster orders-events, got %v", *cluster.ClusterName)
+		}
+
+		if len(cluster.BootstrapBrokers) != 0 {
+			t.Errorf("expected no bootstrap brokers for a cluster being created, got %v", cluster.BootstrapBrokers)
+		}
+	})
+
+	t.Run("with a name that doesn't exist", func(t *testing.T) {
+		_, err := kafkaClusterGetFunc(context.Background(), testKafkaClient{}, "123456789012.eu-west-2", "order")
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+			t.Errorf("expected NOTFOUND error, got %v", err)
+		}
+	})
+}
+
+func TestKafkaClusterListFunc(t *testing.T) {
+	adapter := NewKafkaClusterAdapter(testKafkaClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+}
+
+func TestNewKafkaClusterAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kafka.NewFromConfig(config)
+
+	adapter := NewKafkaClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
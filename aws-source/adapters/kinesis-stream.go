@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type kinesisClient interface {
+	DescribeStreamSummary(ctx context.Context, params *kinesis.DescribeStreamSummaryInput, optFns ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error)
+	ListTagsForStream(ctx context.Context, params *kinesis.ListTagsForStreamInput, optFns ...func(*kinesis.Options)) (*kinesis.ListTagsForStreamOutput, error)
+
+	kinesis.ListStreamsAPIClient
+}
+
+func kinesisStreamGetFunc(ctx context.Context, client kinesisClient, scope, query string) (*types.StreamDescriptionSummary, error) {
+	out, err := client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.StreamDescriptionSummary == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "stream not found",
+		}
+	}
+
+	return out.StreamDescriptionSummary, nil
+}
+
+func kinesisStreamItemMapper(_ *string, scope string, awsItem *types.StreamDescriptionSummary) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kinesis-stream",
+		UniqueAttribute: "StreamName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.StreamStatus {
+	case types.StreamStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StreamStatusCreating, types.StreamStatusUpdating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.StreamStatusDeleting:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	if awsItem.EncryptionType == types.EncryptionTypeKms && awsItem.KeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.KeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func kinesisStreamListTagsFunc(ctx context.Context, stream *types.StreamDescriptionSummary, client kinesisClient) (map[string]string, error) {
+	tags := make(map[string]string)
+	input := &kinesis.ListTagsForStreamInput{
+		StreamARN: stream.StreamARN,
+	}
+
+	for {
+		out, err := client.ListTagsForStream(ctx, input)
+		if err != nil {
+			return adapterhelpers.HandleTagsError(ctx, err), nil
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+
+		if out.HasMoreTags == nil || !*out.HasMoreTags || len(out.Tags) == 0 {
+			return tags, nil
+		}
+		input.ExclusiveStartTagKey = out.Tags[len(out.Tags)-1].Key
+	}
+}
+
+func NewKinesisStreamAdapter(client kinesisClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*kinesis.ListStreamsInput, *kinesis.ListStreamsOutput, *types.StreamDescriptionSummary, kinesisClient, *kinesis.Options] {
+	return &adapterhelpers.GetListAdapterV2[*kinesis.ListStreamsInput, *kinesis.ListStreamsOutput, *types.StreamDescriptionSummary, kinesisClient, *kinesis.Options]{
+		ItemType:        "kinesis-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kinesisStreamAdapterMetadata,
+		GetFunc:         kinesisStreamGetFunc,
+		InputMapperList: func(scope string) (*kinesis.ListStreamsInput, error) {
+			return &kinesis.ListStreamsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client kinesisClient, params *kinesis.ListStreamsInput) adapterhelpers.Paginator[*kinesis.ListStreamsOutput, *kinesis.Options] {
+			return kinesis.NewListStreamsPaginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *kinesis.ListStreamsOutput, client kinesisClient) ([]*types.StreamDescriptionSummary, error) {
+			streams := make([]*types.StreamDescriptionSummary, 0, len(output.StreamSummaries))
+			for _, summary := range output.StreamSummaries {
+				if summary.StreamName == nil {
+					continue
+				}
+
+				stream, err := kinesisStreamGetFunc(ctx, client, "", *summary.StreamName)
+				if err != nil {
+					return nil, err
+				}
+
+				streams = append(streams, stream)
+			}
+			return streams, nil
+		},
+		ItemMapper:   kinesisStreamItemMapper,
+		ListTagsFunc: kinesisStreamListTagsFunc,
+	}
+}
+
+var kinesisStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kinesis-stream",
+	DescriptiveName: "Kinesis Data Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a stream by name",
+		ListDescription:   "List all streams",
+		SearchDescription: "Search for a stream by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_kinesis_stream.name",
+		},
+	},
+	PotentialLinks: []string{"kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var _ = Metadata.RegisterSchema(kinesisStreamAdapterMetadata, sdp.AttributeSchemaFor(types.StreamDescriptionSummary{}))
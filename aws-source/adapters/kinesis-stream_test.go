@@ -0,0 +1,156 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testKinesisClient struct{}
+
+func (t testKinesisClient) DescribeStreamSummary(ctx context.Context, params *kinesis.DescribeStreamSummaryInput, optFns ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error) {
+	if *params.StreamName != "clicks" {
+		return nil, &types.ResourceNotFoundException{
+			Message: aws.String("stream not found"),
+		}
+	}
+
+	return &kinesis.DescribeStreamSummaryOutput{
+		StreamDescriptionSummary: &types.StreamDescriptionSummary{
+			StreamName:              aws.String("clicks"),
+			StreamARN:               aws.String("arn:aws:kinesis:eu-west-2:123456789012:stream/clicks"),
+			StreamStatus:            types.StreamStatusUpdating,
+			StreamModeDetails:       &types.StreamModeDetails{StreamMode: types.StreamModeOnDemand},
+			RetentionPeriodHours:    aws.Int32(24),
+			OpenShardCount:          aws.Int32(4),
+			ConsumerCount:           aws.Int32(1),
+			EncryptionType:          types.EncryptionTypeKms,
+			KeyId:                   aws.String("alias/aws/kinesis"),
+			StreamCreationTimestamp: aws.Time(time.Now()),
+			EnhancedMonitoring: []types.EnhancedMetrics{
+				{ShardLevelMetrics: []types.MetricsName{types.MetricsNameIncomingBytes}},
+			},
+		},
+	}, nil
+}
+
+func (t testKinesisClient) ListStreams(ctx context.Context, params *kinesis.ListStreamsInput, optFns ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error) {
+	return &kinesis.ListStreamsOutput{
+		StreamNames: []string{"clicks"},
+		StreamSummaries: []types.StreamSummary{
+			{
+				StreamName:   aws.String("clicks"),
+				StreamARN:    aws.String("arn:aws:kinesis:eu-west-2:123456789012:stream/clicks"),
+				StreamStatus: types.StreamStatusUpdating,
+			},
+		},
+		HasMoreStreams: aws.Bool(false),
+	}, nil
+}
+
+func (t testKinesisClient) ListTagsForStream(ctx context.Context, params *kinesis.ListTagsForStreamInput, optFns ...func(*kinesis.Options)) (*kinesis.ListTagsForStreamOutput, error) {
+	// Return the tags over two pages
+	if params.ExclusiveStartTagKey == nil {
+		return &kinesis.ListTagsForStreamOutput{
+			Tags: []types.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+			},
+			HasMoreTags: aws.Bool(true),
+		}, nil
+	}
+
+	return &kinesis.ListTagsForStreamOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("team"), Value: aws.String("analytics")},
+		},
+		HasMoreTags: aws.Bool(false),
+	}, nil
+}
+
+func TestKinesisStreamItemMapper(t *testing.T) {
+	stream, err := kinesisStreamGetFunc(context.Background(), testKinesisClient{}, "123456789012.eu-west-2", "clicks")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := kinesisStreamItemMapper(nil, "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	// The stream is encrypted with an AWS managed key alias, which we can't
+	// link to
+	if len(item.GetLinkedItemQueries()) != 0 {
+		t.Errorf("expected no links, got %v", item.GetLinkedItemQueries())
+	}
+
+	stream.KeyId = aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+	item, err = kinesisStreamItemMapper(nil, "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestKinesisStreamListFunc(t *testing.T) {
+	adapter := NewKinesisStreamAdapter(testKinesisClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	tags := items[0].GetTags()
+	if tags["env"] != "prod" || tags["team"] != "analytics" {
+		t.Errorf("expected tags from both pages, got %v", tags)
+	}
+}
+
+func TestNewKinesisStreamAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kinesis.NewFromConfig(config)
+
+	adapter := NewKinesisStreamAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
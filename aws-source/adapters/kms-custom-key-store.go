@@ -128,3 +128,5 @@ var customKeyStoreAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"cloudhsmv2-cluster", "ec2-vpc-endpoint-service"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var _ = Metadata.RegisterSchema(customKeyStoreAdapterMetadata, sdp.AttributeSchemaFor(types.CustomKeyStoresListEntry{}, "tags"))
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -227,6 +228,8 @@ var grantAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
 
+var _ = Metadata.RegisterSchema(grantAdapterMetadata, sdp.AttributeSchemaFor(types.GrantListEntry{}, "tags").WithProperty("UniqueName", stringAttribute))
+
 // example: user/user-name-with-path
 func iamSourceAndQuery(resource string) (string, string) {
 	tmp := strings.Split(resource, "/") // [user, user-name-with-path]
@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/micahhausler/aws-iam-policy/policy"
 
@@ -17,6 +18,11 @@ type keyPolicyClient interface {
 	ListKeyPolicies(ctx context.Context, params *kms.ListKeyPoliciesInput, optFns ...func(*kms.Options)) (*kms.ListKeyPoliciesOutput, error)
 }
 
+type keyParsedPolicy struct {
+	*kms.GetKeyPolicyOutput
+	PolicyDocument *policy.Policy
+}
+
 func getKeyPolicyFunc(ctx context.Context, client keyPolicyClient, scope string, input *kms.GetKeyPolicyInput) (*sdp.Item, error) {
 	output, err := client.GetKeyPolicy(ctx, input)
 	if err != nil {
@@ -30,11 +36,6 @@ func getKeyPolicyFunc(ctx context.Context, client keyPolicyClient, scope string,
 		}
 	}
 
-	type keyParsedPolicy struct {
-		*kms.GetKeyPolicyOutput
-		PolicyDocument *policy.Policy
-	}
-
 	parsedPolicy := keyParsedPolicy{
 		GetKeyPolicyOutput: output,
 	}
@@ -81,6 +82,22 @@ func getKeyPolicyFunc(ctx context.Context, client keyPolicyClient, scope string,
 		},
 	})
 
+	// Link to the users and roles that the key policy allows to use the key.
+	// Key policies apply to the key they are attached to, so they usually
+	// use "*" as the resource
+	if accountID, region, err := adapterhelpers.ParseScope(scope); err == nil {
+		keyARN := adapterhelpers.ARN{
+			ARN: arn.ARN{
+				Partition: adapterhelpers.PartitionFromRegion(region),
+				Service:   "kms",
+				Region:    region,
+				AccountID: accountID,
+				Resource:  "key/" + *input.KeyId,
+			},
+		}
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPrincipalPermissions(EvaluateResourcePolicy(parsedPolicy.PolicyDocument, keyARN.String()))...)
+	}
+
 	return item, nil
 }
 
@@ -131,6 +148,8 @@ var keyPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_kms_key_policy.key_id"},
 	},
-	PotentialLinks: []string{"kms-key"},
+	PotentialLinks: []string{"kms-key", "iam-role", "iam-user"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(keyPolicyAdapterMetadata, sdp.AttributeSchemaFor(keyParsedPolicy{}).WithProperty("KeyId", stringAttribute))
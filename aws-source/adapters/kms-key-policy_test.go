@@ -93,6 +93,8 @@ func TestGetKeyPolicyFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "kms-key",
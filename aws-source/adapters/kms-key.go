@@ -167,3 +167,5 @@ var kmsKeyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-custom-key-store", "kms-key-policy", "kms-grant"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(kmsKeyAdapterMetadata, sdp.AttributeSchemaFor(&types.KeyMetadata{}))
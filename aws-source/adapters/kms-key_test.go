@@ -86,6 +86,8 @@ func TestKMSGetFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewKMSKeyAdapter(t *testing.T) {
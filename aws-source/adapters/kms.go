@@ -2,9 +2,13 @@ package adapters
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
 )
 
 func kmsTags(ctx context.Context, cli kmsClient, keyID string) (map[string]string, error) {
@@ -33,3 +37,45 @@ func kmsTagsToMap(tags []types.Tag) map[string]string {
 
 	return tagsMap
 }
+
+// Links to a KMS key that can be referenced by ID, ARN or alias. Aliases
+// aren't linked since they can't be resolved to a key without an API call
+func kmsKeyLink(scope, keyID string) *sdp.LinkedItemQuery {
+	blastPropagation := &sdp.BlastPropagation{
+		// Changing the key will affect the resource
+		In: true,
+		// Changing the resource won't affect the key
+		Out: false,
+	}
+
+	if a, err := adapterhelpers.ParseARN(keyID); err == nil {
+		if a.Type() != "key" {
+			// Aliases can't be resolved to a key
+			return nil
+		}
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "kms-key",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  keyID,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: blastPropagation,
+		}
+	}
+
+	if strings.HasPrefix(keyID, "alias/") {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "kms-key",
+			Method: sdp.QueryMethod_GET,
+			Query:  keyID,
+			Scope:  scope,
+		},
+		BlastPropagation: blastPropagation,
+	}
+}
@@ -244,3 +244,5 @@ var lambdaEventSourceMappingAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(lambdaEventSourceMappingAdapterMetadata, sdp.AttributeSchemaFor(&types.EventSourceMappingConfiguration{}))
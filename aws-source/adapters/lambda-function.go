@@ -158,37 +158,45 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 		}
 
 		if function.Code.ImageUri != nil {
-			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
-				Query: &sdp.Query{
-					Type:   "http",
-					Method: sdp.QueryMethod_SEARCH,
-					Query:  *function.Code.ImageUri,
-					Scope:  "global",
-				},
-				BlastPropagation: &sdp.BlastPropagation{
-					// Changing the image will affect the function
-					In: true,
-					// Changing the function won't affect the image
-					Out: false,
-				},
-			})
+			if link := ecrImageLink(*function.Code.ImageUri); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *function.Code.ImageUri,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the image will affect the function
+						In: true,
+						// Changing the function won't affect the image
+						Out: false,
+					},
+				})
+			}
 		}
 
 		if function.Code.ResolvedImageUri != nil {
-			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
-				Query: &sdp.Query{
-					Type:   "http",
-					Method: sdp.QueryMethod_SEARCH,
-					Query:  *function.Code.ResolvedImageUri,
-					Scope:  "global",
-				},
-				BlastPropagation: &sdp.BlastPropagation{
-					// Changing the image will affect the function
-					In: true,
-					// Changing the function won't affect the image
-					Out: false,
-				},
-			})
+			if link := ecrImageLink(*function.Code.ResolvedImageUri); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *function.Code.ResolvedImageUri,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the image will affect the function
+						In: true,
+						// Changing the function won't affect the image
+						Out: false,
+					},
+				})
+			}
 		}
 	}
 
@@ -280,6 +288,27 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 			}
 		}
 
+		// Functions log to /aws/lambda/{name} unless a log group is configured
+		logGroupName := "/aws/lambda/" + *function.Configuration.FunctionName
+		if function.Configuration.LoggingConfig != nil && function.Configuration.LoggingConfig.LogGroup != nil {
+			logGroupName = *function.Configuration.LoggingConfig.LogGroup
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  logGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The function can't log if the log group changes
+				In: true,
+				// The function writes to the log group
+				Out: true,
+			},
+		})
+
 		for _, layer := range function.Configuration.Layers {
 			if layer.Arn != nil {
 				if a, err = adapterhelpers.ParseARN(*layer.Arn); err == nil {
@@ -674,6 +703,8 @@ var lambdaFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_lambda_function_event_invoke_config.id"},
 		{TerraformQueryMap: "aws_lambda_function_url.function_arn"},
 	},
-	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group"},
+	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group", "ecr-image"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(lambdaFunctionAdapterMetadata, sdp.AttributeSchemaFor(FunctionDetails{}, "resultMetadata").WithProperty("Name", stringAttribute))
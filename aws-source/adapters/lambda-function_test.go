@@ -181,6 +181,8 @@ func TestFunctionGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "http",
@@ -278,6 +280,12 @@ func TestFunctionGetFunc(t *testing.T) {
 			ExpectedQuery:  "id",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/lambda/aws-controltower-NotificationForwarder",
+			ExpectedScope:  "foo",
+		},
 		{
 			ExpectedType:   "sns-topic",
 			ExpectedMethod: sdp.QueryMethod_GET,
@@ -146,3 +146,5 @@ var layerVersionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"signer-signing-job", "signer-signing-profile"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(layerVersionAdapterMetadata, sdp.AttributeSchemaFor(&lambda.GetLayerVersionOutput{}, "resultMetadata").WithProperty("FullName", stringAttribute))
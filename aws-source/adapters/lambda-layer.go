@@ -90,3 +90,5 @@ var lambdaLayerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"lambda-layer-version"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(lambdaLayerAdapterMetadata, sdp.AttributeSchemaFor(&types.LayersListItem{}))
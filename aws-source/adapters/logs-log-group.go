@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func logsLogGroupGetFunc(ctx context.Context, client logsClient, _, query string) (*types.LogGroup, error) {
+	// Log groups can only be described by prefix, so we need to find the exact
+	// match
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(client, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logGroup := range out.LogGroups {
+			if logGroup.LogGroupName != nil && *logGroup.LogGroupName == query {
+				return &logGroup, nil
+			}
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("log group %v not found", query),
+	}
+}
+
+func logsLogGroupListFunc(ctx context.Context, client logsClient, _ string) ([]*types.LogGroup, error) {
+	logGroups := make([]*types.LogGroup, 0)
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(client, &cloudwatchlogs.DescribeLogGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logGroup := range out.LogGroups {
+			logGroups = append(logGroups, &logGroup)
+		}
+	}
+
+	return logGroups, nil
+}
+
+// Log group ARNs can have a :* suffix, and the default search would include it
+// in the name
+func logsLogGroupSearchFunc(ctx context.Context, client logsClient, scope, query string) ([]*types.LogGroup, error) {
+	q := logsLogGroupQuery(query)
+	if q == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not a log group ARN", query),
+		}
+	}
+
+	if q.GetScope() != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", q.GetScope(), scope),
+			Scope:       scope,
+		}
+	}
+
+	logGroup, err := logsLogGroupGetFunc(ctx, client, scope, q.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.LogGroup{logGroup}, nil
+}
+
+func logsLogGroupListTags(ctx context.Context, logGroup *types.LogGroup, client logsClient) (map[string]string, error) {
+	// The tagging APIs use the ARN without the :* suffix
+	out, err := client.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: logGroup.LogGroupArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+func logsLogGroupOutputMapper(_, scope string, awsItem *types.LogGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "logs-log-group",
+		UniqueAttribute: "LogGroupName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.KmsKeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.KmsKeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.LogGroupName != nil {
+		if awsItem.MetricFilterCount == nil || *awsItem.MetricFilterCount > 0 {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-metric-filter",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.LogGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Metric filters can't affect the log group
+					In: false,
+					// Deleting the log group deletes its metric filters
+					Out: true,
+				},
+			})
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-subscription-filter",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.LogGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Subscription filters can't affect the log group
+				In: false,
+				// Deleting the log group deletes its subscription filters
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewLogsLogGroupAdapter(client logsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.LogGroup, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.GetListAdapter[*types.LogGroup, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-log-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logsLogGroupAdapterMetadata,
+		GetFunc:         logsLogGroupGetFunc,
+		ListFunc:        logsLogGroupListFunc,
+		SearchFunc:      logsLogGroupSearchFunc,
+		ItemMapper:      logsLogGroupOutputMapper,
+		ListTagsFunc:    logsLogGroupListTags,
+	}
+}
+
+var logsLogGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-log-group",
+	DescriptiveName: "CloudWatch Log Group",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a log group by name",
+		List:              true,
+		ListDescription:   "List all log groups",
+		Search:            true,
+		SearchDescription: "Search for a log group by ARN",
+	},
+	PotentialLinks: []string{"kms-key", "logs-metric-filter", "logs-subscription-filter"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudwatch_log_group.name"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(logsLogGroupAdapterMetadata, sdp.AttributeSchemaFor(&types.LogGroup{}))
@@ -0,0 +1,133 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestLogsLogGroupGet(t *testing.T) {
+	adapter := NewLogsLogGroupAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "/aws/lambda/payments", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "/aws/lambda/payments" {
+		t.Errorf("expected unique attribute value /aws/lambda/payments, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetTags()["team"] != "payments" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-metric-filter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "/aws/lambda/payments",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-subscription-filter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "/aws/lambda/payments",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestLogsLogGroupGetWithoutMetricFilters(t *testing.T) {
+	adapter := NewLogsLogGroupAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "/aws/lambda/payments-worker", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "logs-metric-filter" {
+			t.Errorf("expected no metric filter link for a group without metric filters, got %v", link)
+		}
+	}
+}
+
+func TestLogsLogGroupGetNotFound(t *testing.T) {
+	adapter := NewLogsLogGroupAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	// Matches the prefix of existing groups, but not exactly
+	_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "/aws/lambda/pay", false)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestLogsLogGroupList(t *testing.T) {
+	adapter := NewLogsLogGroupAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 log groups, got %v", len(items))
+	}
+}
+
+func TestLogsLogGroupSearch(t *testing.T) {
+	adapter := NewLogsLogGroupAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments:*", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 log group, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "/aws/lambda/payments" {
+		t.Errorf("expected /aws/lambda/payments, got %v", items[0].UniqueAttributeValue())
+	}
+
+	_, err = adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/payments:*", false)
+	if err == nil {
+		t.Error("expected an error for an ARN in another region")
+	}
+}
+
+func TestNewLogsLogGroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cloudwatchlogs.NewFromConfig(config)
+
+	adapter := NewLogsLogGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
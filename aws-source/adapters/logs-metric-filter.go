@@ -0,0 +1,228 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// logsMetricFilter is a metric filter along with a name that is unique within
+// the region, since filter names are only unique within their log group
+type logsMetricFilter struct {
+	// The log group name and filter name separated by a colon
+	UniqueName string
+	types.MetricFilter
+}
+
+// Converts a metric to a search query that finds the metric filters that
+// publish it
+func logsMetricFilterQueryString(namespace string, metricName string) (string, error) {
+	b, err := json.Marshal(&cloudwatchlogs.DescribeMetricFiltersInput{
+		MetricNamespace: &namespace,
+		MetricName:      &metricName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func newLogsMetricFilter(filter types.MetricFilter) *logsMetricFilter {
+	f := &logsMetricFilter{
+		MetricFilter: filter,
+	}
+
+	if filter.LogGroupName != nil && filter.FilterName != nil {
+		f.UniqueName = *filter.LogGroupName + ":" + *filter.FilterName
+	}
+
+	return f
+}
+
+func describeLogsMetricFilters(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeMetricFiltersInput) ([]*logsMetricFilter, error) {
+	filters := make([]*logsMetricFilter, 0)
+	paginator := cloudwatchlogs.NewDescribeMetricFiltersPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, filter := range out.MetricFilters {
+			filters = append(filters, newLogsMetricFilter(filter))
+		}
+	}
+
+	return filters, nil
+}
+
+func logsMetricFilterGetFunc(ctx context.Context, client logsClient, _, query string) (*logsMetricFilter, error) {
+	logGroupName, filterName, err := parseLogsFilterQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := describeLogsMetricFilters(ctx, client, &cloudwatchlogs.DescribeMetricFiltersInput{
+		LogGroupName:     &logGroupName,
+		FilterNamePrefix: &filterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Filters can only be described by prefix, so we need to find the exact
+	// match
+	for _, filter := range filters {
+		if filter.FilterName != nil && *filter.FilterName == filterName {
+			return filter, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("metric filter %v not found", query),
+	}
+}
+
+func logsMetricFilterListFunc(ctx context.Context, client logsClient, _ string) ([]*logsMetricFilter, error) {
+	return describeLogsMetricFilters(ctx, client, &cloudwatchlogs.DescribeMetricFiltersInput{})
+}
+
+// Searches for the metric filters of a log group by the name of the log group,
+// or for the filters that publish a metric by JSON in the format of
+// `cloudwatchlogs.DescribeMetricFiltersInput`. Log group names can't start
+// with a brace
+func logsMetricFilterSearchFunc(ctx context.Context, client logsClient, _, query string) ([]*logsMetricFilter, error) {
+	if strings.HasPrefix(query, "{") {
+		input := &cloudwatchlogs.DescribeMetricFiltersInput{}
+		if err := json.Unmarshal([]byte(query), input); err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: fmt.Sprintf("invalid metric filter search: %v", err),
+			}
+		}
+
+		return describeLogsMetricFilters(ctx, client, &cloudwatchlogs.DescribeMetricFiltersInput{
+			MetricName:      input.MetricName,
+			MetricNamespace: input.MetricNamespace,
+		})
+	}
+
+	return describeLogsMetricFilters(ctx, client, &cloudwatchlogs.DescribeMetricFiltersInput{
+		LogGroupName: &query,
+	})
+}
+
+func logsMetricFilterOutputMapper(_, scope string, awsItem *logsMetricFilter) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "logs-metric-filter",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.LogGroupName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.LogGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The filter is evaluated against the events of the log group,
+				// and is deleted with it
+				In: true,
+				// The filter can't affect the log group
+				Out: false,
+			},
+		})
+	}
+
+	// Link to the alarms on the metrics that the filter publishes. Dimension
+	// values come from the log events, so only alarms on the metric without
+	// dimensions can be found
+	for _, transformation := range awsItem.MetricTransformations {
+		if transformation.MetricName == nil || transformation.MetricNamespace == nil {
+			continue
+		}
+
+		query, err := ToQueryString(&cloudwatch.DescribeAlarmsForMetricInput{
+			MetricName: transformation.MetricName,
+			Namespace:  transformation.MetricNamespace,
+		})
+		if err != nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cloudwatch-alarm",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  query,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Alarms can't affect the filter
+				In: false,
+				// Changing the filter changes the metric that the alarms
+				// evaluate
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewLogsMetricFilterAdapter(client logsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*logsMetricFilter, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.GetListAdapter[*logsMetricFilter, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-metric-filter",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logsMetricFilterAdapterMetadata,
+		GetFunc:         logsMetricFilterGetFunc,
+		ListFunc:        logsMetricFilterListFunc,
+		SearchFunc:      logsMetricFilterSearchFunc,
+		ItemMapper:      logsMetricFilterOutputMapper,
+	}
+}
+
+var logsMetricFilterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-metric-filter",
+	DescriptiveName: "CloudWatch Logs Metric Filter",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a metric filter by log group name and filter name, separated by a colon",
+		List:              true,
+		ListDescription:   "List all metric filters",
+		Search:            true,
+		SearchDescription: "Search for the metric filters of a log group by log group name, or for the filters that publish a metric with JSON in the format of `cloudwatchlogs.DescribeMetricFiltersInput`",
+	},
+	PotentialLinks: []string{"logs-log-group", "cloudwatch-alarm"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_log_metric_filter.log_group_name",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(logsMetricFilterAdapterMetadata, sdp.AttributeSchemaFor(&logsMetricFilter{}))
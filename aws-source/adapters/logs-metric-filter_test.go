@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestLogsMetricFilterGet(t *testing.T) {
+	adapter := NewLogsMetricFilterAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "/aws/lambda/payments:errors", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "/aws/lambda/payments:errors" {
+		t.Errorf("expected unique attribute value /aws/lambda/payments:errors, got %v", item.UniqueAttributeValue())
+	}
+
+	alarmQuery, err := ToQueryString(&cloudwatch.DescribeAlarmsForMetricInput{
+		MetricName: aws.String("PaymentErrors"),
+		Namespace:  aws.String("Payments"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/lambda/payments",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  alarmQuery,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestLogsMetricFilterGetInvalidQuery(t *testing.T) {
+	adapter := NewLogsMetricFilterAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "errors", false)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestLogsMetricFilterList(t *testing.T) {
+	adapter := NewLogsMetricFilterAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 metric filters, got %v", len(items))
+	}
+}
+
+func TestLogsMetricFilterSearch(t *testing.T) {
+	adapter := NewLogsMetricFilterAdapter(testLogsClient{}, "123456789012", "eu-west-2")
+
+	t.Run("log group", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "/aws/lambda/payments", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 2 {
+			t.Fatalf("expected 2 metric filters, got %v", len(items))
+		}
+	})
+
+	t.Run("metric", func(t *testing.T) {
+		query, err := logsMetricFilterQueryString("Payments", "PaymentErrors")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 {
+			t.Fatalf("expected 1 metric filter, got %v", len(items))
+		}
+
+		if items[0].UniqueAttributeValue() != "/aws/lambda/payments:errors" {
+			t.Errorf("expected /aws/lambda/payments:errors, got %v", items[0].UniqueAttributeValue())
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "{not json", false)
+		if err == nil {
+			t.Error("expected an error, got nil")
+		}
+	})
+}
+
+func TestNewLogsMetricFilterAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := cloudwatchlogs.NewFromConfig(config)
+
+	adapter := NewLogsMetricFilterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
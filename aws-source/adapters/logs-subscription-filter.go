@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// logsSubscriptionFilter is a subscription filter along with a name that is
+// unique within the region, since filter names are only unique within their
+// log group
+type logsSubscriptionFilter struct {
+	// The log group name and filter name separated by a colon
+	UniqueName string
+	types.SubscriptionFilter
+}
+
+func describeLogsSubscriptionFilters(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeSubscriptionFiltersInput) ([]*logsSubscriptionFilter, error) {
+	filters := make([]*logsSubscriptionFilter, 0)
+	paginator := cloudwatchlogs.NewDescribeSubscriptionFiltersPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, filter := range out.SubscriptionFilters {
+			f := &logsSubscriptionFilter{
+				SubscriptionFilter: filter,
+			}
+
+			if filter.LogGroupName != nil && filter.FilterName != nil {
+				f.UniqueName = *filter.LogGroupName + ":" + *filter.FilterName
+			}
+
+			filters = append(filters, f)
+		}
+	}
+
+	return filters, nil
+}
+
+func logsSubscriptionFilterGetFunc(ctx context.Context, client logsClient, _, query string) (*logsSubscriptionFilter, error) {
+	logGroupName, filterName, err := parseLogsFilterQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := describeLogsSubscriptionFilters(ctx, client, &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+		LogGroupName:     &logGroupName,
+		FilterNamePrefix: &filterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Filters can only be described by prefix, so we need to find the exact
+	// match
+	for _, filter := range filters {
+		if filter.FilterName != nil && *filter.FilterName == filterName {
+			return filter, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("subscription filter %v not found", query),
+	}
+}
+
+// Searches for the subscription filters of a log group by the name of the log
+// group
+func logsSubscriptionFilterSearchFunc(ctx context.Context, client logsClient, _, query string) ([]*logsSubscriptionFilter, error) {
+	return describeLogsSubscriptionFilters(ctx, client, &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+		LogGroupName: &query,
+	})
+}
+
+func logsSubscriptionFilterOutputMapper(_, scope string, awsItem *logsSubscriptionFilter) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "logs-subscription-filter",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.LogGroupName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.LogGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The filter is evaluated against the events of the log group,
+				// and is deleted with it
+				In: true,
+				// The filter can't affect the log group
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.DestinationArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.DestinationArn); err == nil {
+			var query *sdp.Query
+			switch a.Service {
+			case "lambda":
+				query = &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.DestinationArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				}
+			case "kinesis":
+				query = &sdp.Query{
+					Type:   "kinesis-stream",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.DestinationArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				}
+			case "firehose":
+				query = &sdp.Query{
+					Type:   "firehose-delivery-stream",
+					Method: sdp.QueryMethod_GET,
+					Query:  a.ResourceID(),
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				}
+			}
+
+			// Cross-account destinations aren't linked since they only
+			// exist in the account that receives the logs
+			if query != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: query,
+					BlastPropagation: &sdp.BlastPropagation{
+						// Logs can't be delivered if the destination changes
+						In: true,
+						// The filter sends log events to the destination
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.RoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.RoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.RoleArn,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The role is used to deliver log events to the
+					// destination
+					In: true,
+					// The filter can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewLogsSubscriptionFilterAdapter(client logsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*logsSubscriptionFilter, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.GetListAdapter[*logsSubscriptionFilter, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-subscription-filter",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logsSubscriptionFilterAdapterMetadata,
+		GetFunc:         logsSubscriptionFilterGetFunc,
+		// Subscription filters can only be described for a single log group
+		DisableList: true,
+		SearchFunc:  logsSubscriptionFilterSearchFunc,
+		ItemMapper:  logsSubscriptionFilterOutputMapper,
+	}
+}
+
+var logsSubscriptionFilterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-subscription-filter",
+	DescriptiveName: "CloudWatch Logs Subscription Filter",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a subscription filter by log group name and filter name, separated by a colon",
+		Search:            true,
+		SearchDescription: "Search for the subscription filters of a log group by log group name",
+	},
+	PotentialLinks: []string{"logs-log-group", "lambda-function", "kinesis-stream", "firehose-delivery-stream", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_log_subscription_filter.log_group_name",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(logsSubscriptionFilterAdapterMetadata, sdp.AttributeSchemaFor(&logsSubscriptionFilter{}))
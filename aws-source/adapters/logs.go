@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type logsClient interface {
+	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	DescribeMetricFilters(ctx context.Context, params *cloudwatchlogs.DescribeMetricFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeMetricFiltersOutput, error)
+	DescribeSubscriptionFilters(ctx context.Context, params *cloudwatchlogs.DescribeSubscriptionFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error)
+	ListTagsForResource(ctx context.Context, params *cloudwatchlogs.ListTagsForResourceInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error)
+}
+
+// Returns a query for a log group from its ARN. The ARN is in the format
+// arn:aws:logs:{region}:{account}:log-group:{name}, sometimes with a :* suffix,
+// and log groups are looked up by name. Returns nil if the ARN isn't for a log
+// group
+func logsLogGroupQuery(logGroupARN string) *sdp.Query {
+	a, err := adapterhelpers.ParseARN(logGroupARN)
+	if err != nil || a.Service != "logs" || !strings.HasPrefix(a.Resource, "log-group:") {
+		return nil
+	}
+
+	return &sdp.Query{
+		Type:   "logs-log-group",
+		Method: sdp.QueryMethod_GET,
+		Query:  strings.TrimSuffix(strings.TrimPrefix(a.Resource, "log-group:"), ":*"),
+		Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+	}
+}
+
+// Filters are only unique within their log group, so are queried by the name
+// of the log group and the name of the filter separated by a colon. Log group
+// names can't contain colons
+func parseLogsFilterQuery(query string) (string, string, error) {
+	logGroupName, filterName, found := strings.Cut(query, ":")
+	if !found || logGroupName == "" || filterName == "" {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format log-group-name:filter-name, got %v", query),
+		}
+	}
+
+	return logGroupName, filterName, nil
+}
@@ -0,0 +1,245 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+var testLogGroups = []types.LogGroup{
+	{
+		LogGroupName:      aws.String("/aws/lambda/payments"),
+		Arn:               aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments:*"),
+		LogGroupArn:       aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments"),
+		KmsKeyId:          aws.String("arn:aws:kms:eu-west-2:123456789012:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+		MetricFilterCount: aws.Int32(2),
+		RetentionInDays:   aws.Int32(30),
+		StoredBytes:       aws.Int64(1024),
+		LogGroupClass:     types.LogGroupClassStandard,
+		CreationTime:      aws.Int64(1700000000000),
+	},
+	{
+		// Shares a prefix with the group above
+		LogGroupName:      aws.String("/aws/lambda/payments-worker"),
+		Arn:               aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments-worker:*"),
+		LogGroupArn:       aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments-worker"),
+		MetricFilterCount: aws.Int32(0),
+		LogGroupClass:     types.LogGroupClassStandard,
+		CreationTime:      aws.Int64(1700000000000),
+	},
+}
+
+var testMetricFilters = []types.MetricFilter{
+	{
+		LogGroupName:  aws.String("/aws/lambda/payments"),
+		FilterName:    aws.String("errors"),
+		FilterPattern: aws.String(`{ $.level = "ERROR" }`),
+		MetricTransformations: []types.MetricTransformation{
+			{
+				MetricName:      aws.String("PaymentErrors"),
+				MetricNamespace: aws.String("Payments"),
+				MetricValue:     aws.String("1"),
+			},
+		},
+		CreationTime: aws.Int64(1700000000000),
+	},
+	{
+		// Shares a prefix with the filter above
+		LogGroupName:  aws.String("/aws/lambda/payments"),
+		FilterName:    aws.String("errors-5xx"),
+		FilterPattern: aws.String(`{ $.status >= 500 }`),
+		MetricTransformations: []types.MetricTransformation{
+			{
+				MetricName:      aws.String("Payment5xx"),
+				MetricNamespace: aws.String("Payments"),
+				MetricValue:     aws.String("1"),
+			},
+		},
+		CreationTime: aws.Int64(1700000000000),
+	},
+}
+
+var testSubscriptionFilters = []types.SubscriptionFilter{
+	{
+		LogGroupName:   aws.String("/aws/lambda/payments"),
+		FilterName:     aws.String("to-firehose"),
+		FilterPattern:  aws.String(""),
+		DestinationArn: aws.String("arn:aws:firehose:eu-west-2:123456789012:deliverystream/central-logs"),
+		RoleArn:        aws.String("arn:aws:iam::123456789012:role/CWLtoFirehose"),
+		Distribution:   types.DistributionByLogStream,
+		CreationTime:   aws.Int64(1700000000000),
+	},
+	{
+		LogGroupName:   aws.String("/aws/lambda/payments"),
+		FilterName:     aws.String("to-lambda"),
+		FilterPattern:  aws.String("ERROR"),
+		DestinationArn: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:log-shipper"),
+		Distribution:   types.DistributionByLogStream,
+		CreationTime:   aws.Int64(1700000000000),
+	},
+	{
+		// Destinations in other accounts aren't linked
+		LogGroupName:   aws.String("/aws/lambda/payments"),
+		FilterName:     aws.String("to-security"),
+		FilterPattern:  aws.String(""),
+		DestinationArn: aws.String("arn:aws:logs:eu-west-2:210987654321:destination:security-logs"),
+		Distribution:   types.DistributionByLogStream,
+		CreationTime:   aws.Int64(1700000000000),
+	},
+}
+
+func matchesPrefix(value *string, prefix *string) bool {
+	return prefix == nil || (value != nil && strings.HasPrefix(*value, *prefix))
+}
+
+func matchesExactly(value *string, expected *string) bool {
+	return expected == nil || (value != nil && *value == *expected)
+}
+
+type testLogsClient struct{}
+
+// Returns one log group per page to test pagination
+func (t testLogsClient) DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	matching := make([]types.LogGroup, 0)
+	for _, logGroup := range testLogGroups {
+		if matchesPrefix(logGroup.LogGroupName, params.LogGroupNamePrefix) {
+			matching = append(matching, logGroup)
+		}
+	}
+
+	page := 0
+	if params.NextToken != nil && *params.NextToken == "page-2" {
+		page = 1
+	}
+
+	out := &cloudwatchlogs.DescribeLogGroupsOutput{}
+	if page < len(matching) {
+		out.LogGroups = matching[page : page+1]
+	}
+
+	if page == 0 && len(matching) > 1 {
+		out.NextToken = aws.String("page-2")
+	}
+
+	return out, nil
+}
+
+func (t testLogsClient) DescribeMetricFilters(ctx context.Context, params *cloudwatchlogs.DescribeMetricFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+	out := &cloudwatchlogs.DescribeMetricFiltersOutput{}
+
+	for _, filter := range testMetricFilters {
+		if !matchesExactly(filter.LogGroupName, params.LogGroupName) || !matchesPrefix(filter.FilterName, params.FilterNamePrefix) {
+			continue
+		}
+
+		if params.MetricName != nil || params.MetricNamespace != nil {
+			matched := false
+			for _, transformation := range filter.MetricTransformations {
+				if matchesExactly(transformation.MetricName, params.MetricName) && matchesExactly(transformation.MetricNamespace, params.MetricNamespace) {
+					matched = true
+				}
+			}
+
+			if !matched {
+				continue
+			}
+		}
+
+		out.MetricFilters = append(out.MetricFilters, filter)
+	}
+
+	return out, nil
+}
+
+func (t testLogsClient) DescribeSubscriptionFilters(ctx context.Context, params *cloudwatchlogs.DescribeSubscriptionFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+	out := &cloudwatchlogs.DescribeSubscriptionFiltersOutput{}
+
+	for _, filter := range testSubscriptionFilters {
+		if matchesExactly(filter.LogGroupName, params.LogGroupName) && matchesPrefix(filter.FilterName, params.FilterNamePrefix) {
+			out.SubscriptionFilters = append(out.SubscriptionFilters, filter)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testLogsClient) ListTagsForResource(ctx context.Context, params *cloudwatchlogs.ListTagsForResourceInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
+	return &cloudwatchlogs.ListTagsForResourceOutput{
+		Tags: map[string]string{
+			"team": "payments",
+		},
+	}, nil
+}
+
+func TestLogsLogGroupQuery(t *testing.T) {
+	tests := []struct {
+		ARN           string
+		ExpectedQuery string
+		ExpectedScope string
+	}{
+		{
+			ARN:           "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/payments:*",
+			ExpectedQuery: "/aws/lambda/payments",
+			ExpectedScope: "123456789012.eu-west-2",
+		},
+		{
+			ARN:           "arn:aws:logs:us-east-1:123456789012:log-group:API-Gateway-Access-Logs",
+			ExpectedQuery: "API-Gateway-Access-Logs",
+			ExpectedScope: "123456789012.us-east-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ARN, func(t *testing.T) {
+			query := logsLogGroupQuery(test.ARN)
+			if query == nil {
+				t.Fatal("expected a query, got nil")
+			}
+
+			if query.GetType() != "logs-log-group" || query.GetMethod() != sdp.QueryMethod_GET {
+				t.Errorf("expected a logs-log-group GET, got %v %v", query.GetType(), query.GetMethod())
+			}
+
+			if query.GetQuery() != test.ExpectedQuery {
+				t.Errorf("expected query %v, got %v", test.ExpectedQuery, query.GetQuery())
+			}
+
+			if query.GetScope() != test.ExpectedScope {
+				t.Errorf("expected scope %v, got %v", test.ExpectedScope, query.GetScope())
+			}
+		})
+	}
+
+	for _, arn := range []string{
+		"arn:aws:logs:eu-west-2:123456789012:destination:security-logs",
+		"arn:aws:firehose:eu-west-2:123456789012:deliverystream/central-logs",
+		"not-an-arn",
+	} {
+		if query := logsLogGroupQuery(arn); query != nil {
+			t.Errorf("expected no query for %v, got %v", arn, query)
+		}
+	}
+}
+
+func TestParseLogsFilterQuery(t *testing.T) {
+	logGroupName, filterName, err := parseLogsFilterQuery("/aws/lambda/payments:errors")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if logGroupName != "/aws/lambda/payments" || filterName != "errors" {
+		t.Errorf("unexpected log group %v and filter %v", logGroupName, filterName)
+	}
+
+	for _, query := range []string{"/aws/lambda/payments", ":errors", "/aws/lambda/payments:"} {
+		if _, _, err := parseLogsFilterQuery(query); err == nil {
+			t.Errorf("expected an error for %v", query)
+		}
+	}
+}
@@ -1,7 +1,19 @@
 package adapters
 
 import (
+	"github.com/overmindtech/cli/aws-source/cost"
 	"github.com/overmindtech/cli/sdp-go"
 )
 
-var Metadata = sdp.AdapterMetadataList{}
+var Metadata = sdp.AdapterMetadataList{
+	// Cost estimates add these to items when they are enabled, see
+	// `cost.Enricher`
+	SchemaProperties: map[string]*sdp.AttributeSchema{
+		cost.EstimatedCostAttribute:   cost.EstimatedCostSchema,
+		cost.HistoricalSpendAttribute: cost.HistoricalSpendSchema,
+	},
+}
+
+// stringAttribute is the schema of the string attributes that adapters add to
+// those of the AWS type, such as unique attributes made from several IDs
+var stringAttribute = &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}}
@@ -171,3 +171,5 @@ var firewallPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"network-firewall-rule-group", "network-firewall-tls-inspection-configuration", "kms-key"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(firewallPolicyAdapterMetadata, sdp.AttributeSchemaFor(unifiedFirewallPolicy{}))
@@ -102,6 +102,8 @@ func TestFirewallPolicyGetFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "kms-key",
@@ -314,3 +314,5 @@ var networkFirewallFirewallAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	PotentialLinks: []string{"network-firewall-firewall-policy", "ec2-subnet", "ec2-vpc", "logs-log-group", "s3-bucket", "firehose-delivery-stream", "iam-policy", "kms-key"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(networkFirewallFirewallAdapterMetadata, sdp.AttributeSchemaFor(unifiedFirewall{}))
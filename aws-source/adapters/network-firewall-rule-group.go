@@ -162,3 +162,5 @@ var ruleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-key", "sns-topic", "network-firewall-rule-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var _ = Metadata.RegisterSchema(ruleGroupAdapterMetadata, sdp.AttributeSchemaFor(unifiedRuleGroup{}))
@@ -207,3 +207,5 @@ var tlsInspectionConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"acm-certificate", "acm-pca-certificate-authority", "acm-pca-certificate-authority-certificate", "network-firewall-encryption-configuration"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(tlsInspectionConfigurationAdapterMetadata, sdp.AttributeSchemaFor(unifiedTLSInspectionConfiguration{}))
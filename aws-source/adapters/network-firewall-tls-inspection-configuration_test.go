@@ -115,6 +115,8 @@ func TestTLSInspectionConfigurationGetFunc(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "acm-pca-certificate-authority-certificate",
@@ -112,3 +112,5 @@ var connectAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(connectAttachmentAdapterMetadata, sdp.AttributeSchemaFor(&types.ConnectAttachment{}).WithProperty("AttachmentId", stringAttribute))
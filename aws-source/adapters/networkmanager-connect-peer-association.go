@@ -174,3 +174,5 @@ var connectPeerAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetada
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-connect-peer", "networkmanager-device", "networkmanager-link"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(connectPeerAssociationAdapterMetadata, sdp.AttributeSchemaFor(types.ConnectPeerAssociation{}).WithProperty("GlobalNetworkIdConnectPeerId", stringAttribute))
@@ -247,3 +247,5 @@ var connectPeerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network", "networkmanager-connect-attachment", "ip", "rdap-asn", "ec2-subnet"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(connectPeerAdapterMetadata, sdp.AttributeSchemaFor(&types.ConnectPeer{}, "tags"))
@@ -255,3 +255,5 @@ var networkmanagerConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(networkmanagerConnectionAdapterMetadata, sdp.AttributeSchemaFor(types.Connection{}, "tags").WithProperty("GlobalNetworkIdConnectionId", stringAttribute))
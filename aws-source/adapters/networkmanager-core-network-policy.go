@@ -89,3 +89,5 @@ var coreNetworkPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(coreNetworkPolicyAdapterMetadata, sdp.AttributeSchemaFor(&types.CoreNetworkPolicy{}))
@@ -154,3 +154,5 @@ var coreNetworkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network-policy", "networkmanager-connect-peer"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(coreNetworkAdapterMetadata, sdp.AttributeSchemaFor(&types.CoreNetwork{}))
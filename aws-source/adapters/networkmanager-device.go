@@ -234,3 +234,5 @@ var networkmanagerDeviceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-site", "networkmanager-link-association", "networkmanager-connection", "networkmanager-network-resource-relationship"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(networkmanagerDeviceAdapterMetadata, sdp.AttributeSchemaFor(types.Device{}, "tags").WithProperty("GlobalNetworkIdDeviceId", stringAttribute))
@@ -190,3 +190,5 @@ var linkAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(linkAssociationAdapterMetadata, sdp.AttributeSchemaFor(types.LinkAssociation{}, "tags").WithProperty("GlobalNetworkIdLinkIdDeviceId", stringAttribute))
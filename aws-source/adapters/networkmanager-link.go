@@ -218,3 +218,5 @@ var linkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link-association", "networkmanager-site", "networkmanager-network-resource-relationship"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(linkAdapterMetadata, sdp.AttributeSchemaFor(types.Link{}, "tags").WithProperty("GlobalNetworkIdLinkId", stringAttribute))
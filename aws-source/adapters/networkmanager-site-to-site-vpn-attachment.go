@@ -121,3 +121,5 @@ var siteToSiteVpnAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	PotentialLinks: []string{"networkmanager-core-network", "ec2-vpn-connection"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(siteToSiteVpnAttachmentAdapterMetadata, sdp.AttributeSchemaFor(&types.SiteToSiteVpnAttachment{}).WithProperty("AttachmentId", stringAttribute))
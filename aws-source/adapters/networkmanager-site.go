@@ -185,3 +185,5 @@ var siteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(siteAdapterMetadata, sdp.AttributeSchemaFor(types.Site{}, "tags").WithProperty("GlobalNetworkIdSiteId", stringAttribute))
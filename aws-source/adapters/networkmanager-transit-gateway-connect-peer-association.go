@@ -159,3 +159,5 @@ var transitGatewayConnectPeerAssociationAdapterMetadata = Metadata.Register(&sdp
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-device", "networkmanager-link"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(transitGatewayConnectPeerAssociationAdapterMetadata, sdp.AttributeSchemaFor(types.TransitGatewayConnectPeerAssociation{}, "tags").WithProperty("GlobalNetworkIdWithTransitGatewayConnectPeerArn", stringAttribute))
@@ -70,6 +70,8 @@ func TestTransitGatewayConnectPeerAssociationsOutputMapper(t *testing.T) {
 				if err := item.Validate(); err != nil {
 					t.Error(err)
 				}
+
+				validateAttributeSchema(t, item)
 			}
 
 			if len(items) != 1 {
@@ -137,3 +137,5 @@ var transitGatewayPeeringAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	PotentialLinks: []string{"networkmanager-core-network", "ec2-transit-gateway-peering-attachment", "ec2-transit-gateway"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(transitGatewayPeeringAdapterMetadata, sdp.AttributeSchemaFor(&types.TransitGatewayPeering{}).WithProperty("PeeringId", stringAttribute))
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -137,3 +138,5 @@ var transitGatewayRegistrationAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"networkmanager-global-network", "ec2-transit-gateway"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(transitGatewayRegistrationAdapterMetadata, sdp.AttributeSchemaFor(types.TransitGatewayRegistration{}).WithProperty("GlobalNetworkIdWithTransitGatewayARN", stringAttribute))
@@ -90,6 +90,8 @@ func TestTransitGatewayRegistrationOutputMapper(t *testing.T) {
 				if err := item.Validate(); err != nil {
 					t.Error(err)
 				}
+
+				validateAttributeSchema(t, item)
 			}
 
 			if len(items) != 1 {
@@ -125,3 +125,5 @@ var transitGatewayRouteTableAttachmentAdapterMetadata = Metadata.Register(&sdp.A
 	PotentialLinks: []string{"networkmanager-core-network", "networkmanager-transit-gateway-peering", "ec2-transit-gateway-route-table"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(transitGatewayRouteTableAttachmentAdapterMetadata, sdp.AttributeSchemaFor(&types.TransitGatewayRouteTableAttachment{}).WithProperty("AttachmentId", stringAttribute))
@@ -97,6 +97,8 @@ func TestTransitGatewayRouteTableAttachmentItemMapper(t *testing.T) {
 			if err := item.Validate(); err != nil {
 				t.Error(err)
 			}
+
+			validateAttributeSchema(t, item)
 			// Ensure unique attribute
 			if item.UniqueAttributeValue() != tt.expectedAttr {
 				t.Fatalf("expected %s, got %s", tt.expectedAttr, item.UniqueAttributeValue())
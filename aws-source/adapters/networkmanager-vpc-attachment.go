@@ -93,3 +93,5 @@ var vpcAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(vpcAttachmentAdapterMetadata, sdp.AttributeSchemaFor(&types.VpcAttachment{}).WithProperty("AttachmentId", stringAttribute))
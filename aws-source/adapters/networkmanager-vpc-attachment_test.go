@@ -26,6 +26,8 @@ func TestVPCAttachmentItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	// Ensure unique attribute
 	if item.UniqueAttributeValue() != "attachment1" {
 		t.Fatalf("expected %v, got %v", "attachment1", item.UniqueAttributeValue())
@@ -0,0 +1,338 @@
+package adapters
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type opensearchClient interface {
+	DescribeDomain(ctx context.Context, params *opensearch.DescribeDomainInput, optFns ...func(*opensearch.Options)) (*opensearch.DescribeDomainOutput, error)
+	DescribeDomains(ctx context.Context, params *opensearch.DescribeDomainsInput, optFns ...func(*opensearch.Options)) (*opensearch.DescribeDomainsOutput, error)
+	ListDomainNames(ctx context.Context, params *opensearch.ListDomainNamesInput, optFns ...func(*opensearch.Options)) (*opensearch.ListDomainNamesOutput, error)
+	ListTags(ctx context.Context, params *opensearch.ListTagsInput, optFns ...func(*opensearch.Options)) (*opensearch.ListTagsOutput, error)
+}
+
+// The maximum number of domains that can be passed to DescribeDomains
+const opensearchDescribeDomainsLimit = 5
+
+func opensearchDomainGetFunc(ctx context.Context, client opensearchClient, scope, query string) (*types.DomainStatus, error) {
+	out, err := client.DescribeDomain(ctx, &opensearch.DescribeDomainInput{
+		DomainName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DomainStatus == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "domain not found",
+		}
+	}
+
+	return out.DomainStatus, nil
+}
+
+// Lists all domain names, then describes them in batches since the API
+// doesn't return the details
+func opensearchDomainListFunc(ctx context.Context, client opensearchClient, scope string) ([]*types.DomainStatus, error) {
+	names, err := client.ListDomainNames(ctx, &opensearch.ListDomainNamesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]*types.DomainStatus, 0, len(names.DomainNames))
+	batch := make([]string, 0, opensearchDescribeDomainsLimit)
+
+	describe := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		out, err := client.DescribeDomains(ctx, &opensearch.DescribeDomainsInput{
+			DomainNames: batch,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range out.DomainStatusList {
+			domains = append(domains, &out.DomainStatusList[i])
+		}
+
+		batch = make([]string, 0, opensearchDescribeDomainsLimit)
+		return nil
+	}
+
+	for _, domain := range names.DomainNames {
+		if domain.DomainName == nil {
+			continue
+		}
+
+		batch = append(batch, *domain.DomainName)
+		if len(batch) == opensearchDescribeDomainsLimit {
+			if err := describe(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := describe(); err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+func opensearchDomainItemMapper(_, scope string, awsItem *types.DomainStatus) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "opensearch-domain",
+		UniqueAttribute: "DomainName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.DomainProcessingStatus {
+	case types.DomainProcessingStatusTypeActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DomainProcessingStatusTypeCreating,
+		types.DomainProcessingStatusTypeModifying,
+		types.DomainProcessingStatusTypeUpgrading,
+		types.DomainProcessingStatusTypeUpdating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DomainProcessingStatusTypeDeleting:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.DomainProcessingStatusTypeIsolated:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if vpc := awsItem.VPCOptions; vpc != nil {
+		if vpc.VPCId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *vpc.VPCId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the VPC can affect the domain
+					In: true,
+					// The domain won't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnet := range vpc.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet can affect the domain
+					In: true,
+					// The domain won't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range vpc.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  sg,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the security group can affect the domain
+					In: true,
+					// The domain won't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	// Public domains have Endpoint and EndpointV2, VPC domains have Endpoints
+	endpoints := make([]string, 0)
+	for _, endpoint := range []*string{awsItem.Endpoint, awsItem.EndpointV2} {
+		if endpoint != nil {
+			endpoints = append(endpoints, *endpoint)
+		}
+	}
+	endpoints = append(endpoints, slices.Sorted(maps.Values(awsItem.Endpoints))...)
+	if awsItem.DomainEndpointOptions != nil && awsItem.DomainEndpointOptions.CustomEndpointEnabled != nil && *awsItem.DomainEndpointOptions.CustomEndpointEnabled && awsItem.DomainEndpointOptions.CustomEndpoint != nil {
+		endpoints = append(endpoints, *awsItem.DomainEndpointOptions.CustomEndpoint)
+	}
+
+	for _, endpoint := range endpoints {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  endpoint,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if options := awsItem.DomainEndpointOptions; options != nil && options.CustomEndpointCertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*options.CustomEndpointCertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *options.CustomEndpointCertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the certificate expires the custom endpoint breaks
+					In: true,
+					// The domain can't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.EncryptionAtRestOptions != nil && awsItem.EncryptionAtRestOptions.KmsKeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.EncryptionAtRestOptions.KmsKeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	for _, logType := range slices.Sorted(maps.Keys(awsItem.LogPublishingOptions)) {
+		option := awsItem.LogPublishingOptions[logType]
+		if option.Enabled == nil || !*option.Enabled || option.CloudWatchLogsLogGroupArn == nil {
+			continue
+		}
+
+		a, err := adapterhelpers.ParseARN(*option.CloudWatchLogsLogGroupArn)
+		if err != nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  strings.TrimSuffix(strings.TrimPrefix(a.Resource, "log-group:"), ":*"),
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The log group can't affect the domain
+				In: false,
+				// The domain sends logs to the group
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.CognitoOptions != nil && awsItem.CognitoOptions.RoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.CognitoOptions.RoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.CognitoOptions.RoleArn,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the role can break dashboard authentication
+					In: true,
+					// The domain can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.AccessPolicies != nil && awsItem.ARN != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, linksFromResourcePolicy(*awsItem.AccessPolicies, *awsItem.ARN)...)
+	}
+
+	return &item, nil
+}
+
+func opensearchDomainListTagsFunc(ctx context.Context, domain *types.DomainStatus, client opensearchClient) (map[string]string, error) {
+	out, err := client.ListTags(ctx, &opensearch.ListTagsInput{
+		ARN: domain.ARN,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.TagList {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewOpenSearchDomainAdapter(client opensearchClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DomainStatus, opensearchClient, *opensearch.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DomainStatus, opensearchClient, *opensearch.Options]{
+		ItemType:        "opensearch-domain",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: opensearchDomainAdapterMetadata,
+		GetFunc:         opensearchDomainGetFunc,
+		ListFunc:        opensearchDomainListFunc,
+		ItemMapper:      opensearchDomainItemMapper,
+		ListTagsFunc:    opensearchDomainListTagsFunc,
+	}
+}
+
+var opensearchDomainAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "opensearch-domain",
+	DescriptiveName: "OpenSearch Domain",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a domain by name",
+		ListDescription:   "List all domains",
+		SearchDescription: "Search for a domain by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_opensearch_domain.domain_name",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_elasticsearch_domain.domain_name",
+		},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-security-group", "dns", "acm-certificate", "kms-key", "logs-log-group", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var _ = Metadata.RegisterSchema(opensearchDomainAdapterMetadata, sdp.AttributeSchemaFor(types.DomainStatus{}))
@@ -0,0 +1,232 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testOpenSearchClient struct{}
+
+// The number of domains that the test client returns, which is more than can
+// be described in one call
+const testOpenSearchDomainCount = 7
+
+func testOpenSearchDomain(name string) types.DomainStatus {
+	return types.DomainStatus{
+		DomainName:             aws.String(name),
+		DomainId:               aws.String("123456789012/" + name),
+		ARN:                    aws.String("arn:aws:es:eu-west-2:123456789012:domain/" + name),
+		EngineVersion:          aws.String("OpenSearch_2.13"),
+		DomainProcessingStatus: types.DomainProcessingStatusTypeActive,
+		Endpoints: map[string]string{
+			"vpc": "vpc-" + name + "-abc123.eu-west-2.es.amazonaws.com",
+		},
+		VPCOptions: &types.VPCDerivedInfo{
+			VPCId:            aws.String("vpc-0d7892e00e573e701"),
+			SubnetIds:        []string{"subnet-0450a637af9984235"},
+			SecurityGroupIds: []string{"sg-0b5b3b0d2e1f0a1b2"},
+		},
+		DomainEndpointOptions: &types.DomainEndpointOptions{
+			CustomEndpointEnabled:        aws.Bool(true),
+			CustomEndpoint:               aws.String(name + ".search.example.com"),
+			CustomEndpointCertificateArn: aws.String("arn:aws:acm:eu-west-2:123456789012:certificate/8f2a1c3e-1234-5678-9abc-def012345678"),
+		},
+		EncryptionAtRestOptions: &types.EncryptionAtRestOptions{
+			Enabled:  aws.Bool(true),
+			KmsKeyId: aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		},
+		LogPublishingOptions: map[string]types.LogPublishingOption{
+			"SEARCH_SLOW_LOGS": {
+				Enabled:                   aws.Bool(true),
+				CloudWatchLogsLogGroupArn: aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/opensearch/" + name + "/search:*"),
+			},
+			"INDEX_SLOW_LOGS": {
+				Enabled:                   aws.Bool(false),
+				CloudWatchLogsLogGroupArn: aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/opensearch/" + name + "/index:*"),
+			},
+		},
+		CognitoOptions: &types.CognitoOptions{
+			Enabled:        aws.Bool(true),
+			UserPoolId:     aws.String("eu-west-2_AbCdEfGhI"),
+			IdentityPoolId: aws.String("eu-west-2:12345678-1234-1234-1234-123456789012"),
+			RoleArn:        aws.String("arn:aws:iam::123456789012:role/opensearch-cognito"),
+		},
+		AccessPolicies: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/search-app"},"Action":"es:ESHttp*","Resource":"arn:aws:es:eu-west-2:123456789012:domain/` + name + `/*"}]}`),
+	}
+}
+
+func (t testOpenSearchClient) DescribeDomain(ctx context.Context, params *opensearch.DescribeDomainInput, optFns ...func(*opensearch.Options)) (*opensearch.DescribeDomainOutput, error) {
+	domain := testOpenSearchDomain(*params.DomainName)
+
+	return &opensearch.DescribeDomainOutput{
+		DomainStatus: &domain,
+	}, nil
+}
+
+func (t testOpenSearchClient) DescribeDomains(ctx context.Context, params *opensearch.DescribeDomainsInput, optFns ...func(*opensearch.Options)) (*opensearch.DescribeDomainsOutput, error) {
+	if len(params.DomainNames) > opensearchDescribeDomainsLimit {
+		return nil, fmt.Errorf("too many domains: %v", len(params.DomainNames))
+	}
+
+	out := &opensearch.DescribeDomainsOutput{}
+	for _, name := range params.DomainNames {
+		out.DomainStatusList = append(out.DomainStatusList, testOpenSearchDomain(name))
+	}
+
+	return out, nil
+}
+
+func (t testOpenSearchClient) ListDomainNames(ctx context.Context, params *opensearch.ListDomainNamesInput, optFns ...func(*opensearch.Options)) (*opensearch.ListDomainNamesOutput, error) {
+	out := &opensearch.ListDomainNamesOutput{}
+	for i := range testOpenSearchDomainCount {
+		out.DomainNames = append(out.DomainNames, types.DomainInfo{
+			DomainName: aws.String(fmt.Sprintf("search-%v", i)),
+			EngineType: types.EngineTypeOpenSearch,
+		})
+	}
+
+	return out, nil
+}
+
+func (t testOpenSearchClient) ListTags(ctx context.Context, params *opensearch.ListTagsInput, optFns ...func(*opensearch.Options)) (*opensearch.ListTagsOutput, error) {
+	return &opensearch.ListTagsOutput{
+		TagList: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("search"),
+			},
+		},
+	}, nil
+}
+
+func TestOpenSearchDomainItemMapper(t *testing.T) {
+	domain, err := opensearchDomainGetFunc(context.Background(), testOpenSearchClient{}, "123456789012.eu-west-2", "products")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := opensearchDomainItemMapper("", "123456789012.eu-west-2", domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b5b3b0d2e1f0a1b2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpc-products-abc123.eu-west-2.es.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "products.search.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/8f2a1c3e-1234-5678-9abc-def012345678",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			// Only enabled logs are linked
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/opensearch/products/search",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/opensearch-cognito",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/search-app",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestOpenSearchDomainListFunc(t *testing.T) {
+	adapter := NewOpenSearchDomainAdapter(testOpenSearchClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != testOpenSearchDomainCount {
+		t.Fatalf("expected %v items, got %v", testOpenSearchDomainCount, len(items))
+	}
+
+	if items[0].GetTags()["team"] != "search" {
+		t.Errorf("expected team tag to be search, got %v", items[0].GetTags()["team"])
+	}
+}
+
+func TestNewOpenSearchDomainAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := opensearch.NewFromConfig(config)
+
+	adapter := NewOpenSearchDomainAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -127,3 +127,5 @@ var dbClusterParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var _ = Metadata.RegisterSchema(dbClusterParameterGroupAdapterMetadata, sdp.AttributeSchemaFor(&ClusterParameterGroup{}))
@@ -82,6 +82,8 @@ func TestDBClusterParameterGroupOutputMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewRDSDBClusterParameterGroupAdapter(t *testing.T) {
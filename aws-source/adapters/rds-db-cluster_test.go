@@ -146,6 +146,8 @@ func TestDBClusterOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetTags()["key"] != "value" {
 		t.Errorf("expected tag key to be value, got %v", item.GetTags()["key"])
 	}
@@ -2,70 +2,73 @@ package adapters
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
 )
 
 func statusToHealth(status string) *sdp.Health {
-	switch status {
-	case "Available":
+	// Statuses are returned in lower case, e.g. "available"
+	switch strings.ToLower(status) {
+	case "available":
 		return sdp.Health_HEALTH_OK.Enum()
-	case "Backing-up":
+	case "backing-up":
 		return sdp.Health_HEALTH_OK.Enum()
-	case "Configuring-enhanced-monitoring":
+	case "configuring-enhanced-monitoring":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Configuring-iam-database-auth":
+	case "configuring-iam-database-auth":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Configuring-log-exports":
+	case "configuring-log-exports":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Converting-to-vpc":
+	case "converting-to-vpc":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Creating":
+	case "creating":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Deleting":
+	case "deleting":
 		return sdp.Health_HEALTH_WARNING.Enum()
-	case "Failed":
+	case "failed":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Inaccessible-encryption-credentials":
+	case "inaccessible-encryption-credentials":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Inaccessible-encryption-credentials-recoverable":
+	case "inaccessible-encryption-credentials-recoverable":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Incompatible-network":
+	case "incompatible-network":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Incompatible-option-group":
+	case "incompatible-option-group":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Incompatible-parameters":
+	case "incompatible-parameters":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Incompatible-restore":
+	case "incompatible-restore":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Maintenance":
+	case "maintenance":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Modifying":
+	case "modifying":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Moving-to-vpc":
+	case "moving-to-vpc":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Rebooting":
+	case "rebooting":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Resetting-master-credentials":
+	case "resetting-master-credentials":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Renaming":
+	case "renaming":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Restore-error":
+	case "restore-error":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Starting":
+	case "starting":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Stopped":
+	case "stopped":
 		return nil
-	case "Stopping":
+	case "stopping":
 		return sdp.Health_HEALTH_PENDING.Enum()
-	case "Storage-full":
+	case "storage-full":
 		return sdp.Health_HEALTH_ERROR.Enum()
-	case "Storage-optimization":
+	case "storage-optimization":
 		return sdp.Health_HEALTH_OK.Enum()
-	case "Upgrading":
+	case "upgrading":
 		return sdp.Health_HEALTH_PENDING.Enum()
 	}
 
@@ -519,3 +522,5 @@ var dbInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"dns", "route53-hosted-zone", "ec2-security-group", "rds-db-parameter-group", "rds-db-subnet-group", "rds-db-cluster", "kms-key", "logs-log-stream", "iam-role", "kinesis-stream", "backup-recovery-point", "iam-instance-profile", "rds-db-instance-automated-backup", "secretsmanager-secret"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var _ = Metadata.RegisterSchema(dbInstanceAdapterMetadata, sdp.AttributeSchemaFor(types.DBInstance{}))
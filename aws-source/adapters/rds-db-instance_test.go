@@ -186,6 +186,12 @@ func TestDBInstanceOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
 	if item.GetTags()["key"] != "value" {
 		t.Errorf("got %v, expected %v", item.GetTags()["key"], "value")
 	}
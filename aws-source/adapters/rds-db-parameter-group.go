@@ -122,3 +122,5 @@ var dbParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var _ = Metadata.RegisterSchema(dbParameterGroupAdapterMetadata, sdp.AttributeSchemaFor(&ParameterGroup{}))
@@ -70,6 +70,8 @@ func TestDBParameterGroupOutputMapper(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewRDSDBParameterGroupAdapter(t *testing.T) {
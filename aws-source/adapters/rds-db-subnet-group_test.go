@@ -56,6 +56,8 @@ func TestDBSubnetGroupOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetTags()["key"] != "value" {
 		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
 	}
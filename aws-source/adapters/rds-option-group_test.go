@@ -40,6 +40,8 @@ func TestOptionGroupOutputMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	if item.GetTags()["key"] != "value" {
 		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
 	}
@@ -0,0 +1,337 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type redshiftClient interface {
+	DescribeClusters(ctx context.Context, params *redshift.DescribeClustersInput, optFns ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
+}
+
+func redshiftIPLink(ip *string) *sdp.LinkedItemQuery {
+	if ip == nil || *ip == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ip",
+			Method: sdp.QueryMethod_GET,
+			Query:  *ip,
+			Scope:  "global",
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// IPs are always linked
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+func redshiftClusterOutputMapper(_ context.Context, _ redshiftClient, scope string, _ *redshift.DescribeClustersInput, output *redshift.DescribeClustersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, cluster := range output.Clusters {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(cluster, "tags")
+		if err != nil {
+			return nil, err
+		}
+
+		tags := make(map[string]string)
+		for _, tag := range cluster.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "redshift-cluster",
+			UniqueAttribute: "ClusterIdentifier",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            tags,
+		}
+
+		if cluster.ClusterAvailabilityStatus != nil {
+			switch *cluster.ClusterAvailabilityStatus {
+			case "Available":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "Maintenance", "Modifying":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			case "Unavailable":
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			case "Failed":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			default:
+				item.Health = sdp.Health_HEALTH_UNKNOWN.Enum()
+			}
+		}
+
+		if cluster.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the VPC can affect the cluster
+					In: true,
+					// The cluster won't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range cluster.VpcSecurityGroups {
+			if sg.VpcSecurityGroupId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-security-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *sg.VpcSecurityGroupId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the security group can affect the cluster
+						In: true,
+						// The cluster won't affect the security group
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if cluster.Endpoint != nil {
+			if cluster.Endpoint.Address != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dns",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *cluster.Endpoint.Address,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// DNS always linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+
+			// The subnets that the cluster is in are only visible through
+			// the network interfaces of its VPC endpoints
+			for _, endpoint := range cluster.Endpoint.VpcEndpoints {
+				if endpoint.VpcEndpointId != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-vpc-endpoint",
+							Method: sdp.QueryMethod_GET,
+							Query:  *endpoint.VpcEndpointId,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Tightly coupled
+							In:  true,
+							Out: true,
+						},
+					})
+				}
+
+				for _, eni := range endpoint.NetworkInterfaces {
+					if eni.NetworkInterfaceId != nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+							Query: &sdp.Query{
+								Type:   "ec2-network-interface",
+								Method: sdp.QueryMethod_GET,
+								Query:  *eni.NetworkInterfaceId,
+								Scope:  scope,
+							},
+							BlastPropagation: &sdp.BlastPropagation{
+								// Tightly coupled
+								In:  true,
+								Out: true,
+							},
+						})
+					}
+
+					if eni.SubnetId != nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+							Query: &sdp.Query{
+								Type:   "ec2-subnet",
+								Method: sdp.QueryMethod_GET,
+								Query:  *eni.SubnetId,
+								Scope:  scope,
+							},
+							BlastPropagation: &sdp.BlastPropagation{
+								// Changing the subnet can affect the cluster
+								In: true,
+								// The cluster won't affect the subnet
+								Out: false,
+							},
+						})
+					}
+
+					if link := redshiftIPLink(eni.PrivateIpAddress); link != nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+					}
+				}
+			}
+		}
+
+		for _, node := range cluster.ClusterNodes {
+			for _, ip := range []*string{node.PrivateIPAddress, node.PublicIPAddress} {
+				if link := redshiftIPLink(ip); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+
+		if cluster.CustomDomainName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.CustomDomainName,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if cluster.CustomDomainCertificateArn != nil {
+			if a, err := adapterhelpers.ParseARN(*cluster.CustomDomainCertificateArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "acm-certificate",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *cluster.CustomDomainCertificateArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the certificate expires the custom domain breaks
+						In: true,
+						// The cluster can't affect the certificate
+						Out: false,
+					},
+				})
+			}
+		}
+
+		// The default role is always one of the attached roles so doesn't
+		// need to be linked separately
+		roles := make([]string, 0)
+		for _, role := range cluster.IamRoles {
+			if role.IamRoleArn != nil {
+				roles = append(roles, *role.IamRoleArn)
+			}
+		}
+		for _, role := range roles {
+			if a, err := adapterhelpers.ParseARN(role); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  role,
+						Scope:  a.AccountID,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the role can affect what the cluster can
+						// load and unload
+						In: true,
+						// The cluster can't affect the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if cluster.KmsKeyId != nil {
+			if link := kmsKeyLink(scope, *cluster.KmsKeyId); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if cluster.MasterPasswordSecretArn != nil {
+			if a, err := adapterhelpers.ParseARN(*cluster.MasterPasswordSecretArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "secretsmanager-secret",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *cluster.MasterPasswordSecretArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The secret is managed by Redshift so they are
+						// tightly coupled
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewRedshiftClusterAdapter(client redshiftClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*redshift.DescribeClustersInput, *redshift.DescribeClustersOutput, redshiftClient, *redshift.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*redshift.DescribeClustersInput, *redshift.DescribeClustersOutput, redshiftClient, *redshift.Options]{
+		ItemType:        "redshift-cluster",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: redshiftClusterAdapterMetadata,
+		PaginatorBuilder: func(client redshiftClient, params *redshift.DescribeClustersInput) adapterhelpers.Paginator[*redshift.DescribeClustersOutput, *redshift.Options] {
+			return redshift.NewDescribeClustersPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client redshiftClient, input *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+			return client.DescribeClusters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*redshift.DescribeClustersInput, error) {
+			return &redshift.DescribeClustersInput{
+				ClusterIdentifier: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*redshift.DescribeClustersInput, error) {
+			return &redshift.DescribeClustersInput{}, nil
+		},
+		OutputMapper: redshiftClusterOutputMapper,
+	}
+}
+
+var redshiftClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-cluster",
+	DescriptiveName: "Redshift Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cluster by identifier",
+		ListDescription:   "List all clusters",
+		SearchDescription: "Search for clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_redshift_cluster.cluster_identifier",
+		},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-security-group", "ec2-vpc-endpoint", "ec2-network-interface", "ec2-subnet", "ip", "dns", "acm-certificate", "iam-role", "kms-key", "secretsmanager-secret"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var _ = Metadata.RegisterSchema(redshiftClusterAdapterMetadata, sdp.AttributeSchemaFor(types.Cluster{}, "tags"))
@@ -0,0 +1,201 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRedshiftClusterOutputMapper(t *testing.T) {
+	output := redshift.DescribeClustersOutput{
+		Clusters: []types.Cluster{
+			{
+				ClusterIdentifier:         adapterhelpers.PtrString("analytics"),
+				ClusterStatus:             adapterhelpers.PtrString("available"),
+				ClusterAvailabilityStatus: adapterhelpers.PtrString("Available"),
+				NodeType:                  adapterhelpers.PtrString("ra3.xlplus"),
+				NumberOfNodes:             adapterhelpers.PtrInt32(2),
+				DBName:                    adapterhelpers.PtrString("dev"),
+				VpcId:                     adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+				VpcSecurityGroups: []types.VpcSecurityGroupMembership{
+					{
+						VpcSecurityGroupId: adapterhelpers.PtrString("sg-0b5b3b0d2e1f0a1b2"), // link
+						Status:             adapterhelpers.PtrString("active"),
+					},
+				},
+				ClusterSubnetGroupName: adapterhelpers.PtrString("default"),
+				Endpoint: &types.Endpoint{
+					Address: adapterhelpers.PtrString("analytics.abc123.eu-west-2.redshift.amazonaws.com"), // link
+					Port:    adapterhelpers.PtrInt32(5439),
+					VpcEndpoints: []types.VpcEndpoint{
+						{
+							VpcEndpointId: adapterhelpers.PtrString("vpce-0a1b2c3d4e5f6a7b8"), // link
+							VpcId:         adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+							NetworkInterfaces: []types.NetworkInterface{
+								{
+									NetworkInterfaceId: adapterhelpers.PtrString("eni-0123456789abcdef0"),    // link
+									SubnetId:           adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+									PrivateIpAddress:   adapterhelpers.PtrString("10.0.1.25"),                // link
+									AvailabilityZone:   adapterhelpers.PtrString("eu-west-2a"),
+								},
+							},
+						},
+					},
+				},
+				ClusterNodes: []types.ClusterNode{
+					{
+						NodeRole:         adapterhelpers.PtrString("LEADER"),
+						PrivateIPAddress: adapterhelpers.PtrString("10.0.1.10"), // link
+					},
+				},
+				CustomDomainName:           adapterhelpers.PtrString("warehouse.example.com"),                                                               // link
+				CustomDomainCertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:052392120703:certificate/8f2a1c3e-1234-5678-9abc-def012345678"), // link
+				IamRoles: []types.ClusterIamRole{
+					{
+						IamRoleArn:  adapterhelpers.PtrString("arn:aws:iam::052392120703:role/redshift-s3"), // link
+						ApplyStatus: adapterhelpers.PtrString("in-sync"),
+					},
+				},
+				DefaultIamRoleArn:       adapterhelpers.PtrString("arn:aws:iam::052392120703:role/redshift-s3"),
+				Encrypted:               adapterhelpers.PtrBool(true),
+				KmsKeyId:                adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"),          // link
+				MasterPasswordSecretArn: adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!analytics-admin-AbCdEf"), // link
+				ClusterCreateTime:       adapterhelpers.PtrTime(time.Now()),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("team"),
+						Value: adapterhelpers.PtrString("data"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := redshiftClusterOutputMapper(context.Background(), nil, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected team tag to be data, got %v", item.GetTags()["team"])
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b5b3b0d2e1f0a1b2",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "analytics.abc123.eu-west-2.redshift.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-vpc-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eni-0123456789abcdef0",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.25",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "warehouse.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:052392120703:certificate/8f2a1c3e-1234-5678-9abc-def012345678",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/redshift-s3",
+			ExpectedScope:  "052392120703",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!analytics-admin-AbCdEf",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRedshiftClusterAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshift.NewFromConfig(config)
+
+	adapter := NewRedshiftClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -179,3 +179,5 @@ var healthCheckAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var _ = Metadata.RegisterSchema(healthCheckAdapterMetadata, sdp.AttributeSchemaFor(&HealthCheck{}))
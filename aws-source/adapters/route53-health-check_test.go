@@ -59,6 +59,8 @@ func TestHealthCheckItemMapper(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	tests := adapterhelpers.QueryTests{
 		{
 			ExpectedType:   "cloudwatch-alarm",
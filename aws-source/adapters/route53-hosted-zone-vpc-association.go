@@ -0,0 +1,266 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// route53HostedZoneVPCAssociation is the association of a private hosted zone
+// with a VPC. The zone and the VPC can be owned by different accounts, and
+// AWS doesn't have a single API that describes the association, so it is built
+// from either the zone or the VPC side
+type route53HostedZoneVPCAssociation struct {
+	// The zone ID and VPC ID separated by a colon, which is the same as the
+	// ID of the Terraform resource
+	UniqueName     string
+	HostedZoneId   string
+	HostedZoneName *string
+	VPCId          string
+	VPCRegion      string
+	// The account that owns the zone, which is only known when the
+	// association is found from the VPC side
+	OwningAccount *string
+	// The AWS service that created the zone, if it was created by one
+	OwningService *string
+}
+
+func newRoute53HostedZoneVPCAssociation(zoneID, vpcID, region string) *route53HostedZoneVPCAssociation {
+	zoneID = strings.TrimPrefix(zoneID, "/hostedzone/")
+
+	return &route53HostedZoneVPCAssociation{
+		UniqueName:   zoneID + ":" + vpcID,
+		HostedZoneId: zoneID,
+		VPCId:        vpcID,
+		VPCRegion:    region,
+	}
+}
+
+// Returns the associations of a zone with VPCs in the region of the adapter.
+// This only works for zones that are owned by the account
+func route53ZoneVPCAssociations(ctx context.Context, client route53HostedZoneClient, zoneID, region string) ([]*route53HostedZoneVPCAssociation, error) {
+	out, err := client.GetHostedZone(ctx, &route53.GetHostedZoneInput{
+		Id: &zoneID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	associations := make([]*route53HostedZoneVPCAssociation, 0)
+
+	for _, vpc := range out.VPCs {
+		if vpc.VPCId == nil || string(vpc.VPCRegion) != region {
+			continue
+		}
+
+		association := newRoute53HostedZoneVPCAssociation(zoneID, *vpc.VPCId, region)
+
+		if out.HostedZone != nil {
+			association.HostedZoneName = out.HostedZone.Name
+
+			if out.HostedZone.LinkedService != nil {
+				association.OwningService = out.HostedZone.LinkedService.ServicePrincipal
+			}
+		}
+
+		associations = append(associations, association)
+	}
+
+	return associations, nil
+}
+
+// Returns the associations of a VPC with private hosted zones, including zones
+// that are owned by other accounts
+func route53VPCZoneAssociations(ctx context.Context, client route53HostedZoneClient, vpcID, region string) ([]*route53HostedZoneVPCAssociation, error) {
+	summaries, err := listRoute53HostedZonesByVPC(ctx, client, vpcID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	associations := make([]*route53HostedZoneVPCAssociation, 0, len(summaries))
+
+	for _, summary := range summaries {
+		if summary.HostedZoneId == nil {
+			continue
+		}
+
+		association := newRoute53HostedZoneVPCAssociation(*summary.HostedZoneId, vpcID, region)
+		association.HostedZoneName = summary.Name
+
+		if summary.Owner != nil {
+			association.OwningAccount = summary.Owner.OwningAccount
+			association.OwningService = summary.Owner.OwningService
+		}
+
+		associations = append(associations, association)
+	}
+
+	return associations, nil
+}
+
+func newRoute53HostedZoneVPCAssociationGetFunc(region string) func(context.Context, route53HostedZoneClient, string, string) (*route53HostedZoneVPCAssociation, error) {
+	return func(ctx context.Context, client route53HostedZoneClient, scope, query string) (*route53HostedZoneVPCAssociation, error) {
+		// Terraform adds the region of the VPC as a third field when it isn't
+		// the region of the provider
+		fields := strings.Split(query, ":")
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: fmt.Sprintf("query must be in the format {hostedZoneId}:{vpcId}, got %v", query),
+				Scope:       scope,
+			}
+		}
+
+		zoneID := strings.TrimPrefix(fields[0], "/hostedzone/")
+		vpcID := fields[1]
+
+		if len(fields) == 3 && fields[2] != region {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOSCOPE,
+				ErrorString: fmt.Sprintf("VPC region %v does not match the region %v of the adapter", fields[2], region),
+				Scope:       scope,
+			}
+		}
+
+		// Zones owned by other accounts can't be described, so fall back to
+		// looking at the zones of the VPC
+		associations, err := route53ZoneVPCAssociations(ctx, client, zoneID, region)
+		if err != nil {
+			associations, err = route53VPCZoneAssociations(ctx, client, vpcID, region)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, association := range associations {
+			if association.HostedZoneId == zoneID && association.VPCId == vpcID {
+				return association, nil
+			}
+		}
+
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("hosted zone %v is not associated with VPC %v", zoneID, vpcID),
+			Scope:       scope,
+		}
+	}
+}
+
+func newRoute53HostedZoneVPCAssociationListFunc(region string) func(context.Context, route53HostedZoneClient, string) ([]*route53HostedZoneVPCAssociation, error) {
+	return func(ctx context.Context, client route53HostedZoneClient, _ string) ([]*route53HostedZoneVPCAssociation, error) {
+		associations := make([]*route53HostedZoneVPCAssociation, 0)
+		paginator := route53.NewListHostedZonesPaginator(client, &route53.ListHostedZonesInput{})
+
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, zone := range out.HostedZones {
+				// Only private zones are associated with VPCs
+				if zone.Id == nil || zone.Config == nil || !zone.Config.PrivateZone {
+					continue
+				}
+
+				zoneAssociations, err := route53ZoneVPCAssociations(ctx, client, *zone.Id, region)
+				if err != nil {
+					return nil, err
+				}
+
+				associations = append(associations, zoneAssociations...)
+			}
+		}
+
+		return associations, nil
+	}
+}
+
+func newRoute53HostedZoneVPCAssociationSearchFunc(region string) func(context.Context, route53HostedZoneClient, string, string) ([]*route53HostedZoneVPCAssociation, error) {
+	return func(ctx context.Context, client route53HostedZoneClient, _, query string) ([]*route53HostedZoneVPCAssociation, error) {
+		if strings.HasPrefix(query, "vpc-") {
+			return route53VPCZoneAssociations(ctx, client, query, region)
+		}
+
+		return route53ZoneVPCAssociations(ctx, client, strings.TrimPrefix(query, "/hostedzone/"), region)
+	}
+}
+
+func route53HostedZoneVPCAssociationItemMapper(_, scope string, awsItem *route53HostedZoneVPCAssociation) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-hosted-zone-vpc-association",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// The zone can be owned by another account
+	zoneScope := scope
+	if awsItem.OwningAccount != nil {
+		zoneScope = adapterhelpers.FormatScope(*awsItem.OwningAccount, awsItem.VPCRegion)
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries,
+		&sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53-hosted-zone",
+				Method: sdp.QueryMethod_GET,
+				Query:  awsItem.HostedZoneId,
+				Scope:  zoneScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the zone changes the names that resolve in the VPC
+				In: true,
+				// The association can't affect the zone
+				Out: false,
+			},
+		},
+		route53ResolverVPCLink(scope, awsItem.VPCId),
+	)
+
+	return &item, nil
+}
+
+func NewRoute53HostedZoneVPCAssociationAdapter(client route53HostedZoneClient, accountID string, region string) *adapterhelpers.GetListAdapter[*route53HostedZoneVPCAssociation, route53HostedZoneClient, *route53.Options] {
+	return &adapterhelpers.GetListAdapter[*route53HostedZoneVPCAssociation, route53HostedZoneClient, *route53.Options]{
+		ItemType:        "route53-hosted-zone-vpc-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53HostedZoneVPCAssociationAdapterMetadata,
+		GetFunc:         newRoute53HostedZoneVPCAssociationGetFunc(region),
+		ListFunc:        newRoute53HostedZoneVPCAssociationListFunc(region),
+		SearchFunc:      newRoute53HostedZoneVPCAssociationSearchFunc(region),
+		ItemMapper:      route53HostedZoneVPCAssociationItemMapper,
+	}
+}
+
+var route53HostedZoneVPCAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-hosted-zone-vpc-association",
+	DescriptiveName: "Route 53 Hosted Zone VPC Association",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an association by {hostedZoneId}:{vpcId}",
+		List:              true,
+		ListDescription:   "List the associations of the private hosted zones in this account with VPCs in this region",
+		Search:            true,
+		SearchDescription: "Search for the associations of a VPC by VPC ID, including zones in other accounts, or of a hosted zone by zone ID",
+	},
+	PotentialLinks: []string{"route53-hosted-zone", "ec2-vpc"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_zone_association.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53HostedZoneVPCAssociationAdapterMetadata, sdp.AttributeSchemaFor(&route53HostedZoneVPCAssociation{}))
@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53HostedZoneVPCAssociationGetFunc(t *testing.T) {
+	get := newRoute53HostedZoneVPCAssociationGetFunc("eu-west-2")
+
+	t.Run("zone in this account", func(t *testing.T) {
+		association, err := get(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2", "Z0123456789DEV:vpc-0123456789abcdef0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if association.HostedZoneName == nil || *association.HostedZoneName != "dev.corp.example.com." {
+			t.Errorf("unexpected zone name %v", association.HostedZoneName)
+		}
+	})
+
+	t.Run("zone in another account", func(t *testing.T) {
+		association, err := get(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2", "Z0123456789SHARED:vpc-0123456789abcdef0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if association.OwningAccount == nil || *association.OwningAccount != "210987654321" {
+			t.Errorf("unexpected owning account %v", association.OwningAccount)
+		}
+	})
+
+	t.Run("VPC in another region", func(t *testing.T) {
+		_, err := get(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2", "Z0123456789DEV:vpc-0a1b2c3d4e5f6a7b8:us-east-1")
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOSCOPE {
+			t.Errorf("expected a NOSCOPE error, got %v", err)
+		}
+	})
+
+	t.Run("not associated", func(t *testing.T) {
+		_, err := get(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2", "Z0123456789DEV:vpc-0fedcba9876543210")
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+			t.Errorf("expected a NOTFOUND error, got %v", err)
+		}
+	})
+}
+
+func TestRoute53HostedZoneVPCAssociationListFunc(t *testing.T) {
+	associations, err := newRoute53HostedZoneVPCAssociationListFunc("eu-west-2")(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Public zones and VPCs in other regions are skipped
+	if len(associations) != 1 {
+		t.Fatalf("expected 1 association, got %v", len(associations))
+	}
+
+	if associations[0].UniqueName != "Z0123456789DEV:vpc-0123456789abcdef0" {
+		t.Errorf("unexpected unique name %v", associations[0].UniqueName)
+	}
+}
+
+func TestRoute53HostedZoneVPCAssociationItemMapper(t *testing.T) {
+	associations, err := newRoute53HostedZoneVPCAssociationSearchFunc("eu-west-2")(context.Background(), testRoute53HostedZoneClient{}, "123456789012.eu-west-2", "vpc-0123456789abcdef0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(associations) != 3 {
+		t.Fatalf("expected 3 associations, got %v", len(associations))
+	}
+
+	item, err := route53HostedZoneVPCAssociationItemMapper("", "123456789012.eu-west-2", associations[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "Z0123456789SHARED:vpc-0123456789abcdef0" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53-hosted-zone",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "Z0123456789SHARED",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53HostedZoneVPCAssociationAdapter(t *testing.T) {
+	client, account, region := route53GetAutoConfig(t)
+
+	adapter := NewRoute53HostedZoneVPCAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -123,3 +123,5 @@ var hostedZoneAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var _ = Metadata.RegisterSchema(hostedZoneAdapterMetadata, sdp.AttributeSchemaFor(&types.HostedZone{}))
@@ -200,3 +200,5 @@ var resourceRecordSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_route53_record.id", TerraformMethod: sdp.QueryMethod_SEARCH},
 	},
 })
+
+var _ = Metadata.RegisterSchema(resourceRecordSetAdapterMetadata, sdp.AttributeSchemaFor(&types.ResourceRecordSet{}))
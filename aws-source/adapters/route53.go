@@ -1,6 +1,17 @@
 package adapters
 
-import "github.com/aws/aws-sdk-go-v2/service/route53/types"
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+type route53HostedZoneClient interface {
+	GetHostedZone(ctx context.Context, params *route53.GetHostedZoneInput, optFns ...func(*route53.Options)) (*route53.GetHostedZoneOutput, error)
+	ListHostedZones(ctx context.Context, params *route53.ListHostedZonesInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error)
+	ListHostedZonesByVPC(ctx context.Context, params *route53.ListHostedZonesByVPCInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByVPCOutput, error)
+}
 
 func route53TagsToMap(tags []types.Tag) map[string]string {
 	m := make(map[string]string)
@@ -13,3 +24,31 @@ func route53TagsToMap(tags []types.Tag) map[string]string {
 
 	return m
 }
+
+// Lists the private hosted zones that are associated with a VPC, including
+// zones owned by other accounts and by other AWS services
+func listRoute53HostedZonesByVPC(ctx context.Context, client route53HostedZoneClient, vpcID string, region string) ([]types.HostedZoneSummary, error) {
+	summaries := make([]types.HostedZoneSummary, 0)
+	input := &route53.ListHostedZonesByVPCInput{
+		VPCId:     &vpcID,
+		VPCRegion: types.VPCRegion(region),
+	}
+
+	// There is no paginator for this operation
+	for {
+		out, err := client.ListHostedZonesByVPC(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, out.HostedZoneSummaries...)
+
+		if out.NextToken == nil {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return summaries, nil
+}
@@ -1,12 +1,110 @@
 package adapters
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 )
 
+type testRoute53HostedZoneClient struct{}
+
+func (t testRoute53HostedZoneClient) GetHostedZone(ctx context.Context, params *route53.GetHostedZoneInput, optFns ...func(*route53.Options)) (*route53.GetHostedZoneOutput, error) {
+	switch *params.Id {
+	case "/hostedzone/Z0123456789DEV", "Z0123456789DEV":
+		return &route53.GetHostedZoneOutput{
+			HostedZone: &types.HostedZone{
+				Id:   aws.String("/hostedzone/Z0123456789DEV"),
+				Name: aws.String("dev.corp.example.com."),
+				Config: &types.HostedZoneConfig{
+					PrivateZone: true,
+				},
+			},
+			VPCs: []types.VPC{
+				{
+					VPCId:     aws.String("vpc-0123456789abcdef0"),
+					VPCRegion: types.VPCRegionEuWest2,
+				},
+				{
+					VPCId:     aws.String("vpc-0a1b2c3d4e5f6a7b8"),
+					VPCRegion: types.VPCRegionUsEast1,
+				},
+			},
+		}, nil
+	case "/hostedzone/Z0123456789PUBLIC", "Z0123456789PUBLIC":
+		return nil, errors.New("public zones should not be described")
+	}
+
+	// Zones owned by other accounts can't be described
+	return nil, errors.New("AccessDenied")
+}
+
+func (t testRoute53HostedZoneClient) ListHostedZones(ctx context.Context, params *route53.ListHostedZonesInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error) {
+	return &route53.ListHostedZonesOutput{
+		HostedZones: []types.HostedZone{
+			{
+				Id:   aws.String("/hostedzone/Z0123456789DEV"),
+				Name: aws.String("dev.corp.example.com."),
+				Config: &types.HostedZoneConfig{
+					PrivateZone: true,
+				},
+			},
+			{
+				Id:   aws.String("/hostedzone/Z0123456789PUBLIC"),
+				Name: aws.String("example.com."),
+				Config: &types.HostedZoneConfig{
+					PrivateZone: false,
+				},
+			},
+		},
+	}, nil
+}
+
+// Returns the zones of the VPC over two pages
+func (t testRoute53HostedZoneClient) ListHostedZonesByVPC(ctx context.Context, params *route53.ListHostedZonesByVPCInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByVPCOutput, error) {
+	if *params.VPCId != "vpc-0123456789abcdef0" || params.VPCRegion != types.VPCRegionEuWest2 {
+		return &route53.ListHostedZonesByVPCOutput{}, nil
+	}
+
+	if params.NextToken == nil {
+		return &route53.ListHostedZonesByVPCOutput{
+			HostedZoneSummaries: []types.HostedZoneSummary{
+				{
+					HostedZoneId: aws.String("Z0123456789DEV"),
+					Name:         aws.String("dev.corp.example.com."),
+					Owner: &types.HostedZoneOwner{
+						OwningAccount: aws.String("123456789012"),
+					},
+				},
+				{
+					HostedZoneId: aws.String("Z0123456789CORP"),
+					Name:         aws.String("corp.example.com."),
+					Owner: &types.HostedZoneOwner{
+						OwningAccount: aws.String("123456789012"),
+					},
+				},
+			},
+			NextToken: aws.String("page-2"),
+		}, nil
+	}
+
+	return &route53.ListHostedZonesByVPCOutput{
+		HostedZoneSummaries: []types.HostedZoneSummary{
+			{
+				HostedZoneId: aws.String("Z0123456789SHARED"),
+				Name:         aws.String("internal.example.org."),
+				Owner: &types.HostedZoneOwner{
+					OwningAccount: aws.String("210987654321"),
+				},
+			},
+		},
+	}, nil
+}
+
 func route53GetAutoConfig(t *testing.T) (*route53.Client, string, string) {
 	config, account, region := adapterhelpers.GetAutoConfig(t)
 	client := route53.NewFromConfig(config)
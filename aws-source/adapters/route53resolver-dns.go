@@ -0,0 +1,494 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// How a name is resolved from within a VPC
+const (
+	// Forwarded to the target IPs of a forwarding rule through an outbound
+	// endpoint
+	route53ResolutionForward = "FORWARD"
+	// Delegated to the name servers of a delegation rule through an outbound
+	// endpoint
+	route53ResolutionDelegate = "DELEGATE"
+	// Answered by a private hosted zone that is associated with the VPC
+	route53ResolutionPrivateHostedZone = "PRIVATE_HOSTED_ZONE"
+	// Resolved recursively using public DNS
+	route53ResolutionRecursive = "RECURSIVE"
+)
+
+// route53ResolverDNSResolution describes which Resolver rule or private hosted
+// zone answers queries for a name from within a VPC. This is the resolver-aware
+// counterpart of the stdlib `dns` type rather than a search mode of it: the
+// stdlib adapter runs without AWS credentials and its items are the records
+// that a name resolves to from where the source runs. These items describe
+// the rule or zone instead, since the name can only be resolved from inside
+// the VPC, and link to the `dns` item of the name
+type route53ResolverDNSResolution struct {
+	// The VPC ID and name separated by a colon
+	UniqueName string
+	Name       string
+	VpcId      string
+	// One of FORWARD, DELEGATE, PRIVATE_HOSTED_ZONE or RECURSIVE
+	Resolution string
+	// The domain of the rule or zone that matched the name, if any
+	MatchedDomain      *string
+	ResolverRuleId     *string
+	ResolverEndpointId *string
+	HostedZoneId       *string
+	// The account that owns the hosted zone, which can be another account
+	HostedZoneOwner *string
+	// The IPs that queries are forwarded to by the rule
+	TargetIps []string
+}
+
+// Normalises a domain name for comparison. The root domain becomes an empty
+// string
+func route53NormaliseDomain(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// Returns the number of labels in the domain if the name is in it, or -1 if it
+// isn't. The root domain matches all names with a specificity of 0
+func route53DomainSpecificity(name, domain string) int {
+	if domain == "" {
+		return 0
+	}
+
+	if name != domain && !strings.HasSuffix(name, "."+domain) {
+		return -1
+	}
+
+	return strings.Count(domain, ".") + 1
+}
+
+// Parses a query in the format {vpcId}:{name}
+func parseRoute53ResolverDNSQuery(scope, query string) (string, string, error) {
+	vpcID, name, found := strings.Cut(query, ":")
+	name = route53NormaliseDomain(name)
+
+	if !found || !strings.HasPrefix(vpcID, "vpc-") || name == "" || net.ParseIP(name) != nil {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {vpcId}:{name}, got %v", query),
+			Scope:       scope,
+		}
+	}
+
+	return vpcID, name, nil
+}
+
+// The Resolver rules and private hosted zones that are associated with a VPC
+type route53ResolverVPCConfig struct {
+	VpcId string
+	Rules []*types.ResolverRule
+	Zones []*route53HostedZoneVPCAssociation
+}
+
+func getRoute53ResolverVPCConfig(ctx context.Context, client route53ResolverDNSClient, vpcID, region string) (*route53ResolverVPCConfig, error) {
+	config := &route53ResolverVPCConfig{
+		VpcId: vpcID,
+	}
+
+	associations, err := listRoute53ResolverRuleAssociations(ctx, client, route53ResolverFilter("VPCId", vpcID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range associations {
+		if association.ResolverRuleId == nil {
+			continue
+		}
+
+		switch association.Status {
+		case types.ResolverRuleAssociationStatusFailed, types.ResolverRuleAssociationStatusDeleting, types.ResolverRuleAssociationStatusOverridden:
+			continue
+		}
+
+		out, err := client.GetResolverRule(ctx, &route53resolver.GetResolverRuleInput{
+			ResolverRuleId: association.ResolverRuleId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if out.ResolverRule == nil || out.ResolverRule.DomainName == nil {
+			continue
+		}
+
+		config.Rules = append(config.Rules, out.ResolverRule)
+	}
+
+	zones, err := listRoute53HostedZonesByVPC(ctx, client.Route53, vpcID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, zone := range zones {
+		if zone.HostedZoneId == nil || zone.Name == nil {
+			continue
+		}
+
+		association := newRoute53HostedZoneVPCAssociation(*zone.HostedZoneId, vpcID, region)
+		association.HostedZoneName = zone.Name
+
+		if zone.Owner != nil {
+			association.OwningAccount = zone.Owner.OwningAccount
+		}
+
+		config.Zones = append(config.Zones, association)
+	}
+
+	return config, nil
+}
+
+// Returns the distinct domains of the rules and zones, excluding the root
+// domain since it isn't a name that can be resolved
+func (c *route53ResolverVPCConfig) domains() []string {
+	domains := make([]string, 0)
+	seen := make(map[string]bool)
+
+	add := func(domain string) {
+		domain = route53NormaliseDomain(domain)
+		if domain != "" && !seen[domain] {
+			seen[domain] = true
+			domains = append(domains, domain)
+		}
+	}
+
+	for _, rule := range c.Rules {
+		add(*rule.DomainName)
+	}
+
+	for _, zone := range c.Zones {
+		add(*zone.HostedZoneName)
+	}
+
+	return domains
+}
+
+// Works out how a normalised name is resolved from within the VPC
+func (c *route53ResolverVPCConfig) resolve(name string) *route53ResolverDNSResolution {
+	resolution := &route53ResolverDNSResolution{
+		UniqueName: c.VpcId + ":" + name,
+		Name:       name,
+		VpcId:      c.VpcId,
+		Resolution: route53ResolutionRecursive,
+	}
+
+	// Find the most specific rule that is associated with the VPC
+	var bestRule *types.ResolverRule
+	ruleSpecificity := -1
+
+	for _, rule := range c.Rules {
+		if specificity := route53DomainSpecificity(name, route53NormaliseDomain(*rule.DomainName)); specificity > ruleSpecificity {
+			bestRule = rule
+			ruleSpecificity = specificity
+		}
+	}
+
+	// Find the most specific private zone that is associated with the VPC
+	var bestZone *route53HostedZoneVPCAssociation
+	zoneSpecificity := -1
+
+	for _, zone := range c.Zones {
+		if specificity := route53DomainSpecificity(name, route53NormaliseDomain(*zone.HostedZoneName)); specificity > zoneSpecificity {
+			bestZone = zone
+			zoneSpecificity = specificity
+		}
+	}
+
+	// Rules take precedence over private zones for the same domain, but
+	// system rules hand the query back to the zones and public DNS
+	useZone := bestZone != nil
+
+	if bestRule != nil && ruleSpecificity >= zoneSpecificity {
+		switch bestRule.RuleType {
+		case types.RuleTypeOptionForward, types.RuleTypeOptionDelegate:
+			useZone = false
+
+			resolution.Resolution = route53ResolutionForward
+			if bestRule.RuleType == types.RuleTypeOptionDelegate {
+				resolution.Resolution = route53ResolutionDelegate
+			}
+
+			resolution.MatchedDomain = bestRule.DomainName
+			resolution.ResolverRuleId = bestRule.Id
+			resolution.ResolverEndpointId = bestRule.ResolverEndpointId
+
+			for _, target := range bestRule.TargetIps {
+				if target.Ip != nil {
+					resolution.TargetIps = append(resolution.TargetIps, *target.Ip)
+				}
+
+				if target.Ipv6 != nil {
+					resolution.TargetIps = append(resolution.TargetIps, *target.Ipv6)
+				}
+			}
+		case types.RuleTypeOptionRecursive:
+			useZone = false
+
+			resolution.MatchedDomain = bestRule.DomainName
+			resolution.ResolverRuleId = bestRule.Id
+		case types.RuleTypeOptionSystem:
+			resolution.MatchedDomain = bestRule.DomainName
+			resolution.ResolverRuleId = bestRule.Id
+		}
+	}
+
+	if useZone {
+		resolution.Resolution = route53ResolutionPrivateHostedZone
+		resolution.MatchedDomain = bestZone.HostedZoneName
+		resolution.HostedZoneId = &bestZone.HostedZoneId
+		resolution.HostedZoneOwner = bestZone.OwningAccount
+	}
+
+	return resolution
+}
+
+func newRoute53ResolverDNSGetFunc(region string) func(context.Context, route53ResolverDNSClient, string, string) (*route53ResolverDNSResolution, error) {
+	return func(ctx context.Context, client route53ResolverDNSClient, scope, query string) (*route53ResolverDNSResolution, error) {
+		vpcID, name, err := parseRoute53ResolverDNSQuery(scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := getRoute53ResolverVPCConfig(ctx, client, vpcID, region)
+		if err != nil {
+			return nil, err
+		}
+
+		return config.resolve(name), nil
+	}
+}
+
+func newRoute53ResolverDNSSearchFunc(region string) func(context.Context, route53ResolverDNSClient, string, string) ([]*route53ResolverDNSResolution, error) {
+	get := newRoute53ResolverDNSGetFunc(region)
+
+	return func(ctx context.Context, client route53ResolverDNSClient, scope, query string) ([]*route53ResolverDNSResolution, error) {
+		// A bare VPC ID returns the resolution of each domain that has a rule
+		// or zone associated with the VPC
+		if strings.HasPrefix(query, "vpc-") && !strings.Contains(query, ":") {
+			config, err := getRoute53ResolverVPCConfig(ctx, client, query, region)
+			if err != nil {
+				return nil, err
+			}
+
+			resolutions := make([]*route53ResolverDNSResolution, 0)
+			for _, domain := range config.domains() {
+				resolutions = append(resolutions, config.resolve(domain))
+			}
+
+			return resolutions, nil
+		}
+
+		resolution, err := get(ctx, client, scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*route53ResolverDNSResolution{resolution}, nil
+	}
+}
+
+func route53ResolverDNSItemMapper(_, scope string, awsItem *route53ResolverDNSResolution) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-dns-resolution",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries,
+		&sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  awsItem.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The resolution only applies within the VPC
+				In: true,
+				// Resources in the VPC use the answer
+				Out: true,
+			},
+		},
+		&sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-firewall-rule-group-association",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  awsItem.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS Firewall can block the query before it is resolved
+				In: true,
+				// Resolving a name can't affect the firewall
+				Out: false,
+			},
+		},
+	)
+
+	if awsItem.ResolverRuleId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-rule",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverRuleId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The rule decides where the query is sent
+				In: true,
+				// Resolving a name can't affect the rule
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.ResolverEndpointId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-endpoint",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverEndpointId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The query is forwarded through the endpoint
+				In: true,
+				// Resolving a name can't affect the endpoint
+				Out: false,
+			},
+		})
+	}
+
+	for _, ip := range awsItem.TargetIps {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ip",
+				Method: sdp.QueryMethod_GET,
+				Query:  ip,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The name servers at these IPs answer the query
+				In: true,
+				// Resolving a name can't affect the name servers
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.HostedZoneId != nil {
+		// The zone can be owned by another account
+		zoneScope := scope
+		if awsItem.HostedZoneOwner != nil {
+			_, region, _ := adapterhelpers.ParseScope(scope)
+			zoneScope = adapterhelpers.FormatScope(*awsItem.HostedZoneOwner, region)
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries,
+			&sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53-hosted-zone",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.HostedZoneId,
+					Scope:  zoneScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The zone answers the query
+					In: true,
+					// Resolving a name can't affect the zone
+					Out: false,
+				},
+			},
+			&sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53-resource-record-set",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.HostedZoneId,
+					Scope:  zoneScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The records in the zone are the answer
+					In: true,
+					// Resolving a name can't affect the records
+					Out: false,
+				},
+			},
+		)
+	}
+
+	if awsItem.Resolution == route53ResolutionRecursive {
+		// The answer is the same as from anywhere else, so it can be resolved
+		// by the stdlib adapter
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  awsItem.Name,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Public DNS answers the query
+				In: true,
+				// Resources in the VPC use the answer
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+// NewRoute53ResolverDNSAdapter returns an adapter that works out how a name is
+// resolved from within a VPC, based on the Resolver rules and private hosted
+// zones that are associated with it
+func NewRoute53ResolverDNSAdapter(client route53ResolverClient, zones route53HostedZoneClient, accountID string, region string) *adapterhelpers.GetListAdapter[*route53ResolverDNSResolution, route53ResolverDNSClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*route53ResolverDNSResolution, route53ResolverDNSClient, *route53resolver.Options]{
+		ItemType: "route53resolver-dns-resolution",
+		Client: route53ResolverDNSClient{
+			route53ResolverClient: client,
+			Route53:               zones,
+		},
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverDNSAdapterMetadata,
+		GetFunc:         newRoute53ResolverDNSGetFunc(region),
+		DisableList:     true,
+		SearchFunc:      newRoute53ResolverDNSSearchFunc(region),
+		ItemMapper:      route53ResolverDNSItemMapper,
+	}
+}
+
+var route53ResolverDNSAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-dns-resolution",
+	DescriptiveName: "Route 53 Resolver DNS Resolution",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get the Resolver rule or private hosted zone that answers a name in a VPC, in the format {vpcId}:{name}",
+		Search:            true,
+		SearchDescription: "Search by VPC ID for how each domain with a Resolver rule or private hosted zone in the VPC is answered, or by {vpcId}:{name} for a single name. This is the resolver-aware version of a dns search: dns items are resolved from wherever the stdlib source runs, which can't see the rules and private zones of a VPC",
+	},
+	PotentialLinks: []string{"ec2-vpc", "route53resolver-firewall-rule-group-association", "route53resolver-resolver-rule", "route53resolver-resolver-endpoint", "ip", "route53-hosted-zone", "route53-resource-record-set", "dns"},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverDNSAdapterMetadata, sdp.AttributeSchemaFor(&route53ResolverDNSResolution{}))
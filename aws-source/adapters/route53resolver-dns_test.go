@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53DomainSpecificity(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Domain   string
+		Expected int
+	}{
+		{Name: "app.corp.example.com", Domain: "corp.example.com", Expected: 3},
+		{Name: "corp.example.com", Domain: "corp.example.com", Expected: 3},
+		{Name: "notcorp.example.com", Domain: "corp.example.com", Expected: -1},
+		{Name: "www.example.net", Domain: "", Expected: 0},
+	}
+
+	for _, test := range tests {
+		if actual := route53DomainSpecificity(test.Name, test.Domain); actual != test.Expected {
+			t.Errorf("expected %v for %v in %v, got %v", test.Expected, test.Name, test.Domain, actual)
+		}
+	}
+}
+
+func TestRoute53ResolverDNSGet(t *testing.T) {
+	adapter := NewRoute53ResolverDNSAdapter(testRoute53ResolverClient{}, testRoute53HostedZoneClient{}, "123456789012", "eu-west-2")
+	scope := "123456789012.eu-west-2"
+
+	t.Run("forwarding rule", func(t *testing.T) {
+		// The rule takes precedence over the private zone for the same domain
+		item, err := adapter.Get(context.Background(), scope, testResolverVPC+":APP.Corp.Example.com.", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+
+		if item.UniqueAttributeValue() != testResolverVPC+":app.corp.example.com" {
+			t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+		}
+
+		if resolution, _ := item.GetAttributes().Get("Resolution"); resolution != route53ResolutionForward {
+			t.Errorf("expected FORWARD, got %v", resolution)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-vpc",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  testResolverVPC,
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "route53resolver-firewall-rule-group-association",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  testResolverVPC,
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "route53resolver-resolver-rule",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "rslvr-rr-corp",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "route53resolver-resolver-endpoint",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "rslvr-out-0123456789abcdef0",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "ip",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "192.168.0.2",
+				ExpectedScope:  "global",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("system rule", func(t *testing.T) {
+		// The system rule hands the query back to the private zone
+		item, err := adapter.Get(context.Background(), scope, testResolverVPC+":db.dev.corp.example.com", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resolution, _ := item.GetAttributes().Get("Resolution"); resolution != route53ResolutionPrivateHostedZone {
+			t.Errorf("expected PRIVATE_HOSTED_ZONE, got %v", resolution)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "route53resolver-resolver-rule",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "rslvr-rr-dev",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "route53-hosted-zone",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "Z0123456789DEV",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("zone in another account", func(t *testing.T) {
+		item, err := adapter.Get(context.Background(), scope, testResolverVPC+":api.internal.example.org", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "route53-hosted-zone",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "Z0123456789SHARED",
+				ExpectedScope:  "210987654321.eu-west-2",
+			},
+			{
+				ExpectedType:   "route53-resource-record-set",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "Z0123456789SHARED",
+				ExpectedScope:  "210987654321.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("public DNS", func(t *testing.T) {
+		// Only the internet resolver rule matches, since the rule for
+		// example.com failed to associate
+		item, err := adapter.Get(context.Background(), scope, testResolverVPC+":www.example.com", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resolution, _ := item.GetAttributes().Get("Resolution"); resolution != route53ResolutionRecursive {
+			t.Errorf("expected RECURSIVE, got %v", resolution)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "route53resolver-resolver-rule",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "rslvr-autodefined-rr-internet-resolver",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "www.example.com",
+				ExpectedScope:  "global",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("without a VPC", func(t *testing.T) {
+		_, err := adapter.Get(context.Background(), scope, "www.example.com", false)
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+			t.Errorf("expected a NOTFOUND error, got %v", err)
+		}
+	})
+}
+
+func TestRoute53ResolverDNSSearch(t *testing.T) {
+	adapter := NewRoute53ResolverDNSAdapter(testRoute53ResolverClient{}, testRoute53HostedZoneClient{}, "123456789012", "eu-west-2")
+	scope := "123456789012.eu-west-2"
+
+	t.Run("by name", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), scope, testResolverVPC+":app.corp.example.com", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 {
+			t.Errorf("expected 1 item, got %v", len(items))
+		}
+	})
+
+	t.Run("by VPC", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), scope, testResolverVPC, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The root domain of the internet rule isn't included, and the corp
+		// rule and zone share a domain
+		expected := map[string]string{
+			testResolverVPC + ":corp.example.com":     route53ResolutionForward,
+			testResolverVPC + ":dev.corp.example.com": route53ResolutionPrivateHostedZone,
+			testResolverVPC + ":internal.example.org": route53ResolutionPrivateHostedZone,
+		}
+
+		if len(items) != len(expected) {
+			t.Fatalf("expected %v items, got %v", len(expected), len(items))
+		}
+
+		for _, item := range items {
+			if item.GetType() != "route53resolver-dns-resolution" {
+				t.Errorf("unexpected type %v", item.GetType())
+			}
+
+			resolution, _ := item.GetAttributes().Get("Resolution")
+			if want, ok := expected[item.UniqueAttributeValue()]; !ok || resolution != want {
+				t.Errorf("unexpected resolution %v for %v", resolution, item.UniqueAttributeValue())
+			}
+		}
+	})
+}
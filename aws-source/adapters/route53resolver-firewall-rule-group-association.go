@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func route53ResolverFirewallRuleGroupAssociationGetFunc(ctx context.Context, client route53ResolverClient, _, query string) (*types.FirewallRuleGroupAssociation, error) {
+	out, err := client.GetFirewallRuleGroupAssociation(ctx, &route53resolver.GetFirewallRuleGroupAssociationInput{
+		FirewallRuleGroupAssociationId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.FirewallRuleGroupAssociation, nil
+}
+
+func listRoute53ResolverFirewallRuleGroupAssociations(ctx context.Context, client route53ResolverClient, input *route53resolver.ListFirewallRuleGroupAssociationsInput) ([]*types.FirewallRuleGroupAssociation, error) {
+	associations := make([]*types.FirewallRuleGroupAssociation, 0)
+	paginator := route53resolver.NewListFirewallRuleGroupAssociationsPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.FirewallRuleGroupAssociations {
+			associations = append(associations, &out.FirewallRuleGroupAssociations[i])
+		}
+	}
+
+	return associations, nil
+}
+
+func route53ResolverFirewallRuleGroupAssociationListFunc(ctx context.Context, client route53ResolverClient, _ string) ([]*types.FirewallRuleGroupAssociation, error) {
+	return listRoute53ResolverFirewallRuleGroupAssociations(ctx, client, &route53resolver.ListFirewallRuleGroupAssociationsInput{})
+}
+
+// Searches for an association by ARN, for the associations of a VPC by VPC ID,
+// or for those of a rule group by rule group ID
+func route53ResolverFirewallRuleGroupAssociationSearchFunc(ctx context.Context, client route53ResolverClient, scope, query string) ([]*types.FirewallRuleGroupAssociation, error) {
+	id, isARN, err := route53ResolverARNResourceID(scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if isARN {
+		association, err := route53ResolverFirewallRuleGroupAssociationGetFunc(ctx, client, scope, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.FirewallRuleGroupAssociation{association}, nil
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupAssociationsInput{}
+
+	if strings.HasPrefix(query, "vpc-") {
+		input.VpcId = &query
+	} else {
+		input.FirewallRuleGroupId = &query
+	}
+
+	return listRoute53ResolverFirewallRuleGroupAssociations(ctx, client, input)
+}
+
+func route53ResolverFirewallRuleGroupAssociationItemMapper(_, scope string, awsItem *types.FirewallRuleGroupAssociation) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-firewall-rule-group-association",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          route53ResolverStatusToHealth(string(awsItem.Status)),
+	}
+
+	if awsItem.FirewallRuleGroupId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-firewall-rule-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.FirewallRuleGroupId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the rules changes which queries are blocked
+				In: true,
+				// The association can't affect the rule group
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, route53ResolverVPCLink(scope, *awsItem.VpcId))
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverFirewallRuleGroupAssociationAdapter(client route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.FirewallRuleGroupAssociation, route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*types.FirewallRuleGroupAssociation, route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53resolver-firewall-rule-group-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverFirewallRuleGroupAssociationAdapterMetadata,
+		GetFunc:         route53ResolverFirewallRuleGroupAssociationGetFunc,
+		ListFunc:        route53ResolverFirewallRuleGroupAssociationListFunc,
+		SearchFunc:      route53ResolverFirewallRuleGroupAssociationSearchFunc,
+		ItemMapper:      route53ResolverFirewallRuleGroupAssociationItemMapper,
+		ListTagsFunc: func(ctx context.Context, association *types.FirewallRuleGroupAssociation, client route53ResolverClient) (map[string]string, error) {
+			if association.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *association.Arn)
+		},
+	}
+}
+
+var route53ResolverFirewallRuleGroupAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-firewall-rule-group-association",
+	DescriptiveName: "Route 53 Resolver DNS Firewall Rule Group Association",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a DNS Firewall rule group association by ID",
+		List:              true,
+		ListDescription:   "List all DNS Firewall rule group associations",
+		Search:            true,
+		SearchDescription: "Search for a DNS Firewall rule group association by ARN, for the associations of a VPC by VPC ID, or for those of a rule group by rule group ID",
+	},
+	PotentialLinks: []string{"route53resolver-firewall-rule-group", "ec2-vpc"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_firewall_rule_group_association.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverFirewallRuleGroupAssociationAdapterMetadata, sdp.AttributeSchemaFor(&types.FirewallRuleGroupAssociation{}))
@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53ResolverFirewallRuleGroupAssociationGet(t *testing.T) {
+	adapter := NewRoute53ResolverFirewallRuleGroupAssociationAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "rslvr-frgassoc-0123456789abcdef0", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53resolver-firewall-rule-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-frg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testResolverVPC,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestRoute53ResolverFirewallRuleGroupAssociationSearch(t *testing.T) {
+	adapter := NewRoute53ResolverFirewallRuleGroupAssociationAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	tests := map[string]int{
+		testResolverVPC:               1,
+		"rslvr-frg-0123456789abcdef0": 2,
+		"arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group-association/rslvr-frgassoc-fedcba9876543210f": 1,
+	}
+
+	for query, expected := range tests {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != expected {
+			t.Errorf("expected %v items for %v, got %v", expected, query, len(items))
+		}
+	}
+}
+
+func TestNewRoute53ResolverFirewallRuleGroupAssociationAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverFirewallRuleGroupAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
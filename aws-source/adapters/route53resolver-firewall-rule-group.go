@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// route53ResolverFirewallRuleGroup is a DNS Firewall rule group along with its
+// rules, which are returned by a separate request
+type route53ResolverFirewallRuleGroup struct {
+	types.FirewallRuleGroup
+
+	// The rules in the group, in the order that they are evaluated
+	Rules []types.FirewallRule
+}
+
+func route53ResolverFirewallRuleGroupGetFunc(ctx context.Context, client route53ResolverClient, _, query string) (*route53ResolverFirewallRuleGroup, error) {
+	out, err := client.GetFirewallRuleGroup(ctx, &route53resolver.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group := &route53ResolverFirewallRuleGroup{
+		FirewallRuleGroup: *out.FirewallRuleGroup,
+		Rules:             make([]types.FirewallRule, 0),
+	}
+
+	paginator := route53resolver.NewListFirewallRulesPaginator(client, &route53resolver.ListFirewallRulesInput{
+		FirewallRuleGroupId: &query,
+	})
+
+	for paginator.HasMorePages() {
+		rules, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		group.Rules = append(group.Rules, rules.FirewallRules...)
+	}
+
+	return group, nil
+}
+
+func route53ResolverFirewallRuleGroupListFunc(ctx context.Context, client route53ResolverClient, scope string) ([]*route53ResolverFirewallRuleGroup, error) {
+	groups := make([]*route53ResolverFirewallRuleGroup, 0)
+	paginator := route53resolver.NewListFirewallRuleGroupsPaginator(client, &route53resolver.ListFirewallRuleGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The list only includes the metadata of each group
+		for _, metadata := range out.FirewallRuleGroups {
+			if metadata.Id == nil {
+				continue
+			}
+
+			group, err := route53ResolverFirewallRuleGroupGetFunc(ctx, client, scope, *metadata.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+func route53ResolverFirewallRuleGroupItemMapper(_, scope string, awsItem *route53ResolverFirewallRuleGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-firewall-rule-group",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          route53ResolverStatusToHealth(string(awsItem.Status)),
+	}
+
+	if awsItem.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-firewall-rule-group-association",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Associations can't affect the rule group
+				In: false,
+				// Changing the rules changes which queries are blocked in the
+				// associated VPCs
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverFirewallRuleGroupAdapter(client route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapter[*route53ResolverFirewallRuleGroup, route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*route53ResolverFirewallRuleGroup, route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53resolver-firewall-rule-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverFirewallRuleGroupAdapterMetadata,
+		GetFunc:         route53ResolverFirewallRuleGroupGetFunc,
+		ListFunc:        route53ResolverFirewallRuleGroupListFunc,
+		ItemMapper:      route53ResolverFirewallRuleGroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, group *route53ResolverFirewallRuleGroup, client route53ResolverClient) (map[string]string, error) {
+			// Rule groups shared from another account can only be tagged by
+			// their owner
+			if group.Arn == nil || group.ShareStatus == types.ShareStatusSharedWithMe {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *group.Arn)
+		},
+	}
+}
+
+var route53ResolverFirewallRuleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-firewall-rule-group",
+	DescriptiveName: "Route 53 Resolver DNS Firewall Rule Group",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a DNS Firewall rule group by ID",
+		List:              true,
+		ListDescription:   "List all DNS Firewall rule groups",
+		Search:            true,
+		SearchDescription: "Search for a DNS Firewall rule group by ARN",
+	},
+	PotentialLinks: []string{"route53resolver-firewall-rule-group-association"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_firewall_rule_group.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverFirewallRuleGroupAdapterMetadata, sdp.AttributeSchemaFor(&route53ResolverFirewallRuleGroup{}))
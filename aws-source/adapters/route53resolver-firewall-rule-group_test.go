@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53ResolverFirewallRuleGroupList(t *testing.T) {
+	adapter := NewRoute53ResolverFirewallRuleGroupAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if rules, err := item.GetAttributes().Get("Rules"); err != nil || len(rules.([]interface{})) != 2 {
+		t.Errorf("expected 2 rules, got %v", rules)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53resolver-firewall-rule-group-association",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "rslvr-frg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverFirewallRuleGroupAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverFirewallRuleGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// route53ResolverEndpoint is a Resolver endpoint along with its IP addresses,
+// which are returned by a separate request
+type route53ResolverEndpoint struct {
+	types.ResolverEndpoint
+
+	// The IP addresses of the endpoint and the subnets they are in
+	IpAddresses []types.IpAddressResponse
+}
+
+func route53ResolverEndpointGetFunc(ctx context.Context, client route53ResolverClient, _, query string) (*route53ResolverEndpoint, error) {
+	out, err := client.GetResolverEndpoint(ctx, &route53resolver.GetResolverEndpointInput{
+		ResolverEndpointId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return route53ResolverEndpointWithAddresses(ctx, client, out.ResolverEndpoint)
+}
+
+func route53ResolverEndpointWithAddresses(ctx context.Context, client route53ResolverClient, endpoint *types.ResolverEndpoint) (*route53ResolverEndpoint, error) {
+	result := &route53ResolverEndpoint{
+		ResolverEndpoint: *endpoint,
+		IpAddresses:      make([]types.IpAddressResponse, 0),
+	}
+
+	paginator := route53resolver.NewListResolverEndpointIpAddressesPaginator(client, &route53resolver.ListResolverEndpointIpAddressesInput{
+		ResolverEndpointId: endpoint.Id,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.IpAddresses = append(result.IpAddresses, out.IpAddresses...)
+	}
+
+	return result, nil
+}
+
+func route53ResolverEndpointListFunc(ctx context.Context, client route53ResolverClient, _ string) ([]*route53ResolverEndpoint, error) {
+	endpoints := make([]*route53ResolverEndpoint, 0)
+	paginator := route53resolver.NewListResolverEndpointsPaginator(client, &route53resolver.ListResolverEndpointsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.ResolverEndpoints {
+			endpoint, err := route53ResolverEndpointWithAddresses(ctx, client, &out.ResolverEndpoints[i])
+			if err != nil {
+				return nil, err
+			}
+
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints, nil
+}
+
+func route53ResolverEndpointItemMapper(_, scope string, awsItem *route53ResolverEndpoint) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-resolver-endpoint",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          route53ResolverStatusToHealth(string(awsItem.Status)),
+	}
+
+	if awsItem.HostVPCId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.HostVPCId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The endpoint is in the VPC
+				In: true,
+				// The endpoint can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	for _, id := range awsItem.SecurityGroupIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The security groups control the DNS traffic that can reach
+				// the endpoint
+				In: true,
+				// The endpoint can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	subnets := make(map[string]bool)
+	for _, address := range awsItem.IpAddresses {
+		if address.SubnetId != nil && !subnets[*address.SubnetId] {
+			subnets[*address.SubnetId] = true
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  *address.SubnetId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The endpoint has an address in the subnet
+					In: true,
+					// The endpoint can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, ip := range []*string{address.Ip, address.Ipv6} {
+			if ip == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  *ip,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// Outbound endpoints forward queries for the rules that use them
+	if awsItem.Direction != types.ResolverEndpointDirectionInbound && awsItem.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-rule",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The rules can't affect the endpoint
+				In: false,
+				// Names matched by the rules are resolved through the
+				// endpoint
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverEndpointAdapter(client route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapter[*route53ResolverEndpoint, route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*route53ResolverEndpoint, route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53resolver-resolver-endpoint",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverEndpointAdapterMetadata,
+		GetFunc:         route53ResolverEndpointGetFunc,
+		ListFunc:        route53ResolverEndpointListFunc,
+		ItemMapper:      route53ResolverEndpointItemMapper,
+		ListTagsFunc: func(ctx context.Context, endpoint *route53ResolverEndpoint, client route53ResolverClient) (map[string]string, error) {
+			if endpoint.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *endpoint.Arn)
+		},
+	}
+}
+
+var route53ResolverEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-resolver-endpoint",
+	DescriptiveName: "Route 53 Resolver Endpoint",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Resolver endpoint by ID",
+		List:              true,
+		ListDescription:   "List all Resolver endpoints",
+		Search:            true,
+		SearchDescription: "Search for a Resolver endpoint by ARN",
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-security-group", "ec2-subnet", "ip", "route53resolver-resolver-rule"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_endpoint.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverEndpointAdapterMetadata, sdp.AttributeSchemaFor(&route53ResolverEndpoint{}))
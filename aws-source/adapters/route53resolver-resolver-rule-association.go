@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func route53ResolverRuleAssociationGetFunc(ctx context.Context, client route53ResolverClient, _, query string) (*types.ResolverRuleAssociation, error) {
+	out, err := client.GetResolverRuleAssociation(ctx, &route53resolver.GetResolverRuleAssociationInput{
+		ResolverRuleAssociationId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ResolverRuleAssociation, nil
+}
+
+func listRoute53ResolverRuleAssociations(ctx context.Context, client route53ResolverClient, filters []types.Filter) ([]*types.ResolverRuleAssociation, error) {
+	associations := make([]*types.ResolverRuleAssociation, 0)
+	paginator := route53resolver.NewListResolverRuleAssociationsPaginator(client, &route53resolver.ListResolverRuleAssociationsInput{
+		Filters: filters,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.ResolverRuleAssociations {
+			associations = append(associations, &out.ResolverRuleAssociations[i])
+		}
+	}
+
+	return associations, nil
+}
+
+func route53ResolverRuleAssociationListFunc(ctx context.Context, client route53ResolverClient, _ string) ([]*types.ResolverRuleAssociation, error) {
+	return listRoute53ResolverRuleAssociations(ctx, client, nil)
+}
+
+// Searches for the associations of a VPC by VPC ID, or of a rule by rule ID
+func route53ResolverRuleAssociationSearchFunc(ctx context.Context, client route53ResolverClient, _, query string) ([]*types.ResolverRuleAssociation, error) {
+	if strings.HasPrefix(query, "vpc-") {
+		return listRoute53ResolverRuleAssociations(ctx, client, route53ResolverFilter("VPCId", query))
+	}
+
+	return listRoute53ResolverRuleAssociations(ctx, client, route53ResolverFilter("ResolverRuleId", query))
+}
+
+func route53ResolverRuleAssociationItemMapper(_, scope string, awsItem *types.ResolverRuleAssociation) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-resolver-rule-association",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          route53ResolverStatusToHealth(string(awsItem.Status)),
+	}
+
+	if awsItem.ResolverRuleId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-rule",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverRuleId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the rule changes how names are resolved in the VPC
+				In: true,
+				// The association can't affect the rule
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.VPCId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, route53ResolverVPCLink(scope, *awsItem.VPCId))
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverRuleAssociationAdapter(client route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ResolverRuleAssociation, route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ResolverRuleAssociation, route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53resolver-resolver-rule-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverRuleAssociationAdapterMetadata,
+		GetFunc:         route53ResolverRuleAssociationGetFunc,
+		ListFunc:        route53ResolverRuleAssociationListFunc,
+		SearchFunc:      route53ResolverRuleAssociationSearchFunc,
+		ItemMapper:      route53ResolverRuleAssociationItemMapper,
+	}
+}
+
+var route53ResolverRuleAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-resolver-rule-association",
+	DescriptiveName: "Route 53 Resolver Rule Association",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Resolver rule association by ID",
+		List:              true,
+		ListDescription:   "List all Resolver rule associations",
+		Search:            true,
+		SearchDescription: "Search for the Resolver rule associations of a VPC by VPC ID, or of a rule by rule ID",
+	},
+	PotentialLinks: []string{"route53resolver-resolver-rule", "ec2-vpc"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_rule_association.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverRuleAssociationAdapterMetadata, sdp.AttributeSchemaFor(&types.ResolverRuleAssociation{}))
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53ResolverRuleAssociationGet(t *testing.T) {
+	adapter := NewRoute53ResolverRuleAssociationAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "rslvr-rrassoc-failed", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53resolver-resolver-rule",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-rr-failed",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testResolverVPC,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestRoute53ResolverRuleAssociationSearch(t *testing.T) {
+	adapter := NewRoute53ResolverRuleAssociationAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	t.Run("VPC", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testResolverVPC, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 4 {
+			t.Errorf("expected 4 items, got %v", len(items))
+		}
+	})
+
+	t.Run("rule", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "rslvr-rr-corp", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 2 {
+			t.Errorf("expected 2 items, got %v", len(items))
+		}
+	})
+}
+
+func TestNewRoute53ResolverRuleAssociationAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverRuleAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
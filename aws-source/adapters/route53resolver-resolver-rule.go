@@ -0,0 +1,183 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func route53ResolverRuleGetFunc(ctx context.Context, client route53ResolverClient, _, query string) (*types.ResolverRule, error) {
+	out, err := client.GetResolverRule(ctx, &route53resolver.GetResolverRuleInput{
+		ResolverRuleId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ResolverRule, nil
+}
+
+func listRoute53ResolverRules(ctx context.Context, client route53ResolverClient, filters []types.Filter) ([]*types.ResolverRule, error) {
+	rules := make([]*types.ResolverRule, 0)
+	paginator := route53resolver.NewListResolverRulesPaginator(client, &route53resolver.ListResolverRulesInput{
+		Filters: filters,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.ResolverRules {
+			rules = append(rules, &out.ResolverRules[i])
+		}
+	}
+
+	return rules, nil
+}
+
+func route53ResolverRuleListFunc(ctx context.Context, client route53ResolverClient, _ string) ([]*types.ResolverRule, error) {
+	return listRoute53ResolverRules(ctx, client, nil)
+}
+
+// Searches for a rule by ARN, or for the rules that forward queries through an
+// outbound endpoint by endpoint ID
+func route53ResolverRuleSearchFunc(ctx context.Context, client route53ResolverClient, scope, query string) ([]*types.ResolverRule, error) {
+	id, isARN, err := route53ResolverARNResourceID(scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isARN {
+		return listRoute53ResolverRules(ctx, client, route53ResolverFilter("ResolverEndpointId", query))
+	}
+
+	rule, err := route53ResolverRuleGetFunc(ctx, client, scope, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.ResolverRule{rule}, nil
+}
+
+func route53ResolverRuleItemMapper(_, scope string, awsItem *types.ResolverRule) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53resolver-resolver-rule",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          route53ResolverStatusToHealth(string(awsItem.Status)),
+	}
+
+	if awsItem.ResolverEndpointId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-endpoint",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverEndpointId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Queries that match the rule are forwarded through the
+				// endpoint
+				In: true,
+				// The rule can't affect the endpoint
+				Out: false,
+			},
+		})
+	}
+
+	for _, target := range awsItem.TargetIps {
+		for _, ip := range []*string{target.Ip, target.Ipv6} {
+			if ip == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  *ip,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53resolver-resolver-rule-association",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Removing an association can't affect the rule
+				In: false,
+				// Changing the rule changes resolution in associated VPCs
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverRuleAdapter(client route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ResolverRule, route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ResolverRule, route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53resolver-resolver-rule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: route53ResolverRuleAdapterMetadata,
+		GetFunc:         route53ResolverRuleGetFunc,
+		ListFunc:        route53ResolverRuleListFunc,
+		SearchFunc:      route53ResolverRuleSearchFunc,
+		ItemMapper:      route53ResolverRuleItemMapper,
+		ListTagsFunc: func(ctx context.Context, rule *types.ResolverRule, client route53ResolverClient) (map[string]string, error) {
+			// Rules shared from another account can only be tagged by their
+			// owner, and the built-in rule has no ARN
+			if rule.Arn == nil || rule.ShareStatus == types.ShareStatusSharedWithMe {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *rule.Arn)
+		},
+	}
+}
+
+var route53ResolverRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53resolver-resolver-rule",
+	DescriptiveName: "Route 53 Resolver Rule",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Resolver rule by ID",
+		List:              true,
+		ListDescription:   "List all Resolver rules, including those shared with this account",
+		Search:            true,
+		SearchDescription: "Search for a Resolver rule by ARN, or for the rules that use an outbound endpoint by endpoint ID",
+	},
+	PotentialLinks: []string{"route53resolver-resolver-endpoint", "ip", "route53resolver-resolver-rule-association"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_rule.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(route53ResolverRuleAdapterMetadata, sdp.AttributeSchemaFor(&types.ResolverRule{}))
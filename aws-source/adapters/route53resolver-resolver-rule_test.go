@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestRoute53ResolverRuleGet(t *testing.T) {
+	adapter := NewRoute53ResolverRuleAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "rslvr-rr-corp", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53resolver-resolver-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-out-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.168.0.2",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "route53resolver-resolver-rule-association",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "rslvr-rr-corp",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestRoute53ResolverRuleGetShared(t *testing.T) {
+	adapter := NewRoute53ResolverRuleAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "rslvr-rr-dev", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules shared with the account can't be tagged by it
+	if len(item.GetTags()) != 0 {
+		t.Errorf("expected no tags, got %v", item.GetTags())
+	}
+}
+
+func TestRoute53ResolverRuleSearch(t *testing.T) {
+	adapter := NewRoute53ResolverRuleAdapter(testRoute53ResolverClient{}, "123456789012", "eu-west-2")
+
+	t.Run("endpoint", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "rslvr-out-0123456789abcdef0", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "rslvr-rr-corp" {
+			t.Errorf("expected the corp rule, got %v", items)
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:route53resolver:eu-west-2:123456789012:resolver-rule/rslvr-rr-corp", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "rslvr-rr-corp" {
+			t.Errorf("expected the corp rule, got %v", items)
+		}
+	})
+}
+
+func TestNewRoute53ResolverRuleAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverRuleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type route53ResolverClient interface {
+	GetFirewallRuleGroup(ctx context.Context, params *route53resolver.GetFirewallRuleGroupInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupOutput, error)
+	GetFirewallRuleGroupAssociation(ctx context.Context, params *route53resolver.GetFirewallRuleGroupAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupAssociationOutput, error)
+	GetResolverEndpoint(ctx context.Context, params *route53resolver.GetResolverEndpointInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverEndpointOutput, error)
+	GetResolverRule(ctx context.Context, params *route53resolver.GetResolverRuleInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleOutput, error)
+	GetResolverRuleAssociation(ctx context.Context, params *route53resolver.GetResolverRuleAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error)
+	ListFirewallRuleGroupAssociations(ctx context.Context, params *route53resolver.ListFirewallRuleGroupAssociationsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRuleGroupAssociationsOutput, error)
+	ListFirewallRuleGroups(ctx context.Context, params *route53resolver.ListFirewallRuleGroupsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRuleGroupsOutput, error)
+	ListFirewallRules(ctx context.Context, params *route53resolver.ListFirewallRulesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRulesOutput, error)
+	ListResolverEndpointIpAddresses(ctx context.Context, params *route53resolver.ListResolverEndpointIpAddressesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverEndpointIpAddressesOutput, error)
+	ListResolverEndpoints(ctx context.Context, params *route53resolver.ListResolverEndpointsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverEndpointsOutput, error)
+	ListResolverRuleAssociations(ctx context.Context, params *route53resolver.ListResolverRuleAssociationsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverRuleAssociationsOutput, error)
+	ListResolverRules(ctx context.Context, params *route53resolver.ListResolverRulesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverRulesOutput, error)
+	ListTagsForResource(ctx context.Context, params *route53resolver.ListTagsForResourceInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListTagsForResourceOutput, error)
+}
+
+// route53ResolverDNSClient combines the Resolver API, which describes the rules
+// that forward queries, with the Route 53 API, which describes the private
+// zones that answer them
+type route53ResolverDNSClient struct {
+	route53ResolverClient
+	Route53 route53HostedZoneClient
+}
+
+func route53ResolverListTags(ctx context.Context, client route53ResolverClient, resourceARN string) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := route53resolver.NewListTagsForResourcePaginator(client, &route53resolver.ListTagsForResourceInput{
+		ResourceArn: &resourceARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Resolver resources that are being changed are in the UPDATING, CREATING or
+// DELETING state, and those that are ready in the COMPLETE state
+func route53ResolverStatusToHealth(status string) *sdp.Health {
+	switch status {
+	case "COMPLETE", "OPERATIONAL":
+		return sdp.Health_HEALTH_OK.Enum()
+	case "CREATING", "UPDATING", "DELETING", "AUTO_RECOVERING":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case "OVERRIDDEN":
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case "FAILED", "ACTION_NEEDED":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// Links to a VPC that a Resolver resource is associated with
+func route53ResolverVPCLink(scope string, vpcID string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ec2-vpc",
+			Method: sdp.QueryMethod_GET,
+			Query:  vpcID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the VPC removes the association
+			In: true,
+			// Changing how names are resolved affects everything in the VPC
+			Out: true,
+		},
+	}
+}
+
+// Returns the resource ID from a Resolver ARN, and false if the query isn't an
+// ARN. Searches that aren't by ARN use the query as a filter instead
+func route53ResolverARNResourceID(scope, query string) (string, bool, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return "", false, nil
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return "", true, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	return a.ResourceID(), true, nil
+}
+
+func route53ResolverFilter(name, value string) []types.Filter {
+	return []types.Filter{
+		{
+			Name:   &name,
+			Values: []string{value},
+		},
+	}
+}
@@ -0,0 +1,365 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const testResolverVPC = "vpc-0123456789abcdef0"
+
+var testResolverRules = map[string]types.ResolverRule{
+	"rslvr-rr-corp": {
+		Id:                 aws.String("rslvr-rr-corp"),
+		Arn:                aws.String("arn:aws:route53resolver:eu-west-2:123456789012:resolver-rule/rslvr-rr-corp"),
+		Name:               aws.String("corp"),
+		DomainName:         aws.String("corp.example.com."),
+		RuleType:           types.RuleTypeOptionForward,
+		ResolverEndpointId: aws.String("rslvr-out-0123456789abcdef0"),
+		Status:             types.ResolverRuleStatusComplete,
+		OwnerId:            aws.String("123456789012"),
+		ShareStatus:        types.ShareStatusNotShared,
+		TargetIps: []types.TargetAddress{
+			{
+				Ip:   aws.String("192.168.0.2"),
+				Port: aws.Int32(53),
+			},
+		},
+	},
+	"rslvr-rr-dev": {
+		Id:          aws.String("rslvr-rr-dev"),
+		Arn:         aws.String("arn:aws:route53resolver:eu-west-2:210987654321:resolver-rule/rslvr-rr-dev"),
+		Name:        aws.String("dev"),
+		DomainName:  aws.String("dev.corp.example.com."),
+		RuleType:    types.RuleTypeOptionSystem,
+		Status:      types.ResolverRuleStatusComplete,
+		OwnerId:     aws.String("210987654321"),
+		ShareStatus: types.ShareStatusSharedWithMe,
+	},
+	"rslvr-autodefined-rr-internet-resolver": {
+		Id:         aws.String("rslvr-autodefined-rr-internet-resolver"),
+		Name:       aws.String("Internet Resolver"),
+		DomainName: aws.String("."),
+		RuleType:   types.RuleTypeOptionRecursive,
+		Status:     types.ResolverRuleStatusComplete,
+		OwnerId:    aws.String("Route 53 Resolver"),
+	},
+	"rslvr-rr-failed": {
+		Id:                 aws.String("rslvr-rr-failed"),
+		DomainName:         aws.String("example.com."),
+		RuleType:           types.RuleTypeOptionForward,
+		ResolverEndpointId: aws.String("rslvr-out-0123456789abcdef0"),
+		Status:             types.ResolverRuleStatusFailed,
+	},
+}
+
+var testResolverRuleAssociations = []types.ResolverRuleAssociation{
+	{
+		Id:             aws.String("rslvr-rrassoc-corp"),
+		ResolverRuleId: aws.String("rslvr-rr-corp"),
+		VPCId:          aws.String(testResolverVPC),
+		Status:         types.ResolverRuleAssociationStatusComplete,
+	},
+	{
+		Id:             aws.String("rslvr-rrassoc-dev"),
+		ResolverRuleId: aws.String("rslvr-rr-dev"),
+		VPCId:          aws.String(testResolverVPC),
+		Status:         types.ResolverRuleAssociationStatusComplete,
+	},
+	{
+		Id:             aws.String("rslvr-rrassoc-internet"),
+		ResolverRuleId: aws.String("rslvr-autodefined-rr-internet-resolver"),
+		VPCId:          aws.String(testResolverVPC),
+		Status:         types.ResolverRuleAssociationStatusComplete,
+	},
+	{
+		Id:             aws.String("rslvr-rrassoc-failed"),
+		ResolverRuleId: aws.String("rslvr-rr-failed"),
+		VPCId:          aws.String(testResolverVPC),
+		Status:         types.ResolverRuleAssociationStatusFailed,
+		StatusMessage:  aws.String("The rule conflicts with another rule"),
+	},
+	{
+		Id:             aws.String("rslvr-rrassoc-other"),
+		ResolverRuleId: aws.String("rslvr-rr-corp"),
+		VPCId:          aws.String("vpc-0fedcba9876543210"),
+		Status:         types.ResolverRuleAssociationStatusCreating,
+	},
+}
+
+var testFirewallRuleGroupAssociations = []types.FirewallRuleGroupAssociation{
+	{
+		Id:                  aws.String("rslvr-frgassoc-0123456789abcdef0"),
+		Arn:                 aws.String("arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group-association/rslvr-frgassoc-0123456789abcdef0"),
+		FirewallRuleGroupId: aws.String("rslvr-frg-0123456789abcdef0"),
+		VpcId:               aws.String(testResolverVPC),
+		Name:                aws.String("block-malware"),
+		Priority:            aws.Int32(101),
+		MutationProtection:  types.MutationProtectionStatusEnabled,
+		Status:              types.FirewallRuleGroupAssociationStatusComplete,
+	},
+	{
+		Id:                  aws.String("rslvr-frgassoc-fedcba9876543210f"),
+		Arn:                 aws.String("arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group-association/rslvr-frgassoc-fedcba9876543210f"),
+		FirewallRuleGroupId: aws.String("rslvr-frg-0123456789abcdef0"),
+		VpcId:               aws.String("vpc-0fedcba9876543210"),
+		Priority:            aws.Int32(101),
+		Status:              types.FirewallRuleGroupAssociationStatusUpdating,
+	},
+}
+
+// Returns the first value of the filter with the given name
+func testResolverFilterValue(filters []types.Filter, name string) string {
+	for _, filter := range filters {
+		if filter.Name != nil && *filter.Name == name && len(filter.Values) > 0 {
+			return filter.Values[0]
+		}
+	}
+
+	return ""
+}
+
+type testRoute53ResolverClient struct{}
+
+func (t testRoute53ResolverClient) GetFirewallRuleGroup(ctx context.Context, params *route53resolver.GetFirewallRuleGroupInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupOutput, error) {
+	return &route53resolver.GetFirewallRuleGroupOutput{
+		FirewallRuleGroup: &types.FirewallRuleGroup{
+			Id:          params.FirewallRuleGroupId,
+			Arn:         aws.String("arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group/" + *params.FirewallRuleGroupId),
+			Name:        aws.String("block-malware"),
+			OwnerId:     aws.String("123456789012"),
+			RuleCount:   aws.Int32(2),
+			ShareStatus: types.ShareStatusNotShared,
+			Status:      types.FirewallRuleGroupStatusComplete,
+		},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) GetFirewallRuleGroupAssociation(ctx context.Context, params *route53resolver.GetFirewallRuleGroupAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupAssociationOutput, error) {
+	for _, association := range testFirewallRuleGroupAssociations {
+		if *association.Id == *params.FirewallRuleGroupAssociationId {
+			return &route53resolver.GetFirewallRuleGroupAssociationOutput{
+				FirewallRuleGroupAssociation: &association,
+			}, nil
+		}
+	}
+
+	return nil, errors.New("association not found")
+}
+
+func (t testRoute53ResolverClient) GetResolverEndpoint(ctx context.Context, params *route53resolver.GetResolverEndpointInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverEndpointOutput, error) {
+	return &route53resolver.GetResolverEndpointOutput{
+		ResolverEndpoint: &types.ResolverEndpoint{
+			Id:                   params.ResolverEndpointId,
+			Arn:                  aws.String("arn:aws:route53resolver:eu-west-2:123456789012:resolver-endpoint/" + *params.ResolverEndpointId),
+			Name:                 aws.String("outbound"),
+			Direction:            types.ResolverEndpointDirectionOutbound,
+			HostVPCId:            aws.String(testResolverVPC),
+			IpAddressCount:       aws.Int32(2),
+			SecurityGroupIds:     []string{"sg-0123456789abcdef0"},
+			ResolverEndpointType: types.ResolverEndpointTypeIpv4,
+			Protocols:            []types.Protocol{types.ProtocolDo53},
+			Status:               types.ResolverEndpointStatusOperational,
+		},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) GetResolverRule(ctx context.Context, params *route53resolver.GetResolverRuleInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleOutput, error) {
+	if *params.ResolverRuleId == "rslvr-rr-failed" {
+		return nil, errors.New("failed rules should not be described")
+	}
+
+	rule, ok := testResolverRules[*params.ResolverRuleId]
+	if !ok {
+		return nil, errors.New("rule not found")
+	}
+
+	return &route53resolver.GetResolverRuleOutput{
+		ResolverRule: &rule,
+	}, nil
+}
+
+func (t testRoute53ResolverClient) GetResolverRuleAssociation(ctx context.Context, params *route53resolver.GetResolverRuleAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+	for _, association := range testResolverRuleAssociations {
+		if *association.Id == *params.ResolverRuleAssociationId {
+			return &route53resolver.GetResolverRuleAssociationOutput{
+				ResolverRuleAssociation: &association,
+			}, nil
+		}
+	}
+
+	return nil, errors.New("association not found")
+}
+
+func (t testRoute53ResolverClient) ListFirewallRuleGroupAssociations(ctx context.Context, params *route53resolver.ListFirewallRuleGroupAssociationsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRuleGroupAssociationsOutput, error) {
+	associations := make([]types.FirewallRuleGroupAssociation, 0)
+
+	for _, association := range testFirewallRuleGroupAssociations {
+		if params.VpcId != nil && *params.VpcId != *association.VpcId {
+			continue
+		}
+
+		if params.FirewallRuleGroupId != nil && *params.FirewallRuleGroupId != *association.FirewallRuleGroupId {
+			continue
+		}
+
+		associations = append(associations, association)
+	}
+
+	return &route53resolver.ListFirewallRuleGroupAssociationsOutput{
+		FirewallRuleGroupAssociations: associations,
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListFirewallRuleGroups(ctx context.Context, params *route53resolver.ListFirewallRuleGroupsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRuleGroupsOutput, error) {
+	return &route53resolver.ListFirewallRuleGroupsOutput{
+		FirewallRuleGroups: []types.FirewallRuleGroupMetadata{
+			{
+				Id:   aws.String("rslvr-frg-0123456789abcdef0"),
+				Name: aws.String("block-malware"),
+			},
+		},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListFirewallRules(ctx context.Context, params *route53resolver.ListFirewallRulesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListFirewallRulesOutput, error) {
+	return &route53resolver.ListFirewallRulesOutput{
+		FirewallRules: []types.FirewallRule{
+			{
+				FirewallRuleGroupId:  params.FirewallRuleGroupId,
+				FirewallDomainListId: aws.String("rslvr-fdl-0123456789abcdef0"),
+				Name:                 aws.String("block-malware-domains"),
+				Action:               types.ActionBlock,
+				BlockResponse:        types.BlockResponseNxdomain,
+				Priority:             aws.Int32(1),
+			},
+			{
+				FirewallRuleGroupId:  params.FirewallRuleGroupId,
+				FirewallDomainListId: aws.String("rslvr-fdl-fedcba9876543210f"),
+				Name:                 aws.String("alert-suspicious"),
+				Action:               types.ActionAlert,
+				Priority:             aws.Int32(2),
+			},
+		},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListResolverEndpointIpAddresses(ctx context.Context, params *route53resolver.ListResolverEndpointIpAddressesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverEndpointIpAddressesOutput, error) {
+	return &route53resolver.ListResolverEndpointIpAddressesOutput{
+		IpAddresses: []types.IpAddressResponse{
+			{
+				IpId:     aws.String("rni-0123456789abcdef0"),
+				Ip:       aws.String("10.0.1.10"),
+				SubnetId: aws.String("subnet-0123456789abcdef0"),
+				Status:   types.IpAddressStatusAttached,
+			},
+			{
+				IpId:     aws.String("rni-fedcba9876543210f"),
+				Ip:       aws.String("10.0.2.10"),
+				SubnetId: aws.String("subnet-fedcba9876543210f"),
+				Status:   types.IpAddressStatusAttached,
+			},
+		},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListResolverEndpoints(ctx context.Context, params *route53resolver.ListResolverEndpointsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverEndpointsOutput, error) {
+	endpoint, err := t.GetResolverEndpoint(ctx, &route53resolver.GetResolverEndpointInput{
+		ResolverEndpointId: aws.String("rslvr-out-0123456789abcdef0"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &route53resolver.ListResolverEndpointsOutput{
+		ResolverEndpoints: []types.ResolverEndpoint{*endpoint.ResolverEndpoint},
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListResolverRuleAssociations(ctx context.Context, params *route53resolver.ListResolverRuleAssociationsInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverRuleAssociationsOutput, error) {
+	vpcID := testResolverFilterValue(params.Filters, "VPCId")
+	ruleID := testResolverFilterValue(params.Filters, "ResolverRuleId")
+	associations := make([]types.ResolverRuleAssociation, 0)
+
+	for _, association := range testResolverRuleAssociations {
+		if vpcID != "" && vpcID != *association.VPCId {
+			continue
+		}
+
+		if ruleID != "" && ruleID != *association.ResolverRuleId {
+			continue
+		}
+
+		associations = append(associations, association)
+	}
+
+	return &route53resolver.ListResolverRuleAssociationsOutput{
+		ResolverRuleAssociations: associations,
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListResolverRules(ctx context.Context, params *route53resolver.ListResolverRulesInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListResolverRulesOutput, error) {
+	endpointID := testResolverFilterValue(params.Filters, "ResolverEndpointId")
+	rules := make([]types.ResolverRule, 0)
+
+	for _, id := range []string{"rslvr-rr-corp", "rslvr-rr-dev", "rslvr-autodefined-rr-internet-resolver"} {
+		rule := testResolverRules[id]
+
+		if endpointID != "" && (rule.ResolverEndpointId == nil || endpointID != *rule.ResolverEndpointId) {
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &route53resolver.ListResolverRulesOutput{
+		ResolverRules: rules,
+	}, nil
+}
+
+func (t testRoute53ResolverClient) ListTagsForResource(ctx context.Context, params *route53resolver.ListTagsForResourceInput, optFns ...func(*route53resolver.Options)) (*route53resolver.ListTagsForResourceOutput, error) {
+	return &route53resolver.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("Name"),
+				Value: aws.String("test"),
+			},
+		},
+	}, nil
+}
+
+func route53ResolverGetAutoConfig(t *testing.T) (*route53resolver.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := route53resolver.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestRoute53ResolverARNResourceID(t *testing.T) {
+	id, isARN, err := route53ResolverARNResourceID("123456789012.eu-west-2", "arn:aws:route53resolver:eu-west-2:123456789012:resolver-rule/rslvr-rr-corp")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !isARN || id != "rslvr-rr-corp" {
+		t.Errorf("expected rslvr-rr-corp, got %v", id)
+	}
+
+	if _, isARN, _ = route53ResolverARNResourceID("123456789012.eu-west-2", "rslvr-out-0123456789abcdef0"); isARN {
+		t.Error("expected an ID not to be treated as an ARN")
+	}
+
+	_, _, err = route53ResolverARNResourceID("123456789012.eu-west-2", "arn:aws:route53resolver:us-east-1:123456789012:resolver-rule/rslvr-rr-corp")
+
+	var qErr *sdp.QueryError
+	if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOSCOPE {
+		t.Errorf("expected a NOSCOPE error, got %v", err)
+	}
+}
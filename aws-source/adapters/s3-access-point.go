@@ -0,0 +1,213 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// s3AccessPoint is an access point along with its policy, which is returned by
+// a separate request
+type s3AccessPoint struct {
+	s3control.GetAccessPointOutput
+
+	// The access point policy as a JSON document, if one is attached
+	Policy *string
+}
+
+func s3AccessPointGetFunc(ctx context.Context, client s3ControlClient, scope, query string) (*s3AccessPoint, error) {
+	accountID, err := s3ControlAccountID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetAccessPoint(ctx, &s3control.GetAccessPointInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessPoint := &s3AccessPoint{
+		GetAccessPointOutput: *out,
+	}
+
+	// Access points without a policy return an error, so it is ignored
+	if policy, err := client.GetAccessPointPolicy(ctx, &s3control.GetAccessPointPolicyInput{
+		AccountId: &accountID,
+		Name:      &query,
+	}); err == nil {
+		accessPoint.Policy = policy.Policy
+	}
+
+	return accessPoint, nil
+}
+
+// Lists the access points in the scope, optionally only those for a bucket.
+// The list doesn't include all details, so each access point is described
+func listS3AccessPoints(ctx context.Context, client s3ControlClient, scope string, bucket *string) ([]*s3AccessPoint, error) {
+	accountID, err := s3ControlAccountID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPoints := make([]*s3AccessPoint, 0)
+	paginator := s3control.NewListAccessPointsPaginator(client, &s3control.ListAccessPointsInput{
+		AccountId: &accountID,
+		Bucket:    bucket,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.AccessPointList {
+			if summary.Name == nil {
+				continue
+			}
+
+			accessPoint, err := s3AccessPointGetFunc(ctx, client, scope, *summary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			accessPoints = append(accessPoints, accessPoint)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+func s3AccessPointListFunc(ctx context.Context, client s3ControlClient, scope string) ([]*s3AccessPoint, error) {
+	return listS3AccessPoints(ctx, client, scope, nil)
+}
+
+// Searches for an access point by ARN, or for the access points of a bucket by
+// bucket name
+func s3AccessPointSearchFunc(ctx context.Context, client s3ControlClient, scope, query string) ([]*s3AccessPoint, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return listS3AccessPoints(ctx, client, scope, &query)
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	accessPoint, err := s3AccessPointGetFunc(ctx, client, scope, a.ResourceID())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*s3AccessPoint{accessPoint}, nil
+}
+
+func s3AccessPointOutputMapper(_, scope string, awsItem *s3AccessPoint) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "s3-access-point",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Bucket != nil {
+		// The bucket can be owned by another account
+		bucketAccountID, _, _ := adapterhelpers.ParseScope(scope)
+		if awsItem.BucketAccountId != nil {
+			bucketAccountID = *awsItem.BucketAccountId
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.Bucket,
+				Scope:  adapterhelpers.FormatScope(bucketAccountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The access point serves the objects in the bucket
+				In: true,
+				// Access points can't change the bucket
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.VpcConfiguration != nil && awsItem.VpcConfiguration.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.VpcConfiguration.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The access point only accepts requests from the VPC
+				In: true,
+				// The access point can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	// Link to the users and roles that the access point policy allows to use
+	// the access point
+	if awsItem.Policy != nil && awsItem.AccessPointArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, linksFromResourcePolicy(*awsItem.Policy, *awsItem.AccessPointArn)...)
+	}
+
+	return &item, nil
+}
+
+func NewS3AccessPointAdapter(client s3ControlClient, accountID string, region string) *adapterhelpers.GetListAdapter[*s3AccessPoint, s3ControlClient, *s3control.Options] {
+	return &adapterhelpers.GetListAdapter[*s3AccessPoint, s3ControlClient, *s3control.Options]{
+		ItemType:        "s3-access-point",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: s3AccessPointAdapterMetadata,
+		GetFunc:         s3AccessPointGetFunc,
+		ListFunc:        s3AccessPointListFunc,
+		SearchFunc:      s3AccessPointSearchFunc,
+		ItemMapper:      s3AccessPointOutputMapper,
+	}
+}
+
+var s3AccessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "s3-access-point",
+	DescriptiveName: "S3 Access Point",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an access point by name",
+		List:              true,
+		ListDescription:   "List all access points",
+		Search:            true,
+		SearchDescription: "Search for an access point by ARN, or for the access points of a bucket by bucket name",
+	},
+	PotentialLinks: []string{"s3-bucket", "ec2-vpc", "iam-role", "iam-user"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_s3_access_point.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(s3AccessPointAdapterMetadata, sdp.AttributeSchemaFor(&s3AccessPoint{}))
@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestS3AccessPointGet(t *testing.T) {
+	adapter := NewS3AccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "reports", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "reports-bucket",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/reporting",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestS3AccessPointGetCrossAccountBucket(t *testing.T) {
+	adapter := NewS3AccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "shared", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "partner-bucket",
+			ExpectedScope:  "210987654321",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestS3AccessPointList(t *testing.T) {
+	adapter := NewS3AccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 access points, got %v", len(items))
+	}
+}
+
+func TestS3AccessPointSearch(t *testing.T) {
+	adapter := NewS3AccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	t.Run("ARN", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testAccessPointARN, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "reports" {
+			t.Errorf("expected the reports access point, got %v", items)
+		}
+	})
+
+	t.Run("bucket", func(t *testing.T) {
+		items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "partner-bucket", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].UniqueAttributeValue() != "shared" {
+			t.Errorf("expected the shared access point, got %v", items)
+		}
+	})
+
+	t.Run("ARN in another region", func(t *testing.T) {
+		_, err := adapter.Search(context.Background(), "123456789012.eu-west-2", "arn:aws:s3:us-east-1:123456789012:accesspoint/reports", false)
+		if err == nil {
+			t.Error("expected an error, got nil")
+		}
+	})
+}
+
+func TestNewS3AccessPointAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := s3control.NewFromConfig(config)
+
+	adapter := NewS3AccessPointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,168 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// s3ObjectLambdaAccessPoint is an Object Lambda access point along with its
+// configuration, which is returned by a separate request
+type s3ObjectLambdaAccessPoint struct {
+	s3control.GetAccessPointForObjectLambdaOutput
+
+	// The supporting access point and the Lambda functions that transform
+	// objects
+	Configuration *types.ObjectLambdaConfiguration
+}
+
+func s3ObjectLambdaAccessPointGetFunc(ctx context.Context, client s3ControlClient, scope, query string) (*s3ObjectLambdaAccessPoint, error) {
+	accountID, err := s3ControlAccountID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetAccessPointForObjectLambda(ctx, &s3control.GetAccessPointForObjectLambdaInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := client.GetAccessPointConfigurationForObjectLambda(ctx, &s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3ObjectLambdaAccessPoint{
+		GetAccessPointForObjectLambdaOutput: *out,
+		Configuration:                       configuration.Configuration,
+	}, nil
+}
+
+func s3ObjectLambdaAccessPointListFunc(ctx context.Context, client s3ControlClient, scope string) ([]*s3ObjectLambdaAccessPoint, error) {
+	accountID, err := s3ControlAccountID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPoints := make([]*s3ObjectLambdaAccessPoint, 0)
+	paginator := s3control.NewListAccessPointsForObjectLambdaPaginator(client, &s3control.ListAccessPointsForObjectLambdaInput{
+		AccountId: &accountID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.ObjectLambdaAccessPointList {
+			if summary.Name == nil {
+				continue
+			}
+
+			accessPoint, err := s3ObjectLambdaAccessPointGetFunc(ctx, client, scope, *summary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			accessPoints = append(accessPoints, accessPoint)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+func s3ObjectLambdaAccessPointOutputMapper(_, scope string, awsItem *s3ObjectLambdaAccessPoint) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "s3-object-lambda-access-point",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Configuration != nil {
+		if awsItem.Configuration.SupportingAccessPoint != nil {
+			if link := s3AccessPointLink(*awsItem.Configuration.SupportingAccessPoint); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		for _, transformation := range awsItem.Configuration.TransformationConfigurations {
+			lambda, ok := transformation.ContentTransformation.(*types.ObjectLambdaContentTransformationMemberAwsLambda)
+			if !ok || lambda.Value.FunctionArn == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*lambda.Value.FunctionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *lambda.Value.FunctionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The function transforms the objects that are
+						// returned
+						In: true,
+						// The access point can't affect the function
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewS3ObjectLambdaAccessPointAdapter(client s3ControlClient, accountID string, region string) *adapterhelpers.GetListAdapter[*s3ObjectLambdaAccessPoint, s3ControlClient, *s3control.Options] {
+	return &adapterhelpers.GetListAdapter[*s3ObjectLambdaAccessPoint, s3ControlClient, *s3control.Options]{
+		ItemType:        "s3-object-lambda-access-point",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: s3ObjectLambdaAccessPointAdapterMetadata,
+		GetFunc:         s3ObjectLambdaAccessPointGetFunc,
+		ListFunc:        s3ObjectLambdaAccessPointListFunc,
+		ItemMapper:      s3ObjectLambdaAccessPointOutputMapper,
+	}
+}
+
+var s3ObjectLambdaAccessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "s3-object-lambda-access-point",
+	DescriptiveName: "S3 Object Lambda Access Point",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an Object Lambda access point by name",
+		List:              true,
+		ListDescription:   "List all Object Lambda access points",
+		Search:            true,
+		SearchDescription: "Search for an Object Lambda access point by ARN",
+	},
+	PotentialLinks: []string{"s3-access-point", "lambda-function"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_s3control_object_lambda_access_point.arn",
+		},
+	},
+})
+
+var _ = Metadata.RegisterSchema(s3ObjectLambdaAccessPointAdapterMetadata, sdp.AttributeSchemaFor(&s3ObjectLambdaAccessPoint{}))
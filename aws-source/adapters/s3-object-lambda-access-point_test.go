@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestS3ObjectLambdaAccessPointGet(t *testing.T) {
+	adapter := NewS3ObjectLambdaAccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "redacted-reports", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-access-point",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testAccessPointARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:redact-pii",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestS3ObjectLambdaAccessPointList(t *testing.T) {
+	adapter := NewS3ObjectLambdaAccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 access point, got %v", len(items))
+	}
+}
+
+func TestS3ObjectLambdaAccessPointSearch(t *testing.T) {
+	adapter := NewS3ObjectLambdaAccessPointAdapter(testS3ControlClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testObjectLambdaAccessPointARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 access point, got %v", len(items))
+	}
+}
+
+func TestNewS3ObjectLambdaAccessPointAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := s3control.NewFromConfig(config)
+
+	adapter := NewS3ObjectLambdaAccessPointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -3,12 +3,14 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/getsentry/sentry-go"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
@@ -62,10 +64,12 @@ var s3Metadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_s3_object_copy.bucket"},
 		{TerraformQueryMap: "aws_s3_object.bucket"},
 	},
-	PotentialLinks: []string{"lambda-function", "sqs-queue", "sns-topic", "s3-bucket"},
+	PotentialLinks: []string{"lambda-function", "sqs-queue", "sns-topic", "events-event-bus", "s3-bucket", "s3-access-point", "kms-key", "iam-role", "iam-user"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
 
+var _ = Metadata.RegisterSchema(s3Metadata, sdp.AttributeSchemaFor(Bucket{}))
+
 type S3Source struct {
 	// AWS Config including region and credentials
 	config aws.Config
@@ -78,6 +82,7 @@ type S3Source struct {
 	client          *s3.Client
 	clientCreated   bool
 	clientMutex     sync.Mutex
+	controlClient   *s3control.Client
 	AdapterMetadata *sdp.AdapterMetadata
 
 	CacheDuration time.Duration   // How long to cache items for
@@ -110,11 +115,20 @@ func (s *S3Source) Client() *s3.Client {
 
 	// Otherwise create a new client from the config
 	s.client = s3.NewFromConfig(s.config)
+	s.controlClient = s3control.NewFromConfig(s.config)
 	s.clientCreated = true
 
 	return s.client
 }
 
+// ControlClient The client for the S3 Control API, which is used for the
+// account level public access block
+func (s *S3Source) ControlClient() *s3control.Client {
+	s.Client()
+
+	return s.controlClient
+}
+
 // Type The type of items that this adapter is capable of finding
 func (s *S3Source) Type() string {
 
@@ -160,6 +174,13 @@ type S3Client interface {
 	GetBucketTagging(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
 	GetBucketVersioning(ctx context.Context, params *s3.GetBucketVersioningInput, optFns ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)
 	GetBucketWebsite(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error)
+	GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
+}
+
+// S3AccountPublicAccessBlockClient A client that can get the public access
+// block settings of an account
+type S3AccountPublicAccessBlockClient interface {
+	GetPublicAccessBlock(ctx context.Context, params *s3control.GetPublicAccessBlockInput, optFns ...func(*s3control.Options)) (*s3control.GetPublicAccessBlockOutput, error)
 }
 
 // Bucket represents an actual s3 bucket, with all of the extra requests
@@ -186,6 +207,136 @@ type Bucket struct {
 	s3.GetBucketRequestPaymentOutput
 	s3.GetBucketVersioningOutput
 	s3.GetBucketWebsiteOutput
+	s3.GetPublicAccessBlockOutput
+
+	// Computed from the policy status, ACL, ownership controls and the bucket
+	// and account public access blocks
+	EffectivePublicAccess BucketPublicAccess
+}
+
+// BucketPublicAccess Whether a bucket can be accessed publicly once the public
+// access block settings have been applied
+type BucketPublicAccess struct {
+	// Whether the bucket can be accessed publicly through either its policy or
+	// its ACL
+	IsPublic bool
+	// Whether S3 considers the bucket policy to be public
+	PolicyIsPublic bool
+	// Whether the ACL grants access to all users or all authenticated users
+	ACLIsPublic bool
+	// The bucket and account public access block settings combined. A setting
+	// applies if it is enabled at either level
+	PublicAccessBlock types.PublicAccessBlockConfiguration
+}
+
+// The groups that make a bucket public when they are granted access in an ACL
+var publicACLGroups = map[string]bool{
+	"http://acs.amazonaws.com/groups/global/AllUsers":           true,
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers": true,
+}
+
+// Computes the effective public access of a bucket from the bucket's settings
+// and the account's public access block, which can be nil
+func bucketPublicAccess(bucket *Bucket, accountBlock *types.PublicAccessBlockConfiguration) BucketPublicAccess {
+	access := BucketPublicAccess{}
+
+	enabled := func(settings ...*bool) *bool {
+		for _, setting := range settings {
+			if setting != nil && *setting {
+				return adapterhelpers.PtrBool(true)
+			}
+		}
+		return adapterhelpers.PtrBool(false)
+	}
+
+	bucketBlock := bucket.PublicAccessBlockConfiguration
+	if bucketBlock == nil {
+		bucketBlock = &types.PublicAccessBlockConfiguration{}
+	}
+	if accountBlock == nil {
+		accountBlock = &types.PublicAccessBlockConfiguration{}
+	}
+
+	access.PublicAccessBlock = types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       enabled(bucketBlock.BlockPublicAcls, accountBlock.BlockPublicAcls),
+		BlockPublicPolicy:     enabled(bucketBlock.BlockPublicPolicy, accountBlock.BlockPublicPolicy),
+		IgnorePublicAcls:      enabled(bucketBlock.IgnorePublicAcls, accountBlock.IgnorePublicAcls),
+		RestrictPublicBuckets: enabled(bucketBlock.RestrictPublicBuckets, accountBlock.RestrictPublicBuckets),
+	}
+
+	if bucket.PolicyStatus != nil && bucket.PolicyStatus.IsPublic != nil {
+		access.PolicyIsPublic = *bucket.PolicyStatus.IsPublic
+	}
+
+	// ACLs are disabled when the bucket owner is enforced
+	aclsDisabled := false
+	if bucket.OwnershipControls != nil {
+		for _, rule := range bucket.OwnershipControls.Rules {
+			if rule.ObjectOwnership == types.ObjectOwnershipBucketOwnerEnforced {
+				aclsDisabled = true
+			}
+		}
+	}
+
+	if !aclsDisabled {
+		for _, grant := range bucket.Grants {
+			if grant.Grantee != nil && grant.Grantee.URI != nil && publicACLGroups[*grant.Grantee.URI] {
+				access.ACLIsPublic = true
+			}
+		}
+	}
+
+	// Blocking public ACLs and policies only stops new ones from being
+	// applied, existing ones are ignored or restricted by the other settings
+	policyApplies := access.PolicyIsPublic && !*access.PublicAccessBlock.RestrictPublicBuckets
+	aclApplies := access.ACLIsPublic && !*access.PublicAccessBlock.IgnorePublicAcls
+	access.IsPublic = policyApplies || aclApplies
+
+	return access
+}
+
+// The region of a bucket from its location constraint. Buckets in us-east-1
+// have no location constraint, and EU is a legacy name for eu-west-1
+func bucketRegion(location types.BucketLocationConstraint) string {
+	switch location {
+	case "":
+		return "us-east-1"
+	case types.BucketLocationConstraintEu:
+		return "eu-west-1"
+	default:
+		return string(location)
+	}
+}
+
+// Links to the bucket of an S3 URI such as s3://bucket/path/to/object, as
+// used by data processing services for scripts, inputs and results. Buckets
+// are linked in the given account since the URI doesn't contain the owner.
+// Returns nil if the location isn't an S3 URI
+func s3URILink(accountID string, uri string) *sdp.LinkedItemQuery {
+	path, found := strings.CutPrefix(uri, "s3://")
+	if !found {
+		return nil
+	}
+
+	bucket, _, _ := strings.Cut(path, "/")
+	if bucket == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-bucket",
+			Method: sdp.QueryMethod_GET,
+			Query:  bucket,
+			Scope:  adapterhelpers.FormatScope(accountID, ""),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The resource reads from and writes to the bucket, so they can
+			// affect each other
+			In:  true,
+			Out: true,
+		},
+	}
 }
 
 // Get Get a single item with a given scope and query. The item returned
@@ -203,10 +354,38 @@ func (s *S3Source) Get(ctx context.Context, scope string, query string, ignoreCa
 	}
 
 	s.ensureCache()
-	return getImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	return getImpl(ctx, s.cache, s.Client(), s.ControlClient(), scope, query, ignoreCache)
 }
 
-func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+// getAccountPublicAccessBlock returns the public access block settings of the
+// account, which apply to all of its buckets. Returns nil if they can't be
+// read
+func getAccountPublicAccessBlock(ctx context.Context, controlClient S3AccountPublicAccessBlockClient, scope string) *types.PublicAccessBlockConfiguration {
+	// The scope of a bucket is the account ID
+	out, err := controlClient.GetPublicAccessBlock(ctx, &s3control.GetPublicAccessBlockInput{AccountId: &scope})
+	if err != nil || out.PublicAccessBlockConfiguration == nil {
+		return nil
+	}
+
+	// The account settings use a different type, but have the same fields
+	return &types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       out.PublicAccessBlockConfiguration.BlockPublicAcls,
+		BlockPublicPolicy:     out.PublicAccessBlockConfiguration.BlockPublicPolicy,
+		IgnorePublicAcls:      out.PublicAccessBlockConfiguration.IgnorePublicAcls,
+		RestrictPublicBuckets: out.PublicAccessBlockConfiguration.RestrictPublicBuckets,
+	}
+}
+
+func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, controlClient S3AccountPublicAccessBlockClient, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	return getBucket(ctx, cache, client, func() *types.PublicAccessBlockConfiguration {
+		return getAccountPublicAccessBlock(ctx, controlClient, scope)
+	}, scope, query, ignoreCache)
+}
+
+// getBucket gets a single bucket. The account public access block is passed
+// as a function so that it is only fetched if the bucket isn't cached, and
+// can be shared between the buckets of a list
+func getBucket(ctx context.Context, cache *sdpcache.Cache, client S3Client, accountBlock func() *types.PublicAccessBlockConfiguration, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	cacheHit, ck, cachedItems, qErr := cache.Lookup(ctx, "aws-s3-adapter", sdp.QueryMethod_GET, scope, "s3-bucket", query, ignoreCache)
 	if qErr != nil {
 		return nil, qErr
@@ -246,6 +425,7 @@ func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 	// crippled by latency. This API is really stupid but there's not much I can
 	// do about it
 	var tagging *s3.GetBucketTaggingOutput
+	var accountBlockConfig *types.PublicAccessBlockConfiguration
 
 	wg.Add(1)
 	go func() {
@@ -391,10 +571,26 @@ func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 			bucket.GetBucketWebsiteOutput = *website
 		}
 	}()
+	wg.Add(1)
+	go func() {
+		defer sentry.Recover()
+		defer wg.Done()
+		if publicAccessBlock, err := client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: bucketName}); err == nil {
+			bucket.GetPublicAccessBlockOutput = *publicAccessBlock
+		}
+	}()
+	wg.Add(1)
+	go func() {
+		defer sentry.Recover()
+		defer wg.Done()
+		accountBlockConfig = accountBlock()
+	}()
 
 	// Wait for all requests to complete
 	wg.Wait()
 
+	bucket.EffectivePublicAccess = bucketPublicAccess(&bucket, accountBlockConfig)
+
 	attributes, err := adapterhelpers.ToAttributesWithExclude(bucket)
 
 	if err != nil {
@@ -515,6 +711,28 @@ func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 		}
 	}
 
+	// Regional resources that are linked to the bucket are in the same region
+	// as the bucket
+	regionalScope := adapterhelpers.FormatScope(scope, bucketRegion(bucket.LocationConstraint))
+
+	if bucket.EventBridgeConfiguration != nil {
+		// Events are always sent to the default bus
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-event-bus",
+				Method: sdp.QueryMethod_GET,
+				Query:  "default",
+				Scope:  regionalScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The bus can't affect the bucket
+				In: false,
+				// The bucket sends events to the bus
+				Out: true,
+			},
+		})
+	}
+
 	if bucket.LoggingEnabled != nil {
 		if bucket.LoggingEnabled.TargetBucket != nil {
 			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -587,6 +805,86 @@ func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 		}
 	}
 
+	if bucket.ReplicationConfiguration != nil {
+		if bucket.ReplicationConfiguration.Role != nil {
+			if a, err = adapterhelpers.ParseARN(*bucket.ReplicationConfiguration.Role); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *bucket.ReplicationConfiguration.Role,
+						Scope:  a.AccountID,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Replication fails if the role changes
+						In: true,
+						// The bucket can't affect the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		for _, rule := range bucket.ReplicationConfiguration.Rules {
+			if rule.Destination == nil {
+				continue
+			}
+
+			if rule.Destination.Bucket != nil {
+				if a, err = adapterhelpers.ParseARN(*rule.Destination.Bucket); err == nil {
+					// The destination can be in another account
+					destinationScope := scope
+					if rule.Destination.Account != nil {
+						destinationScope = adapterhelpers.FormatScope(*rule.Destination.Account, "")
+					}
+
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "s3-bucket",
+							Method: sdp.QueryMethod_GET,
+							Query:  a.ResourceID(),
+							Scope:  destinationScope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Replication fails if the destination changes
+							In: true,
+							// Objects are replicated to the destination
+							Out: true,
+						},
+					})
+				}
+			}
+
+			if rule.Destination.EncryptionConfiguration != nil && rule.Destination.EncryptionConfiguration.ReplicaKmsKeyID != nil {
+				if link := kmsKeyLink(regionalScope, *rule.Destination.EncryptionConfiguration.ReplicaKmsKeyID); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+	}
+
+	// Access points are in the same region as the bucket
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-access-point",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *bucketName,
+			Scope:  regionalScope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Access points can't change the bucket
+			In: false,
+			// Access points serve the objects in the bucket
+			Out: true,
+		},
+	})
+
+	// Link to the users and roles that the bucket policy allows to act on the
+	// bucket or its objects
+	if bucket.Policy != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, linksFromResourcePolicy(*bucket.Policy, fmt.Sprintf("arn:%v:s3:::%v", adapterhelpers.PartitionFromRegion(bucketRegion(bucket.LocationConstraint)), *bucketName))...)
+	}
+
 	cache.StoreItem(&item, CacheDuration, ck)
 
 	return &item, nil
@@ -603,10 +901,10 @@ func (s *S3Source) List(ctx context.Context, scope string, ignoreCache bool) ([]
 	}
 
 	s.ensureCache()
-	return listImpl(ctx, s.cache, s.Client(), scope, ignoreCache)
+	return listImpl(ctx, s.cache, s.Client(), s.ControlClient(), scope, ignoreCache)
 }
 
-func listImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, ignoreCache bool) ([]*sdp.Item, error) {
+func listImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, controlClient S3AccountPublicAccessBlockClient, scope string, ignoreCache bool) ([]*sdp.Item, error) {
 	cacheHit, ck, cachedItems, qErr := cache.Lookup(ctx, "aws-s3-adapter", sdp.QueryMethod_LIST, scope, "s3-bucket", "", ignoreCache)
 	if qErr != nil {
 		return nil, qErr
@@ -629,8 +927,14 @@ func listImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 		return nil, err
 	}
 
+	// The account settings are the same for all buckets, so they are only
+	// fetched once
+	accountBlock := sync.OnceValue(func() *types.PublicAccessBlockConfiguration {
+		return getAccountPublicAccessBlock(ctx, controlClient, scope)
+	})
+
 	for _, bucket := range buckets.Buckets {
-		item, err := getImpl(ctx, cache, client, scope, *bucket.Name, ignoreCache)
+		item, err := getBucket(ctx, cache, client, accountBlock, scope, *bucket.Name, ignoreCache)
 
 		if err != nil {
 			continue
@@ -656,10 +960,10 @@ func (s *S3Source) Search(ctx context.Context, scope string, query string, ignor
 	}
 
 	s.ensureCache()
-	return searchImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	return searchImpl(ctx, s.cache, s.Client(), s.ControlClient(), scope, query, ignoreCache)
 }
 
-func searchImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
+func searchImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, controlClient S3AccountPublicAccessBlockClient, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
 	// Parse the ARN
 	a, err := adapterhelpers.ParseARN(query)
 
@@ -676,7 +980,7 @@ func searchImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, sco
 	}
 
 	// If the ARN was parsed we can just ask Get for the item
-	item, err := getImpl(ctx, cache, client, scope, a.ResourceID(), ignoreCache)
+	item, err := getImpl(ctx, cache, client, controlClient, scope, a.ResourceID(), ignoreCache)
 	if err != nil {
 		return nil, err
 	}
@@ -3,11 +3,13 @@ package adapters
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
 
 	"github.com/overmindtech/cli/aws-source/adapterhelpers"
 	"github.com/overmindtech/cli/sdp-go"
@@ -17,7 +19,7 @@ import (
 func TestS3SearchImpl(t *testing.T) {
 	cache := sdpcache.NewCache()
 	t.Run("with a good ARN", func(t *testing.T) {
-		items, err := searchImpl(context.Background(), cache, TestS3Client{}, "account-id.region", "arn:partition:service:region:account-id:resource-type:resource-id", false)
+		items, err := searchImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "account-id.region", "arn:partition:service:region:account-id:resource-type:resource-id", false)
 
 		if err != nil {
 			t.Error(err)
@@ -28,7 +30,7 @@ func TestS3SearchImpl(t *testing.T) {
 	})
 
 	t.Run("with a bad ARN", func(t *testing.T) {
-		_, err := searchImpl(context.Background(), cache, TestS3Client{}, "account-id.region", "foo", false)
+		_, err := searchImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "account-id.region", "foo", false)
 
 		if err == nil {
 			t.Error("expected error")
@@ -45,7 +47,7 @@ func TestS3SearchImpl(t *testing.T) {
 	})
 
 	t.Run("with an ARN in another scope", func(t *testing.T) {
-		_, err := searchImpl(context.Background(), cache, TestS3Client{}, "account-id.region", "arn:partition:service:region:account-id-2:resource-type:resource-id", false)
+		_, err := searchImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "account-id.region", "arn:partition:service:region:account-id-2:resource-type:resource-id", false)
 
 		if err == nil {
 			t.Error("expected error")
@@ -64,7 +66,7 @@ func TestS3SearchImpl(t *testing.T) {
 
 func TestS3ListImpl(t *testing.T) {
 	cache := sdpcache.NewCache()
-	items, err := listImpl(context.Background(), cache, TestS3Client{}, "foo", false)
+	items, err := listImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "foo", false)
 
 	if err != nil {
 		t.Error(err)
@@ -74,9 +76,57 @@ func TestS3ListImpl(t *testing.T) {
 	}
 }
 
+// countingS3ControlClient counts the requests for the account public access
+// block
+type countingS3ControlClient struct {
+	testS3ControlClient
+
+	calls atomic.Int32
+}
+
+func (c *countingS3ControlClient) GetPublicAccessBlock(ctx context.Context, params *s3control.GetPublicAccessBlockInput, optFns ...func(*s3control.Options)) (*s3control.GetPublicAccessBlockOutput, error) {
+	c.calls.Add(1)
+	return c.testS3ControlClient.GetPublicAccessBlock(ctx, params, optFns...)
+}
+
+// testS3ManyBucketsClient lists several buckets
+type testS3ManyBucketsClient struct {
+	TestS3Client
+}
+
+func (t testS3ManyBucketsClient) ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	return &s3.ListBucketsOutput{
+		Buckets: []types.Bucket{
+			{Name: adapterhelpers.PtrString("foo")},
+			{Name: adapterhelpers.PtrString("bar")},
+			{Name: adapterhelpers.PtrString("baz")},
+		},
+		Owner: &owner,
+	}, nil
+}
+
+func TestS3ListImplAccountPublicAccessBlock(t *testing.T) {
+	cache := sdpcache.NewCache()
+	controlClient := &countingS3ControlClient{}
+
+	items, err := listImpl(context.Background(), cache, testS3ManyBucketsClient{}, controlClient, "foo", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 3 {
+		t.Errorf("expected 3 items, got %v", len(items))
+	}
+
+	// The account settings apply to all buckets
+	if calls := controlClient.calls.Load(); calls != 1 {
+		t.Errorf("expected the account public access block to be fetched once, got %v", calls)
+	}
+}
+
 func TestS3GetImpl(t *testing.T) {
 	cache := sdpcache.NewCache()
-	item, err := getImpl(context.Background(), cache, TestS3Client{}, "foo", "bar", false)
+	item, err := getImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "foo", "bar", false)
 
 	if err != nil {
 		t.Fatal(err)
@@ -125,14 +175,224 @@ func TestS3GetImpl(t *testing.T) {
 			ExpectedQuery:  "arn:partition:service:region:account-id:resource-type:resource-id",
 			ExpectedScope:  "account-id.region",
 		},
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "default",
+			ExpectedScope:  "foo.af-south-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/replication",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "replica-bucket",
+			ExpectedScope:  "210987654321",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:210987654321:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-access-point",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "bar",
+			ExpectedScope:  "foo.af-south-1",
+		},
 	}
 
 	tests.Execute(t, item)
+
+	access, err := item.GetAttributes().Get("EffectivePublicAccess")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The policy is public and isn't restricted
+	if isPublic := access.(map[string]interface{})["IsPublic"]; isPublic != true {
+		t.Errorf("expected the bucket to be public, got %v", isPublic)
+	}
+}
+
+func TestBucketPublicAccess(t *testing.T) {
+	publicGrant := types.Grant{
+		Grantee: &types.Grantee{
+			Type: types.TypeGroup,
+			URI:  adapterhelpers.PtrString("http://acs.amazonaws.com/groups/global/AllUsers"),
+		},
+		Permission: types.PermissionRead,
+	}
+
+	tests := []struct {
+		Name           string
+		Bucket         Bucket
+		AccountBlock   *types.PublicAccessBlockConfiguration
+		ExpectedPolicy bool
+		ExpectedACL    bool
+		ExpectedPublic bool
+	}{
+		{
+			Name:           "private",
+			Bucket:         Bucket{},
+			ExpectedPublic: false,
+		},
+		{
+			Name: "public policy",
+			Bucket: Bucket{
+				GetBucketPolicyStatusOutput: s3.GetBucketPolicyStatusOutput{
+					PolicyStatus: &types.PolicyStatus{IsPublic: adapterhelpers.PtrBool(true)},
+				},
+			},
+			ExpectedPolicy: true,
+			ExpectedPublic: true,
+		},
+		{
+			Name: "public policy restricted by the account",
+			Bucket: Bucket{
+				GetBucketPolicyStatusOutput: s3.GetBucketPolicyStatusOutput{
+					PolicyStatus: &types.PolicyStatus{IsPublic: adapterhelpers.PtrBool(true)},
+				},
+			},
+			AccountBlock: &types.PublicAccessBlockConfiguration{
+				RestrictPublicBuckets: adapterhelpers.PtrBool(true),
+			},
+			ExpectedPolicy: true,
+			ExpectedPublic: false,
+		},
+		{
+			Name: "public policy only blocked for new policies",
+			Bucket: Bucket{
+				GetBucketPolicyStatusOutput: s3.GetBucketPolicyStatusOutput{
+					PolicyStatus: &types.PolicyStatus{IsPublic: adapterhelpers.PtrBool(true)},
+				},
+				GetPublicAccessBlockOutput: s3.GetPublicAccessBlockOutput{
+					PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+						BlockPublicPolicy: adapterhelpers.PtrBool(true),
+					},
+				},
+			},
+			ExpectedPolicy: true,
+			ExpectedPublic: true,
+		},
+		{
+			Name: "public ACL",
+			Bucket: Bucket{
+				GetBucketAclOutput: s3.GetBucketAclOutput{
+					Grants: []types.Grant{publicGrant},
+				},
+			},
+			ExpectedACL:    true,
+			ExpectedPublic: true,
+		},
+		{
+			Name: "public ACL ignored by the bucket",
+			Bucket: Bucket{
+				GetBucketAclOutput: s3.GetBucketAclOutput{
+					Grants: []types.Grant{publicGrant},
+				},
+				GetPublicAccessBlockOutput: s3.GetPublicAccessBlockOutput{
+					PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+						IgnorePublicAcls: adapterhelpers.PtrBool(true),
+					},
+				},
+			},
+			ExpectedACL:    true,
+			ExpectedPublic: false,
+		},
+		{
+			Name: "public ACL disabled by ownership controls",
+			Bucket: Bucket{
+				GetBucketAclOutput: s3.GetBucketAclOutput{
+					Grants: []types.Grant{publicGrant},
+				},
+				GetBucketOwnershipControlsOutput: s3.GetBucketOwnershipControlsOutput{
+					OwnershipControls: &types.OwnershipControls{
+						Rules: []types.OwnershipControlsRule{
+							{ObjectOwnership: types.ObjectOwnershipBucketOwnerEnforced},
+						},
+					},
+				},
+			},
+			ExpectedACL:    false,
+			ExpectedPublic: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			access := bucketPublicAccess(&test.Bucket, test.AccountBlock)
+
+			if access.PolicyIsPublic != test.ExpectedPolicy {
+				t.Errorf("expected PolicyIsPublic to be %v, got %v", test.ExpectedPolicy, access.PolicyIsPublic)
+			}
+
+			if access.ACLIsPublic != test.ExpectedACL {
+				t.Errorf("expected ACLIsPublic to be %v, got %v", test.ExpectedACL, access.ACLIsPublic)
+			}
+
+			if access.IsPublic != test.ExpectedPublic {
+				t.Errorf("expected IsPublic to be %v, got %v", test.ExpectedPublic, access.IsPublic)
+			}
+		})
+	}
+}
+
+func TestBucketRegion(t *testing.T) {
+	regions := map[types.BucketLocationConstraint]string{
+		"":                                     "us-east-1",
+		types.BucketLocationConstraintEu:       "eu-west-1",
+		types.BucketLocationConstraintEuWest2:  "eu-west-2",
+		types.BucketLocationConstraintApSouth1: "ap-south-1",
+	}
+
+	for location, expected := range regions {
+		if region := bucketRegion(location); region != expected {
+			t.Errorf("expected region %v for location %q, got %v", expected, location, region)
+		}
+	}
+}
+
+func TestS3URILink(t *testing.T) {
+	buckets := map[string]string{
+		"s3://scripts/jobs/etl.py": "scripts",
+		"s3://results":             "results",
+		"s3://results/":            "results",
+		"s3:///key":                "",
+		"https://example.com/file": "",
+		"scripts/jobs/etl.py":      "",
+	}
+
+	for uri, expected := range buckets {
+		link := s3URILink("123456789012", uri)
+
+		if expected == "" {
+			if link != nil {
+				t.Errorf("expected no link for %q, got %v", uri, link.GetQuery().GetQuery())
+			}
+
+			continue
+		}
+
+		if link == nil {
+			t.Errorf("expected a link for %q", uri)
+			continue
+		}
+
+		if link.GetQuery().GetQuery() != expected || link.GetQuery().GetScope() != "123456789012" {
+			t.Errorf("expected bucket %v in scope 123456789012 for %q, got %v in %v", expected, uri, link.GetQuery().GetQuery(), link.GetQuery().GetScope())
+		}
+	}
 }
 
 func TestS3SourceCaching(t *testing.T) {
 	cache := sdpcache.NewCache()
-	first, err := getImpl(context.Background(), cache, TestS3Client{}, "foo", "bar", false)
+	first, err := getImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "foo", "bar", false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -140,7 +400,7 @@ func TestS3SourceCaching(t *testing.T) {
 		t.Fatal("expected first item")
 	}
 
-	second, err := getImpl(context.Background(), cache, TestS3FailClient{}, "foo", "bar", false)
+	second, err := getImpl(context.Background(), cache, TestS3FailClient{}, testS3ControlClient{}, "foo", "bar", false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -148,7 +408,7 @@ func TestS3SourceCaching(t *testing.T) {
 		t.Fatal("expected second item")
 	}
 
-	third, err := getImpl(context.Background(), cache, TestS3Client{}, "foo", "bar", true)
+	third, err := getImpl(context.Background(), cache, TestS3Client{}, testS3ControlClient{}, "foo", "bar", true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -445,17 +705,17 @@ func (t TestS3Client) GetBucketPolicyStatus(ctx context.Context, params *s3.GetB
 func (t TestS3Client) GetBucketReplication(ctx context.Context, params *s3.GetBucketReplicationInput, optFns ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
 	return &s3.GetBucketReplicationOutput{
 		ReplicationConfiguration: &types.ReplicationConfiguration{
-			Role: adapterhelpers.PtrString("role"),
+			Role: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/replication"),
 			Rules: []types.ReplicationRule{
 				{
 					Destination: &types.Destination{
-						Bucket: adapterhelpers.PtrString("bucket"),
+						Bucket: adapterhelpers.PtrString("arn:aws:s3:::replica-bucket"),
 						AccessControlTranslation: &types.AccessControlTranslation{
 							Owner: types.OwnerOverrideDestination,
 						},
-						Account: adapterhelpers.PtrString("account"),
+						Account: adapterhelpers.PtrString("210987654321"),
 						EncryptionConfiguration: &types.EncryptionConfiguration{
-							ReplicaKmsKeyID: adapterhelpers.PtrString("keyId"),
+							ReplicaKmsKeyID: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:210987654321:key/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
 						},
 						Metrics: &types.Metrics{
 							Status: types.MetricsStatusEnabled,
@@ -496,6 +756,15 @@ func (t TestS3Client) GetBucketVersioning(ctx context.Context, params *s3.GetBuc
 	}, nil
 }
 
+func (t TestS3Client) GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	return &s3.GetPublicAccessBlockOutput{
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:  adapterhelpers.PtrBool(true),
+			IgnorePublicAcls: adapterhelpers.PtrBool(true),
+		},
+	}, nil
+}
+
 func (t TestS3Client) GetBucketWebsite(ctx context.Context, params *s3.GetBucketWebsiteInput, optFns ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
 	return &s3.GetBucketWebsiteOutput{
 		ErrorDocument: &types.ErrorDocument{
@@ -603,6 +872,10 @@ func (t TestS3FailClient) GetBucketWebsite(ctx context.Context, params *s3.GetBu
 	return nil, errors.New("failed to get bucket website")
 }
 
+func (t TestS3FailClient) GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	return nil, errors.New("failed to get public access block")
+}
+
 func (t TestS3FailClient) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
 	return nil, errors.New("failed to get object")
 }
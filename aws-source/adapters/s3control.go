@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type s3ControlClient interface {
+	GetAccessPoint(ctx context.Context, params *s3control.GetAccessPointInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointOutput, error)
+	GetAccessPointPolicy(ctx context.Context, params *s3control.GetAccessPointPolicyInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointPolicyOutput, error)
+	ListAccessPoints(ctx context.Context, params *s3control.ListAccessPointsInput, optFns ...func(*s3control.Options)) (*s3control.ListAccessPointsOutput, error)
+	GetAccessPointForObjectLambda(ctx context.Context, params *s3control.GetAccessPointForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointForObjectLambdaOutput, error)
+	GetAccessPointConfigurationForObjectLambda(ctx context.Context, params *s3control.GetAccessPointConfigurationForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointConfigurationForObjectLambdaOutput, error)
+	ListAccessPointsForObjectLambda(ctx context.Context, params *s3control.ListAccessPointsForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.ListAccessPointsForObjectLambdaOutput, error)
+}
+
+// The S3 Control API requires the account ID in every request, so it is taken
+// from the scope
+func s3ControlAccountID(scope string) (string, error) {
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("invalid scope %v: %v", scope, err),
+			Scope:       scope,
+		}
+	}
+
+	return accountID, nil
+}
+
+// Returns a query for an access point from its ARN. Access points that are
+// attached to buckets in other accounts are still owned by the account in
+// the ARN
+func s3AccessPointLink(accessPointARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(accessPointARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-access-point",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  accessPointARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Requests are made through the access point
+			In: true,
+			// The access point can't be affected by the things that use it
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,160 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+)
+
+const (
+	testAccessPointARN             = "arn:aws:s3:eu-west-2:123456789012:accesspoint/reports"
+	testObjectLambdaAccessPointARN = "arn:aws:s3-object-lambda:eu-west-2:123456789012:accesspoint/redacted-reports"
+)
+
+type testS3ControlClient struct{}
+
+func (t testS3ControlClient) GetAccessPoint(ctx context.Context, params *s3control.GetAccessPointInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointOutput, error) {
+	switch *params.Name {
+	case "reports":
+		return &s3control.GetAccessPointOutput{
+			Name:            params.Name,
+			AccessPointArn:  aws.String(testAccessPointARN),
+			Alias:           aws.String("reports-a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6q7-s3alias"),
+			Bucket:          aws.String("reports-bucket"),
+			BucketAccountId: aws.String("123456789012"),
+			NetworkOrigin:   types.NetworkOriginVpc,
+			VpcConfiguration: &types.VpcConfiguration{
+				VpcId: aws.String("vpc-0123456789abcdef0"),
+			},
+			PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+			CreationDate: aws.Time(time.Now()),
+		}, nil
+	case "shared":
+		// An access point for a bucket in another account
+		return &s3control.GetAccessPointOutput{
+			Name:            params.Name,
+			AccessPointArn:  aws.String("arn:aws:s3:eu-west-2:123456789012:accesspoint/shared"),
+			Bucket:          aws.String("partner-bucket"),
+			BucketAccountId: aws.String("210987654321"),
+			NetworkOrigin:   types.NetworkOriginInternet,
+			CreationDate:    aws.Time(time.Now()),
+		}, nil
+	}
+
+	return nil, errors.New("access point not found")
+}
+
+func (t testS3ControlClient) GetAccessPointPolicy(ctx context.Context, params *s3control.GetAccessPointPolicyInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointPolicyOutput, error) {
+	if *params.Name != "reports" {
+		return nil, errors.New("no access point policy")
+	}
+
+	return &s3control.GetAccessPointPolicyOutput{
+		Policy: aws.String(`{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Principal": {"AWS": "arn:aws:iam::123456789012:role/reporting"},
+					"Action": "s3:GetObject",
+					"Resource": "arn:aws:s3:eu-west-2:123456789012:accesspoint/reports/object/*"
+				}
+			]
+		}`),
+	}, nil
+}
+
+func (t testS3ControlClient) ListAccessPoints(ctx context.Context, params *s3control.ListAccessPointsInput, optFns ...func(*s3control.Options)) (*s3control.ListAccessPointsOutput, error) {
+	accessPoints := []types.AccessPoint{
+		{
+			Name:   aws.String("reports"),
+			Bucket: aws.String("reports-bucket"),
+		},
+		{
+			Name:   aws.String("shared"),
+			Bucket: aws.String("partner-bucket"),
+		},
+	}
+
+	out := &s3control.ListAccessPointsOutput{}
+	for _, accessPoint := range accessPoints {
+		if params.Bucket == nil || *params.Bucket == *accessPoint.Bucket {
+			out.AccessPointList = append(out.AccessPointList, accessPoint)
+		}
+	}
+
+	return out, nil
+}
+
+func (t testS3ControlClient) GetAccessPointForObjectLambda(ctx context.Context, params *s3control.GetAccessPointForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointForObjectLambdaOutput, error) {
+	if *params.Name != "redacted-reports" {
+		return nil, errors.New("object lambda access point not found")
+	}
+
+	return &s3control.GetAccessPointForObjectLambdaOutput{
+		Name: params.Name,
+		Alias: &types.ObjectLambdaAccessPointAlias{
+			Status: types.ObjectLambdaAccessPointAliasStatusReady,
+			Value:  aws.String("redacted-reports-a1b2c3d4e5f6g7h8i9j0k1l2m3n4o5p6q7--ol-s3"),
+		},
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+		CreationDate: aws.Time(time.Now()),
+	}, nil
+}
+
+func (t testS3ControlClient) GetAccessPointConfigurationForObjectLambda(ctx context.Context, params *s3control.GetAccessPointConfigurationForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+	return &s3control.GetAccessPointConfigurationForObjectLambdaOutput{
+		Configuration: &types.ObjectLambdaConfiguration{
+			SupportingAccessPoint: aws.String(testAccessPointARN),
+			TransformationConfigurations: []types.ObjectLambdaTransformationConfiguration{
+				{
+					Actions: []types.ObjectLambdaTransformationConfigurationAction{
+						types.ObjectLambdaTransformationConfigurationActionGetObject,
+					},
+					ContentTransformation: &types.ObjectLambdaContentTransformationMemberAwsLambda{
+						Value: types.AwsLambdaTransformation{
+							FunctionArn: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:redact-pii"),
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (t testS3ControlClient) ListAccessPointsForObjectLambda(ctx context.Context, params *s3control.ListAccessPointsForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.ListAccessPointsForObjectLambdaOutput, error) {
+	return &s3control.ListAccessPointsForObjectLambdaOutput{
+		ObjectLambdaAccessPointList: []types.ObjectLambdaAccessPoint{
+			{
+				Name:                       aws.String("redacted-reports"),
+				ObjectLambdaAccessPointArn: aws.String(testObjectLambdaAccessPointARN),
+			},
+		},
+	}, nil
+}
+
+// The account doesn't block public access, so the bucket settings decide
+func (t testS3ControlClient) GetPublicAccessBlock(ctx context.Context, params *s3control.GetPublicAccessBlockInput, optFns ...func(*s3control.Options)) (*s3control.GetPublicAccessBlockOutput, error) {
+	return &s3control.GetPublicAccessBlockOutput{
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(false),
+			BlockPublicPolicy:     aws.Bool(false),
+			IgnorePublicAcls:      aws.Bool(false),
+			RestrictPublicBuckets: aws.Bool(false),
+		},
+	}, nil
+}
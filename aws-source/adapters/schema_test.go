@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	cetypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/aws-source/cost"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// validateAttributeSchema checks that the attributes of the item match the
+// schema that was registered for its type
+func validateAttributeSchema(t *testing.T, item *sdp.Item) {
+	t.Helper()
+
+	schema := Metadata.AttributeSchema(item.GetType())
+	if schema == nil {
+		t.Fatalf("no attribute schema registered for %v", item.GetType())
+	}
+
+	if err := schema.Validate(item.GetAttributes()); err != nil {
+		t.Errorf("attributes of %v don't match the schema: %v", item.GloballyUniqueName(), err)
+	}
+}
+
+func TestAttributeSchemas(t *testing.T) {
+	// These adapters build their attributes by hand rather than from an AWS
+	// SDK type
+	withoutSchema := map[string]bool{
+		"networkmanager-network-resource-relationship": true,
+	}
+
+	for _, metadata := range Metadata.AllAdapterMetadata() {
+		schema := Metadata.AttributeSchema(metadata.GetType())
+		if withoutSchema[metadata.GetType()] {
+			if schema != nil {
+				t.Errorf("unexpected schema for %v", metadata.GetType())
+			}
+			continue
+		}
+
+		if schema == nil {
+			t.Errorf("no attribute schema registered for %v", metadata.GetType())
+			continue
+		}
+
+		if len(schema.Properties) == 0 && schema.AdditionalProperties == nil {
+			t.Errorf("attribute schema for %v has no properties", metadata.GetType())
+		}
+	}
+}
+
+type testCostExplorerClient struct{}
+
+func (c testCostExplorerClient) GetCostAndUsage(ctx context.Context, params *costexplorer.GetCostAndUsageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error) {
+	return &costexplorer.GetCostAndUsageOutput{
+		ResultsByTime: []cetypes.ResultByTime{
+			{
+				Total: map[string]cetypes.MetricValue{
+					"UnblendedCost": {Amount: aws.String("12.5"), Unit: aws.String("USD")},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestAttributeSchemasWithCost(t *testing.T) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(types.NatGateway{
+		NatGatewayId: aws.String("nat-0123456789abcdef0"),
+		State:        types.NatGatewayStateAvailable,
+	}, "tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := &sdp.Item{
+		Type:            "ec2-nat-gateway",
+		UniqueAttribute: "NatGatewayId",
+		Scope:           "123456789012.us-east-1",
+		Attributes:      attributes,
+		Tags:            map[string]string{"team": "payments"},
+	}
+
+	enricher := &cost.Enricher{
+		Pricer: cost.NewPriceList(),
+		Spend: map[string]*cost.TagSpend{
+			"123456789012": cost.NewTagSpend(testCostExplorerClient{}, "team"),
+		},
+	}
+	enricher.Enrich(context.Background(), item)
+
+	for _, attribute := range []string{cost.EstimatedCostAttribute, cost.HistoricalSpendAttribute} {
+		if _, err := item.GetAttributes().Get(attribute); err != nil {
+			t.Fatalf("expected %v to be added: %v", attribute, err)
+		}
+	}
+
+	validateAttributeSchema(t, item)
+}
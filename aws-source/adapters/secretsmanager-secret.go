@@ -0,0 +1,241 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// secretsManagerClient only includes the API calls that describe secrets. We
+// never want to be able to fetch secret values, so GetSecretValue is
+// deliberately left out
+type secretsManagerClient interface {
+	DescribeSecret(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error)
+
+	secretsmanager.ListSecretsAPIClient
+}
+
+// Secrets Manager adds a hyphen and 6 random characters to the end of the name
+// when it creates the ARN of a secret
+var secretARNSuffix = regexp.MustCompile(`-[a-zA-Z0-9]{6}$`)
+
+func secretGetFunc(ctx context.Context, client secretsManagerClient, scope, query string) (*secretsmanager.DescribeSecretOutput, error) {
+	// When searching by ARN the query can also contain the JSON key, version
+	// stage and version ID that ECS uses to reference part of a secret e.g.
+	// name-AbCdEf:password::. Secret names can't contain colons so we remove
+	// these
+	name, _, _ := strings.Cut(query, ":")
+
+	out, err := client.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: &name,
+	})
+
+	var notFound *types.ResourceNotFoundException
+	if errors.As(err, &notFound) && secretARNSuffix.MatchString(name) {
+		// If the query came from an ARN then it will have the random suffix,
+		// which isn't part of the name. In this case we need to use the full
+		// ARN to find the secret
+		accountID, region, scopeErr := adapterhelpers.ParseScope(scope)
+		if scopeErr != nil {
+			return nil, err
+		}
+
+		secretARN := fmt.Sprintf("arn:aws:secretsmanager:%v:%v:secret:%v", region, accountID, name)
+		out, err = client.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+			SecretId: &secretARN,
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Converts a secret from the list output to the same format as the describe
+// output so that they can be mapped in the same way
+func secretFromListEntry(entry types.SecretListEntry) *secretsmanager.DescribeSecretOutput {
+	return &secretsmanager.DescribeSecretOutput{
+		ARN:                entry.ARN,
+		CreatedDate:        entry.CreatedDate,
+		DeletedDate:        entry.DeletedDate,
+		Description:        entry.Description,
+		KmsKeyId:           entry.KmsKeyId,
+		LastAccessedDate:   entry.LastAccessedDate,
+		LastChangedDate:    entry.LastChangedDate,
+		LastRotatedDate:    entry.LastRotatedDate,
+		Name:               entry.Name,
+		NextRotationDate:   entry.NextRotationDate,
+		OwningService:      entry.OwningService,
+		PrimaryRegion:      entry.PrimaryRegion,
+		RotationEnabled:    entry.RotationEnabled,
+		RotationLambdaARN:  entry.RotationLambdaARN,
+		RotationRules:      entry.RotationRules,
+		Tags:               entry.Tags,
+		VersionIdsToStages: entry.SecretVersionsToStages,
+	}
+}
+
+// Converts a slice of tags to a map
+func secretsManagerTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+func secretItemMapper(_ *string, scope string, awsItem *secretsmanager.DescribeSecretOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "secretsmanager-secret",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            secretsManagerTagsToMap(awsItem.Tags),
+	}
+
+	if awsItem.DeletedDate != nil {
+		// The secret is scheduled for deletion
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	if awsItem.KmsKeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.KmsKeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.RotationLambdaARN != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.RotationLambdaARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.RotationLambdaARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The rotation function changes the secret
+					In: true,
+					// Changing the secret won't affect the function
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.Name != nil {
+		accountID, region, err := adapterhelpers.ParseScope(scope)
+
+		if err == nil {
+			// Replicas have the same name in each region that they are
+			// replicated to
+			for _, replica := range awsItem.ReplicationStatus {
+				if replica.Region != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "secretsmanager-secret",
+							Method: sdp.QueryMethod_GET,
+							Query:  *awsItem.Name,
+							Scope:  adapterhelpers.FormatScope(accountID, *replica.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changes to the primary are replicated
+							In:  false,
+							Out: true,
+						},
+					})
+				}
+			}
+
+			if awsItem.PrimaryRegion != nil && *awsItem.PrimaryRegion != region {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "secretsmanager-secret",
+						Method: sdp.QueryMethod_GET,
+						Query:  *awsItem.Name,
+						Scope:  adapterhelpers.FormatScope(accountID, *awsItem.PrimaryRegion),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// This is a replica so changes to the primary will
+						// affect it
+						In:  true,
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSecretsManagerSecretAdapter(client secretsManagerClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*secretsmanager.ListSecretsInput, *secretsmanager.ListSecretsOutput, *secretsmanager.DescribeSecretOutput, secretsManagerClient, *secretsmanager.Options] {
+	return &adapterhelpers.GetListAdapterV2[*secretsmanager.ListSecretsInput, *secretsmanager.ListSecretsOutput, *secretsmanager.DescribeSecretOutput, secretsManagerClient, *secretsmanager.Options]{
+		ItemType:        "secretsmanager-secret",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: secretsManagerSecretAdapterMetadata,
+		GetFunc:         secretGetFunc,
+		InputMapperList: func(scope string) (*secretsmanager.ListSecretsInput, error) {
+			return &secretsmanager.ListSecretsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client secretsManagerClient, params *secretsmanager.ListSecretsInput) adapterhelpers.Paginator[*secretsmanager.ListSecretsOutput, *secretsmanager.Options] {
+			return secretsmanager.NewListSecretsPaginator(client, params)
+		},
+		ListExtractor: func(_ context.Context, output *secretsmanager.ListSecretsOutput, _ secretsManagerClient) ([]*secretsmanager.DescribeSecretOutput, error) {
+			secrets := make([]*secretsmanager.DescribeSecretOutput, 0, len(output.SecretList))
+			for _, entry := range output.SecretList {
+				secrets = append(secrets, secretFromListEntry(entry))
+			}
+			return secrets, nil
+		},
+		ItemMapper: secretItemMapper,
+	}
+}
+
+var secretsManagerSecretAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "secretsmanager-secret",
+	DescriptiveName: "Secrets Manager Secret",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a secret by name",
+		ListDescription:   "List all secrets",
+		SearchDescription: "Search for a secret by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_secretsmanager_secret.arn",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_secretsmanager_secret_version.arn",
+		},
+	},
+	PotentialLinks: []string{"kms-key", "lambda-function", "secretsmanager-secret"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(secretsManagerSecretAdapterMetadata, sdp.AttributeSchemaFor(secretsmanager.DescribeSecretOutput{}, "tags"))
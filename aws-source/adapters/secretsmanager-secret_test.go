@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testSecretsManagerClient struct {
+	// The secret IDs that DescribeSecret was called with
+	describedIDs []string
+}
+
+func (t *testSecretsManagerClient) DescribeSecret(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error) {
+	t.describedIDs = append(t.describedIDs, *params.SecretId)
+
+	switch *params.SecretId {
+	case "prod/db", "arn:aws:secretsmanager:eu-west-2:123456789012:secret:prod/db-AbCdEf":
+		return &secretsmanager.DescribeSecretOutput{
+			ARN:               aws.String("arn:aws:secretsmanager:eu-west-2:123456789012:secret:prod/db-AbCdEf"),
+			Name:              aws.String("prod/db"),
+			Description:       aws.String("Database credentials"),
+			KmsKeyId:          aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			RotationEnabled:   aws.Bool(true),
+			RotationLambdaARN: aws.String("arn:aws:lambda:eu-west-2:123456789012:function:rotate-db"),
+			RotationRules: &types.RotationRulesType{
+				AutomaticallyAfterDays: aws.Int64(30),
+			},
+			CreatedDate:     aws.Time(time.Now()),
+			LastChangedDate: aws.Time(time.Now()),
+			ReplicationStatus: []types.ReplicationStatusType{
+				{
+					Region:   aws.String("us-east-1"),
+					KmsKeyId: aws.String("alias/aws/secretsmanager"),
+					Status:   types.StatusTypeInSync,
+				},
+			},
+			Tags: []types.Tag{
+				{
+					Key:   aws.String("env"),
+					Value: aws.String("prod"),
+				},
+			},
+			VersionIdsToStages: map[string][]string{
+				"a1b2c3": {"AWSCURRENT"},
+			},
+		}, nil
+	}
+
+	return nil, &types.ResourceNotFoundException{
+		Message: aws.String("Secrets Manager can't find the specified secret."),
+	}
+}
+
+func (t *testSecretsManagerClient) ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	return &secretsmanager.ListSecretsOutput{
+		SecretList: []types.SecretListEntry{
+			{
+				ARN:      aws.String("arn:aws:secretsmanager:eu-west-2:123456789012:secret:prod/db-AbCdEf"),
+				Name:     aws.String("prod/db"),
+				KmsKeyId: aws.String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+				SecretVersionsToStages: map[string][]string{
+					"a1b2c3": {"AWSCURRENT"},
+				},
+			},
+			{
+				ARN:           aws.String("arn:aws:secretsmanager:eu-west-2:123456789012:secret:replicated-XyZ123"),
+				Name:          aws.String("replicated"),
+				PrimaryRegion: aws.String("us-east-1"),
+				DeletedDate:   aws.Time(time.Now()),
+			},
+		},
+	}, nil
+}
+
+func TestSecretGetFunc(t *testing.T) {
+	t.Run("by name", func(t *testing.T) {
+		client := &testSecretsManagerClient{}
+
+		secret, err := secretGetFunc(context.Background(), client, "123456789012.eu-west-2", "prod/db")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *secret.Name != "prod/db" {
+			t.Errorf("expected name prod/db, got %v", *secret.Name)
+		}
+		if len(client.describedIDs) != 1 {
+			t.Errorf("expected 1 call, got %v", client.describedIDs)
+		}
+	})
+
+	t.Run("by the resource ID of an ARN with a JSON key", func(t *testing.T) {
+		client := &testSecretsManagerClient{}
+
+		secret, err := secretGetFunc(context.Background(), client, "123456789012.eu-west-2", "prod/db-AbCdEf:password::")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *secret.Name != "prod/db" {
+			t.Errorf("expected name prod/db, got %v", *secret.Name)
+		}
+
+		expected := []string{"prod/db-AbCdEf", "arn:aws:secretsmanager:eu-west-2:123456789012:secret:prod/db-AbCdEf"}
+		if len(client.describedIDs) != 2 || client.describedIDs[0] != expected[0] || client.describedIDs[1] != expected[1] {
+			t.Errorf("expected calls with %v, got %v", expected, client.describedIDs)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := secretGetFunc(context.Background(), &testSecretsManagerClient{}, "123456789012.eu-west-2", "missing")
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestSecretItemMapper(t *testing.T) {
+	secret, err := secretGetFunc(context.Background(), &testSecretsManagerClient{}, "123456789012.eu-west-2", "prod/db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := secretItemMapper(nil, "123456789012.eu-west-2", secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["env"] != "prod" {
+		t.Errorf("expected env tag, got %v", item.GetTags())
+	}
+
+	if _, err := item.GetAttributes().Get("SecretString"); err == nil {
+		t.Error("secret values must never be included")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:rotate-db",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "prod/db",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSecretListFunc(t *testing.T) {
+	adapter := NewSecretsManagerSecretAdapter(&testSecretsManagerClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		validateAttributeSchema(t, item)
+	}
+
+	// The replica links back to its primary, and is scheduled for deletion
+	replica := items[1]
+	if replica.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected a secret scheduled for deletion to have a warning, got %v", replica.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "replicated",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, replica)
+}
+
+func TestNewSecretsManagerSecretAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := secretsmanager.NewFromConfig(config)
+
+	adapter := NewSecretsManagerSecretAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
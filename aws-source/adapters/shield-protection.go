@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type shieldClient interface {
+	DescribeProtection(ctx context.Context, params *shield.DescribeProtectionInput, optFns ...func(*shield.Options)) (*shield.DescribeProtectionOutput, error)
+	ListTagsForResource(ctx context.Context, params *shield.ListTagsForResourceInput, optFns ...func(*shield.Options)) (*shield.ListTagsForResourceOutput, error)
+
+	shield.ListProtectionsAPIClient
+}
+
+func shieldProtectionGetFunc(ctx context.Context, client shieldClient, scope string, query string) (*types.Protection, error) {
+	out, err := client.DescribeProtection(ctx, &shield.DescribeProtectionInput{
+		ProtectionId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Protection, nil
+}
+
+func shieldProtectionListFunc(ctx context.Context, client shieldClient, scope string) ([]*types.Protection, error) {
+	protections := make([]*types.Protection, 0)
+	paginator := shield.NewListProtectionsPaginator(client, &shield.ListProtectionsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			var notFound *types.ResourceNotFoundException
+			if errors.As(err, &notFound) {
+				// This is returned when the account doesn't have Shield
+				// Advanced, and therefore no protections
+				return protections, nil
+			}
+
+			return nil, err
+		}
+
+		for i := range out.Protections {
+			protections = append(protections, &out.Protections[i])
+		}
+	}
+
+	return protections, nil
+}
+
+// Links a protection to the resource that it protects
+func shieldProtectedResourceLink(resourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(resourceARN)
+	if err != nil {
+		return nil
+	}
+
+	var query *sdp.Query
+	switch a.Service {
+	case "cloudfront":
+		query = &sdp.Query{
+			Type:   "cloudfront-distribution",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.ResourceID(),
+			Scope:  adapterhelpers.FormatScope(a.AccountID, ""),
+		}
+	case "elasticloadbalancing":
+		if strings.HasPrefix(a.Resource, "loadbalancer/app/") || strings.HasPrefix(a.Resource, "loadbalancer/net/") {
+			query = &sdp.Query{
+				Type:   "elbv2-load-balancer",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  resourceARN,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			}
+		} else {
+			// Classic load balancers are in the format loadbalancer/{name}
+			query = &sdp.Query{
+				Type:   "elb-load-balancer",
+				Method: sdp.QueryMethod_GET,
+				Query:  a.ResourceID(),
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			}
+		}
+	case "ec2":
+		if a.Type() != "eip-allocation" {
+			return nil
+		}
+
+		query = &sdp.Query{
+			Type:   "ec2-address",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.ResourceID(),
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		}
+	case "globalaccelerator":
+		query = &sdp.Query{
+			Type:   "globalaccelerator-accelerator",
+			Method: sdp.QueryMethod_GET,
+			Query:  resourceARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, ""),
+		}
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the resource leaves the protection with nothing to
+			// protect
+			In: true,
+			// Removing the protection leaves the resource exposed to attacks
+			Out: true,
+		},
+	}
+}
+
+func shieldProtectionItemMapper(_, scope string, awsItem *types.Protection) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "shield-protection",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.ResourceArn != nil {
+		if link := shieldProtectedResourceLink(*awsItem.ResourceArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func shieldProtectionListTagsFunc(ctx context.Context, protection *types.Protection, client shieldClient) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &shield.ListTagsForResourceInput{
+		ResourceARN: protection.ProtectionArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewShieldProtectionAdapter(client shieldClient, accountID string) *adapterhelpers.GetListAdapter[*types.Protection, shieldClient, *shield.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Protection, shieldClient, *shield.Options]{
+		ItemType:        "shield-protection",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Shield protections aren't tied to a region
+		AdapterMetadata: shieldProtectionAdapterMetadata,
+		GetFunc:         shieldProtectionGetFunc,
+		ListFunc:        shieldProtectionListFunc,
+		ItemMapper:      shieldProtectionItemMapper,
+		ListTagsFunc:    shieldProtectionListTagsFunc,
+	}
+}
+
+var shieldProtectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "shield-protection",
+	DescriptiveName: "Shield Protection",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Shield Advanced protection by ID",
+		ListDescription:   "List all Shield Advanced protections",
+		SearchDescription: "Search for a Shield Advanced protection by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_shield_protection.id",
+		},
+	},
+	PotentialLinks: []string{"cloudfront-distribution", "elbv2-load-balancer", "elb-load-balancer", "ec2-address", "globalaccelerator-accelerator"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(shieldProtectionAdapterMetadata, sdp.AttributeSchemaFor(types.Protection{}))
@@ -0,0 +1,219 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type testShieldClient struct {
+	// Whether the account has Shield Advanced
+	subscribed bool
+}
+
+func (t testShieldClient) DescribeProtection(ctx context.Context, params *shield.DescribeProtectionInput, optFns ...func(*shield.Options)) (*shield.DescribeProtectionOutput, error) {
+	return &shield.DescribeProtectionOutput{
+		Protection: &types.Protection{
+			Id:            params.ProtectionId,
+			Name:          aws.String("api"),
+			ProtectionArn: aws.String("arn:aws:shield::123456789012:protection/" + *params.ProtectionId),
+			ResourceArn:   aws.String("arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/api/50dc6c495c0c9188"),
+		},
+	}, nil
+}
+
+func (t testShieldClient) ListTagsForResource(ctx context.Context, params *shield.ListTagsForResourceInput, optFns ...func(*shield.Options)) (*shield.ListTagsForResourceOutput, error) {
+	return &shield.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("env"), Value: aws.String("prod")},
+		},
+	}, nil
+}
+
+func (t testShieldClient) ListProtections(ctx context.Context, params *shield.ListProtectionsInput, optFns ...func(*shield.Options)) (*shield.ListProtectionsOutput, error) {
+	if !t.subscribed {
+		return nil, &types.ResourceNotFoundException{
+			Message: aws.String("The subscription does not exist."),
+		}
+	}
+
+	return &shield.ListProtectionsOutput{
+		Protections: []types.Protection{
+			{
+				Id:            aws.String("a1b2c3d4"),
+				Name:          aws.String("cdn"),
+				ProtectionArn: aws.String("arn:aws:shield::123456789012:protection/a1b2c3d4"),
+				ResourceArn:   aws.String("arn:aws:cloudfront::123456789012:distribution/E1A2B3C4D5E6F7"),
+			},
+			{
+				Id:            aws.String("e5f6a7b8"),
+				Name:          aws.String("eip"),
+				ProtectionArn: aws.String("arn:aws:shield::123456789012:protection/e5f6a7b8"),
+				ResourceArn:   aws.String("arn:aws:ec2:eu-west-2:123456789012:eip-allocation/eipalloc-0123456789abcdef0"),
+			},
+		},
+	}, nil
+}
+
+func TestShieldProtectedResourceLink(t *testing.T) {
+	tests := []struct {
+		arn      string
+		expected *sdp.Query
+	}{
+		{
+			arn: "arn:aws:cloudfront::123456789012:distribution/E1A2B3C4D5E6F7",
+			expected: &sdp.Query{
+				Type:   "cloudfront-distribution",
+				Method: sdp.QueryMethod_GET,
+				Query:  "E1A2B3C4D5E6F7",
+				Scope:  "123456789012",
+			},
+		},
+		{
+			arn: "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/nlb/50dc6c495c0c9188",
+			expected: &sdp.Query{
+				Type:   "elbv2-load-balancer",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/nlb/50dc6c495c0c9188",
+				Scope:  "123456789012.eu-west-2",
+			},
+		},
+		{
+			arn: "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/classic",
+			expected: &sdp.Query{
+				Type:   "elb-load-balancer",
+				Method: sdp.QueryMethod_GET,
+				Query:  "classic",
+				Scope:  "123456789012.eu-west-2",
+			},
+		},
+		{
+			arn: "arn:aws:ec2:eu-west-2:123456789012:eip-allocation/eipalloc-0123456789abcdef0",
+			expected: &sdp.Query{
+				Type:   "ec2-address",
+				Method: sdp.QueryMethod_GET,
+				Query:  "eipalloc-0123456789abcdef0",
+				Scope:  "123456789012.eu-west-2",
+			},
+		},
+		{
+			arn: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+			expected: &sdp.Query{
+				Type:   "globalaccelerator-accelerator",
+				Method: sdp.QueryMethod_GET,
+				Query:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+				Scope:  "123456789012",
+			},
+		},
+		{
+			arn:      "arn:aws:route53:::hostedzone/Z1D633PJN98FT9",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arn, func(t *testing.T) {
+			link := shieldProtectedResourceLink(tt.arn)
+
+			if tt.expected == nil {
+				if link != nil {
+					t.Errorf("expected no link, got %v", link)
+				}
+				return
+			}
+
+			if link == nil {
+				t.Fatal("expected a link")
+			}
+
+			query := link.GetQuery()
+			if query.GetType() != tt.expected.GetType() || query.GetMethod() != tt.expected.GetMethod() || query.GetQuery() != tt.expected.GetQuery() || query.GetScope() != tt.expected.GetScope() {
+				t.Errorf("expected %v, got %v", tt.expected, query)
+			}
+		})
+	}
+}
+
+func TestShieldProtectionItemMapper(t *testing.T) {
+	protection, err := shieldProtectionGetFunc(context.Background(), testShieldClient{}, "123456789012", "a1b2c3d4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := shieldProtectionItemMapper("", "123456789012", protection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/api/50dc6c495c0c9188",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestShieldProtectionList(t *testing.T) {
+	t.Run("with Shield Advanced", func(t *testing.T) {
+		adapter := NewShieldProtectionAdapter(testShieldClient{subscribed: true}, "123456789012")
+
+		items, err := adapter.List(context.Background(), "123456789012", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 2 {
+			t.Fatalf("expected 2 items, got %v", len(items))
+		}
+
+		if items[0].GetTags()["env"] != "prod" {
+			t.Errorf("expected env tag, got %v", items[0].GetTags())
+		}
+	})
+
+	t.Run("without Shield Advanced", func(t *testing.T) {
+		adapter := NewShieldProtectionAdapter(testShieldClient{}, "123456789012")
+
+		items, err := adapter.List(context.Background(), "123456789012", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 0 {
+			t.Errorf("expected no items, got %v", len(items))
+		}
+	})
+}
+
+func TestNewShieldProtectionAdapter(t *testing.T) {
+	config, account, _ := adapterhelpers.GetAutoConfig(t)
+	client := shield.NewFromConfig(config, func(o *shield.Options) {
+		o.Region = "us-east-1"
+	})
+
+	adapter := NewShieldProtectionAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -95,3 +95,5 @@ var dataProtectionPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	PotentialLinks: []string{"sns-topic"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(dataProtectionPolicyAdapterMetadata, sdp.AttributeSchemaFor(map[string]any{}))
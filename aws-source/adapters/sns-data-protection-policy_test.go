@@ -31,6 +31,8 @@ func TestGetDataProtectionPolicyFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewSNSDataProtectionPolicyAdapter(t *testing.T) {
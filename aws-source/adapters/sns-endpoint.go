@@ -97,3 +97,5 @@ var snsEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(snsEndpointAdapterMetadata, sdp.AttributeSchemaFor(map[string]string{}))
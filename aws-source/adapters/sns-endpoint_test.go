@@ -53,6 +53,8 @@ func TestGetEndpointFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewSNSEndpointAdapter(t *testing.T) {
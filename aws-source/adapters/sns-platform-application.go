@@ -113,3 +113,5 @@ var platformApplicationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"sns-endpoint"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(platformApplicationAdapterMetadata, sdp.AttributeSchemaFor(map[string]string{}))
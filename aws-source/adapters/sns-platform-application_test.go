@@ -65,6 +65,8 @@ func TestGetPlatformApplicationFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewSNSPlatformApplicationAdapter(t *testing.T) {
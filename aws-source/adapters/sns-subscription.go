@@ -130,3 +130,5 @@ var snsSubscriptionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"sns-topic", "iam-role"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(snsSubscriptionAdapterMetadata, sdp.AttributeSchemaFor(map[string]string{}))
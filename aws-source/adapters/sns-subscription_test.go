@@ -62,6 +62,8 @@ func TestSNSGetFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewSNSSubscriptionAdapter(t *testing.T) {
@@ -62,6 +62,11 @@ func getTopicFunc(ctx context.Context, client topicClient, scope string, input *
 		})
 	}
 
+	// Link to the users and roles that the topic policy allows to use it
+	if topicPolicy, exists := output.Attributes["Policy"]; exists {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, linksFromResourcePolicy(topicPolicy, *input.TopicArn)...)
+	}
+
 	return item, nil
 }
 
@@ -108,6 +113,8 @@ var snsTopicAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_sns_topic.id"},
 	},
-	PotentialLinks: []string{"kms-key"},
+	PotentialLinks: []string{"kms-key", "iam-role", "iam-user"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var _ = Metadata.RegisterSchema(snsTopicAdapterMetadata, sdp.AttributeSchemaFor(map[string]string{}))
@@ -59,6 +59,8 @@ func TestGetTopicFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Fatal(err)
 	}
+
+	validateAttributeSchema(t, item)
 }
 
 func TestNewSNSTopicAdapter(t *testing.T) {
@@ -78,6 +78,11 @@ func getFunc(ctx context.Context, client sqsClient, scope string, input *sqs.Get
 				Out: true,
 			},
 		})
+
+		// Link to the users and roles that the queue policy allows to use it
+		if queuePolicy, exists := output.Attributes["Policy"]; exists {
+			linkedItemQueries = append(linkedItemQueries, linksFromResourcePolicy(queuePolicy, arn)...)
+		}
 	}
 
 	return &sdp.Item{
@@ -139,6 +144,10 @@ var sqsQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{
 		"http",
 		"lambda-event-source-mapping",
+		"iam-role",
+		"iam-user",
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var _ = Metadata.RegisterSchema(sqsQueueAdapterMetadata, sdp.AttributeSchemaFor(map[string]string{}))
@@ -27,6 +27,7 @@ func (t testClient) GetQueueAttributes(ctx context.Context, params *sqs.GetQueue
 			"ReceiveMessageWaitTimeSeconds":         "0",
 			"VisibilityTimeout":                     "30",
 			"RedrivePolicy":                         "{\"deadLetterTargetArn\":\"arn:aws:sqs:us-east-1:80398EXAMPLE:MyDeadLetterQueue\",\"maxReceiveCount\":1000}",
+			"Policy":                                "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"arn:aws:iam::123456789012:role/producer\"},\"Action\":\"sqs:SendMessage\",\"Resource\":\"arn:aws:sqs:us-west-2:123456789012:MyQueue\"}]}",
 		},
 	}, nil
 }
@@ -64,9 +65,11 @@ func TestGetFunc(t *testing.T) {
 		t.Error(err)
 	}
 
+	validateAttributeSchema(t, item)
+
 	// Test linked item queries
-	if len(item.GetLinkedItemQueries()) != 2 {
-		t.Errorf("Expected 2 linked item queries, got %d", len(item.GetLinkedItemQueries()))
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("Expected 3 linked item queries, got %d", len(item.GetLinkedItemQueries()))
 	}
 
 	// Test HTTP link
@@ -103,6 +106,18 @@ func TestGetFunc(t *testing.T) {
 	if lambdaLink.GetBlastPropagation().GetOut() != true {
 		t.Errorf("Expected Lambda link blast propagation Out to be true, got %v", lambdaLink.GetBlastPropagation().GetOut())
 	}
+
+	// Test the link to the role allowed by the queue policy
+	roleLink := item.GetLinkedItemQueries()[2]
+	if roleLink.GetQuery().GetType() != "iam-role" {
+		t.Errorf("Expected third link type to be 'iam-role', got %s", roleLink.GetQuery().GetType())
+	}
+	if roleLink.GetQuery().GetQuery() != "arn:aws:iam::123456789012:role/producer" {
+		t.Errorf("Expected role link query to be the role ARN, got %s", roleLink.GetQuery().GetQuery())
+	}
+	if roleLink.GetBlastPropagation().GetIn() != true || roleLink.GetBlastPropagation().GetOut() != false {
+		t.Errorf("Expected role link blast propagation to be incoming only, got %v", roleLink.GetBlastPropagation())
+	}
 }
 
 func TestNewQueueAdapter(t *testing.T) {
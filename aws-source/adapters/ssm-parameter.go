@@ -282,3 +282,5 @@ var ssmParameterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"dns",
 	},
 })
+
+var _ = Metadata.RegisterSchema(ssmParameterAdapterMetadata, sdp.AttributeSchemaFor(&types.ParameterMetadata{}).WithProperty("Value", stringAttribute))
@@ -0,0 +1,245 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// ssoAdminAccountAssignment gives a user or group access to an account with a
+// permission set
+type ssoAdminAccountAssignment struct {
+	// The principal, target and permission set separated by commas. This is
+	// the same as the ID of the Terraform resource:
+	// `{principal-id},{principal-type},{account-id},AWS_ACCOUNT,{permission-set-arn},{instance-arn}`
+	UniqueName        string
+	InstanceArn       string
+	PermissionSetName *string
+	types.AccountAssignment
+}
+
+func newSSOAdminAccountAssignment(instanceARN string, permissionSetName *string, assignment types.AccountAssignment) *ssoAdminAccountAssignment {
+	return &ssoAdminAccountAssignment{
+		UniqueName: strings.Join([]string{
+			*assignment.PrincipalId,
+			string(assignment.PrincipalType),
+			*assignment.AccountId,
+			"AWS_ACCOUNT",
+			*assignment.PermissionSetArn,
+			instanceARN,
+		}, ","),
+		InstanceArn:       instanceARN,
+		PermissionSetName: permissionSetName,
+		AccountAssignment: assignment,
+	}
+}
+
+// Lists the assignments of a permission set in an account
+func listSSOAdminAccountAssignments(ctx context.Context, client ssoAdminClient, instanceARN string, permissionSet *types.PermissionSet, accountID string) ([]*ssoAdminAccountAssignment, error) {
+	assignments := make([]*ssoAdminAccountAssignment, 0)
+	paginator := ssoadmin.NewListAccountAssignmentsPaginator(client, &ssoadmin.ListAccountAssignmentsInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: permissionSet.PermissionSetArn,
+		AccountId:        &accountID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assignment := range out.AccountAssignments {
+			assignments = append(assignments, newSSOAdminAccountAssignment(instanceARN, permissionSet.Name, assignment))
+		}
+	}
+
+	return assignments, nil
+}
+
+func ssoAdminAccountAssignmentGetFunc(ctx context.Context, client ssoAdminClient, _, query string) (*ssoAdminAccountAssignment, error) {
+	sections := strings.Split(query, ",")
+	if len(sections) != 6 || sections[3] != "AWS_ACCOUNT" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format principal-id,principal-type,account-id,AWS_ACCOUNT,permission-set-arn,instance-arn, got %v", query),
+		}
+	}
+	principalID, principalType, accountID, permissionSetARN, instanceARN := sections[0], sections[1], sections[2], sections[4], sections[5]
+
+	out, err := client.DescribePermissionSet(ctx, &ssoadmin.DescribePermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := listSSOAdminAccountAssignments(ctx, client, instanceARN, out.PermissionSet, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range assignments {
+		if *assignment.PrincipalId == principalID && string(assignment.PrincipalType) == principalType {
+			return assignment, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("account assignment %v not found", query),
+	}
+}
+
+// Gets the assignments of a permission set in every account it has been
+// provisioned to
+func ssoAdminAssignmentsOfPermissionSet(ctx context.Context, client ssoAdminClient, instanceARN string, permissionSetARN string) ([]*ssoAdminAccountAssignment, error) {
+	out, err := client.DescribePermissionSet(ctx, &ssoadmin.DescribePermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs, err := listSSOAdminProvisionedAccounts(ctx, client, instanceARN, permissionSetARN)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*ssoAdminAccountAssignment, 0)
+	for _, accountID := range accountIDs {
+		inAccount, err := listSSOAdminAccountAssignments(ctx, client, instanceARN, out.PermissionSet, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, inAccount...)
+	}
+
+	return assignments, nil
+}
+
+func ssoAdminAccountAssignmentListFunc(ctx context.Context, client ssoAdminClient, _ string) ([]*ssoAdminAccountAssignment, error) {
+	instanceARNs, err := listSSOAdminInstanceARNs(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*ssoAdminAccountAssignment, 0)
+	for _, instanceARN := range instanceARNs {
+		permissionSetARNs, err := listSSOAdminPermissionSetARNs(ctx, client, instanceARN)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, permissionSetARN := range permissionSetARNs {
+			ofPermissionSet, err := ssoAdminAssignmentsOfPermissionSet(ctx, client, instanceARN, permissionSetARN)
+			if err != nil {
+				var notFound *types.ResourceNotFoundException
+				if errors.As(err, &notFound) {
+					// Deleted since it was listed
+					continue
+				}
+
+				return nil, err
+			}
+
+			assignments = append(assignments, ofPermissionSet...)
+		}
+	}
+
+	return assignments, nil
+}
+
+// Searches for the assignments of a permission set by the ARN of the
+// permission set
+func ssoAdminAccountAssignmentSearchFunc(ctx context.Context, client ssoAdminClient, _, query string) ([]*ssoAdminAccountAssignment, error) {
+	instanceARN, err := ssoAdminInstanceARN(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssoAdminAssignmentsOfPermissionSet(ctx, client, instanceARN, query)
+}
+
+func ssoAdminAccountAssignmentOutputMapper(_, scope string, awsItem *ssoAdminAccountAssignment) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssoadmin-account-assignment",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.PermissionSetArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ssoadmin-permission-set",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.PermissionSetArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the permission set changes what the principal can
+				// do
+				In: true,
+				// The assignment can't affect the permission set
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.PermissionSetName != nil && awsItem.AccountId != nil {
+		// The principal signs in to the account with this role
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssoAdminRoleLink(*awsItem.PermissionSetName, *awsItem.AccountId))
+	}
+
+	return &item, nil
+}
+
+func NewSSOAdminAccountAssignmentAdapter(client ssoAdminClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ssoAdminAccountAssignment, ssoAdminClient, *ssoadmin.Options] {
+	return &adapterhelpers.GetListAdapter[*ssoAdminAccountAssignment, ssoAdminClient, *ssoadmin.Options]{
+		ItemType:        "ssoadmin-account-assignment",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssoAdminAccountAssignmentAdapterMetadata,
+		GetFunc:         ssoAdminAccountAssignmentGetFunc,
+		ListFunc:        ssoAdminAccountAssignmentListFunc,
+		SearchFunc:      ssoAdminAccountAssignmentSearchFunc,
+		ItemMapper:      ssoAdminAccountAssignmentOutputMapper,
+	}
+}
+
+var ssoAdminAccountAssignmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssoadmin-account-assignment",
+	DescriptiveName: "IAM Identity Center Account Assignment",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an account assignment by its Terraform ID: principal-id,principal-type,account-id,AWS_ACCOUNT,permission-set-arn,instance-arn",
+		List:              true,
+		ListDescription:   "List all account assignments",
+		Search:            true,
+		SearchDescription: "Search for the account assignments of a permission set by permission set ARN",
+	},
+	PotentialLinks: []string{"ssoadmin-permission-set", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssoadmin_account_assignment.id"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(ssoAdminAccountAssignmentAdapterMetadata, sdp.AttributeSchemaFor(&ssoAdminAccountAssignment{}))
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const testSSOAdminAccountAssignmentID = "a1b2c3d4-0000-0000-0000-000000000002,USER,111111111111,AWS_ACCOUNT," + testSSOAdminPermissionSetARN + "," + testSSOAdminInstanceARN
+
+func TestSSOAdminAccountAssignmentGet(t *testing.T) {
+	adapter := NewSSOAdminAccountAssignmentAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testSSOAdminAccountAssignmentID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != testSSOAdminAccountAssignmentID {
+		t.Errorf("expected %v, got %v", testSSOAdminAccountAssignmentID, item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssoadmin-permission-set",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  testSSOAdminPermissionSetARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "Developer",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSSOAdminAccountAssignmentGetNotFound(t *testing.T) {
+	adapter := NewSSOAdminAccountAssignmentAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	for _, query := range []string{
+		// A principal that isn't assigned
+		"a1b2c3d4-0000-0000-0000-000000000003,USER,111111111111,AWS_ACCOUNT," + testSSOAdminPermissionSetARN + "," + testSSOAdminInstanceARN,
+		// Not in the Terraform ID format
+		testSSOAdminPermissionSetARN,
+	} {
+		_, err := adapter.Get(context.Background(), "123456789012.eu-west-2", query, false)
+		if err == nil {
+			t.Errorf("expected an error for %v", query)
+		}
+	}
+}
+
+func TestSSOAdminAccountAssignmentSearch(t *testing.T) {
+	adapter := NewSSOAdminAccountAssignmentAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testSSOAdminPermissionSetARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 account assignments, got %v", len(items))
+	}
+}
+
+func TestSSOAdminAccountAssignmentList(t *testing.T) {
+	adapter := NewSSOAdminAccountAssignmentAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The permission set that was deleted after being listed is skipped
+	if len(items) != 2 {
+		t.Fatalf("expected 2 account assignments, got %v", len(items))
+	}
+}
+
+func TestNewSSOAdminAccountAssignmentAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := ssoadmin.NewFromConfig(config)
+
+	adapter := NewSSOAdminAccountAssignmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
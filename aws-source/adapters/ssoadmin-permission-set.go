@@ -0,0 +1,346 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// ssoAdminPermissionSet is a permission set along with the policies that
+// define its permissions and the accounts it has been provisioned to, all of
+// which are returned by separate APIs
+type ssoAdminPermissionSet struct {
+	types.PermissionSet
+	InstanceArn                     string
+	ManagedPolicies                 []types.AttachedManagedPolicy
+	CustomerManagedPolicyReferences []types.CustomerManagedPolicyReference
+	PermissionsBoundary             *types.PermissionsBoundary
+	InlinePolicy                    *string
+	ProvisionedAccounts             []string
+}
+
+func getSSOAdminPermissionSet(ctx context.Context, client ssoAdminClient, instanceARN string, permissionSetARN string) (*ssoAdminPermissionSet, error) {
+	out, err := client.DescribePermissionSet(ctx, &ssoadmin.DescribePermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	permissionSet := &ssoAdminPermissionSet{
+		PermissionSet:                   *out.PermissionSet,
+		InstanceArn:                     instanceARN,
+		ManagedPolicies:                 make([]types.AttachedManagedPolicy, 0),
+		CustomerManagedPolicyReferences: make([]types.CustomerManagedPolicyReference, 0),
+	}
+
+	managedPolicies := ssoadmin.NewListManagedPoliciesInPermissionSetPaginator(client, &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	for managedPolicies.HasMorePages() {
+		page, err := managedPolicies.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		permissionSet.ManagedPolicies = append(permissionSet.ManagedPolicies, page.AttachedManagedPolicies...)
+	}
+
+	customerManagedPolicies := ssoadmin.NewListCustomerManagedPolicyReferencesInPermissionSetPaginator(client, &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	for customerManagedPolicies.HasMorePages() {
+		page, err := customerManagedPolicies.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		permissionSet.CustomerManagedPolicyReferences = append(permissionSet.CustomerManagedPolicyReferences, page.CustomerManagedPolicyReferences...)
+	}
+
+	boundary, err := client.GetPermissionsBoundaryForPermissionSet(ctx, &ssoadmin.GetPermissionsBoundaryForPermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+		// The permission set doesn't have a boundary
+	} else {
+		permissionSet.PermissionsBoundary = boundary.PermissionsBoundary
+	}
+
+	inlinePolicy, err := client.GetInlinePolicyForPermissionSet(ctx, &ssoadmin.GetInlinePolicyForPermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if inlinePolicy.InlinePolicy != nil && *inlinePolicy.InlinePolicy != "" {
+		permissionSet.InlinePolicy = inlinePolicy.InlinePolicy
+	}
+
+	permissionSet.ProvisionedAccounts, err = listSSOAdminProvisionedAccounts(ctx, client, instanceARN, permissionSetARN)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissionSet, nil
+}
+
+func ssoAdminPermissionSetGetFunc(ctx context.Context, client ssoAdminClient, _, query string) (*ssoAdminPermissionSet, error) {
+	instanceARN, err := ssoAdminInstanceARN(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getSSOAdminPermissionSet(ctx, client, instanceARN, query)
+}
+
+// Gets all the permission sets in an instance
+func ssoAdminPermissionSetsInInstance(ctx context.Context, client ssoAdminClient, instanceARN string) ([]*ssoAdminPermissionSet, error) {
+	permissionSetARNs, err := listSSOAdminPermissionSetARNs(ctx, client, instanceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionSets := make([]*ssoAdminPermissionSet, 0, len(permissionSetARNs))
+	for _, permissionSetARN := range permissionSetARNs {
+		permissionSet, err := getSSOAdminPermissionSet(ctx, client, instanceARN, permissionSetARN)
+		if err != nil {
+			var notFound *types.ResourceNotFoundException
+			if errors.As(err, &notFound) {
+				// Deleted since it was listed
+				continue
+			}
+
+			return nil, err
+		}
+
+		permissionSets = append(permissionSets, permissionSet)
+	}
+
+	return permissionSets, nil
+}
+
+func ssoAdminPermissionSetListFunc(ctx context.Context, client ssoAdminClient, _ string) ([]*ssoAdminPermissionSet, error) {
+	instanceARNs, err := listSSOAdminInstanceARNs(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionSets := make([]*ssoAdminPermissionSet, 0)
+	for _, instanceARN := range instanceARNs {
+		inInstance, err := ssoAdminPermissionSetsInInstance(ctx, client, instanceARN)
+		if err != nil {
+			return nil, err
+		}
+
+		permissionSets = append(permissionSets, inInstance...)
+	}
+
+	return permissionSets, nil
+}
+
+// Searches for the permission sets in an instance by the ARN of the instance
+func ssoAdminPermissionSetSearchFunc(ctx context.Context, client ssoAdminClient, _, query string) ([]*ssoAdminPermissionSet, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Service != "sso" || a.Type() != "instance" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not the ARN of an Identity Center instance", query),
+		}
+	}
+
+	return ssoAdminPermissionSetsInInstance(ctx, client, query)
+}
+
+func ssoAdminPermissionSetTags(ctx context.Context, permissionSet *ssoAdminPermissionSet, client ssoAdminClient) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := ssoadmin.NewListTagsForResourcePaginator(client, &ssoadmin.ListTagsForResourceInput{
+		InstanceArn: &permissionSet.InstanceArn,
+		ResourceArn: permissionSet.PermissionSetArn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Links to a managed IAM policy by ARN
+func ssoAdminPolicyLink(policyARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(policyARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-policy",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  policyARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the policy changes the permissions of the permission
+			// set
+			In: true,
+			// The permission set can't affect the policy
+			Out: false,
+		},
+	}
+}
+
+// Returns the ARN of a customer managed policy in an account. The policy must
+// exist in every account that the permission set is provisioned to
+func ssoAdminCustomerManagedPolicyARN(partition string, accountID string, reference types.CustomerManagedPolicyReference) string {
+	path := "/"
+	if reference.Path != nil {
+		path = *reference.Path
+	}
+
+	return fmt.Sprintf("arn:%s:iam::%s:policy%s%s", partition, accountID, path, *reference.Name)
+}
+
+func ssoAdminPermissionSetOutputMapper(_, scope string, awsItem *ssoAdminPermissionSet) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssoadmin-permission-set",
+		UniqueAttribute: "PermissionSetArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	partition := "aws"
+	if awsItem.PermissionSetArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.PermissionSetArn); err == nil {
+			partition = a.Partition
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ssoadmin-account-assignment",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.PermissionSetArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Assignments can't affect the permission set
+				In: false,
+				// Changing the permission set changes what the assigned users
+				// can do
+				Out: true,
+			},
+		})
+	}
+
+	for _, policy := range awsItem.ManagedPolicies {
+		if policy.Arn != nil {
+			if link := ssoAdminPolicyLink(*policy.Arn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	if awsItem.PermissionsBoundary != nil && awsItem.PermissionsBoundary.ManagedPolicyArn != nil {
+		if link := ssoAdminPolicyLink(*awsItem.PermissionsBoundary.ManagedPolicyArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// Customer managed policies and boundaries are referenced by name, and
+	// resolved in each account the permission set is provisioned to
+	references := make([]types.CustomerManagedPolicyReference, 0, len(awsItem.CustomerManagedPolicyReferences)+1)
+	references = append(references, awsItem.CustomerManagedPolicyReferences...)
+	if awsItem.PermissionsBoundary != nil && awsItem.PermissionsBoundary.CustomerManagedPolicyReference != nil {
+		references = append(references, *awsItem.PermissionsBoundary.CustomerManagedPolicyReference)
+	}
+
+	for _, accountID := range awsItem.ProvisionedAccounts {
+		if awsItem.Name != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, ssoAdminRoleLink(*awsItem.Name, accountID))
+		}
+
+		for _, reference := range references {
+			if reference.Name == nil {
+				continue
+			}
+
+			if link := ssoAdminPolicyLink(ssoAdminCustomerManagedPolicyARN(partition, accountID, reference)); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSSOAdminPermissionSetAdapter(client ssoAdminClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ssoAdminPermissionSet, ssoAdminClient, *ssoadmin.Options] {
+	return &adapterhelpers.GetListAdapter[*ssoAdminPermissionSet, ssoAdminClient, *ssoadmin.Options]{
+		ItemType:        "ssoadmin-permission-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssoAdminPermissionSetAdapterMetadata,
+		GetFunc:         ssoAdminPermissionSetGetFunc,
+		ListFunc:        ssoAdminPermissionSetListFunc,
+		SearchFunc:      ssoAdminPermissionSetSearchFunc,
+		ItemMapper:      ssoAdminPermissionSetOutputMapper,
+		ListTagsFunc:    ssoAdminPermissionSetTags,
+	}
+}
+
+var ssoAdminPermissionSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssoadmin-permission-set",
+	DescriptiveName: "IAM Identity Center Permission Set",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a permission set by ARN",
+		List:              true,
+		ListDescription:   "List all permission sets",
+		Search:            true,
+		SearchDescription: "Search for the permission sets of an Identity Center instance by instance ARN",
+	},
+	PotentialLinks: []string{"ssoadmin-account-assignment", "iam-policy", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssoadmin_permission_set.arn"},
+		{TerraformQueryMap: "aws_ssoadmin_managed_policy_attachment.permission_set_arn"},
+		{TerraformQueryMap: "aws_ssoadmin_customer_managed_policy_attachment.permission_set_arn"},
+		{TerraformQueryMap: "aws_ssoadmin_permissions_boundary_attachment.permission_set_arn"},
+		{TerraformQueryMap: "aws_ssoadmin_permission_set_inline_policy.permission_set_arn"},
+	},
+})
+
+var _ = Metadata.RegisterSchema(ssoAdminPermissionSetAdapterMetadata, sdp.AttributeSchemaFor(&ssoAdminPermissionSet{}))
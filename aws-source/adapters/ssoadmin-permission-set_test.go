@@ -0,0 +1,119 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestSSOAdminPermissionSetGet(t *testing.T) {
+	adapter := NewSSOAdminPermissionSetAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", testSSOAdminPermissionSetARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected the team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssoadmin-account-assignment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testSSOAdminPermissionSetARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::aws:policy/ReadOnlyAccess",
+			ExpectedScope:  "aws",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "Developer",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "Developer",
+			ExpectedScope:  "222222222222",
+		},
+		{
+			ExpectedType:   "iam-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::111111111111:policy/developer-access",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "iam-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::222222222222:policy/boundaries/developer-boundary",
+			ExpectedScope:  "222222222222",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSSOAdminPermissionSetList(t *testing.T) {
+	adapter := NewSSOAdminPermissionSetAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The permission set that was deleted after being listed is skipped
+	if len(items) != 1 {
+		t.Fatalf("expected 1 permission set, got %v", len(items))
+	}
+}
+
+func TestSSOAdminPermissionSetSearch(t *testing.T) {
+	adapter := NewSSOAdminPermissionSetAdapter(testSSOAdminClient{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.Search(context.Background(), "123456789012.eu-west-2", testSSOAdminInstanceARN, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 permission set, got %v", len(items))
+	}
+
+	_, err = adapter.Search(context.Background(), "123456789012.eu-west-2", testSSOAdminPermissionSetARN, false)
+	if err == nil {
+		t.Error("expected an error when searching by a permission set ARN")
+	}
+}
+
+func TestNewSSOAdminPermissionSetAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := ssoadmin.NewFromConfig(config)
+
+	adapter := NewSSOAdminPermissionSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
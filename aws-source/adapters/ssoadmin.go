@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// The IAM Identity Center APIs that the adapters use. Users and groups are in
+// the identity store, which is never read
+type ssoAdminClient interface {
+	DescribePermissionSet(ctx context.Context, params *ssoadmin.DescribePermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.DescribePermissionSetOutput, error)
+	GetInlinePolicyForPermissionSet(ctx context.Context, params *ssoadmin.GetInlinePolicyForPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.GetInlinePolicyForPermissionSetOutput, error)
+	GetPermissionsBoundaryForPermissionSet(ctx context.Context, params *ssoadmin.GetPermissionsBoundaryForPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.GetPermissionsBoundaryForPermissionSetOutput, error)
+	ListAccountAssignments(ctx context.Context, params *ssoadmin.ListAccountAssignmentsInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListAccountAssignmentsOutput, error)
+	ListAccountsForProvisionedPermissionSet(ctx context.Context, params *ssoadmin.ListAccountsForProvisionedPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListAccountsForProvisionedPermissionSetOutput, error)
+	ListCustomerManagedPolicyReferencesInPermissionSet(ctx context.Context, params *ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput, error)
+	ListInstances(ctx context.Context, params *ssoadmin.ListInstancesInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListInstancesOutput, error)
+	ListManagedPoliciesInPermissionSet(ctx context.Context, params *ssoadmin.ListManagedPoliciesInPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListManagedPoliciesInPermissionSetOutput, error)
+	ListPermissionSets(ctx context.Context, params *ssoadmin.ListPermissionSetsInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListPermissionSetsOutput, error)
+	ListTagsForResource(ctx context.Context, params *ssoadmin.ListTagsForResourceInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListTagsForResourceOutput, error)
+}
+
+// Returns the ARN of the Identity Center instance that a permission set
+// belongs to. Permission set ARNs are in the format
+// `arn:aws:sso:::permissionSet/{instance-id}/{permission-set-id}` and
+// instance ARNs are in the format `arn:aws:sso:::instance/{instance-id}`
+func ssoAdminInstanceARN(permissionSetARN string) (string, error) {
+	a, err := adapterhelpers.ParseARN(permissionSetARN)
+	if err != nil {
+		return "", err
+	}
+
+	sections := strings.Split(a.Resource, "/")
+	if a.Service != "sso" || len(sections) != 3 || sections[0] != "permissionSet" || sections[1] == "" || sections[2] == "" {
+		return "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("%v is not the ARN of a permission set", permissionSetARN),
+		}
+	}
+
+	return fmt.Sprintf("arn:%s:sso:::instance/%s", a.Partition, sections[1]), nil
+}
+
+// Lists the ARNs of the Identity Center instances. There is at most one
+// organization instance, but there can also be account instances
+func listSSOAdminInstanceARNs(ctx context.Context, client ssoAdminClient) ([]string, error) {
+	instanceARNs := make([]string, 0)
+	paginator := ssoadmin.NewListInstancesPaginator(client, &ssoadmin.ListInstancesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range out.Instances {
+			if instance.InstanceArn != nil && instance.Status == types.InstanceStatusActive {
+				instanceARNs = append(instanceARNs, *instance.InstanceArn)
+			}
+		}
+	}
+
+	return instanceARNs, nil
+}
+
+func listSSOAdminPermissionSetARNs(ctx context.Context, client ssoAdminClient, instanceARN string) ([]string, error) {
+	permissionSetARNs := make([]string, 0)
+	paginator := ssoadmin.NewListPermissionSetsPaginator(client, &ssoadmin.ListPermissionSetsInput{
+		InstanceArn: &instanceARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		permissionSetARNs = append(permissionSetARNs, out.PermissionSets...)
+	}
+
+	return permissionSetARNs, nil
+}
+
+// Lists the accounts that a permission set has been provisioned to, i.e. the
+// accounts that contain an IAM role for it
+func listSSOAdminProvisionedAccounts(ctx context.Context, client ssoAdminClient, instanceARN string, permissionSetARN string) ([]string, error) {
+	accountIDs := make([]string, 0)
+	paginator := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(client, &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      &instanceARN,
+		PermissionSetArn: &permissionSetARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDs = append(accountIDs, out.AccountIds...)
+	}
+
+	return accountIDs, nil
+}
+
+// Links to the IAM role that Identity Center creates for a permission set in
+// a member account. IAM is global so the scope is just the account
+func ssoAdminRoleLink(permissionSetName string, accountID string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  permissionSetName,
+			Scope:  accountID,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Identity Center manages the role, changing it directly has no
+			// effect on the permission set
+			In: false,
+			// Changes are provisioned to the role
+			Out: true,
+		},
+	}
+}
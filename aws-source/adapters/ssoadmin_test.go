@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	testSSOAdminInstanceARN      = "arn:aws:sso:::instance/ssoins-1111111111111111"
+	testSSOAdminPermissionSetARN = "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-aaaaaaaaaaaaaaaa"
+	// A permission set that is deleted between being listed and described
+	testSSOAdminDeletedPermissionSetARN = "arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-bbbbbbbbbbbbbbbb"
+)
+
+type testSSOAdminClient struct{}
+
+func (t testSSOAdminClient) DescribePermissionSet(ctx context.Context, params *ssoadmin.DescribePermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.DescribePermissionSetOutput, error) {
+	if *params.PermissionSetArn != testSSOAdminPermissionSetARN {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("permission set not found")}
+	}
+
+	return &ssoadmin.DescribePermissionSetOutput{
+		PermissionSet: &types.PermissionSet{
+			PermissionSetArn: params.PermissionSetArn,
+			Name:             aws.String("Developer"),
+			Description:      aws.String("Access for developers"),
+			SessionDuration:  aws.String("PT8H"),
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) GetInlinePolicyForPermissionSet(ctx context.Context, params *ssoadmin.GetInlinePolicyForPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.GetInlinePolicyForPermissionSetOutput, error) {
+	return &ssoadmin.GetInlinePolicyForPermissionSetOutput{
+		InlinePolicy: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListAllMyBuckets","Resource":"*"}]}`),
+	}, nil
+}
+
+func (t testSSOAdminClient) GetPermissionsBoundaryForPermissionSet(ctx context.Context, params *ssoadmin.GetPermissionsBoundaryForPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.GetPermissionsBoundaryForPermissionSetOutput, error) {
+	return &ssoadmin.GetPermissionsBoundaryForPermissionSetOutput{
+		PermissionsBoundary: &types.PermissionsBoundary{
+			CustomerManagedPolicyReference: &types.CustomerManagedPolicyReference{
+				Name: aws.String("developer-boundary"),
+				Path: aws.String("/boundaries/"),
+			},
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListAccountAssignments(ctx context.Context, params *ssoadmin.ListAccountAssignmentsInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListAccountAssignmentsOutput, error) {
+	if *params.AccountId != "111111111111" {
+		return &ssoadmin.ListAccountAssignmentsOutput{}, nil
+	}
+
+	return &ssoadmin.ListAccountAssignmentsOutput{
+		AccountAssignments: []types.AccountAssignment{
+			{
+				AccountId:        params.AccountId,
+				PermissionSetArn: params.PermissionSetArn,
+				PrincipalId:      aws.String("a1b2c3d4-0000-0000-0000-000000000001"),
+				PrincipalType:    types.PrincipalTypeGroup,
+			},
+			{
+				AccountId:        params.AccountId,
+				PermissionSetArn: params.PermissionSetArn,
+				PrincipalId:      aws.String("a1b2c3d4-0000-0000-0000-000000000002"),
+				PrincipalType:    types.PrincipalTypeUser,
+			},
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListAccountsForProvisionedPermissionSet(ctx context.Context, params *ssoadmin.ListAccountsForProvisionedPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListAccountsForProvisionedPermissionSetOutput, error) {
+	// The first page only contains a token, to check that we follow it
+	if params.NextToken == nil {
+		return &ssoadmin.ListAccountsForProvisionedPermissionSetOutput{
+			AccountIds: []string{"111111111111"},
+			NextToken:  aws.String("page2"),
+		}, nil
+	}
+
+	return &ssoadmin.ListAccountsForProvisionedPermissionSetOutput{
+		AccountIds: []string{"222222222222"},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListCustomerManagedPolicyReferencesInPermissionSet(ctx context.Context, params *ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput, error) {
+	return &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput{
+		CustomerManagedPolicyReferences: []types.CustomerManagedPolicyReference{
+			{Name: aws.String("developer-access")},
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListInstances(ctx context.Context, params *ssoadmin.ListInstancesInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListInstancesOutput, error) {
+	return &ssoadmin.ListInstancesOutput{
+		Instances: []types.InstanceMetadata{
+			{InstanceArn: aws.String(testSSOAdminInstanceARN), Status: types.InstanceStatusActive},
+			{InstanceArn: aws.String("arn:aws:sso:::instance/ssoins-2222222222222222"), Status: types.InstanceStatusDeleteInProgress},
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListManagedPoliciesInPermissionSet(ctx context.Context, params *ssoadmin.ListManagedPoliciesInPermissionSetInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListManagedPoliciesInPermissionSetOutput, error) {
+	return &ssoadmin.ListManagedPoliciesInPermissionSetOutput{
+		AttachedManagedPolicies: []types.AttachedManagedPolicy{
+			{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"), Name: aws.String("ReadOnlyAccess")},
+		},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListPermissionSets(ctx context.Context, params *ssoadmin.ListPermissionSetsInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListPermissionSetsOutput, error) {
+	return &ssoadmin.ListPermissionSetsOutput{
+		PermissionSets: []string{testSSOAdminPermissionSetARN, testSSOAdminDeletedPermissionSetARN},
+	}, nil
+}
+
+func (t testSSOAdminClient) ListTagsForResource(ctx context.Context, params *ssoadmin.ListTagsForResourceInput, optFns ...func(*ssoadmin.Options)) (*ssoadmin.ListTagsForResourceOutput, error) {
+	return &ssoadmin.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{Key: aws.String("team"), Value: aws.String("platform")},
+		},
+	}, nil
+}
+
+func TestSSOAdminInstanceARN(t *testing.T) {
+	instanceARN, err := ssoAdminInstanceARN(testSSOAdminPermissionSetARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instanceARN != testSSOAdminInstanceARN {
+		t.Errorf("expected %v, got %v", testSSOAdminInstanceARN, instanceARN)
+	}
+
+	for _, query := range []string{
+		testSSOAdminInstanceARN,
+		"arn:aws:sso:::permissionSet/ssoins-1111111111111111",
+		"arn:aws:iam::123456789012:role/Developer",
+	} {
+		_, err := ssoAdminInstanceARN(query)
+
+		var qErr *sdp.QueryError
+		if !errors.As(err, &qErr) || qErr.GetErrorType() != sdp.QueryError_NOTFOUND {
+			t.Errorf("expected a NOTFOUND error for %v, got %v", query, err)
+		}
+	}
+}
+
+func TestListSSOAdminInstanceARNs(t *testing.T) {
+	instanceARNs, err := listSSOAdminInstanceARNs(context.Background(), testSSOAdminClient{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Instances that are being deleted are skipped
+	if len(instanceARNs) != 1 || instanceARNs[0] != testSSOAdminInstanceARN {
+		t.Errorf("expected only the active instance, got %v", instanceARNs)
+	}
+}
@@ -0,0 +1,195 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func stateMachineGetFunc(ctx context.Context, client statesClient, scope, query string) (*sfn.DescribeStateMachineOutput, error) {
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{
+		StateMachineArn: adapterhelpers.PtrString(fmt.Sprintf("arn:aws:states:%v:%v:stateMachine:%v", region, accountID, query)),
+	})
+}
+
+func stateMachineItemMapper(_ *string, scope string, awsItem *sfn.DescribeStateMachineOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "states-state-machine",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.StateMachineStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StateMachineStatusDeleting:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	if awsItem.RoleArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.RoleArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-role",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.RoleArn,
+					Scope:  a.AccountID,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the role's permissions can cause executions
+					// to fail
+					In: true,
+					// The state machine can't affect the role
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.LoggingConfiguration != nil {
+		for _, destination := range awsItem.LoggingConfiguration.Destinations {
+			if destination.CloudWatchLogsLogGroup == nil || destination.CloudWatchLogsLogGroup.LogGroupArn == nil {
+				continue
+			}
+
+			a, err := adapterhelpers.ParseARN(*destination.CloudWatchLogsLogGroup.LogGroupArn)
+			if err != nil {
+				continue
+			}
+
+			// The ARN is in the format log-group:{name}:*
+			name := strings.TrimSuffix(strings.TrimPrefix(a.Resource, "log-group:"), ":*")
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  name,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The log group can't affect the state machine
+					In: false,
+					// The state machine sends execution history to the log
+					// group
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.EncryptionConfiguration != nil && awsItem.EncryptionConfiguration.KmsKeyId != nil {
+		if link := kmsKeyLink(scope, *awsItem.EncryptionConfiguration.KmsKeyId); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.Definition != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, statesDefinitionLinks(scope, *awsItem.Definition)...)
+	}
+
+	return &item, nil
+}
+
+func stateMachineListTagsFunc(ctx context.Context, stateMachine *sfn.DescribeStateMachineOutput, client statesClient) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &sfn.ListTagsForResourceInput{
+		ResourceArn: stateMachine.StateMachineArn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err), nil
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func NewStatesStateMachineAdapter(client statesClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*sfn.ListStateMachinesInput, *sfn.ListStateMachinesOutput, *sfn.DescribeStateMachineOutput, statesClient, *sfn.Options] {
+	return &adapterhelpers.GetListAdapterV2[*sfn.ListStateMachinesInput, *sfn.ListStateMachinesOutput, *sfn.DescribeStateMachineOutput, statesClient, *sfn.Options]{
+		ItemType:        "states-state-machine",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: stateMachineAdapterMetadata,
+		GetFunc:         stateMachineGetFunc,
+		InputMapperList: func(scope string) (*sfn.ListStateMachinesInput, error) {
+			return &sfn.ListStateMachinesInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client statesClient, params *sfn.ListStateMachinesInput) adapterhelpers.Paginator[*sfn.ListStateMachinesOutput, *sfn.Options] {
+			return sfn.NewListStateMachinesPaginator(client, params)
+		},
+		ListExtractor: func(ctx context.Context, output *sfn.ListStateMachinesOutput, client statesClient) ([]*sfn.DescribeStateMachineOutput, error) {
+			stateMachines := make([]*sfn.DescribeStateMachineOutput, 0, len(output.StateMachines))
+			for _, summary := range output.StateMachines {
+				stateMachine, err := client.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{
+					StateMachineArn: summary.StateMachineArn,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				stateMachines = append(stateMachines, stateMachine)
+			}
+			return stateMachines, nil
+		},
+		ItemMapper:   stateMachineItemMapper,
+		ListTagsFunc: stateMachineListTagsFunc,
+	}
+}
+
+var stateMachineAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "states-state-machine",
+	DescriptiveName: "Step Functions State Machine",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a state machine by name",
+		ListDescription:   "List all state machines",
+		SearchDescription: "Search for a state machine by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_sfn_state_machine.arn",
+		},
+	},
+	PotentialLinks: []string{
+		"iam-role",
+		"logs-log-group",
+		"kms-key",
+		"lambda-function",
+		"sqs-queue",
+		"sns-topic",
+		"dynamodb-table",
+		"states-state-machine",
+		"events-event-bus",
+		"ecs-cluster",
+		"ecs-task-definition",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var _ = Metadata.RegisterSchema(stateMachineAdapterMetadata, sdp.AttributeSchemaFor(sfn.DescribeStateMachineOutput{}))
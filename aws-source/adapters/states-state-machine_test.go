@@ -0,0 +1,314 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const testStateMachineDefinition = `{
+  "StartAt": "Validate",
+  "States": {
+    "Validate": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:eu-west-2:123456789012:function:validate-order",
+      "Next": "Fulfil"
+    },
+    "Fulfil": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Queue",
+          "States": {
+            "Queue": {
+              "Type": "Task",
+              "Resource": "arn:aws:states:::sqs:sendMessage",
+              "Parameters": {
+                "QueueUrl": "https://sqs.eu-west-2.amazonaws.com/123456789012/fulfilment",
+                "MessageBody.$": "$"
+              },
+              "End": true
+            }
+          }
+        },
+        {
+          "StartAt": "Save",
+          "States": {
+            "Save": {
+              "Type": "Task",
+              "Resource": "arn:aws:states:::dynamodb:putItem",
+              "Parameters": {
+                "TableName": "orders",
+                "Item": {}
+              },
+              "End": true
+            }
+          }
+        }
+      ],
+      "Next": "Items"
+    },
+    "Items": {
+      "Type": "Map",
+      "ItemProcessor": {
+        "StartAt": "Invoice",
+        "States": {
+          "Invoice": {
+            "Type": "Task",
+            "Resource": "arn:aws:states:::lambda:invoke",
+            "Parameters": {
+              "FunctionName": "arn:aws:lambda:eu-west-2:123456789012:function:invoice",
+              "Payload.$": "$"
+            },
+            "End": true
+          }
+        }
+      },
+      "Next": "Notify"
+    },
+    "Notify": {
+      "Type": "Task",
+      "QueryLanguage": "JSONata",
+      "Resource": "arn:aws:states:::aws-sdk:sns:publish",
+      "Arguments": {
+        "TopicArn": "arn:aws:sns:eu-west-2:123456789012:order-notifications",
+        "Message": "{% $states.input.message %}"
+      },
+      "Next": "Publish"
+    },
+    "Publish": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::events:putEvents",
+      "Parameters": {
+        "Entries": [
+          {
+            "EventBusName": "orders",
+            "Detail.$": "$"
+          }
+        ]
+      },
+      "Next": "Dynamic"
+    },
+    "Dynamic": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName.$": "$.function"
+      },
+      "Next": "Revalidate"
+    },
+    "Revalidate": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:eu-west-2:123456789012:function:validate-order",
+      "End": true
+    }
+  }
+}`
+
+type testStatesClient struct{}
+
+func (t testStatesClient) DescribeStateMachine(ctx context.Context, params *sfn.DescribeStateMachineInput, optFns ...func(*sfn.Options)) (*sfn.DescribeStateMachineOutput, error) {
+	if *params.StateMachineArn != "arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow" {
+		return nil, &types.StateMachineDoesNotExist{
+			Message: aws.String("state machine does not exist"),
+		}
+	}
+
+	return &sfn.DescribeStateMachineOutput{
+		Name:            aws.String("order-workflow"),
+		StateMachineArn: params.StateMachineArn,
+		Type:            types.StateMachineTypeStandard,
+		Status:          types.StateMachineStatusActive,
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/order-workflow"),
+		Definition:      aws.String(testStateMachineDefinition),
+		CreationDate:    aws.Time(time.Now()),
+		LoggingConfiguration: &types.LoggingConfiguration{
+			Level: types.LogLevelError,
+			Destinations: []types.LogDestination{
+				{
+					CloudWatchLogsLogGroup: &types.CloudWatchLogsLogGroup{
+						LogGroupArn: aws.String("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/vendedlogs/states/order-workflow:*"),
+					},
+				},
+			},
+		},
+		EncryptionConfiguration: &types.EncryptionConfiguration{
+			Type:     types.EncryptionTypeCustomerManagedKmsKey,
+			KmsKeyId: aws.String("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		},
+	}, nil
+}
+
+func (t testStatesClient) ListStateMachines(ctx context.Context, params *sfn.ListStateMachinesInput, optFns ...func(*sfn.Options)) (*sfn.ListStateMachinesOutput, error) {
+	return &sfn.ListStateMachinesOutput{
+		StateMachines: []types.StateMachineListItem{
+			{
+				Name:            aws.String("order-workflow"),
+				StateMachineArn: aws.String("arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow"),
+				Type:            types.StateMachineTypeStandard,
+				CreationDate:    aws.Time(time.Now()),
+			},
+		},
+	}, nil
+}
+
+func (t testStatesClient) ListTagsForResource(ctx context.Context, params *sfn.ListTagsForResourceInput, optFns ...func(*sfn.Options)) (*sfn.ListTagsForResourceOutput, error) {
+	return &sfn.ListTagsForResourceOutput{
+		Tags: []types.Tag{
+			{
+				Key:   aws.String("team"),
+				Value: aws.String("orders"),
+			},
+		},
+	}, nil
+}
+
+func TestStatesDefinitionLinks(t *testing.T) {
+	links := statesDefinitionLinks("123456789012.eu-west-2", testStateMachineDefinition)
+
+	item := &sdp.Item{LinkedItemQueries: links}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:validate-order",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.eu-west-2.amazonaws.com/123456789012/fulfilment",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:invoice",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:order-notifications",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The function called twice is only linked once, and the dynamic
+	// function name isn't linked at all
+	if len(links) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(links))
+	}
+
+	if links := statesDefinitionLinks("123456789012.eu-west-2", "not json"); len(links) != 0 {
+		t.Errorf("expected no links for an invalid definition, got %v", links)
+	}
+}
+
+func TestStateMachineItemMapper(t *testing.T) {
+	stateMachine, err := stateMachineGetFunc(context.Background(), testStatesClient{}, "123456789012.eu-west-2", "order-workflow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := stateMachineItemMapper(nil, "123456789012.eu-west-2", stateMachine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/order-workflow",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/vendedlogs/states/order-workflow",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestStateMachineListFunc(t *testing.T) {
+	adapter := NewStatesStateMachineAdapter(testStatesClient{}, "123456789012", "eu-west-2")
+
+	stream := discovery.NewRecordingQueryResultStream()
+	adapter.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+
+	if errs := stream.GetErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	items := stream.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].GetTags()["team"] != "orders" {
+		t.Errorf("expected team tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewStatesStateMachineAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := sfn.NewFromConfig(config)
+
+	adapter := NewStatesStateMachineAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,189 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type statesClient interface {
+	DescribeStateMachine(ctx context.Context, params *sfn.DescribeStateMachineInput, optFns ...func(*sfn.Options)) (*sfn.DescribeStateMachineOutput, error)
+	ListTagsForResource(ctx context.Context, params *sfn.ListTagsForResourceInput, optFns ...func(*sfn.Options)) (*sfn.ListTagsForResourceOutput, error)
+
+	sfn.ListStateMachinesAPIClient
+}
+
+// aslDefinition is the subset of the Amazon States Language that we need in
+// order to find the resources that a state machine calls. Parallel branches
+// and Map iterators are full definitions of their own
+type aslDefinition struct {
+	States map[string]aslState `json:"States"`
+}
+
+type aslState struct {
+	Type     string `json:"Type"`
+	Resource string `json:"Resource"`
+
+	// JSONPath states use Parameters, JSONata states use Arguments
+	Parameters map[string]any `json:"Parameters"`
+	Arguments  map[string]any `json:"Arguments"`
+
+	Branches      []aslDefinition `json:"Branches"`
+	Iterator      *aslDefinition  `json:"Iterator"`
+	ItemProcessor *aslDefinition  `json:"ItemProcessor"`
+}
+
+// A parameter of a service integration that refers to another resource
+type aslResourceParameter struct {
+	Parameter string
+	Type      string
+}
+
+// The parameters that we link for each service integration, keyed by the
+// service name in the resource e.g. `arn:aws:states:::sqs:sendMessage` or
+// `arn:aws:states:::aws-sdk:sqs:sendMessage`. The optimised integrations and
+// the SDK integrations use the same parameter names
+var aslServiceParameters = map[string][]aslResourceParameter{
+	"lambda":   {{Parameter: "FunctionName", Type: "lambda-function"}},
+	"sqs":      {{Parameter: "QueueUrl", Type: "sqs-queue"}},
+	"sns":      {{Parameter: "TopicArn", Type: "sns-topic"}},
+	"dynamodb": {{Parameter: "TableName", Type: "dynamodb-table"}},
+	"states":   {{Parameter: "StateMachineArn", Type: "states-state-machine"}},
+	"ecs": {
+		{Parameter: "Cluster", Type: "ecs-cluster"},
+		{Parameter: "TaskDefinition", Type: "ecs-task-definition"},
+	},
+}
+
+// Extracts links to the resources that a state machine calls from its Amazon
+// States Language definition. Only static values are linked, parameters that
+// are evaluated at runtime from the state's input are ignored
+func statesDefinitionLinks(scope string, definition string) []*sdp.LinkedItemQuery {
+	var def aslDefinition
+	if err := json.Unmarshal([]byte(definition), &def); err != nil {
+		return nil
+	}
+
+	links := make([]*sdp.LinkedItemQuery, 0)
+	seen := make(map[string]bool)
+	add := func(link *sdp.LinkedItemQuery) {
+		if link == nil {
+			return
+		}
+
+		key := link.GetQuery().GetType() + "|" + link.GetQuery().GetQuery()
+		if seen[key] {
+			return
+		}
+
+		seen[key] = true
+		links = append(links, link)
+	}
+
+	var walk func(def aslDefinition)
+	walk = func(def aslDefinition) {
+		for _, state := range def.States {
+			for _, link := range aslStateLinks(scope, state) {
+				add(link)
+			}
+
+			for _, branch := range state.Branches {
+				walk(branch)
+			}
+			if state.Iterator != nil {
+				walk(*state.Iterator)
+			}
+			if state.ItemProcessor != nil {
+				walk(*state.ItemProcessor)
+			}
+		}
+	}
+	walk(def)
+
+	return links
+}
+
+func aslStateLinks(scope string, state aslState) []*sdp.LinkedItemQuery {
+	if state.Type != "Task" || state.Resource == "" {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(state.Resource)
+	if err != nil {
+		return nil
+	}
+
+	// Lambda functions can be called directly by ARN
+	if a.Service == "lambda" {
+		return []*sdp.LinkedItemQuery{aslResourceLink(scope, "lambda-function", state.Resource)}
+	}
+
+	if a.Service != "states" {
+		return nil
+	}
+
+	// Service integrations look like `sqs:sendMessage` or
+	// `aws-sdk:sqs:sendMessage.waitForTaskToken`
+	service, _, _ := strings.Cut(strings.TrimPrefix(a.Resource, "aws-sdk:"), ":")
+
+	parameters := state.Parameters
+	if parameters == nil {
+		parameters = state.Arguments
+	}
+
+	links := make([]*sdp.LinkedItemQuery, 0)
+	for _, param := range aslServiceParameters[service] {
+		if value, ok := parameters[param.Parameter].(string); ok {
+			links = append(links, aslResourceLink(scope, param.Type, value))
+		}
+	}
+
+	// PutEvents can send to many buses at once
+	if service == "events" {
+		entries, _ := parameters["Entries"].([]any)
+		for _, entry := range entries {
+			if entry, ok := entry.(map[string]any); ok {
+				if value, ok := entry["EventBusName"].(string); ok {
+					links = append(links, aslResourceLink(scope, "events-event-bus", value))
+				}
+			}
+		}
+	}
+
+	return links
+}
+
+// Links to a resource that is referenced either by ARN or by name. Values that
+// are JSONata expressions are evaluated at runtime so can't be linked
+func aslResourceLink(scope, itemType, value string) *sdp.LinkedItemQuery {
+	if value == "" || strings.HasPrefix(value, "{%") {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Type:   itemType,
+		Method: sdp.QueryMethod_GET,
+		Query:  value,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(value); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the resource changes the state machine's executions can fail
+			In: true,
+			// Executions call the resource
+			Out: true,
+		},
+	}
+}
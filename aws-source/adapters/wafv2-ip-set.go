@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func getWAFv2IPSet(ctx context.Context, client wafv2Client, scope string, summary wafv2Summary) (*types.IPSet, error) {
+	out, err := client.GetIPSet(ctx, &wafv2.GetIPSetInput{
+		Name:  summary.Name,
+		Id:    summary.Id,
+		Scope: wafv2Scope(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.IPSet, nil
+}
+
+func listWAFv2IPSetSummaries(ctx context.Context, client wafv2Client, scope string) ([]wafv2Summary, error) {
+	return listWAFv2Summaries(ctx, func(ctx context.Context, marker *string) ([]wafv2Summary, *string, error) {
+		out, err := client.ListIPSets(ctx, &wafv2.ListIPSetsInput{
+			Scope:      wafv2Scope(scope),
+			NextMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		summaries := make([]wafv2Summary, 0, len(out.IPSets))
+		for _, summary := range out.IPSets {
+			summaries = append(summaries, wafv2Summary{Name: summary.Name, Id: summary.Id})
+		}
+
+		return summaries, out.NextMarker, nil
+	})
+}
+
+func wafv2IPSetGetFunc(ctx context.Context, client wafv2Client, scope string, query string) (*types.IPSet, error) {
+	summaries, err := listWAFv2IPSetSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := findWAFv2Summary(summaries, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2IPSet(ctx, client, scope, *summary)
+}
+
+func wafv2IPSetListFunc(ctx context.Context, client wafv2Client, scope string) ([]*types.IPSet, error) {
+	summaries, err := listWAFv2IPSetSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2Summaries(ctx, summaries, func(ctx context.Context, summary wafv2Summary) (*types.IPSet, error) {
+		return getWAFv2IPSet(ctx, client, scope, summary)
+	})
+}
+
+func wafv2IPSetSearchFunc(ctx context.Context, client wafv2Client, scope string, query string) ([]*types.IPSet, error) {
+	summary, err := parseWAFv2ARN(query, "ipset", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := getWAFv2IPSet(ctx, client, scope, *summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.IPSet{set}, nil
+}
+
+func wafv2IPSetItemMapper(_, scope string, awsItem *types.IPSet) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-ip-set",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2IPSetAdapter(client wafv2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.IPSet, wafv2Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.IPSet, wafv2Client, *wafv2.Options]{
+		ItemType:        "wafv2-ip-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region, // Empty for IP sets used by CloudFront web ACLs
+		AdapterMetadata: wafv2IPSetAdapterMetadata,
+		GetFunc:         wafv2IPSetGetFunc,
+		ListFunc:        wafv2IPSetListFunc,
+		SearchFunc:      wafv2IPSetSearchFunc,
+		ItemMapper:      wafv2IPSetItemMapper,
+		ListTagsFunc: func(ctx context.Context, set *types.IPSet, client wafv2Client) (map[string]string, error) {
+			return wafv2Tags(ctx, client, set.ARN)
+		},
+	}
+}
+
+var wafv2IPSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-ip-set",
+	DescriptiveName: "WAF IP Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an IP set by name",
+		ListDescription:   "List all IP sets",
+		SearchDescription: "Search for an IP set by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_ip_set.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(wafv2IPSetAdapterMetadata, sdp.AttributeSchemaFor(types.IPSet{}))
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func getWAFv2RegexPatternSet(ctx context.Context, client wafv2Client, scope string, summary wafv2Summary) (*types.RegexPatternSet, error) {
+	out, err := client.GetRegexPatternSet(ctx, &wafv2.GetRegexPatternSetInput{
+		Name:  summary.Name,
+		Id:    summary.Id,
+		Scope: wafv2Scope(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.RegexPatternSet, nil
+}
+
+func listWAFv2RegexPatternSetSummaries(ctx context.Context, client wafv2Client, scope string) ([]wafv2Summary, error) {
+	return listWAFv2Summaries(ctx, func(ctx context.Context, marker *string) ([]wafv2Summary, *string, error) {
+		out, err := client.ListRegexPatternSets(ctx, &wafv2.ListRegexPatternSetsInput{
+			Scope:      wafv2Scope(scope),
+			NextMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		summaries := make([]wafv2Summary, 0, len(out.RegexPatternSets))
+		for _, summary := range out.RegexPatternSets {
+			summaries = append(summaries, wafv2Summary{Name: summary.Name, Id: summary.Id})
+		}
+
+		return summaries, out.NextMarker, nil
+	})
+}
+
+func wafv2RegexPatternSetGetFunc(ctx context.Context, client wafv2Client, scope string, query string) (*types.RegexPatternSet, error) {
+	summaries, err := listWAFv2RegexPatternSetSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := findWAFv2Summary(summaries, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2RegexPatternSet(ctx, client, scope, *summary)
+}
+
+func wafv2RegexPatternSetListFunc(ctx context.Context, client wafv2Client, scope string) ([]*types.RegexPatternSet, error) {
+	summaries, err := listWAFv2RegexPatternSetSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2Summaries(ctx, summaries, func(ctx context.Context, summary wafv2Summary) (*types.RegexPatternSet, error) {
+		return getWAFv2RegexPatternSet(ctx, client, scope, summary)
+	})
+}
+
+func wafv2RegexPatternSetSearchFunc(ctx context.Context, client wafv2Client, scope string, query string) ([]*types.RegexPatternSet, error) {
+	summary, err := parseWAFv2ARN(query, "regexpatternset", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := getWAFv2RegexPatternSet(ctx, client, scope, *summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.RegexPatternSet{set}, nil
+}
+
+func wafv2RegexPatternSetItemMapper(_, scope string, awsItem *types.RegexPatternSet) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-regex-pattern-set",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2RegexPatternSetAdapter(client wafv2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.RegexPatternSet, wafv2Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.RegexPatternSet, wafv2Client, *wafv2.Options]{
+		ItemType:        "wafv2-regex-pattern-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region, // Empty for regex pattern sets used by CloudFront web ACLs
+		AdapterMetadata: wafv2RegexPatternSetAdapterMetadata,
+		GetFunc:         wafv2RegexPatternSetGetFunc,
+		ListFunc:        wafv2RegexPatternSetListFunc,
+		SearchFunc:      wafv2RegexPatternSetSearchFunc,
+		ItemMapper:      wafv2RegexPatternSetItemMapper,
+		ListTagsFunc: func(ctx context.Context, set *types.RegexPatternSet, client wafv2Client) (map[string]string, error) {
+			return wafv2Tags(ctx, client, set.ARN)
+		},
+	}
+}
+
+var wafv2RegexPatternSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-regex-pattern-set",
+	DescriptiveName: "WAF Regex Pattern Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a regex pattern set by name",
+		ListDescription:   "List all regex pattern sets",
+		SearchDescription: "Search for a regex pattern set by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_regex_pattern_set.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(wafv2RegexPatternSetAdapterMetadata, sdp.AttributeSchemaFor(types.RegexPatternSet{}))
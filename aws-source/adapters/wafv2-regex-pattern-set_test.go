@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+)
+
+func TestWAFv2RegexPatternSetList(t *testing.T) {
+	adapter := NewWAFv2RegexPatternSetAdapter(testWAFv2Client{}, "123456789012", "eu-west-2")
+
+	items, err := adapter.List(context.Background(), "123456789012.eu-west-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	if item.UniqueAttributeValue() != "bots" {
+		t.Errorf("expected bots, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetTags()["env"] != "prod" {
+		t.Errorf("expected env tag, got %v", item.GetTags())
+	}
+}
+
+func TestNewWAFv2RegexPatternSetAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2RegexPatternSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func getWAFv2RuleGroup(ctx context.Context, client wafv2Client, scope string, summary wafv2Summary) (*types.RuleGroup, error) {
+	out, err := client.GetRuleGroup(ctx, &wafv2.GetRuleGroupInput{
+		Name:  summary.Name,
+		Id:    summary.Id,
+		Scope: wafv2Scope(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.RuleGroup, nil
+}
+
+func listWAFv2RuleGroupSummaries(ctx context.Context, client wafv2Client, scope string) ([]wafv2Summary, error) {
+	return listWAFv2Summaries(ctx, func(ctx context.Context, marker *string) ([]wafv2Summary, *string, error) {
+		out, err := client.ListRuleGroups(ctx, &wafv2.ListRuleGroupsInput{
+			Scope:      wafv2Scope(scope),
+			NextMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		summaries := make([]wafv2Summary, 0, len(out.RuleGroups))
+		for _, summary := range out.RuleGroups {
+			summaries = append(summaries, wafv2Summary{Name: summary.Name, Id: summary.Id})
+		}
+
+		return summaries, out.NextMarker, nil
+	})
+}
+
+func wafv2RuleGroupGetFunc(ctx context.Context, client wafv2Client, scope string, query string) (*types.RuleGroup, error) {
+	summaries, err := listWAFv2RuleGroupSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := findWAFv2Summary(summaries, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2RuleGroup(ctx, client, scope, *summary)
+}
+
+func wafv2RuleGroupListFunc(ctx context.Context, client wafv2Client, scope string) ([]*types.RuleGroup, error) {
+	summaries, err := listWAFv2RuleGroupSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2Summaries(ctx, summaries, func(ctx context.Context, summary wafv2Summary) (*types.RuleGroup, error) {
+		return getWAFv2RuleGroup(ctx, client, scope, summary)
+	})
+}
+
+func wafv2RuleGroupSearchFunc(ctx context.Context, client wafv2Client, scope string, query string) ([]*types.RuleGroup, error) {
+	summary, err := parseWAFv2ARN(query, "rulegroup", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := getWAFv2RuleGroup(ctx, client, scope, *summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.RuleGroup{group}, nil
+}
+
+func wafv2RuleGroupItemMapper(_, scope string, awsItem *types.RuleGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-rule-group",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, wafv2RuleLinks(awsItem.Rules)...)
+
+	return &item, nil
+}
+
+func NewWAFv2RuleGroupAdapter(client wafv2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.RuleGroup, wafv2Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.RuleGroup, wafv2Client, *wafv2.Options]{
+		ItemType:        "wafv2-rule-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region, // Empty for rule groups used by CloudFront web ACLs
+		AdapterMetadata: wafv2RuleGroupAdapterMetadata,
+		GetFunc:         wafv2RuleGroupGetFunc,
+		ListFunc:        wafv2RuleGroupListFunc,
+		SearchFunc:      wafv2RuleGroupSearchFunc,
+		ItemMapper:      wafv2RuleGroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, group *types.RuleGroup, client wafv2Client) (map[string]string, error) {
+			return wafv2Tags(ctx, client, group.ARN)
+		},
+	}
+}
+
+var wafv2RuleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-rule-group",
+	DescriptiveName: "WAF Rule Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a rule group by name",
+		ListDescription:   "List all rule groups",
+		SearchDescription: "Search for a rule group by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_rule_group.arn",
+		},
+	},
+	PotentialLinks: []string{"wafv2-ip-set", "wafv2-regex-pattern-set"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(wafv2RuleGroupAdapterMetadata, sdp.AttributeSchemaFor(types.RuleGroup{}))
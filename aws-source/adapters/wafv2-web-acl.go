@@ -0,0 +1,276 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// The types of regional resources that we look for web ACL associations on.
+// These are the ones that we have adapters for
+var wafv2ProtectedResourceTypes = []types.ResourceType{
+	types.ResourceTypeApplicationLoadBalancer,
+	types.ResourceTypeApiGateway,
+	types.ResourceTypeCognitioUserPool,
+}
+
+// wafv2WebACL is a web ACL along with the ARNs of the resources it is
+// associated with. CloudFront distributions aren't included since they
+// reference the ACL themselves
+type wafv2WebACL struct {
+	*types.WebACL
+	ProtectedResources []string
+}
+
+func getWAFv2WebACL(ctx context.Context, client wafv2Client, scope string, summary wafv2Summary) (*wafv2WebACL, error) {
+	out, err := client.GetWebACL(ctx, &wafv2.GetWebACLInput{
+		Name:  summary.Name,
+		Id:    summary.Id,
+		Scope: wafv2Scope(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	acl := &wafv2WebACL{
+		WebACL:             out.WebACL,
+		ProtectedResources: make([]string, 0),
+	}
+
+	if wafv2Scope(scope) == types.ScopeCloudfront {
+		return acl, nil
+	}
+
+	for _, resourceType := range wafv2ProtectedResourceTypes {
+		resources, err := client.ListResourcesForWebACL(ctx, &wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    out.WebACL.ARN,
+			ResourceType: resourceType,
+		})
+		if err != nil {
+			var invalid *types.WAFInvalidParameterException
+			if errors.As(err, &invalid) {
+				// The resource type isn't supported in this region
+				continue
+			}
+
+			return nil, err
+		}
+
+		acl.ProtectedResources = append(acl.ProtectedResources, resources.ResourceArns...)
+	}
+
+	return acl, nil
+}
+
+func listWAFv2WebACLSummaries(ctx context.Context, client wafv2Client, scope string) ([]wafv2Summary, error) {
+	return listWAFv2Summaries(ctx, func(ctx context.Context, marker *string) ([]wafv2Summary, *string, error) {
+		out, err := client.ListWebACLs(ctx, &wafv2.ListWebACLsInput{
+			Scope:      wafv2Scope(scope),
+			NextMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		summaries := make([]wafv2Summary, 0, len(out.WebACLs))
+		for _, acl := range out.WebACLs {
+			summaries = append(summaries, wafv2Summary{Name: acl.Name, Id: acl.Id})
+		}
+
+		return summaries, out.NextMarker, nil
+	})
+}
+
+func wafv2WebACLGetFunc(ctx context.Context, client wafv2Client, scope string, query string) (*wafv2WebACL, error) {
+	summaries, err := listWAFv2WebACLSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := findWAFv2Summary(summaries, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2WebACL(ctx, client, scope, *summary)
+}
+
+func wafv2WebACLListFunc(ctx context.Context, client wafv2Client, scope string) ([]*wafv2WebACL, error) {
+	summaries, err := listWAFv2WebACLSummaries(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getWAFv2Summaries(ctx, summaries, func(ctx context.Context, summary wafv2Summary) (*wafv2WebACL, error) {
+		return getWAFv2WebACL(ctx, client, scope, summary)
+	})
+}
+
+// Searches by the ARN of the web ACL, or by the ARN of a resource that the ACL
+// is associated with
+func wafv2WebACLSearchFunc(ctx context.Context, client wafv2Client, scope string, query string) ([]*wafv2WebACL, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Service != "wafv2" {
+		out, err := client.GetWebACLForResource(ctx, &wafv2.GetWebACLForResourceInput{
+			ResourceArn: &query,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if out.WebACL == nil {
+			return []*wafv2WebACL{}, nil
+		}
+
+		acl, err := getWAFv2WebACL(ctx, client, scope, wafv2Summary{Name: out.WebACL.Name, Id: out.WebACL.Id})
+		if err != nil {
+			return nil, err
+		}
+
+		return []*wafv2WebACL{acl}, nil
+	}
+
+	summary, err := parseWAFv2ARN(query, "webacl", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	acl, err := getWAFv2WebACL(ctx, client, scope, *summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*wafv2WebACL{acl}, nil
+}
+
+// Links a web ACL to a resource that it protects. The resource will be in the
+// same scope as the ACL
+func wafv2ProtectedResourceLink(scope string, resourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(resourceARN)
+	if err != nil {
+		return nil
+	}
+
+	var query *sdp.Query
+	switch a.Service {
+	case "elasticloadbalancing":
+		query = &sdp.Query{
+			Type:   "elbv2-load-balancer",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  resourceARN,
+			Scope:  scope,
+		}
+	case "apigateway":
+		// Stage ARNs don't contain the account, e.g.
+		// arn:aws:apigateway:eu-west-2::/restapis/abc123/stages/prod
+		sections := strings.Split(strings.TrimPrefix(a.Resource, "/"), "/")
+		if len(sections) != 4 || sections[0] != "restapis" || sections[2] != "stages" {
+			return nil
+		}
+
+		query = &sdp.Query{
+			Type:   "apigateway-stage",
+			Method: sdp.QueryMethod_GET,
+			Query:  sections[1] + "/" + sections[3],
+			Scope:  scope,
+		}
+	case "cognito-idp":
+		// arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abc123
+		query = &sdp.Query{
+			Type:   "cognito-idp-user-pool",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.ResourceID(),
+			Scope:  scope,
+		}
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// The resource can't affect the ACL
+			In: false,
+			// Changing the ACL changes which requests reach the resource
+			Out: true,
+		},
+	}
+}
+
+func wafv2WebACLItemMapper(_, scope string, awsItem *wafv2WebACL) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-web-acl",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, wafv2RuleLinks(awsItem.Rules)...)
+
+	for _, resourceARN := range awsItem.ProtectedResources {
+		if link := wafv2ProtectedResourceLink(scope, resourceARN); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2WebACLAdapter(client wafv2Client, accountID string, region string) *adapterhelpers.GetListAdapter[*wafv2WebACL, wafv2Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*wafv2WebACL, wafv2Client, *wafv2.Options]{
+		ItemType:        "wafv2-web-acl",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region, // Empty for ACLs that protect CloudFront distributions
+		AdapterMetadata: wafv2WebACLAdapterMetadata,
+		GetFunc:         wafv2WebACLGetFunc,
+		ListFunc:        wafv2WebACLListFunc,
+		SearchFunc:      wafv2WebACLSearchFunc,
+		ItemMapper:      wafv2WebACLItemMapper,
+		ListTagsFunc: func(ctx context.Context, acl *wafv2WebACL, client wafv2Client) (map[string]string, error) {
+			return wafv2Tags(ctx, client, acl.ARN)
+		},
+	}
+}
+
+var wafv2WebACLAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-web-acl",
+	DescriptiveName: "WAF Web ACL",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a web ACL by name",
+		ListDescription:   "List all web ACLs",
+		SearchDescription: "Search for a web ACL by its ARN, or by the ARN of a resource that it is associated with",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_web_acl.arn",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_web_acl_association.resource_arn",
+		},
+	},
+	PotentialLinks: []string{"wafv2-rule-group", "wafv2-ip-set", "wafv2-regex-pattern-set", "elbv2-load-balancer", "apigateway-stage", "cognito-idp-user-pool"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var _ = Metadata.RegisterSchema(wafv2WebACLAdapterMetadata, sdp.AttributeSchemaFor(wafv2WebACL{}))
@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func TestWAFv2WebACLItemMapper(t *testing.T) {
+	acl, err := wafv2WebACLGetFunc(context.Background(), testWAFv2Client{}, "123456789012.eu-west-2", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := wafv2WebACLItemMapper("", "123456789012.eu-west-2", acl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	validateAttributeSchema(t, item)
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "wafv2-rule-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2RuleGroupARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "wafv2-ip-set",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2IPSetARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "wafv2-regex-pattern-set",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2RegexARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2LoadBalancerARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "abc123/prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_abc123",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestWAFv2WebACLSearchFunc(t *testing.T) {
+	t.Run("by the ARN of a protected resource", func(t *testing.T) {
+		acls, err := wafv2WebACLSearchFunc(context.Background(), testWAFv2Client{}, "123456789012.eu-west-2", testWAFv2LoadBalancerARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(acls) != 1 || *acls[0].Name != "api" {
+			t.Errorf("expected the api ACL, got %v", acls)
+		}
+	})
+
+	t.Run("by the ARN of an unprotected resource", func(t *testing.T) {
+		acls, err := wafv2WebACLSearchFunc(context.Background(), testWAFv2Client{}, "123456789012.eu-west-2", "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/other/50dc6c495c0c9189")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(acls) != 0 {
+			t.Errorf("expected no ACLs, got %v", len(acls))
+		}
+	})
+
+	t.Run("by the ARN of a CloudFront ACL", func(t *testing.T) {
+		acls, err := wafv2WebACLSearchFunc(context.Background(), testWAFv2Client{}, "123456789012", testWAFv2CloudFrontACLARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(acls) != 1 || *acls[0].Name != "edge" {
+			t.Fatalf("expected the edge ACL, got %v", acls)
+		}
+
+		// Distributions reference their ACL themselves
+		if len(acls[0].ProtectedResources) != 0 {
+			t.Errorf("expected no protected resources, got %v", acls[0].ProtectedResources)
+		}
+	})
+}
+
+func TestWAFv2WebACLList(t *testing.T) {
+	adapter := NewWAFv2WebACLAdapter(testWAFv2Client{}, "123456789012", "")
+
+	items, err := adapter.List(context.Background(), "123456789012", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].UniqueAttributeValue() != "edge" {
+		t.Errorf("expected the edge ACL, got %v", items[0].UniqueAttributeValue())
+	}
+
+	if items[0].GetTags()["env"] != "prod" {
+		t.Errorf("expected env tag, got %v", items[0].GetTags())
+	}
+}
+
+func TestNewWAFv2WebACLAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2WebACLAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,245 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+type wafv2Client interface {
+	GetIPSet(ctx context.Context, params *wafv2.GetIPSetInput, optFns ...func(*wafv2.Options)) (*wafv2.GetIPSetOutput, error)
+	GetRegexPatternSet(ctx context.Context, params *wafv2.GetRegexPatternSetInput, optFns ...func(*wafv2.Options)) (*wafv2.GetRegexPatternSetOutput, error)
+	GetRuleGroup(ctx context.Context, params *wafv2.GetRuleGroupInput, optFns ...func(*wafv2.Options)) (*wafv2.GetRuleGroupOutput, error)
+	GetWebACL(ctx context.Context, params *wafv2.GetWebACLInput, optFns ...func(*wafv2.Options)) (*wafv2.GetWebACLOutput, error)
+	GetWebACLForResource(ctx context.Context, params *wafv2.GetWebACLForResourceInput, optFns ...func(*wafv2.Options)) (*wafv2.GetWebACLForResourceOutput, error)
+	ListIPSets(ctx context.Context, params *wafv2.ListIPSetsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListIPSetsOutput, error)
+	ListRegexPatternSets(ctx context.Context, params *wafv2.ListRegexPatternSetsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListRegexPatternSetsOutput, error)
+	ListResourcesForWebACL(ctx context.Context, params *wafv2.ListResourcesForWebACLInput, optFns ...func(*wafv2.Options)) (*wafv2.ListResourcesForWebACLOutput, error)
+	ListRuleGroups(ctx context.Context, params *wafv2.ListRuleGroupsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListRuleGroupsOutput, error)
+	ListTagsForResource(ctx context.Context, params *wafv2.ListTagsForResourceInput, optFns ...func(*wafv2.Options)) (*wafv2.ListTagsForResourceOutput, error)
+	ListWebACLs(ctx context.Context, params *wafv2.ListWebACLsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListWebACLsOutput, error)
+}
+
+// The name and ID of a WAF resource. The Get APIs need both, but we only want
+// users to have to know the name, which is unique within a WAF scope
+type wafv2Summary struct {
+	Name *string
+	Id   *string
+}
+
+// WAF resources either protect regional resources, or CloudFront
+// distributions. CloudFront resources are global so they are discovered in the
+// account scope, which has no region
+func wafv2Scope(scope string) types.Scope {
+	if strings.Contains(scope, ".") {
+		return types.ScopeRegional
+	}
+
+	return types.ScopeCloudfront
+}
+
+// Returns the scope that the WAF resource with the given ARN is discovered in.
+// The ARNs of CloudFront resources contain us-east-1 but the resources are
+// global, e.g.
+// `arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4`
+func wafv2ARNScope(a *adapterhelpers.ARN) string {
+	if strings.HasPrefix(a.Resource, "global/") {
+		return adapterhelpers.FormatScope(a.AccountID, "")
+	}
+
+	return adapterhelpers.FormatScope(a.AccountID, a.Region)
+}
+
+// Parses the ARN of a WAF resource, checks that it is the expected type and in
+// the expected scope, and returns its name and ID. The resource part of the ARN
+// is in the format `{regional|global}/{type}/{name}/{id}`
+func parseWAFv2ARN(arn string, resourceType string, scope string) (*wafv2Summary, error) {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := strings.Split(a.Resource, "/")
+	if a.Service != "wafv2" || len(sections) != 4 || sections[1] != resourceType {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: fmt.Sprintf("%v is not the ARN of a WAF %v", arn, resourceType),
+		}
+	}
+
+	if arnScope := wafv2ARNScope(a); arnScope != scope {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		}
+	}
+
+	return &wafv2Summary{
+		Name: &sections[2],
+		Id:   &sections[3],
+	}, nil
+}
+
+// Lists all resources of a type using the given function, which returns one
+// page of summaries and the marker for the next page. None of the WAF APIs
+// have paginators
+func listWAFv2Summaries(ctx context.Context, listPage func(ctx context.Context, marker *string) ([]wafv2Summary, *string, error)) ([]wafv2Summary, error) {
+	summaries := make([]wafv2Summary, 0)
+	var marker *string
+
+	for {
+		page, next, err := listPage(ctx, marker)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, page...)
+
+		if next == nil || *next == "" {
+			return summaries, nil
+		}
+		marker = next
+	}
+}
+
+// Finds the summary of the resource with the given name
+func findWAFv2Summary(summaries []wafv2Summary, name string) (*wafv2Summary, error) {
+	for i := range summaries {
+		if summaries[i].Name != nil && *summaries[i].Name == name {
+			return &summaries[i], nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("%v not found", name),
+	}
+}
+
+// Gets every resource in the list of summaries
+func getWAFv2Summaries[T any](ctx context.Context, summaries []wafv2Summary, get func(ctx context.Context, summary wafv2Summary) (T, error)) ([]T, error) {
+	items := make([]T, 0, len(summaries))
+
+	for _, summary := range summaries {
+		item, err := get(ctx, summary)
+		if err != nil {
+			var notFound *types.WAFNonexistentItemException
+			if errors.As(err, &notFound) {
+				// Deleted since it was listed
+				continue
+			}
+
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func wafv2Tags(ctx context.Context, client wafv2Client, arn *string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &wafv2.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	if out.TagInfoForResource != nil {
+		for _, tag := range out.TagInfoForResource.TagList {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Links from the rules of a web ACL or rule group to the rule groups, IP sets
+// and regex pattern sets that they reference. Each referenced resource is only
+// linked once
+func wafv2RuleLinks(rules []types.Rule) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	seen := make(map[string]bool)
+
+	var walk func(statement *types.Statement)
+	walk = func(statement *types.Statement) {
+		if statement == nil {
+			return
+		}
+
+		var queryType string
+		var arn *string
+		switch {
+		case statement.RuleGroupReferenceStatement != nil:
+			queryType = "wafv2-rule-group"
+			arn = statement.RuleGroupReferenceStatement.ARN
+		case statement.IPSetReferenceStatement != nil:
+			queryType = "wafv2-ip-set"
+			arn = statement.IPSetReferenceStatement.ARN
+		case statement.RegexPatternSetReferenceStatement != nil:
+			queryType = "wafv2-regex-pattern-set"
+			arn = statement.RegexPatternSetReferenceStatement.ARN
+		}
+
+		if arn != nil && !seen[*arn] {
+			seen[*arn] = true
+
+			if a, err := adapterhelpers.ParseARN(*arn); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   queryType,
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *arn,
+						Scope:  wafv2ARNScope(a),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing what is referenced changes what the rule
+						// matches
+						In: true,
+						// The rule can't affect what it references
+						Out: false,
+					},
+				})
+			}
+		}
+
+		// Statements can be nested to any depth
+		if statement.AndStatement != nil {
+			for i := range statement.AndStatement.Statements {
+				walk(&statement.AndStatement.Statements[i])
+			}
+		}
+		if statement.OrStatement != nil {
+			for i := range statement.OrStatement.Statements {
+				walk(&statement.OrStatement.Statements[i])
+			}
+		}
+		if statement.NotStatement != nil {
+			walk(statement.NotStatement.Statement)
+		}
+		if statement.RateBasedStatement != nil {
+			walk(statement.RateBasedStatement.ScopeDownStatement)
+		}
+		if statement.ManagedRuleGroupStatement != nil {
+			walk(statement.ManagedRuleGroupStatement.ScopeDownStatement)
+		}
+	}
+
+	for i := range rules {
+		walk(rules[i].Statement)
+	}
+
+	return links
+}
@@ -0,0 +1,285 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	testWAFv2RegionalACLARN   = "arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/api/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	testWAFv2CloudFrontACLARN = "arn:aws:wafv2:us-east-1:123456789012:global/webacl/edge/a1b2c3d4-5678-90ab-cdef-EXAMPLE22222"
+	testWAFv2RuleGroupARN     = "arn:aws:wafv2:eu-west-2:123456789012:regional/rulegroup/common/a1b2c3d4-5678-90ab-cdef-EXAMPLE33333"
+	testWAFv2IPSetARN         = "arn:aws:wafv2:eu-west-2:123456789012:regional/ipset/blocked/a1b2c3d4-5678-90ab-cdef-EXAMPLE44444"
+	testWAFv2RegexARN         = "arn:aws:wafv2:eu-west-2:123456789012:regional/regexpatternset/bots/a1b2c3d4-5678-90ab-cdef-EXAMPLE55555"
+	testWAFv2LoadBalancerARN  = "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/api/50dc6c495c0c9188"
+	testWAFv2StageARN         = "arn:aws:apigateway:eu-west-2::/restapis/abc123/stages/prod"
+	testWAFv2UserPoolARN      = "arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abc123"
+)
+
+// A web ACL rule that references the IP set, and the regex pattern set nested
+// inside a rate based rule
+var testWAFv2Rules = []types.Rule{
+	{
+		Name: aws.String("block-ips"),
+		Statement: &types.Statement{
+			IPSetReferenceStatement: &types.IPSetReferenceStatement{
+				ARN: aws.String(testWAFv2IPSetARN),
+			},
+		},
+	},
+	{
+		Name: aws.String("rate-limit-bots"),
+		Statement: &types.Statement{
+			RateBasedStatement: &types.RateBasedStatement{
+				Limit: aws.Int64(100),
+				ScopeDownStatement: &types.Statement{
+					NotStatement: &types.NotStatement{
+						Statement: &types.Statement{
+							RegexPatternSetReferenceStatement: &types.RegexPatternSetReferenceStatement{
+								ARN: aws.String(testWAFv2RegexARN),
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		// References the same IP set again
+		Name: aws.String("block-ips-again"),
+		Statement: &types.Statement{
+			IPSetReferenceStatement: &types.IPSetReferenceStatement{
+				ARN: aws.String(testWAFv2IPSetARN),
+			},
+		},
+	},
+}
+
+type testWAFv2Client struct{}
+
+func (t testWAFv2Client) GetIPSet(ctx context.Context, params *wafv2.GetIPSetInput, optFns ...func(*wafv2.Options)) (*wafv2.GetIPSetOutput, error) {
+	return &wafv2.GetIPSetOutput{
+		IPSet: &types.IPSet{
+			ARN:              aws.String(testWAFv2IPSetARN),
+			Name:             params.Name,
+			Id:               params.Id,
+			IPAddressVersion: types.IPAddressVersionIpv4,
+			Addresses:        []string{"192.0.2.0/24"},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) GetRegexPatternSet(ctx context.Context, params *wafv2.GetRegexPatternSetInput, optFns ...func(*wafv2.Options)) (*wafv2.GetRegexPatternSetOutput, error) {
+	return &wafv2.GetRegexPatternSetOutput{
+		RegexPatternSet: &types.RegexPatternSet{
+			ARN:  aws.String(testWAFv2RegexARN),
+			Name: params.Name,
+			Id:   params.Id,
+			RegularExpressionList: []types.Regex{
+				{RegexString: aws.String("(?i)bot")},
+			},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) GetRuleGroup(ctx context.Context, params *wafv2.GetRuleGroupInput, optFns ...func(*wafv2.Options)) (*wafv2.GetRuleGroupOutput, error) {
+	return &wafv2.GetRuleGroupOutput{
+		RuleGroup: &types.RuleGroup{
+			ARN:      aws.String(testWAFv2RuleGroupARN),
+			Name:     params.Name,
+			Id:       params.Id,
+			Capacity: aws.Int64(50),
+			Rules:    testWAFv2Rules[:2],
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) GetWebACL(ctx context.Context, params *wafv2.GetWebACLInput, optFns ...func(*wafv2.Options)) (*wafv2.GetWebACLOutput, error) {
+	arn := testWAFv2RegionalACLARN
+	if params.Scope == types.ScopeCloudfront {
+		arn = testWAFv2CloudFrontACLARN
+	}
+
+	return &wafv2.GetWebACLOutput{
+		WebACL: &types.WebACL{
+			ARN:  aws.String(arn),
+			Name: params.Name,
+			Id:   params.Id,
+			DefaultAction: &types.DefaultAction{
+				Allow: &types.AllowAction{},
+			},
+			Rules: append([]types.Rule{
+				{
+					Name: aws.String("common"),
+					Statement: &types.Statement{
+						RuleGroupReferenceStatement: &types.RuleGroupReferenceStatement{
+							ARN: aws.String(testWAFv2RuleGroupARN),
+						},
+					},
+				},
+			}, testWAFv2Rules...),
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) GetWebACLForResource(ctx context.Context, params *wafv2.GetWebACLForResourceInput, optFns ...func(*wafv2.Options)) (*wafv2.GetWebACLForResourceOutput, error) {
+	if *params.ResourceArn != testWAFv2LoadBalancerARN {
+		return &wafv2.GetWebACLForResourceOutput{}, nil
+	}
+
+	return &wafv2.GetWebACLForResourceOutput{
+		WebACL: &types.WebACL{
+			ARN:  aws.String(testWAFv2RegionalACLARN),
+			Name: aws.String("api"),
+			Id:   aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) ListIPSets(ctx context.Context, params *wafv2.ListIPSetsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListIPSetsOutput, error) {
+	return &wafv2.ListIPSetsOutput{
+		IPSets: []types.IPSetSummary{
+			{Name: aws.String("blocked"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE44444")},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) ListRegexPatternSets(ctx context.Context, params *wafv2.ListRegexPatternSetsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListRegexPatternSetsOutput, error) {
+	return &wafv2.ListRegexPatternSetsOutput{
+		RegexPatternSets: []types.RegexPatternSetSummary{
+			{Name: aws.String("bots"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE55555")},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) ListResourcesForWebACL(ctx context.Context, params *wafv2.ListResourcesForWebACLInput, optFns ...func(*wafv2.Options)) (*wafv2.ListResourcesForWebACLOutput, error) {
+	switch params.ResourceType {
+	case types.ResourceTypeApplicationLoadBalancer:
+		return &wafv2.ListResourcesForWebACLOutput{
+			ResourceArns: []string{testWAFv2LoadBalancerARN},
+		}, nil
+	case types.ResourceTypeApiGateway:
+		return &wafv2.ListResourcesForWebACLOutput{
+			ResourceArns: []string{testWAFv2StageARN},
+		}, nil
+	case types.ResourceTypeCognitioUserPool:
+		return &wafv2.ListResourcesForWebACLOutput{
+			ResourceArns: []string{testWAFv2UserPoolARN},
+		}, nil
+	}
+
+	return &wafv2.ListResourcesForWebACLOutput{}, nil
+}
+
+func (t testWAFv2Client) ListRuleGroups(ctx context.Context, params *wafv2.ListRuleGroupsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListRuleGroupsOutput, error) {
+	return &wafv2.ListRuleGroupsOutput{
+		RuleGroups: []types.RuleGroupSummary{
+			{Name: aws.String("common"), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE33333")},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) ListTagsForResource(ctx context.Context, params *wafv2.ListTagsForResourceInput, optFns ...func(*wafv2.Options)) (*wafv2.ListTagsForResourceOutput, error) {
+	return &wafv2.ListTagsForResourceOutput{
+		TagInfoForResource: &types.TagInfoForResource{
+			ResourceARN: params.ResourceARN,
+			TagList: []types.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+			},
+		},
+	}, nil
+}
+
+func (t testWAFv2Client) ListWebACLs(ctx context.Context, params *wafv2.ListWebACLsInput, optFns ...func(*wafv2.Options)) (*wafv2.ListWebACLsOutput, error) {
+	// The first page only contains a marker, to check that we follow it
+	if params.NextMarker == nil {
+		return &wafv2.ListWebACLsOutput{
+			NextMarker: aws.String("page2"),
+		}, nil
+	}
+
+	name := "api"
+	if params.Scope == types.ScopeCloudfront {
+		name = "edge"
+	}
+
+	return &wafv2.ListWebACLsOutput{
+		WebACLs: []types.WebACLSummary{
+			{Name: aws.String(name), Id: aws.String("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111")},
+		},
+	}, nil
+}
+
+func TestParseWAFv2ARN(t *testing.T) {
+	t.Run("regional", func(t *testing.T) {
+		summary, err := parseWAFv2ARN(testWAFv2IPSetARN, "ipset", "123456789012.eu-west-2")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *summary.Name != "blocked" || *summary.Id != "a1b2c3d4-5678-90ab-cdef-EXAMPLE44444" {
+			t.Errorf("unexpected summary %v/%v", *summary.Name, *summary.Id)
+		}
+	})
+
+	t.Run("cloudfront", func(t *testing.T) {
+		summary, err := parseWAFv2ARN(testWAFv2CloudFrontACLARN, "webacl", "123456789012")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *summary.Name != "edge" {
+			t.Errorf("expected name edge, got %v", *summary.Name)
+		}
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		if _, err := parseWAFv2ARN(testWAFv2IPSetARN, "webacl", "123456789012.eu-west-2"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("wrong scope", func(t *testing.T) {
+		_, err := parseWAFv2ARN(testWAFv2CloudFrontACLARN, "webacl", "123456789012.us-east-1")
+
+		var queryErr *sdp.QueryError
+		if !errors.As(err, &queryErr) || queryErr.GetErrorType() != sdp.QueryError_NOSCOPE {
+			t.Errorf("expected a NOSCOPE error, got %v", err)
+		}
+	})
+}
+
+func TestWAFv2RuleLinks(t *testing.T) {
+	item := &sdp.Item{
+		LinkedItemQueries: wafv2RuleLinks(testWAFv2Rules),
+	}
+
+	if len(item.GetLinkedItemQueries()) != 2 {
+		t.Errorf("expected each referenced resource to be linked once, got %v links", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "wafv2-ip-set",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2IPSetARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "wafv2-regex-pattern-set",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  testWAFv2RegexARN,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
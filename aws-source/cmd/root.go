@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/getsentry/sentry-go"
 	"github.com/overmindtech/cli/aws-source/proc"
 	"github.com/overmindtech/cli/discovery"
@@ -41,9 +42,20 @@ var rootCmd = &cobra.Command{
 			TargetRoleARN:   viper.GetString("aws-target-role-arn"),
 			Profile:         viper.GetString("aws-profile"),
 			AutoConfig:      viper.GetBool("auto-config"),
+			Organizations: proc.OrganizationsConfig{
+				RoleName:              viper.GetString("aws-organizations-role-name"),
+				OrganizationalUnitIDs: viper.GetStringSlice("aws-organizations-ou-ids"),
+				RefreshInterval:       viper.GetDuration("aws-organizations-refresh-interval"),
+			},
 		}
 
-		err := viper.UnmarshalKey("aws-regions", &awsAuthConfig.Regions)
+		orgTags, err := parseOrganizationTags(viper.GetStringSlice("aws-organizations-tags"))
+		if err != nil {
+			log.WithError(err).Fatal("Could not parse aws-organizations-tags")
+		}
+		awsAuthConfig.Organizations.Tags = orgTags
+
+		err = viper.UnmarshalKey("aws-regions", &awsAuthConfig.Regions)
 		if err != nil {
 			log.WithError(err).Fatal("Could not parse aws-regions")
 		}
@@ -62,6 +74,14 @@ var rootCmd = &cobra.Command{
 			"auto-config":         awsAuthConfig.AutoConfig,
 			"health-check-port":   healthCheckPort,
 		}).Info("Got config")
+		if awsAuthConfig.Strategy == "organizations" {
+			log.WithFields(log.Fields{
+				"aws-organizations-role-name":        awsAuthConfig.Organizations.RoleName,
+				"aws-organizations-ou-ids":           awsAuthConfig.Organizations.OrganizationalUnitIDs,
+				"aws-organizations-tags":             awsAuthConfig.Organizations.Tags,
+				"aws-organizations-refresh-interval": awsAuthConfig.Organizations.RefreshInterval,
+			}).Info("Got organizations config")
+		}
 
 		err = engineConfig.CreateClients()
 		if err != nil {
@@ -72,9 +92,45 @@ var rootCmd = &cobra.Command{
 		rateLimitContext, rateLimitCancel := context.WithCancel(context.Background())
 		defer rateLimitCancel()
 
-		configs, err := proc.CreateAWSConfigs(awsAuthConfig)
+		var configs []aws.Config
+		var orgDiscovery *proc.OrganizationDiscovery
+		var orgAccounts []proc.OrganizationAccount
+		if awsAuthConfig.Strategy == "organizations" {
+			orgDiscovery, err = proc.NewOrganizationDiscovery(awsAuthConfig)
+			if err != nil {
+				log.WithError(err).Fatal("Could not create organization discovery")
+			}
+
+			accounts, err := orgDiscovery.Accounts(rateLimitContext)
+			if err != nil {
+				log.WithError(err).Fatal("Could not list organization accounts")
+			}
+			orgAccounts = orgDiscovery.AccessibleAccounts(rateLimitContext, accounts)
+			if len(orgAccounts) == 0 {
+				log.WithField("accounts", len(accounts)).Fatal("Could not assume the role in any organization account")
+			}
+
+			log.WithFields(log.Fields{
+				"accounts":   len(accounts),
+				"accessible": len(orgAccounts),
+			}).Info("Discovered organization accounts")
+
+			configs = orgDiscovery.ConfigsForAccounts(orgAccounts)
+		} else {
+			configs, err = proc.CreateAWSConfigs(awsAuthConfig)
+			if err != nil {
+				log.WithError(err).Fatal("Could not create AWS configs")
+			}
+		}
+
+		costs, err := proc.EnableCostEstimates(rateLimitContext, proc.CostConfig{
+			Enabled:       viper.GetBool("cost-estimates"),
+			PriceListPath: viper.GetString("cost-price-list-path"),
+			Offline:       viper.GetBool("cost-offline"),
+			SpendTagKey:   viper.GetString("cost-spend-tag-key"),
+		}, configs...)
 		if err != nil {
-			log.WithError(err).Fatal("Could not create AWS configs")
+			log.WithError(err).Fatal("Could not enable cost estimates")
 		}
 
 		// Initialize the engine
@@ -88,6 +144,37 @@ var rootCmd = &cobra.Command{
 			log.WithError(err).Fatal("Could not initialize AWS source")
 		}
 
+		var eksDiscovery *proc.EKSDiscovery
+		if viper.GetBool("eks-discovery") {
+			eksDiscovery = proc.NewEKSDiscovery(proc.EKSConfig{
+				RefreshInterval: viper.GetDuration("eks-refresh-interval"),
+				RateLimitQPS:    float32(viper.GetFloat64("eks-rate-limit-qps")),
+				RateLimitBurst:  viper.GetInt("eks-rate-limit-burst"),
+			})
+
+			// Clusters that can't be discovered shouldn't stop the rest of
+			// the source from working
+			err = eksDiscovery.AddAccounts(rateLimitContext, configs...)
+			if err != nil {
+				log.WithError(err).Error("Could not add accounts for EKS discovery")
+			}
+
+			err = eksDiscovery.Refresh(rateLimitContext, e)
+			if err != nil {
+				log.WithError(err).Error("Could not discover EKS clusters")
+			}
+
+			// Pick up clusters and namespaces that are created or deleted
+			go eksDiscovery.Watch(rateLimitContext, e)
+		}
+
+		if orgDiscovery != nil {
+			// Pick up accounts that join or leave the organization
+			orgDiscovery.Costs = costs
+			orgDiscovery.EKS = eksDiscovery
+			go orgDiscovery.WatchAccounts(rateLimitContext, e, orgAccounts)
+		}
+
 		// Start HTTP server for status
 		healthCheckPath := "/healthz"
 
@@ -181,15 +268,27 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log", "info", "Set the log level. Valid values: panic, fatal, error, warn, info, debug, trace")
 
 	// Custom flags for this source
-	rootCmd.PersistentFlags().String("aws-access-strategy", "defaults", "The strategy to use to access this customer's AWS account. Valid values: 'access-key', 'external-id', 'sso-profile', 'defaults'. Default: 'defaults'.")
+	rootCmd.PersistentFlags().String("aws-access-strategy", "defaults", "The strategy to use to access this customer's AWS account. Valid values: 'access-key', 'external-id', 'sso-profile', 'organizations', 'defaults'. Default: 'defaults'.")
 	rootCmd.PersistentFlags().String("aws-access-key-id", "", "The ID of the access key to use")
 	rootCmd.PersistentFlags().String("aws-secret-access-key", "", "The secret access key to use for auth")
-	rootCmd.PersistentFlags().String("aws-external-id", "", "The external ID to use when assuming the customer's role")
+	rootCmd.PersistentFlags().String("aws-external-id", "", "The external ID to use when assuming the customer's role, or the role in each account when using the 'organizations' strategy")
 	rootCmd.PersistentFlags().String("aws-target-role-arn", "", "The role to assume in the customer's account")
 	rootCmd.PersistentFlags().String("aws-profile", "", "The AWS SSO Profile to use. Defaults to $AWS_PROFILE, then whatever the AWS SDK's SSO config defaults to")
 	rootCmd.PersistentFlags().String("aws-regions", "", "Comma-separated list of AWS regions that this source should operate in")
+	rootCmd.PersistentFlags().String("aws-organizations-role-name", "", "The name of the role to assume in each account of the organization when using the 'organizations' strategy")
+	rootCmd.PersistentFlags().StringSlice("aws-organizations-ou-ids", []string{}, "Comma-separated list of organizational units. When using the 'organizations' strategy only accounts in these OUs or their children are discovered")
+	rootCmd.PersistentFlags().StringSlice("aws-organizations-tags", []string{}, "Comma-separated list of key=value tags. When using the 'organizations' strategy only accounts with all of these tags are discovered")
+	rootCmd.PersistentFlags().Duration("aws-organizations-refresh-interval", proc.DefaultOrganizationRefreshInterval, "How often to refresh the list of accounts when using the 'organizations' strategy")
 	rootCmd.PersistentFlags().BoolP("auto-config", "a", false, "Use the local AWS config, the same as the AWS CLI could use. This can be set up with \"aws configure\"")
 	rootCmd.PersistentFlags().IntP("health-check-port", "", 8080, "The port that the health check should run on")
+	rootCmd.PersistentFlags().Bool("cost-estimates", false, "Add the estimated hourly and monthly cost to items such as EC2 instances, EBS volumes, RDS instances, NAT gateways and load balancers")
+	rootCmd.PersistentFlags().String("cost-price-list-path", "", "The file that prices from the AWS Pricing API are cached in, so that they can be reused offline. If this is not set prices are only cached in memory")
+	rootCmd.PersistentFlags().Bool("cost-offline", false, "Only use the built in and cached prices for cost estimates, without calling the AWS Pricing API")
+	rootCmd.PersistentFlags().String("cost-spend-tag-key", "", "If set, items with this tag also get the spend over the last 30 days that Cost Explorer reports for the value of the tag. The tag must be activated as a cost allocation tag")
+	rootCmd.PersistentFlags().Bool("eks-discovery", false, "Discover the Kubernetes resources inside EKS clusters, using IAM to authenticate with each cluster. The principal needs an access entry in the clusters")
+	rootCmd.PersistentFlags().Duration("eks-refresh-interval", proc.DefaultEKSRefreshInterval, "How often to refresh the EKS clusters and their namespaces when using 'eks-discovery'")
+	rootCmd.PersistentFlags().Float32("eks-rate-limit-qps", 10.0, "The maximum sustained queries per second to the API of each EKS cluster")
+	rootCmd.PersistentFlags().Int("eks-rate-limit-burst", 30, "The maximum burst of queries to the API of each EKS cluster")
 
 	// tracing
 	rootCmd.PersistentFlags().String("honeycomb-api-key", "", "If specified, configures opentelemetry libraries to submit traces to honeycomb")
@@ -233,6 +332,19 @@ func init() {
 	}
 }
 
+// parseOrganizationTags parses a list of key=value tags
+func parseOrganizationTags(tags []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid tag format: %s", tag)
+		}
+		parsed[key] = value
+	}
+	return parsed, nil
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	viper.SetConfigFile(cfgFile)
@@ -0,0 +1,232 @@
+// Package cost estimates what AWS resources cost to run. Estimates are based
+// on on-demand list prices, which come from a local price list or the AWS
+// Pricing API, and optionally on the historical spend that Cost Explorer
+// reports for the tags of a resource. Estimates don't include discounts,
+// savings plans, data transfer or usage-based charges, so they are a guide to
+// the size of a change rather than a bill
+package cost
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// HoursPerMonth is the number of hours that AWS uses to convert hourly prices
+// to monthly prices
+const HoursPerMonth = 730
+
+// Product is a kind of resource that can be priced
+type Product string
+
+const (
+	// An EC2 instance running Linux with shared tenancy, priced per hour
+	ProductEC2Instance Product = "ec2-instance"
+	// An EBS volume, priced per GB-month
+	ProductEBSVolume Product = "ebs-volume"
+	// An RDS database instance, priced per hour
+	ProductRDSInstance Product = "rds-instance"
+	// The storage allocated to an RDS database instance, priced per GB-month
+	ProductRDSStorage Product = "rds-storage"
+	// A NAT gateway, priced per hour
+	ProductNATGateway Product = "nat-gateway"
+	// An application, network, gateway or classic load balancer, priced per
+	// hour
+	ProductLoadBalancer Product = "load-balancer"
+)
+
+// ErrNoPrice is returned when there is no price for a resource, for example
+// because the price list is offline and doesn't include it
+var ErrNoPrice = errors.New("no price found")
+
+// PriceKey identifies a single price. Which fields are used depends on the
+// product
+type PriceKey struct {
+	Product Product
+	Region  string
+
+	// The instance type, instance class, volume type, storage type or load
+	// balancer type
+	Type string
+
+	// The database engine, e.g. "postgres"
+	Engine string
+
+	// Whether the database is deployed in multiple availability zones
+	MultiAZ bool
+}
+
+// String returns the key in the format that is used in price lists
+func (k PriceKey) String() string {
+	parts := []string{k.Region, string(k.Product)}
+
+	if k.Engine != "" {
+		parts = append(parts, k.Engine)
+	}
+
+	if k.Type != "" {
+		parts = append(parts, k.Type)
+	}
+
+	if k.MultiAZ {
+		parts = append(parts, "multi-az")
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// Resource is the information that is needed to estimate the cost of a
+// resource. It is taken from the attributes of an item, or of a Terraform
+// resource
+type Resource struct {
+	Region string
+
+	// The product that is charged per hour, if any
+	Hourly *PriceKey
+
+	// The product that is charged per GB-month, if any, and how many GB are
+	// allocated
+	Storage   *PriceKey
+	StorageGB float64
+}
+
+// Estimate is the estimated cost of a resource in USD
+type Estimate struct {
+	Hourly  float64
+	Monthly float64
+}
+
+// Pricer returns the price of a single product. Hourly products are priced
+// per hour and storage products per GB-month
+type Pricer interface {
+	Price(key PriceKey) (float64, error)
+}
+
+// EstimateResource estimates the cost of a resource from its prices
+func EstimateResource(pricer Pricer, resource Resource) (*Estimate, error) {
+	if resource.Hourly == nil && resource.Storage == nil {
+		return nil, fmt.Errorf("%w: resource has nothing to price", ErrNoPrice)
+	}
+
+	var hourly float64
+
+	if resource.Hourly != nil {
+		price, err := pricer.Price(*resource.Hourly)
+		if err != nil {
+			return nil, err
+		}
+
+		hourly += price
+	}
+
+	if resource.Storage != nil && resource.StorageGB > 0 {
+		price, err := pricer.Price(*resource.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		hourly += price * resource.StorageGB / HoursPerMonth
+	}
+
+	return &Estimate{
+		Hourly:  round(hourly, 6),
+		Monthly: round(hourly*HoursPerMonth, 2),
+	}, nil
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+
+	return math.Round(value*factor) / factor
+}
+
+// RegionFromAvailabilityZone returns the region of an availability zone
+// name, e.g. "eu-west-2" for "eu-west-2a"
+func RegionFromAvailabilityZone(zone string) string {
+	if len(zone) < 2 {
+		return ""
+	}
+
+	last := zone[len(zone)-1]
+	if last < 'a' || last > 'z' {
+		return ""
+	}
+
+	return zone[:len(zone)-1]
+}
+
+// LoadBalancerType normalises the type of a load balancer to the names used
+// in price keys: "application", "network", "gateway" or "classic"
+func LoadBalancerType(lbType string) string {
+	switch strings.ToLower(lbType) {
+	case "", "application", "alb":
+		return "application"
+	case "network", "nlb":
+		return "network"
+	case "gateway", "gwlb":
+		return "gateway"
+	default:
+		return "classic"
+	}
+}
+
+// NewInstanceResource describes an EC2 instance
+func NewInstanceResource(region, instanceType string) Resource {
+	return Resource{
+		Region: region,
+		Hourly: &PriceKey{Product: ProductEC2Instance, Region: region, Type: instanceType},
+	}
+}
+
+// NewVolumeResource describes an EBS volume. AWS uses gp2 if the type isn't
+// set
+func NewVolumeResource(region, volumeType string, sizeGB float64) Resource {
+	if volumeType == "" {
+		volumeType = "gp2"
+	}
+
+	return Resource{
+		Region:    region,
+		Storage:   &PriceKey{Product: ProductEBSVolume, Region: region, Type: volumeType},
+		StorageGB: sizeGB,
+	}
+}
+
+// NewDBInstanceResource describes an RDS database instance. Aurora storage
+// is charged to the cluster by usage, so only the instance is priced for
+// Aurora engines
+func NewDBInstanceResource(region, instanceClass, engine string, multiAZ bool, storageType string, storageGB float64) Resource {
+	resource := Resource{
+		Region: region,
+		Hourly: &PriceKey{Product: ProductRDSInstance, Region: region, Type: instanceClass, Engine: engine, MultiAZ: multiAZ},
+	}
+
+	if !strings.HasPrefix(engine, "aurora") && storageGB > 0 {
+		if storageType == "" {
+			storageType = "gp2"
+		}
+
+		resource.Storage = &PriceKey{Product: ProductRDSStorage, Region: region, Type: storageType, MultiAZ: multiAZ}
+		resource.StorageGB = storageGB
+	}
+
+	return resource
+}
+
+// NewNATGatewayResource describes a NAT gateway
+func NewNATGatewayResource(region string) Resource {
+	return Resource{
+		Region: region,
+		Hourly: &PriceKey{Product: ProductNATGateway, Region: region},
+	}
+}
+
+// NewLoadBalancerResource describes a load balancer, see `LoadBalancerType()`
+// for the types
+func NewLoadBalancerResource(region, lbType string) Resource {
+	return Resource{
+		Region: region,
+		Hourly: &PriceKey{Product: ProductLoadBalancer, Region: region, Type: LoadBalancerType(lbType)},
+	}
+}
@@ -0,0 +1,200 @@
+package cost
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestPriceKeyString(t *testing.T) {
+	tests := []struct {
+		Key      PriceKey
+		Expected string
+	}{
+		{
+			Key:      PriceKey{Product: ProductEC2Instance, Region: "us-east-1", Type: "t3.micro"},
+			Expected: "us-east-1/ec2-instance/t3.micro",
+		},
+		{
+			Key:      PriceKey{Product: ProductRDSInstance, Region: "eu-west-2", Type: "db.t3.micro", Engine: "postgres", MultiAZ: true},
+			Expected: "eu-west-2/rds-instance/postgres/db.t3.micro/multi-az",
+		},
+		{
+			Key:      PriceKey{Product: ProductNATGateway, Region: "us-east-1"},
+			Expected: "us-east-1/nat-gateway",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.Key.String(); got != test.Expected {
+			t.Errorf("expected %v, got %v", test.Expected, got)
+		}
+	}
+}
+
+func TestBuiltinPrices(t *testing.T) {
+	var file priceListFile
+
+	if err := json.Unmarshal(builtinPrices, &file); err != nil {
+		t.Fatalf("built in prices are invalid: %v", err)
+	}
+
+	if len(file.Prices) == 0 {
+		t.Fatal("expected built in prices")
+	}
+
+	for key, price := range file.Prices {
+		if price <= 0 {
+			t.Errorf("expected a positive price for %v, got %v", key, price)
+		}
+	}
+}
+
+func TestEstimateResource(t *testing.T) {
+	list := NewPriceList()
+
+	t.Run("instance", func(t *testing.T) {
+		estimate, err := EstimateResource(list, NewInstanceResource("us-east-1", "t3.micro"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if estimate.Hourly != 0.0104 {
+			t.Errorf("expected hourly cost 0.0104, got %v", estimate.Hourly)
+		}
+
+		if estimate.Monthly != 7.59 {
+			t.Errorf("expected monthly cost 7.59, got %v", estimate.Monthly)
+		}
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		estimate, err := EstimateResource(list, NewVolumeResource("us-east-1", "gp3", 100))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if estimate.Monthly != 8 {
+			t.Errorf("expected monthly cost 8, got %v", estimate.Monthly)
+		}
+	})
+
+	t.Run("volume without type", func(t *testing.T) {
+		estimate, err := EstimateResource(list, NewVolumeResource("us-east-1", "", 10))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Priced as gp2
+		if estimate.Monthly != 1 {
+			t.Errorf("expected monthly cost 1, got %v", estimate.Monthly)
+		}
+	})
+
+	t.Run("database with storage", func(t *testing.T) {
+		estimate, err := EstimateResource(list, NewDBInstanceResource("us-east-1", "db.t3.micro", "postgres", false, "gp2", 20))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 0.018 * 730 + 0.115 * 20
+		if estimate.Monthly != 15.44 {
+			t.Errorf("expected monthly cost 15.44, got %v", estimate.Monthly)
+		}
+	})
+
+	t.Run("aurora database", func(t *testing.T) {
+		resource := NewDBInstanceResource("us-east-1", "db.r5.large", "aurora-postgresql", false, "aurora", 1)
+
+		if resource.Storage != nil {
+			t.Error("expected aurora storage not to be priced")
+		}
+
+		estimate, err := EstimateResource(list, resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if estimate.Monthly != 211.7 {
+			t.Errorf("expected monthly cost 211.7, got %v", estimate.Monthly)
+		}
+	})
+
+	t.Run("missing price", func(t *testing.T) {
+		_, err := EstimateResource(list, NewInstanceResource("eu-west-2", "t3.micro"))
+		if !errors.Is(err, ErrNoPrice) {
+			t.Errorf("expected ErrNoPrice, got %v", err)
+		}
+	})
+}
+
+func TestRegionFromAvailabilityZone(t *testing.T) {
+	tests := map[string]string{
+		"eu-west-2a": "eu-west-2",
+		"us-east-1f": "us-east-1",
+		"eu-west-2":  "",
+		"":           "",
+	}
+
+	for zone, expected := range tests {
+		if got := RegionFromAvailabilityZone(zone); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, zone, got)
+		}
+	}
+}
+
+func TestLoadBalancerType(t *testing.T) {
+	tests := map[string]string{
+		"":            "application",
+		"application": "application",
+		"network":     "network",
+		"gateway":     "gateway",
+		"classic":     "classic",
+	}
+
+	for lbType, expected := range tests {
+		if got := LoadBalancerType(lbType); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, lbType, got)
+		}
+	}
+}
+
+func TestPriceListCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "prices.json")
+
+	// A missing file is created when a price is set
+	list, err := LoadPriceList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := PriceKey{Product: ProductEC2Instance, Region: "eu-west-2", Type: "t3.micro"}
+
+	if _, err := list.Price(key); !errors.Is(err, ErrNoPrice) {
+		t.Errorf("expected ErrNoPrice, got %v", err)
+	}
+
+	if err := list.Set(key, 0.0118); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadPriceList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price, err := loaded.Price(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if price != 0.0118 {
+		t.Errorf("expected cached price 0.0118, got %v", price)
+	}
+
+	// The built in prices are still available
+	if _, err := loaded.Price(PriceKey{Product: ProductNATGateway, Region: "us-east-1"}); err != nil {
+		t.Error(err)
+	}
+}
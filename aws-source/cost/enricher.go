@@ -0,0 +1,254 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+const (
+	// EstimatedCostAttribute is the attribute that estimated costs are added
+	// to
+	EstimatedCostAttribute = "EstimatedCost"
+
+	// HistoricalSpendAttribute is the attribute that spend reported by Cost
+	// Explorer is added to
+	HistoricalSpendAttribute = "HistoricalSpend"
+)
+
+var (
+	numberSchema = &sdp.AttributeSchema{Type: sdp.SchemaTypes{"number"}}
+	stringSchema = &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}}
+
+	// EstimatedCostSchema is the attribute schema of `EstimatedCostAttribute`
+	EstimatedCostSchema = &sdp.AttributeSchema{
+		Type: sdp.SchemaTypes{"object"},
+		Properties: map[string]*sdp.AttributeSchema{
+			"HourlyUSD":  numberSchema,
+			"MonthlyUSD": numberSchema,
+		},
+	}
+
+	// HistoricalSpendSchema is the attribute schema of
+	// `HistoricalSpendAttribute`
+	HistoricalSpendSchema = &sdp.AttributeSchema{
+		Type: sdp.SchemaTypes{"object"},
+		Properties: map[string]*sdp.AttributeSchema{
+			"TagKey":   stringSchema,
+			"TagValue": stringSchema,
+			"Days":     {Type: sdp.SchemaTypes{"integer"}},
+			"USD":      numberSchema,
+		},
+	}
+)
+
+// Enricher adds cost attributes to items. It satisfies
+// `adapterhelpers.ItemEnricher` so that it can be registered with
+// `adapterhelpers.AddItemEnricher()`
+type Enricher struct {
+	// Prices resources. Required
+	Pricer Pricer
+
+	// Reports historical spend by tag, keyed by the ID of the account that
+	// the spend is reported for. Optional. Accounts that are discovered once
+	// the enricher is in use must be added with `AddSpend()`
+	Spend map[string]*TagSpend
+
+	spendMu sync.RWMutex
+}
+
+// AddSpend adds the spend of an account, replacing any existing spend for
+// that account. This is safe to call while items are being enriched
+func (e *Enricher) AddSpend(accountID string, spend *TagSpend) {
+	e.spendMu.Lock()
+	defer e.spendMu.Unlock()
+
+	if e.Spend == nil {
+		e.Spend = make(map[string]*TagSpend)
+	}
+
+	e.Spend[accountID] = spend
+}
+
+// AccountSpend returns the spend of an account, if there is one
+func (e *Enricher) AccountSpend(accountID string) (*TagSpend, bool) {
+	e.spendMu.RLock()
+	defer e.spendMu.RUnlock()
+
+	spend, ok := e.Spend[accountID]
+
+	return spend, ok
+}
+
+// contextPricer is implemented by pricers that make requests, so that the
+// requests can be cancelled along with the query
+type contextPricer interface {
+	PriceWithContext(ctx context.Context, key PriceKey) (float64, error)
+}
+
+type contextPricerAdapter struct {
+	ctx    context.Context
+	pricer contextPricer
+}
+
+func (p contextPricerAdapter) Price(key PriceKey) (float64, error) {
+	return p.pricer.PriceWithContext(p.ctx, key)
+}
+
+// Enrich adds the estimated cost of the resource to the item, and the
+// historical spend for the tag of the resource if spend is configured. Items
+// that can't be priced are left as they are. Failures are logged rather than
+// returned since cost is supplementary and shouldn't fail the query
+func (e *Enricher) Enrich(ctx context.Context, item *sdp.Item) {
+	if e == nil || item == nil || item.GetAttributes() == nil {
+		return
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(item.GetScope())
+	if err != nil {
+		return
+	}
+
+	if resource, ok := ResourceFromItem(item); ok && e.Pricer != nil {
+		pricer := e.Pricer
+		if cp, ok := e.Pricer.(contextPricer); ok {
+			pricer = contextPricerAdapter{ctx: ctx, pricer: cp}
+		}
+
+		estimate, err := EstimateResource(pricer, resource)
+
+		switch {
+		case err == nil:
+			_ = item.GetAttributes().Set(EstimatedCostAttribute, map[string]interface{}{
+				"HourlyUSD":  estimate.Hourly,
+				"MonthlyUSD": estimate.Monthly,
+			})
+		case errors.Is(err, ErrNoPrice):
+			// Nothing to add
+		default:
+			log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+				"type": item.GetType(),
+				"item": item.GloballyUniqueName(),
+			}).Warn("Could not estimate cost")
+		}
+	}
+
+	spend, ok := e.AccountSpend(accountID)
+	if !ok {
+		return
+	}
+
+	tagValue, ok := item.GetTags()[spend.TagKey()]
+	if !ok || tagValue == "" {
+		return
+	}
+
+	amount, err := spend.Spend(ctx, tagValue)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithFields(log.Fields{
+			"type": item.GetType(),
+			"item": item.GloballyUniqueName(),
+		}).Warn("Could not get historical spend")
+		return
+	}
+
+	_ = item.GetAttributes().Set(HistoricalSpendAttribute, map[string]interface{}{
+		"TagKey":   spend.TagKey(),
+		"TagValue": tagValue,
+		"Days":     int(SpendPeriod.Hours() / 24),
+		"USD":      amount,
+	})
+}
+
+// ResourceFromItem returns the resource that an item describes, if it is a
+// type that can be priced. Resources that aren't running, such as stopped
+// instances, aren't charged for and so return false
+func ResourceFromItem(item *sdp.Item) (Resource, bool) {
+	_, region, err := adapterhelpers.ParseScope(item.GetScope())
+	if err != nil || region == "" {
+		return Resource{}, false
+	}
+
+	attrs := item.GetAttributes()
+
+	switch item.GetType() {
+	case "ec2-instance":
+		switch stringAttribute(attrs, "State.Name") {
+		case "stopped", "stopping", "shutting-down", "terminated":
+			return Resource{}, false
+		}
+
+		instanceType := stringAttribute(attrs, "InstanceType")
+		if instanceType == "" {
+			return Resource{}, false
+		}
+
+		return NewInstanceResource(region, instanceType), true
+	case "ec2-volume":
+		size := numberAttribute(attrs, "Size")
+		if size <= 0 {
+			return Resource{}, false
+		}
+
+		return NewVolumeResource(region, stringAttribute(attrs, "VolumeType"), size), true
+	case "rds-db-instance":
+		if stringAttribute(attrs, "DBInstanceStatus") == "stopped" {
+			return Resource{}, false
+		}
+
+		instanceClass := stringAttribute(attrs, "DBInstanceClass")
+		if instanceClass == "" {
+			return Resource{}, false
+		}
+
+		multiAZ, _ := attributeValue(attrs, "MultiAZ").(bool)
+
+		return NewDBInstanceResource(
+			region,
+			instanceClass,
+			stringAttribute(attrs, "Engine"),
+			multiAZ,
+			stringAttribute(attrs, "StorageType"),
+			numberAttribute(attrs, "AllocatedStorage"),
+		), true
+	case "ec2-nat-gateway":
+		switch stringAttribute(attrs, "State") {
+		case "deleted", "failed":
+			return Resource{}, false
+		}
+
+		return NewNATGatewayResource(region), true
+	case "elbv2-load-balancer":
+		return NewLoadBalancerResource(region, stringAttribute(attrs, "Type")), true
+	case "elb-load-balancer":
+		return NewLoadBalancerResource(region, "classic"), true
+	}
+
+	return Resource{}, false
+}
+
+func attributeValue(attrs *sdp.ItemAttributes, name string) interface{} {
+	value, err := attrs.Get(name)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func stringAttribute(attrs *sdp.ItemAttributes, name string) string {
+	s, _ := attributeValue(attrs, name).(string)
+
+	return s
+}
+
+func numberAttribute(attrs *sdp.ItemAttributes, name string) float64 {
+	n, _ := attributeValue(attrs, name).(float64)
+
+	return n
+}
@@ -0,0 +1,214 @@
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+func newTestItem(t *testing.T, itemType string, scope string, attributes map[string]interface{}) *sdp.Item {
+	t.Helper()
+
+	attrs, err := sdp.ToAttributes(attributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &sdp.Item{
+		Type:       itemType,
+		Scope:      scope,
+		Attributes: attrs,
+	}
+}
+
+func TestResourceFromItem(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Type       string
+		Attributes map[string]interface{}
+		Expected   string
+		Priced     bool
+	}{
+		{
+			Name: "running instance",
+			Type: "ec2-instance",
+			Attributes: map[string]interface{}{
+				"InstanceType": "t3.micro",
+				"State":        map[string]interface{}{"Name": "running"},
+			},
+			Expected: "us-east-1/ec2-instance/t3.micro",
+			Priced:   true,
+		},
+		{
+			Name: "stopped instance",
+			Type: "ec2-instance",
+			Attributes: map[string]interface{}{
+				"InstanceType": "t3.micro",
+				"State":        map[string]interface{}{"Name": "stopped"},
+			},
+		},
+		{
+			Name: "volume",
+			Type: "ec2-volume",
+			Attributes: map[string]interface{}{
+				"VolumeType": "gp3",
+				"Size":       100,
+			},
+			Expected: "us-east-1/ebs-volume/gp3",
+			Priced:   true,
+		},
+		{
+			Name: "database",
+			Type: "rds-db-instance",
+			Attributes: map[string]interface{}{
+				"DBInstanceClass":  "db.t3.micro",
+				"Engine":           "mysql",
+				"MultiAZ":          true,
+				"AllocatedStorage": 20,
+			},
+			Expected: "us-east-1/rds-instance/mysql/db.t3.micro/multi-az",
+			Priced:   true,
+		},
+		{
+			Name: "nat gateway",
+			Type: "ec2-nat-gateway",
+			Attributes: map[string]interface{}{
+				"State": "available",
+			},
+			Expected: "us-east-1/nat-gateway",
+			Priced:   true,
+		},
+		{
+			Name: "network load balancer",
+			Type: "elbv2-load-balancer",
+			Attributes: map[string]interface{}{
+				"Type": "network",
+			},
+			Expected: "us-east-1/load-balancer/network",
+			Priced:   true,
+		},
+		{
+			Name: "classic load balancer",
+			Type: "elb-load-balancer",
+			Attributes: map[string]interface{}{
+				"LoadBalancerName": "web",
+			},
+			Expected: "us-east-1/load-balancer/classic",
+			Priced:   true,
+		},
+		{
+			Name: "unpriced type",
+			Type: "sqs-queue",
+			Attributes: map[string]interface{}{
+				"QueueUrl": "https://sqs.us-east-1.amazonaws.com/123456789012/queue",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			item := newTestItem(t, test.Type, "123456789012.us-east-1", test.Attributes)
+
+			resource, ok := ResourceFromItem(item)
+			if ok != test.Priced {
+				t.Fatalf("expected priced to be %v, got %v", test.Priced, ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			key := resource.Hourly
+			if key == nil {
+				key = resource.Storage
+			}
+
+			if key.String() != test.Expected {
+				t.Errorf("expected key %v, got %v", test.Expected, key)
+			}
+		})
+	}
+}
+
+func TestEnricher(t *testing.T) {
+	client := &testCostExplorerClient{}
+	enricher := &Enricher{
+		Pricer: NewPriceList(),
+		Spend: map[string]*TagSpend{
+			"123456789012": NewTagSpend(client, "team"),
+		},
+	}
+
+	t.Run("priced item", func(t *testing.T) {
+		item := newTestItem(t, "ec2-nat-gateway", "123456789012.us-east-1", map[string]interface{}{
+			"NatGatewayId": "nat-0123456789abcdef0",
+			"State":        "available",
+		})
+		item.Tags = map[string]string{"team": "payments"}
+
+		enricher.Enrich(context.Background(), item)
+
+		monthly, err := item.GetAttributes().Get(EstimatedCostAttribute + ".MonthlyUSD")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if monthly != 32.85 {
+			t.Errorf("expected monthly cost 32.85, got %v", monthly)
+		}
+
+		spend, err := item.GetAttributes().Get(HistoricalSpendAttribute + ".USD")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if spend != 42.6 {
+			t.Errorf("expected spend 42.6, got %v", spend)
+		}
+	})
+
+	t.Run("item without a price", func(t *testing.T) {
+		item := newTestItem(t, "ec2-instance", "123456789012.eu-west-2", map[string]interface{}{
+			"InstanceType": "t3.micro",
+		})
+
+		enricher.Enrich(context.Background(), item)
+
+		if _, err := item.GetAttributes().Get(EstimatedCostAttribute); err == nil {
+			t.Error("expected no estimated cost")
+		}
+
+		if _, err := item.GetAttributes().Get(HistoricalSpendAttribute); err == nil {
+			t.Error("expected no spend for an item without the tag")
+		}
+	})
+
+	t.Run("item in another account", func(t *testing.T) {
+		item := newTestItem(t, "sqs-queue", "210987654321.us-east-1", map[string]interface{}{
+			"QueueUrl": "https://sqs.us-east-1.amazonaws.com/210987654321/queue",
+		})
+		item.Tags = map[string]string{"team": "payments"}
+
+		enricher.Enrich(context.Background(), item)
+
+		if _, err := item.GetAttributes().Get(HistoricalSpendAttribute); err == nil {
+			t.Error("expected no spend for an account without Cost Explorer")
+		}
+	})
+
+	t.Run("item in an account that was added later", func(t *testing.T) {
+		enricher.AddSpend("210987654321", NewTagSpend(client, "team"))
+
+		item := newTestItem(t, "sqs-queue", "210987654321.us-east-1", map[string]interface{}{
+			"QueueUrl": "https://sqs.us-east-1.amazonaws.com/210987654321/queue",
+		})
+		item.Tags = map[string]string{"team": "payments"}
+
+		enricher.Enrich(context.Background(), item)
+
+		if _, err := item.GetAttributes().Get(HistoricalSpendAttribute); err != nil {
+			t.Error("expected spend for the added account")
+		}
+	})
+}
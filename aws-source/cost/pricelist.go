@@ -0,0 +1,147 @@
+package cost
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// The built in prices are on-demand prices in USD for common resources in
+// us-east-1. They allow estimates to be made offline, for example when
+// calculating the cost of a Terraform plan
+//
+//go:embed prices.json
+var builtinPrices []byte
+
+// priceListFile is the format that price lists are stored in
+type priceListFile struct {
+	Updated time.Time          `json:"updated"`
+	Prices  map[string]float64 `json:"prices"`
+}
+
+// PriceList is a set of prices that is kept locally so that estimates can be
+// made without calling the Pricing API. If the list has a path, prices are
+// loaded from it and saved back to it when they change
+type PriceList struct {
+	path string
+
+	mu      sync.RWMutex
+	prices  map[string]float64
+	updated time.Time
+}
+
+// NewPriceList returns a price list that contains the built in prices
+func NewPriceList() *PriceList {
+	l := &PriceList{
+		prices: make(map[string]float64),
+	}
+
+	// The built in prices are checked by the tests so this can't fail
+	_ = l.merge(builtinPrices)
+
+	return l
+}
+
+// LoadPriceList returns a price list that contains the built in prices and
+// the prices cached at the path. A missing file isn't an error since it will
+// be created when the list is saved
+func LoadPriceList(path string) (*PriceList, error) {
+	l := NewPriceList()
+	l.path = path
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return l, nil
+		}
+
+		return nil, fmt.Errorf("could not read price list %v: %w", path, err)
+	}
+
+	if err := l.merge(data); err != nil {
+		return nil, fmt.Errorf("could not parse price list %v: %w", path, err)
+	}
+
+	return l, nil
+}
+
+func (l *PriceList) merge(data []byte) error {
+	var file priceListFile
+
+	if err := json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, price := range file.Prices {
+		l.prices[key] = price
+	}
+
+	if file.Updated.After(l.updated) {
+		l.updated = file.Updated
+	}
+
+	return nil
+}
+
+// Price returns the price from the list, or ErrNoPrice if it isn't in the
+// list
+func (l *PriceList) Price(key PriceKey) (float64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	price, ok := l.prices[key.String()]
+	if !ok {
+		return 0, fmt.Errorf("%w for %v", ErrNoPrice, key)
+	}
+
+	return price, nil
+}
+
+// Set adds a price to the list and saves the list if it has a path
+func (l *PriceList) Set(key PriceKey, price float64) error {
+	l.mu.Lock()
+	l.prices[key.String()] = price
+	l.updated = time.Now()
+	l.mu.Unlock()
+
+	if l.path == "" {
+		return nil
+	}
+
+	return l.Save(l.path)
+}
+
+// Save writes the list to a file, which can be loaded with `LoadPriceList()`
+func (l *PriceList) Save(path string) error {
+	l.mu.RLock()
+	data, err := json.MarshalIndent(priceListFile{
+		Updated: l.updated,
+		Prices:  l.prices,
+	}, "", "  ")
+	l.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create directory for price list: %w", err)
+	}
+
+	// Write to a temporary file first so that a failed write doesn't leave a
+	// corrupt list behind
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write price list: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,260 @@
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/pricing/types"
+)
+
+// PricingRegion is the region that the Pricing API is called in. The API is
+// only available in a few regions but returns prices for all of them
+const PricingRegion = "us-east-1"
+
+type pricingClient interface {
+	GetProducts(ctx context.Context, params *pricing.GetProductsInput, optFns ...func(*pricing.Options)) (*pricing.GetProductsOutput, error)
+}
+
+// APIPricer looks up prices with the AWS Pricing API. Prices are stored in a
+// price list, which is checked before calling the API and is saved to disk if
+// it has a path, so each price is only requested once
+type APIPricer struct {
+	client pricingClient
+	list   *PriceList
+
+	// Prices that the API doesn't have, so that they aren't requested again
+	mu      sync.Mutex
+	missing map[string]bool
+}
+
+// NewAPIPricer returns a pricer that uses the Pricing API for prices that
+// aren't in the list
+func NewAPIPricer(client pricingClient, list *PriceList) *APIPricer {
+	return &APIPricer{
+		client:  client,
+		list:    list,
+		missing: make(map[string]bool),
+	}
+}
+
+// Price returns the price from the list, or from the Pricing API if it isn't
+// in the list
+func (p *APIPricer) Price(key PriceKey) (float64, error) {
+	// The Pricer interface doesn't take a context since the price list
+	// doesn't need one
+	return p.PriceWithContext(context.Background(), key)
+}
+
+// PriceWithContext is the same as `Price()` but uses the context for calls
+// to the Pricing API
+func (p *APIPricer) PriceWithContext(ctx context.Context, key PriceKey) (float64, error) {
+	price, err := p.list.Price(key)
+	if err == nil {
+		return price, nil
+	}
+
+	p.mu.Lock()
+	missing := p.missing[key.String()]
+	p.mu.Unlock()
+
+	if missing {
+		return 0, fmt.Errorf("%w for %v", ErrNoPrice, key)
+	}
+
+	price, err = p.lookup(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrNoPrice) {
+			p.mu.Lock()
+			p.missing[key.String()] = true
+			p.mu.Unlock()
+		}
+
+		return 0, err
+	}
+
+	// Failing to save the cache doesn't affect the price
+	_ = p.list.Set(key, price)
+
+	return price, nil
+}
+
+func (p *APIPricer) lookup(ctx context.Context, key PriceKey) (float64, error) {
+	input, unit, err := productsInput(key)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := p.client.GetProducts(ctx, input)
+	if err != nil {
+		return 0, fmt.Errorf("could not get price for %v: %w", key, err)
+	}
+
+	for _, product := range out.PriceList {
+		if price, ok := onDemandPrice(product, unit); ok {
+			return price, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w for %v", ErrNoPrice, key)
+}
+
+// rdsEngines maps RDS engine names to the names used by the Pricing API.
+// Oracle and SQL Server aren't included since their price depends on the
+// licence as well
+var rdsEngines = map[string]string{
+	"mysql":             "MySQL",
+	"postgres":          "PostgreSQL",
+	"mariadb":           "MariaDB",
+	"aurora-mysql":      "Aurora MySQL",
+	"aurora-postgresql": "Aurora PostgreSQL",
+}
+
+// rdsStorageTypes maps RDS storage types to the volume types used by the
+// Pricing API
+var rdsStorageTypes = map[string]string{
+	"gp2":      "General Purpose",
+	"gp3":      "General Purpose-GP3",
+	"io1":      "Provisioned IOPS",
+	"io2":      "Provisioned IOPS-IO2",
+	"standard": "Magnetic",
+}
+
+// loadBalancerFamilies maps load balancer types to the product families used
+// by the Pricing API
+var loadBalancerFamilies = map[string]string{
+	"application": "Load Balancer-Application",
+	"network":     "Load Balancer-Network",
+	"gateway":     "Load Balancer-Gateway",
+	"classic":     "Load Balancer",
+}
+
+// Returns the request that finds the product for a key, and the unit that the
+// product is priced in
+func productsInput(key PriceKey) (*pricing.GetProductsInput, string, error) {
+	filters := map[string]string{
+		"regionCode": key.Region,
+	}
+
+	var serviceCode, unit string
+
+	deployment := "Single-AZ"
+	if key.MultiAZ {
+		deployment = "Multi-AZ"
+	}
+
+	switch key.Product {
+	case ProductEC2Instance:
+		serviceCode, unit = "AmazonEC2", "Hrs"
+		filters["instanceType"] = key.Type
+		filters["operatingSystem"] = "Linux"
+		filters["tenancy"] = "Shared"
+		filters["preInstalledSw"] = "NA"
+		filters["capacitystatus"] = "Used"
+	case ProductEBSVolume:
+		serviceCode, unit = "AmazonEC2", "GB-Mo"
+		filters["productFamily"] = "Storage"
+		filters["volumeApiName"] = key.Type
+	case ProductRDSInstance:
+		engine, ok := rdsEngines[key.Engine]
+		if !ok {
+			return nil, "", fmt.Errorf("%w for RDS engine %v", ErrNoPrice, key.Engine)
+		}
+
+		serviceCode, unit = "AmazonRDS", "Hrs"
+		filters["instanceType"] = key.Type
+		filters["databaseEngine"] = engine
+		filters["deploymentOption"] = deployment
+	case ProductRDSStorage:
+		volumeType, ok := rdsStorageTypes[key.Type]
+		if !ok {
+			return nil, "", fmt.Errorf("%w for RDS storage type %v", ErrNoPrice, key.Type)
+		}
+
+		serviceCode, unit = "AmazonRDS", "GB-Mo"
+		filters["productFamily"] = "Database Storage"
+		filters["volumeType"] = volumeType
+		filters["deploymentOption"] = deployment
+	case ProductNATGateway:
+		serviceCode, unit = "AmazonEC2", "Hrs"
+		filters["productFamily"] = "NAT Gateway"
+	case ProductLoadBalancer:
+		family, ok := loadBalancerFamilies[key.Type]
+		if !ok {
+			return nil, "", fmt.Errorf("%w for load balancer type %v", ErrNoPrice, key.Type)
+		}
+
+		serviceCode, unit = "AWSELB", "Hrs"
+		filters["productFamily"] = family
+	default:
+		return nil, "", fmt.Errorf("%w for product %v", ErrNoPrice, key.Product)
+	}
+
+	input := &pricing.GetProductsInput{
+		ServiceCode:   &serviceCode,
+		FormatVersion: aws.String("aws_v1"),
+		MaxResults:    aws.Int32(10),
+	}
+
+	for field, value := range filters {
+		input.Filters = append(input.Filters, types.Filter{
+			Field: aws.String(field),
+			Type:  types.FilterTypeTermMatch,
+			Value: aws.String(value),
+		})
+	}
+
+	return input, unit, nil
+}
+
+// pricingProduct is the part of a product in the Pricing API's price list
+// that contains the on-demand prices
+type pricingProduct struct {
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				BeginRange   string            `json:"beginRange"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// Returns the on-demand price in USD of a product from the Pricing API for
+// the first usage tier in the unit. Dimensions with other units, such as the
+// per-GB charge for a NAT gateway, are ignored
+func onDemandPrice(product string, unit string) (float64, bool) {
+	var p pricingProduct
+
+	if err := json.Unmarshal([]byte(product), &p); err != nil {
+		return 0, false
+	}
+
+	for _, term := range p.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			if !strings.EqualFold(dimension.Unit, unit) {
+				continue
+			}
+
+			if dimension.BeginRange != "" && dimension.BeginRange != "0" {
+				continue
+			}
+
+			price, err := strconv.ParseFloat(dimension.PricePerUnit["USD"], 64)
+			if err != nil || price <= 0 {
+				continue
+			}
+
+			return price, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,192 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+)
+
+type testPricingClient struct {
+	calls   int
+	filters map[string]string
+	prices  []string
+}
+
+func (c *testPricingClient) GetProducts(ctx context.Context, params *pricing.GetProductsInput, optFns ...func(*pricing.Options)) (*pricing.GetProductsOutput, error) {
+	c.calls++
+	c.filters = make(map[string]string)
+
+	for _, filter := range params.Filters {
+		c.filters[*filter.Field] = *filter.Value
+	}
+
+	return &pricing.GetProductsOutput{
+		PriceList: c.prices,
+	}, nil
+}
+
+const testNATGatewayProduct = `{
+	"product": {
+		"productFamily": "NAT Gateway",
+		"attributes": {"regionCode": "eu-west-2", "usagetype": "EUW2-NatGateway-Hours"}
+	},
+	"terms": {
+		"OnDemand": {
+			"ABC.JRTCKXETXF": {
+				"priceDimensions": {
+					"ABC.JRTCKXETXF.6YS6EN2CT7": {
+						"unit": "Hrs",
+						"beginRange": "0",
+						"endRange": "Inf",
+						"pricePerUnit": {"USD": "0.0500000000"}
+					}
+				}
+			}
+		}
+	}
+}`
+
+const testNATGatewayBytesProduct = `{
+	"product": {
+		"productFamily": "NAT Gateway",
+		"attributes": {"regionCode": "eu-west-2", "usagetype": "EUW2-NatGateway-Bytes"}
+	},
+	"terms": {
+		"OnDemand": {
+			"DEF.JRTCKXETXF": {
+				"priceDimensions": {
+					"DEF.JRTCKXETXF.6YS6EN2CT7": {
+						"unit": "GB",
+						"beginRange": "0",
+						"endRange": "Inf",
+						"pricePerUnit": {"USD": "0.0500000000"}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestAPIPricer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+
+	list, err := LoadPriceList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &testPricingClient{
+		prices: []string{testNATGatewayBytesProduct, testNATGatewayProduct},
+	}
+
+	pricer := NewAPIPricer(client, list)
+	key := PriceKey{Product: ProductNATGateway, Region: "eu-west-2"}
+
+	price, err := pricer.Price(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if price != 0.05 {
+		t.Errorf("expected price 0.05, got %v", price)
+	}
+
+	if client.filters["regionCode"] != "eu-west-2" {
+		t.Errorf("expected regionCode filter eu-west-2, got %v", client.filters["regionCode"])
+	}
+
+	if client.filters["productFamily"] != "NAT Gateway" {
+		t.Errorf("expected productFamily filter NAT Gateway, got %v", client.filters["productFamily"])
+	}
+
+	// The price is cached so the API isn't called again
+	if _, err := pricer.Price(key); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 call to the Pricing API, got %v", client.calls)
+	}
+
+	// And saved so that it can be used offline
+	loaded, err := LoadPriceList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if price, err := loaded.Price(key); err != nil || price != 0.05 {
+		t.Errorf("expected cached price 0.05, got %v (%v)", price, err)
+	}
+
+	// Built in prices don't call the API
+	if _, err := pricer.Price(PriceKey{Product: ProductEC2Instance, Region: "us-east-1", Type: "t3.micro"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 call to the Pricing API, got %v", client.calls)
+	}
+}
+
+func TestAPIPricerMissing(t *testing.T) {
+	client := &testPricingClient{}
+	pricer := NewAPIPricer(client, NewPriceList())
+	key := PriceKey{Product: ProductEC2Instance, Region: "eu-west-2", Type: "x99.huge"}
+
+	for range 2 {
+		if _, err := pricer.Price(key); !errors.Is(err, ErrNoPrice) {
+			t.Errorf("expected ErrNoPrice, got %v", err)
+		}
+	}
+
+	// Missing prices are only requested once
+	if client.calls != 1 {
+		t.Errorf("expected 1 call to the Pricing API, got %v", client.calls)
+	}
+
+	// Engines whose price depends on the licence aren't requested at all
+	_, err := pricer.Price(PriceKey{Product: ProductRDSInstance, Region: "eu-west-2", Type: "db.m5.large", Engine: "oracle-ee"})
+	if !errors.Is(err, ErrNoPrice) {
+		t.Errorf("expected ErrNoPrice, got %v", err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 call to the Pricing API, got %v", client.calls)
+	}
+}
+
+func TestProductsInput(t *testing.T) {
+	input, unit, err := productsInput(PriceKey{Product: ProductRDSInstance, Region: "eu-west-2", Type: "db.t3.micro", Engine: "postgres", MultiAZ: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *input.ServiceCode != "AmazonRDS" {
+		t.Errorf("expected service code AmazonRDS, got %v", *input.ServiceCode)
+	}
+
+	if unit != "Hrs" {
+		t.Errorf("expected unit Hrs, got %v", unit)
+	}
+
+	filters := make(map[string]string)
+	for _, filter := range input.Filters {
+		filters[*filter.Field] = *filter.Value
+	}
+
+	expected := map[string]string{
+		"regionCode":       "eu-west-2",
+		"instanceType":     "db.t3.micro",
+		"databaseEngine":   "PostgreSQL",
+		"deploymentOption": "Multi-AZ",
+	}
+
+	for field, value := range expected {
+		if filters[field] != value {
+			t.Errorf("expected filter %v=%v, got %v", field, value, filters[field])
+		}
+	}
+}
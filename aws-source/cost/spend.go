@@ -0,0 +1,134 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+)
+
+// SpendPeriod is how far back historical spend is reported
+const SpendPeriod = 30 * 24 * time.Hour
+
+// Cost Explorer data is only updated a few times a day and each request is
+// charged for, so spend is cached for this long
+const spendCacheDuration = 12 * time.Hour
+
+type costExplorerClient interface {
+	GetCostAndUsage(ctx context.Context, params *costexplorer.GetCostAndUsageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error)
+}
+
+type cachedSpend struct {
+	amount  float64
+	expires time.Time
+}
+
+// TagSpend reports the historical spend for the resources that have a tag,
+// using Cost Explorer. The tag must be activated as a cost allocation tag for
+// Cost Explorer to report it
+type TagSpend struct {
+	client costExplorerClient
+	tagKey string
+
+	mu    sync.Mutex
+	cache map[string]cachedSpend
+
+	// Used by the tests to fix the time
+	now func() time.Time
+}
+
+// NewTagSpend returns historical spend for the values of the tag key
+func NewTagSpend(client costExplorerClient, tagKey string) *TagSpend {
+	return &TagSpend{
+		client: client,
+		tagKey: tagKey,
+		cache:  make(map[string]cachedSpend),
+		now:    time.Now,
+	}
+}
+
+// TagKey is the tag that spend is reported for
+func (s *TagSpend) TagKey() string {
+	return s.tagKey
+}
+
+// Spend returns the unblended cost in USD of the resources with the tag
+// value over the last `SpendPeriod`
+func (s *TagSpend) Spend(ctx context.Context, tagValue string) (float64, error) {
+	now := s.now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[tagValue]
+	s.mu.Unlock()
+
+	if ok && now.Before(cached.expires) {
+		return cached.amount, nil
+	}
+
+	// The end date is exclusive
+	end := now.UTC().Format(time.DateOnly)
+	start := now.Add(-SpendPeriod).UTC().Format(time.DateOnly)
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: types.GranularityMonthly,
+		Metrics:     []string{"UnblendedCost"},
+		TimePeriod: &types.DateInterval{
+			Start: &start,
+			End:   &end,
+		},
+		Filter: &types.Expression{
+			Tags: &types.TagValues{
+				Key:          aws.String(s.tagKey),
+				Values:       []string{tagValue},
+				MatchOptions: []types.MatchOption{types.MatchOptionEquals},
+			},
+		},
+	}
+
+	var amount float64
+
+	for {
+		out, err := s.client.GetCostAndUsage(ctx, input)
+		if err != nil {
+			return 0, fmt.Errorf("could not get spend for tag %v=%v: %w", s.tagKey, tagValue, err)
+		}
+
+		// The period can span two months, in which case there is a result
+		// for each
+		for _, result := range out.ResultsByTime {
+			metric, ok := result.Total["UnblendedCost"]
+			if !ok || metric.Amount == nil {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(*metric.Amount, 64)
+			if err != nil {
+				return 0, fmt.Errorf("could not parse spend %v: %w", *metric.Amount, err)
+			}
+
+			amount += value
+		}
+
+		if out.NextPageToken == nil {
+			break
+		}
+
+		input.NextPageToken = out.NextPageToken
+	}
+
+	amount = round(amount, 2)
+
+	s.mu.Lock()
+	s.cache[tagValue] = cachedSpend{
+		amount:  amount,
+		expires: now.Add(spendCacheDuration),
+	}
+	s.mu.Unlock()
+
+	return amount, nil
+}
@@ -0,0 +1,83 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+)
+
+type testCostExplorerClient struct {
+	calls int
+	input *costexplorer.GetCostAndUsageInput
+}
+
+func (c *testCostExplorerClient) GetCostAndUsage(ctx context.Context, params *costexplorer.GetCostAndUsageInput, optFns ...func(*costexplorer.Options)) (*costexplorer.GetCostAndUsageOutput, error) {
+	c.calls++
+	c.input = params
+
+	// The period spans two months
+	return &costexplorer.GetCostAndUsageOutput{
+		ResultsByTime: []types.ResultByTime{
+			{
+				Total: map[string]types.MetricValue{
+					"UnblendedCost": {Amount: aws.String("12.504"), Unit: aws.String("USD")},
+				},
+			},
+			{
+				Total: map[string]types.MetricValue{
+					"UnblendedCost": {Amount: aws.String("30.1"), Unit: aws.String("USD")},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestTagSpend(t *testing.T) {
+	client := &testCostExplorerClient{}
+	spend := NewTagSpend(client, "team")
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	spend.now = func() time.Time { return now }
+
+	amount, err := spend.Spend(context.Background(), "payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if amount != 42.6 {
+		t.Errorf("expected spend 42.6, got %v", amount)
+	}
+
+	if *client.input.TimePeriod.Start != "2026-09-19" || *client.input.TimePeriod.End != "2026-10-19" {
+		t.Errorf("unexpected time period %v to %v", *client.input.TimePeriod.Start, *client.input.TimePeriod.End)
+	}
+
+	tags := client.input.Filter.Tags
+	if *tags.Key != "team" || len(tags.Values) != 1 || tags.Values[0] != "payments" {
+		t.Errorf("unexpected tag filter %v=%v", *tags.Key, tags.Values)
+	}
+
+	// Spend is cached
+	if _, err := spend.Spend(context.Background(), "payments"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 call to Cost Explorer, got %v", client.calls)
+	}
+
+	// Until it expires
+	now = now.Add(spendCacheDuration + time.Minute)
+
+	if _, err := spend.Spend(context.Background(), "payments"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.calls != 2 {
+		t.Errorf("expected 2 calls to Cost Explorer, got %v", client.calls)
+	}
+}
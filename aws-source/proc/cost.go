@@ -0,0 +1,129 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscostexplorer "github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	awspricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/aws-source/cost"
+)
+
+// CostConfig configures the cost attributes that are added to items
+type CostConfig struct {
+	// Whether to add estimated costs to items
+	Enabled bool
+
+	// The file that prices are cached in. If this is empty the prices are
+	// only cached in memory
+	PriceListPath string
+
+	// Only use the price list, without calling the Pricing API. Resources
+	// that aren't in the list won't have an estimate
+	Offline bool
+
+	// If set, items with this tag also get the spend that Cost Explorer
+	// reports for the value of the tag. The tag must be activated as a cost
+	// allocation tag
+	SpendTagKey string
+}
+
+// CostEstimates is the cost enricher that `EnableCostEstimates()` registered,
+// which accounts that are discovered later can be added to
+type CostEstimates struct {
+	config   CostConfig
+	enricher *cost.Enricher
+}
+
+// EnableCostEstimates registers an enricher that adds cost attributes to the
+// items of all adapters, using the configs to call the Pricing API and Cost
+// Explorer. This should be called before the engine is started. Returns nil if
+// cost estimates are disabled
+func EnableCostEstimates(ctx context.Context, c CostConfig, configs ...aws.Config) (*CostEstimates, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	if len(configs) == 0 {
+		return nil, errors.New("No configs specified")
+	}
+
+	var list *cost.PriceList
+	var err error
+
+	if c.PriceListPath != "" {
+		list, err = cost.LoadPriceList(c.PriceListPath)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		list = cost.NewPriceList()
+	}
+
+	enricher := &cost.Enricher{
+		Pricer: list,
+	}
+
+	if !c.Offline {
+		// Prices are the same for every account so any config will do
+		enricher.Pricer = cost.NewAPIPricer(awspricing.NewFromConfig(configs[0], func(o *awspricing.Options) {
+			o.Region = cost.PricingRegion
+			o.RetryMode = aws.RetryModeAdaptive
+		}), list)
+	}
+
+	estimates := &CostEstimates{
+		config:   c,
+		enricher: enricher,
+	}
+
+	err = estimates.AddAccounts(ctx, configs...)
+	if err != nil {
+		return nil, err
+	}
+
+	adapterhelpers.AddItemEnricher(enricher)
+
+	log.WithFields(log.Fields{
+		"price-list-path": c.PriceListPath,
+		"offline":         c.Offline,
+		"spend-tag-key":   c.SpendTagKey,
+	}).Info("Enabled cost estimates")
+
+	return estimates, nil
+}
+
+// AddAccounts adds the historical spend of the accounts of the configs, if
+// spend is configured. This does nothing if `c` is nil so that it can be
+// called when cost estimates are disabled
+func (c *CostEstimates) AddAccounts(ctx context.Context, configs ...aws.Config) error {
+	if c == nil || c.config.SpendTagKey == "" {
+		return nil
+	}
+
+	for _, cfg := range configs {
+		callerID, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return fmt.Errorf("error getting caller identity for region %v: %w", cfg.Region, err)
+		}
+
+		if _, ok := c.enricher.AccountSpend(*callerID.Account); ok {
+			continue
+		}
+
+		// Cost Explorer is a global service that is only served from
+		// us-east-1
+		c.enricher.AddSpend(*callerID.Account, cost.NewTagSpend(awscostexplorer.NewFromConfig(cfg, func(o *awscostexplorer.Options) {
+			o.Region = "us-east-1"
+			o.RetryMode = aws.RetryModeAdaptive
+		}), c.config.SpendTagKey))
+	}
+
+	return nil
+}
@@ -0,0 +1,463 @@
+package proc
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsHttp "github.com/aws/smithy-go/transport/http"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/aws-source/adapters"
+	"github.com/overmindtech/cli/discovery"
+	k8sadapters "github.com/overmindtech/cli/k8s-source/adapters"
+)
+
+// DefaultEKSRefreshInterval is how often the clusters and their namespaces
+// are refreshed unless configured otherwise
+const DefaultEKSRefreshInterval = 10 * time.Minute
+
+// EKSConfig configures the discovery of the Kubernetes resources inside EKS
+// clusters
+type EKSConfig struct {
+	// How often to refresh the clusters and their namespaces. Defaults to
+	// `DefaultEKSRefreshInterval`
+	RefreshInterval time.Duration
+	// The maximum sustained queries per second to the API of each cluster
+	RateLimitQPS float32
+	// The maximum burst of queries to the API of each cluster
+	RateLimitBurst int
+}
+
+// EKSClusterClient is the subset of the EKS API that is used to find the
+// clusters in a region
+type EKSClusterClient interface {
+	awseks.ListClustersAPIClient
+	awseks.DescribeClusterAPIClient
+}
+
+// The clients for one region of an account
+type eksRegion struct {
+	AccountID string
+	Region    string
+	EKS       EKSClusterClient
+	// Used to create the tokens that authenticate with the clusters
+	STS *sts.PresignClient
+}
+
+// A cluster whose adapters have been added
+type eksCluster struct {
+	Endpoint   string
+	Namespaces []string
+	ClientSet  *kubernetes.Clientset
+}
+
+// EKSDiscovery discovers the EKS clusters of the configs and adds the
+// Kubernetes adapters for each of them, so that the resources inside clusters
+// are discovered without running k8s-source. The adapters use the ARN of the
+// cluster as their scope, see `adapters.EKSKubernetesScope()`.
+//
+// The clusters are authenticated with IAM, the same way as `aws eks
+// get-token`, so the principal of the configs needs an access entry or an
+// `aws-auth` mapping in each cluster. Clusters that can't be accessed are
+// skipped
+type EKSDiscovery struct {
+	Config EKSConfig
+
+	regions []eksRegion
+
+	mu       sync.Mutex
+	clusters map[string]*eksCluster
+}
+
+// NewEKSDiscovery creates an `EKSDiscovery`. Use `AddAccounts()` to add the
+// accounts and regions in which to find the clusters
+func NewEKSDiscovery(c EKSConfig) *EKSDiscovery {
+	return &EKSDiscovery{
+		Config:   c,
+		clusters: make(map[string]*eksCluster),
+	}
+}
+
+// AddAccounts adds the account and region of each config, so that their
+// clusters are discovered on the next refresh. Configs whose account and
+// region have already been added are skipped, and configs whose account
+// can't be found are returned as errors without stopping the others from being
+// added. This does nothing if `d` is nil
+// so that it can be called when EKS discovery is disabled
+func (d *EKSDiscovery) AddAccounts(ctx context.Context, configs ...aws.Config) error {
+	if d == nil {
+		return nil
+	}
+
+	var errs []error
+
+	regions := make([]eksRegion, 0, len(configs))
+	for _, cfg := range configs {
+		stsClient := sts.NewFromConfig(cfg)
+
+		callerID, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting caller identity for region %v: %w", cfg.Region, err))
+			continue
+		}
+
+		regions = append(regions, eksRegion{
+			AccountID: *callerID.Account,
+			Region:    cfg.Region,
+			EKS: awseks.NewFromConfig(cfg, func(o *awseks.Options) {
+				o.RetryMode = aws.RetryModeAdaptive
+			}),
+			STS: sts.NewPresignClient(stsClient),
+		})
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, region := range regions {
+		known := slices.ContainsFunc(d.regions, func(r eksRegion) bool {
+			return r.AccountID == region.AccountID && r.Region == region.Region
+		})
+		if !known {
+			d.regions = append(d.regions, region)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RemoveAccount stops discovering the clusters of an account and removes the
+// adapters of its clusters from the engine. The clusters of an account that
+// can no longer be accessed would otherwise never be removed, as `Refresh()`
+// only removes clusters when every region could be listed. This does nothing
+// if `d` is nil
+func (d *EKSDiscovery) RemoveAccount(e *discovery.Engine, accountID string) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.regions = slices.DeleteFunc(d.regions, func(r eksRegion) bool {
+		return r.AccountID == accountID
+	})
+
+	for scope := range d.clusters {
+		a, err := adapterhelpers.ParseARN(scope)
+		if err != nil || a.AccountID != accountID {
+			continue
+		}
+
+		log.WithField("ovm.aws.eksCluster", scope).Info("Account of EKS cluster was removed, removing Kubernetes adapters")
+		removeEKSClusterAdapters(e, scope)
+		delete(d.clusters, scope)
+	}
+}
+
+// Watch refreshes the clusters until the context is cancelled
+func (d *EKSDiscovery) Watch(ctx context.Context, e *discovery.Engine) {
+	interval := d.Config.RefreshInterval
+	if interval <= 0 {
+		interval = DefaultEKSRefreshInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := d.Refresh(ctx, e)
+			if err != nil {
+				log.WithError(err).Error("Error refreshing EKS clusters")
+			}
+		}
+	}
+}
+
+// Refresh updates the Kubernetes adapters of the engine to match the current
+// clusters and their namespaces. The adapters of a cluster are replaced when
+// its namespaces change, and removed when it is deleted
+func (d *EKSDiscovery) Refresh(ctx context.Context, e *discovery.Engine) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	current := make(map[string]bool)
+	changed := false
+	var errs []error
+
+	for _, region := range d.regions {
+		clusters, err := listEKSClusters(ctx, region.EKS)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing EKS clusters in region %v: %w", region.Region, err))
+			continue
+		}
+
+		for _, cluster := range clusters {
+			if cluster.Arn == nil || cluster.Name == nil || cluster.Endpoint == nil {
+				continue
+			}
+
+			if cluster.Status != ekstypes.ClusterStatusActive && cluster.Status != ekstypes.ClusterStatusUpdating {
+				continue
+			}
+
+			scope := adapters.EKSKubernetesScope(*cluster.Arn)
+			current[scope] = true
+
+			lf := log.Fields{
+				"ovm.aws.eksCluster": *cluster.Arn,
+			}
+
+			added, err := d.refreshCluster(ctx, e, region, cluster)
+			if err != nil {
+				// This is usually because the principal doesn't have
+				// access to the cluster, which is expected for some
+				// clusters so the other clusters are still discovered
+				log.WithError(err).WithFields(lf).Warn("Could not discover the Kubernetes resources of EKS cluster")
+				continue
+			}
+
+			if added {
+				log.WithFields(lf).Info("Added Kubernetes adapters for EKS cluster")
+				changed = true
+			}
+		}
+	}
+
+	// Only remove clusters if every region was listed, otherwise the
+	// clusters of a region that failed would be removed
+	if len(errs) == 0 {
+		for scope := range d.clusters {
+			if current[scope] {
+				continue
+			}
+
+			log.WithField("ovm.aws.eksCluster", scope).Info("EKS cluster was deleted, removing Kubernetes adapters")
+			removeEKSClusterAdapters(e, scope)
+			delete(d.clusters, scope)
+			changed = true
+		}
+	}
+
+	if changed {
+		// Let the API know about the new set of scopes straight away
+		err := e.SendHeartbeat(ctx, nil)
+		if err != nil && !errors.Is(err, discovery.ErrNoHealthcheckDefined) {
+			log.WithError(err).Error("Error sending heartbeat")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// refreshCluster adds the adapters of a cluster if it is new or its
+// namespaces have changed. Returns whether the adapters were added
+func (d *EKSDiscovery) refreshCluster(ctx context.Context, e *discovery.Engine, region eksRegion, cluster ekstypes.Cluster) (bool, error) {
+	scope := adapters.EKSKubernetesScope(*cluster.Arn)
+
+	known, ok := d.clusters[scope]
+	if !ok || known.Endpoint != *cluster.Endpoint {
+		clientSet, err := d.clientSet(region, cluster)
+		if err != nil {
+			return false, err
+		}
+
+		known = &eksCluster{
+			Endpoint:  *cluster.Endpoint,
+			ClientSet: clientSet,
+		}
+	}
+
+	list, err := known.ClientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("could not list namespaces: %w", err)
+	}
+
+	namespaces := make([]string, len(list.Items))
+	for i := range list.Items {
+		namespaces[i] = list.Items[i].Name
+	}
+
+	slices.Sort(namespaces)
+
+	if existing, ok := d.clusters[scope]; ok && existing.ClientSet == known.ClientSet && slices.Equal(existing.Namespaces, namespaces) {
+		return false, nil
+	}
+
+	removeEKSClusterAdapters(e, scope)
+	delete(d.clusters, scope)
+
+	err = e.AddAdapters(k8sadapters.LoadAllAdapters(known.ClientSet, scope, namespaces)...)
+	if err != nil {
+		// Remove any that were added so that they are all added again on
+		// the next refresh
+		removeEKSClusterAdapters(e, scope)
+		return false, err
+	}
+
+	known.Namespaces = namespaces
+	d.clusters[scope] = known
+
+	return true, nil
+}
+
+// clientSet creates a Kubernetes client for a cluster that authenticates
+// with a token from STS
+func (d *EKSDiscovery) clientSet(region eksRegion, cluster ekstypes.Cluster) (*kubernetes.Clientset, error) {
+	restConfig := &rest.Config{
+		Host: *cluster.Endpoint,
+	}
+
+	if cluster.CertificateAuthority != nil && cluster.CertificateAuthority.Data != nil {
+		ca, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode certificate authority: %w", err)
+		}
+
+		restConfig.TLSClientConfig.CAData = ca
+	}
+
+	token := &eksToken{
+		presigner:   region.STS,
+		clusterName: *cluster.Name,
+	}
+
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &eksTokenTransport{
+			token:     token,
+			transport: otelhttp.NewTransport(rt),
+		}
+	})
+
+	if d.Config.RateLimitQPS > 0 {
+		restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(d.Config.RateLimitQPS, d.Config.RateLimitBurst)
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
+
+// listEKSClusters returns the details of all clusters in a region
+func listEKSClusters(ctx context.Context, client EKSClusterClient) ([]ekstypes.Cluster, error) {
+	clusters := make([]ekstypes.Cluster, 0)
+
+	paginator := awseks.NewListClustersPaginator(client, &awseks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range page.Clusters {
+			output, err := client.DescribeCluster(ctx, &awseks.DescribeClusterInput{
+				Name: &name,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if output.Cluster != nil {
+				clusters = append(clusters, *output.Cluster)
+			}
+		}
+	}
+
+	return clusters, nil
+}
+
+// removeEKSClusterAdapters removes the adapters of a cluster from the engine,
+// including the adapters of its namespaces
+func removeEKSClusterAdapters(e *discovery.Engine, scope string) {
+	e.FilterAdapters(func(a discovery.Adapter) bool {
+		return !slices.ContainsFunc(a.Scopes(), func(s string) bool {
+			return s == scope || strings.HasPrefix(s, scope+".")
+		})
+	})
+}
+
+const (
+	// The prefix of the tokens that EKS accepts
+	eksTokenPrefix = "k8s-aws-v1."
+	// The header that binds a token to a cluster
+	eksClusterIDHeader = "x-k8s-aws-id"
+	// Tokens are valid for 15 minutes, they are refreshed before then to
+	// allow for clock skew
+	eksTokenLifetime = 10 * time.Minute
+)
+
+// eksToken creates and caches the bearer token for a cluster. The token is a
+// presigned STS GetCallerIdentity request, which EKS calls to find out who
+// the caller is
+type eksToken struct {
+	presigner   *sts.PresignClient
+	clusterName string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// Get returns the current token, creating a new one if it has expired
+func (t *eksToken) Get(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Before(t.expires) {
+		return t.token, nil
+	}
+
+	request, err := t.presigner.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}, func(o *sts.PresignOptions) {
+		o.ClientOptions = append(o.ClientOptions, func(o *sts.Options) {
+			o.APIOptions = append(o.APIOptions,
+				awsHttp.SetHeaderValue(eksClusterIDHeader, t.clusterName),
+				awsHttp.SetHeaderValue("X-Amz-Expires", "60"),
+			)
+		})
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not presign GetCallerIdentity for EKS token: %w", err)
+	}
+
+	t.token = eksTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))
+	t.expires = time.Now().Add(eksTokenLifetime)
+
+	return t.token, nil
+}
+
+// eksTokenTransport adds the token of the cluster to requests
+type eksTokenTransport struct {
+	token     *eksToken
+	transport http.RoundTripper
+}
+
+func (t *eksTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token.Get(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,470 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/config"
+	stscredsv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/discovery"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// DefaultOrganizationRefreshInterval is how often the accounts of an
+// organization are refreshed unless configured otherwise
+const DefaultOrganizationRefreshInterval = time.Hour
+
+// OrganizationsConfig configures the `organizations` strategy. This uses the
+// default credentials to list the accounts of an AWS Organization, which
+// requires running in the management account or a delegated administrator
+// account, and then assumes a role in each of the accounts
+type OrganizationsConfig struct {
+	// The name of the role to assume in each account, e.g. `overmind-read-only`
+	RoleName string
+	// If set, only accounts in these organizational units, or any of their
+	// child OUs, are discovered
+	OrganizationalUnitIDs []string
+	// If set, only accounts that have all of these tags are discovered
+	Tags map[string]string
+	// How often to refresh the list of accounts. Defaults to
+	// `DefaultOrganizationRefreshInterval`
+	RefreshInterval time.Duration
+}
+
+// OrganizationsClient is the subset of the AWS Organizations API that is used
+// to discover the accounts of an organization
+type OrganizationsClient interface {
+	organizations.ListAccountsAPIClient
+	organizations.ListAccountsForParentAPIClient
+	organizations.ListOrganizationalUnitsForParentAPIClient
+	organizations.ListTagsForResourceAPIClient
+}
+
+// OrganizationAccount is an active account of an organization
+type OrganizationAccount struct {
+	ID   string
+	Name string
+	// The partition of the account, e.g. `aws` or `aws-cn`
+	Partition string
+}
+
+// RoleARN returns the ARN of the role with the given name in this account
+func (a OrganizationAccount) RoleARN(roleName string) string {
+	partition := a.Partition
+	if partition == "" {
+		partition = "aws"
+	}
+	return fmt.Sprintf("arn:%v:iam::%v:role/%v", partition, a.ID, roleName)
+}
+
+// Scopes returns the scopes of all adapters of this account, including the
+// global adapters
+func (a OrganizationAccount) Scopes(regions []string) []string {
+	scopes := []string{adapterhelpers.FormatScope(a.ID, "")}
+	for _, region := range regions {
+		scopes = append(scopes, adapterhelpers.FormatScope(a.ID, region))
+	}
+	return scopes
+}
+
+// OrganizationDiscovery discovers the accounts of an AWS Organization and
+// creates AWS configs that assume a role in each of them
+type OrganizationDiscovery struct {
+	Config     OrganizationsConfig
+	ExternalID string
+	Regions    []string
+
+	Organizations OrganizationsClient
+	STS           stscredsv2.AssumeRoleAPIClient
+
+	// The config that the configs of the accounts are based on
+	BaseConfig aws.Config
+
+	// Adds the spend of accounts that join the organization. Optional
+	Costs *CostEstimates
+	// Discovers the EKS clusters of accounts that join the organization.
+	// Optional
+	EKS *EKSDiscovery
+}
+
+// NewOrganizationDiscovery creates an `OrganizationDiscovery` from the
+// AwsAuthConfig. The organization is queried using the default credentials, or
+// the profile if one is set
+func NewOrganizationDiscovery(c AwsAuthConfig) (*OrganizationDiscovery, error) {
+	if c.Organizations.RoleName == "" {
+		return nil, errors.New("with organizations strategy, aws-organizations-role-name cannot be blank")
+	}
+	if c.AccessKeyID != "" || c.SecretAccessKey != "" {
+		return nil, errors.New("with organizations strategy, aws-access-key-id and aws-secret-access-key must be blank")
+	}
+	if c.TargetRoleARN != "" {
+		return nil, errors.New("with organizations strategy, aws-target-role-arn must be blank")
+	}
+	if len(c.Regions) == 0 {
+		return nil, errors.New("no regions specified")
+	}
+
+	regions := make([]string, 0, len(c.Regions))
+	for _, region := range c.Regions {
+		regions = append(regions, strings.Trim(region, " "))
+	}
+
+	options := []func(*config.LoadOptions) error{
+		config.WithRegion(regions[0]),
+		config.WithAppID("Overmind"),
+	}
+	if c.Profile != "" {
+		options = append(options, config.WithSharedConfigProfile(c.Profile))
+	}
+
+	base, err := config.LoadDefaultConfig(context.Background(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("could not load default config from environment: %w", err)
+	}
+
+	// Add OTel instrumentation
+	base.HTTPClient = &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+
+	return &OrganizationDiscovery{
+		Config:        c.Organizations,
+		ExternalID:    c.ExternalID,
+		Regions:       regions,
+		Organizations: organizations.NewFromConfig(base),
+		STS:           sts.NewFromConfig(base),
+		BaseConfig:    base,
+	}, nil
+}
+
+// Accounts returns all active accounts of the organization that match the
+// configured organizational units and tags, sorted by ID
+func (d *OrganizationDiscovery) Accounts(ctx context.Context) ([]OrganizationAccount, error) {
+	var accounts []types.Account
+
+	if len(d.Config.OrganizationalUnitIDs) == 0 {
+		paginator := organizations.NewListAccountsPaginator(d.Organizations, &organizations.ListAccountsInput{})
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error listing accounts: %w", err)
+			}
+			accounts = append(accounts, out.Accounts...)
+		}
+	} else {
+		for _, ou := range d.Config.OrganizationalUnitIDs {
+			found, err := d.accountsInOU(ctx, ou)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, found...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	result := make([]OrganizationAccount, 0, len(accounts))
+	for _, account := range accounts {
+		if account.Id == nil || account.Status != types.AccountStatusActive || seen[*account.Id] {
+			continue
+		}
+		seen[*account.Id] = true
+
+		matches, err := d.matchesTags(ctx, *account.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		oa := OrganizationAccount{
+			ID:   *account.Id,
+			Name: aws.ToString(account.Name),
+		}
+		if a, err := arn.Parse(aws.ToString(account.Arn)); err == nil {
+			oa.Partition = a.Partition
+		}
+		result = append(result, oa)
+	}
+
+	slices.SortFunc(result, func(a, b OrganizationAccount) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return result, nil
+}
+
+// accountsInOU returns the accounts in the OU and all of its children
+func (d *OrganizationDiscovery) accountsInOU(ctx context.Context, ou string) ([]types.Account, error) {
+	var accounts []types.Account
+
+	accountPaginator := organizations.NewListAccountsForParentPaginator(d.Organizations, &organizations.ListAccountsForParentInput{
+		ParentId: &ou,
+	})
+	for accountPaginator.HasMorePages() {
+		out, err := accountPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing accounts for %v: %w", ou, err)
+		}
+		accounts = append(accounts, out.Accounts...)
+	}
+
+	ouPaginator := organizations.NewListOrganizationalUnitsForParentPaginator(d.Organizations, &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: &ou,
+	})
+	for ouPaginator.HasMorePages() {
+		out, err := ouPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing organizational units for %v: %w", ou, err)
+		}
+		for _, child := range out.OrganizationalUnits {
+			if child.Id == nil {
+				continue
+			}
+			found, err := d.accountsInOU(ctx, *child.Id)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, found...)
+		}
+	}
+
+	return accounts, nil
+}
+
+// matchesTags returns whether the account has all configured tags
+func (d *OrganizationDiscovery) matchesTags(ctx context.Context, accountID string) (bool, error) {
+	if len(d.Config.Tags) == 0 {
+		return true, nil
+	}
+
+	tags := make(map[string]string)
+	paginator := organizations.NewListTagsForResourcePaginator(d.Organizations, &organizations.ListTagsForResourceInput{
+		ResourceId: &accountID,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, fmt.Errorf("error listing tags for account %v: %w", accountID, err)
+		}
+		for _, tag := range out.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
+	for key, value := range d.Config.Tags {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Configs returns a config for each region of the account. These assume the
+// configured role in the account
+func (d *OrganizationDiscovery) Configs(account OrganizationAccount) []aws.Config {
+	credentials := aws.NewCredentialsCache(stscredsv2.NewAssumeRoleProvider(
+		d.STS,
+		account.RoleARN(d.Config.RoleName),
+		func(aro *stscredsv2.AssumeRoleOptions) {
+			if d.ExternalID != "" {
+				aro.ExternalID = &d.ExternalID
+			}
+		},
+	))
+
+	configs := make([]aws.Config, 0, len(d.Regions))
+	for _, region := range d.Regions {
+		cfg := d.BaseConfig.Copy()
+		cfg.Region = region
+		cfg.Credentials = credentials
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+// AccessibleAccounts returns the accounts in which the role can be assumed.
+// Accounts that aren't accessible, for example because the role hasn't been
+// deployed yet, are logged and skipped so that they can be retried on the
+// next refresh
+func (d *OrganizationDiscovery) AccessibleAccounts(ctx context.Context, accounts []OrganizationAccount) []OrganizationAccount {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	accessible := make([]OrganizationAccount, 0, len(accounts))
+
+	for _, account := range accounts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			configs := d.Configs(account)
+			if len(configs) == 0 {
+				return
+			}
+			_, err := configs[0].Credentials.Retrieve(ctx)
+			if err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"ovm.aws.accountId": account.ID,
+					"ovm.aws.roleArn":   account.RoleARN(d.Config.RoleName),
+				}).Warn("Could not assume role in account, skipping")
+				return
+			}
+
+			mu.Lock()
+			accessible = append(accessible, account)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	slices.SortFunc(accessible, func(a, b OrganizationAccount) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return accessible
+}
+
+// ConfigsForAccounts returns the configs of all accounts, see `Configs()`
+func (d *OrganizationDiscovery) ConfigsForAccounts(accounts []OrganizationAccount) []aws.Config {
+	configs := make([]aws.Config, 0, len(accounts)*len(d.Regions))
+	for _, account := range accounts {
+		configs = append(configs, d.Configs(account)...)
+	}
+	return configs
+}
+
+// WatchAccounts refreshes the accounts of the organization periodically until
+// the context is cancelled. Adapters are added to the engine for accounts that
+// joined the organization, and removed for accounts that left it. `known` are
+// the accounts that the engine was initialized with
+func (d *OrganizationDiscovery) WatchAccounts(ctx context.Context, e *discovery.Engine, known []OrganizationAccount) {
+	interval := d.Config.RefreshInterval
+	if interval <= 0 {
+		interval = DefaultOrganizationRefreshInterval
+	}
+
+	knownAccounts := make(map[string]OrganizationAccount)
+	for _, account := range known {
+		knownAccounts[account.ID] = account
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := d.refresh(ctx, e, knownAccounts, addAdaptersForConfig)
+			if err != nil {
+				log.WithError(err).Error("Error refreshing organization accounts")
+			}
+		}
+	}
+}
+
+// refresh updates the adapters of the engine to match the current accounts of
+// the organization. `addAdapters` is called for each config of a new account
+func (d *OrganizationDiscovery) refresh(ctx context.Context, e *discovery.Engine, known map[string]OrganizationAccount, addAdapters func(context.Context, *discovery.Engine, aws.Config, func(string) bool) error) error {
+	accounts, err := d.Accounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool)
+	added := make([]OrganizationAccount, 0)
+	for _, account := range accounts {
+		current[account.ID] = true
+		if _, ok := known[account.ID]; !ok {
+			added = append(added, account)
+		}
+	}
+
+	changed := false
+
+	for id, account := range known {
+		if current[id] {
+			continue
+		}
+		log.WithField("ovm.aws.accountId", id).Info("Account left the organization, removing adapters")
+		removeAccountAdapters(e, account, d.Regions)
+		d.EKS.RemoveAccount(e, id)
+		delete(known, id)
+		changed = true
+	}
+
+	for _, account := range d.AccessibleAccounts(ctx, added) {
+		globalAdded := false
+		addGlobal := func(string) bool {
+			if globalAdded {
+				return false
+			}
+			globalAdded = true
+			return true
+		}
+
+		var addErr error
+		for _, cfg := range d.Configs(account) {
+			addErr = addAdapters(ctx, e, cfg, addGlobal)
+			if addErr != nil {
+				break
+			}
+		}
+		if addErr != nil {
+			// Remove the adapters of the regions that did work so that the
+			// account can be retried on the next refresh
+			log.WithError(addErr).WithField("ovm.aws.accountId", account.ID).Error("Error adding adapters for account")
+			removeAccountAdapters(e, account, d.Regions)
+			continue
+		}
+
+		log.WithField("ovm.aws.accountId", account.ID).Info("Account joined the organization, added adapters")
+
+		err = d.Costs.AddAccounts(ctx, d.Configs(account)...)
+		if err != nil {
+			// Spend is supplementary, so the account is kept without it
+			log.WithError(err).WithField("ovm.aws.accountId", account.ID).Error("Error adding spend for account")
+		}
+
+		// The clusters of the account are discovered on the next EKS refresh
+		err = d.EKS.AddAccounts(ctx, d.Configs(account)...)
+		if err != nil {
+			log.WithError(err).WithField("ovm.aws.accountId", account.ID).Error("Error adding EKS discovery for account")
+		}
+		known[account.ID] = account
+		changed = true
+	}
+
+	if changed {
+		// Let the API know about the new set of scopes straight away
+		err = e.SendHeartbeat(ctx, nil)
+		if err != nil && !errors.Is(err, discovery.ErrNoHealthcheckDefined) {
+			log.WithError(err).Error("Error sending heartbeat")
+		}
+	}
+
+	return nil
+}
+
+// removeAccountAdapters removes all adapters of the account from the engine
+func removeAccountAdapters(e *discovery.Engine, account OrganizationAccount, regions []string) {
+	scopes := account.Scopes(regions)
+	e.FilterAdapters(func(a discovery.Adapter) bool {
+		return !slices.ContainsFunc(a.Scopes(), func(scope string) bool {
+			return slices.Contains(scopes, scope)
+		})
+	})
+}
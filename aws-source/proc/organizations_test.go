@@ -0,0 +1,323 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/overmindtech/cli/aws-source/adapters"
+	"github.com/overmindtech/cli/discovery"
+)
+
+type testOrganizationsClient struct {
+	accounts []types.Account
+	// Accounts by parent OU
+	children map[string][]types.Account
+	// Child OUs by parent OU
+	units map[string][]string
+	tags  map[string]map[string]string
+}
+
+func testAccount(id string, status types.AccountStatus) types.Account {
+	return types.Account{
+		Id:     aws.String(id),
+		Name:   aws.String("account-" + id),
+		Arn:    aws.String("arn:aws:organizations::111111111111:account/o-example/" + id),
+		Status: status,
+	}
+}
+
+func (t *testOrganizationsClient) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	// Return one account per page to exercise pagination
+	i := 0
+	if params.NextToken != nil {
+		for i < len(t.accounts) && aws.ToString(t.accounts[i].Id) != *params.NextToken {
+			i++
+		}
+	}
+	if i >= len(t.accounts) {
+		return &organizations.ListAccountsOutput{}, nil
+	}
+
+	out := &organizations.ListAccountsOutput{
+		Accounts: []types.Account{t.accounts[i]},
+	}
+	if i+1 < len(t.accounts) {
+		out.NextToken = t.accounts[i+1].Id
+	}
+	return out, nil
+}
+
+func (t *testOrganizationsClient) ListAccountsForParent(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+	return &organizations.ListAccountsForParentOutput{
+		Accounts: t.children[*params.ParentId],
+	}, nil
+}
+
+func (t *testOrganizationsClient) ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	units := make([]types.OrganizationalUnit, 0)
+	for _, id := range t.units[*params.ParentId] {
+		units = append(units, types.OrganizationalUnit{Id: aws.String(id)})
+	}
+	return &organizations.ListOrganizationalUnitsForParentOutput{
+		OrganizationalUnits: units,
+	}, nil
+}
+
+func (t *testOrganizationsClient) ListTagsForResource(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
+	tags := make([]types.Tag, 0)
+	for k, v := range t.tags[*params.ResourceId] {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return &organizations.ListTagsForResourceOutput{
+		Tags: tags,
+	}, nil
+}
+
+type testSTSClient struct {
+	mu     sync.Mutex
+	inputs []*sts.AssumeRoleInput
+	// Roles that can't be assumed
+	denied []string
+}
+
+func (t *testSTSClient) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inputs = append(t.inputs, params)
+
+	if slices.Contains(t.denied, *params.RoleArn) {
+		return nil, errors.New("access denied")
+	}
+
+	return &sts.AssumeRoleOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("AKIA"),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+func accountIDs(accounts []OrganizationAccount) []string {
+	ids := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func TestOrganizationDiscoveryAccounts(t *testing.T) {
+	client := &testOrganizationsClient{
+		accounts: []types.Account{
+			testAccount("333333333333", types.AccountStatusActive),
+			testAccount("111111111111", types.AccountStatusActive),
+			testAccount("222222222222", types.AccountStatusSuspended),
+			testAccount("444444444444", types.AccountStatusActive),
+		},
+		children: map[string][]types.Account{
+			"ou-root": {testAccount("111111111111", types.AccountStatusActive)},
+			"ou-prod": {testAccount("333333333333", types.AccountStatusActive)},
+		},
+		units: map[string][]string{
+			"ou-root": {"ou-prod"},
+		},
+		tags: map[string]map[string]string{
+			"333333333333": {"env": "prod", "team": "a"},
+			"444444444444": {"env": "dev"},
+		},
+	}
+
+	t.Run("all accounts", func(t *testing.T) {
+		d := &OrganizationDiscovery{Organizations: client}
+
+		accounts, err := d.Accounts(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"111111111111", "333333333333", "444444444444"}
+		if !slices.Equal(accountIDs(accounts), expected) {
+			t.Errorf("expected %v, got %v", expected, accountIDs(accounts))
+		}
+		if accounts[0].Partition != "aws" || accounts[0].Name != "account-111111111111" {
+			t.Errorf("unexpected account %+v", accounts[0])
+		}
+	})
+
+	t.Run("filtered by OU", func(t *testing.T) {
+		d := &OrganizationDiscovery{
+			Organizations: client,
+			Config: OrganizationsConfig{
+				OrganizationalUnitIDs: []string{"ou-root"},
+			},
+		}
+
+		accounts, err := d.Accounts(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"111111111111", "333333333333"}
+		if !slices.Equal(accountIDs(accounts), expected) {
+			t.Errorf("expected %v, got %v", expected, accountIDs(accounts))
+		}
+	})
+
+	t.Run("filtered by tag", func(t *testing.T) {
+		d := &OrganizationDiscovery{
+			Organizations: client,
+			Config: OrganizationsConfig{
+				Tags: map[string]string{"env": "prod"},
+			},
+		}
+
+		accounts, err := d.Accounts(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []string{"333333333333"}
+		if !slices.Equal(accountIDs(accounts), expected) {
+			t.Errorf("expected %v, got %v", expected, accountIDs(accounts))
+		}
+	})
+}
+
+func TestOrganizationDiscoveryConfigs(t *testing.T) {
+	stsClient := &testSTSClient{
+		denied: []string{"arn:aws:iam::222222222222:role/overmind"},
+	}
+	d := &OrganizationDiscovery{
+		Config: OrganizationsConfig{
+			RoleName: "overmind",
+		},
+		ExternalID: "external-id",
+		Regions:    []string{"eu-west-1", "us-east-1"},
+		STS:        stsClient,
+	}
+
+	account := OrganizationAccount{ID: "111111111111", Partition: "aws"}
+	configs := d.Configs(account)
+	if len(configs) != 2 {
+		t.Fatalf("expected 2 configs, got %v", len(configs))
+	}
+	if configs[0].Region != "eu-west-1" || configs[1].Region != "us-east-1" {
+		t.Errorf("unexpected regions %v, %v", configs[0].Region, configs[1].Region)
+	}
+
+	_, err := configs[1].Credentials.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stsClient.inputs) != 1 {
+		t.Fatalf("expected 1 AssumeRole call, got %v", len(stsClient.inputs))
+	}
+	if *stsClient.inputs[0].RoleArn != "arn:aws:iam::111111111111:role/overmind" {
+		t.Errorf("unexpected role ARN %v", *stsClient.inputs[0].RoleArn)
+	}
+	if aws.ToString(stsClient.inputs[0].ExternalId) != "external-id" {
+		t.Errorf("unexpected external ID %v", aws.ToString(stsClient.inputs[0].ExternalId))
+	}
+
+	accessible := d.AccessibleAccounts(context.Background(), []OrganizationAccount{
+		{ID: "222222222222", Partition: "aws"},
+		account,
+	})
+	if !slices.Equal(accountIDs(accessible), []string{"111111111111"}) {
+		t.Errorf("expected only the accessible account, got %v", accountIDs(accessible))
+	}
+
+	expectedScopes := []string{"111111111111", "111111111111.eu-west-1", "111111111111.us-east-1"}
+	if scopes := account.Scopes(d.Regions); !slices.Equal(scopes, expectedScopes) {
+		t.Errorf("expected scopes %v, got %v", expectedScopes, scopes)
+	}
+}
+
+func TestOrganizationDiscoveryRefresh(t *testing.T) {
+	client := &testOrganizationsClient{
+		accounts: []types.Account{
+			testAccount("111111111111", types.AccountStatusActive),
+			testAccount("333333333333", types.AccountStatusActive),
+		},
+	}
+	d := &OrganizationDiscovery{
+		Config: OrganizationsConfig{
+			RoleName: "overmind",
+		},
+		Regions:       []string{"eu-west-1"},
+		Organizations: client,
+		STS:           &testSTSClient{},
+		EKS: &EKSDiscovery{
+			regions: []eksRegion{
+				{AccountID: "222222222222", Region: "eu-west-1"},
+			},
+			clusters: make(map[string]*eksCluster),
+		},
+	}
+
+	e, err := discovery.NewEngine(&discovery.EngineConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The engine starts out with the adapters of an account that has since
+	// left the organization
+	err = e.AddAdapters(
+		adapters.NewSQSQueueAdapter(&sqs.Client{}, "111111111111", "eu-west-1"),
+		adapters.NewSQSQueueAdapter(&sqs.Client{}, "222222222222", "eu-west-1"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	known := map[string]OrganizationAccount{
+		"111111111111": {ID: "111111111111"},
+		"222222222222": {ID: "222222222222"},
+	}
+
+	var added []string
+	var globals int
+	addAdapters := func(ctx context.Context, e *discovery.Engine, cfg aws.Config, addGlobal func(string) bool) error {
+		added = append(added, cfg.Region)
+		if addGlobal("333333333333") {
+			globals++
+		}
+		return e.AddAdapters(adapters.NewSQSQueueAdapter(&sqs.Client{}, "333333333333", cfg.Region))
+	}
+
+	err = d.refresh(context.Background(), e, known, addAdapters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(added, []string{"eu-west-1"}) || globals != 1 {
+		t.Errorf("expected the new account to be added once with globals, got %v and %v globals", added, globals)
+	}
+
+	scopes, _ := e.GetAvailableScopesAndMetadata()
+	slices.Sort(scopes)
+	expected := []string{"111111111111.eu-west-1", "333333333333.eu-west-1"}
+	if !slices.Equal(scopes, expected) {
+		t.Errorf("expected scopes %v, got %v", expected, scopes)
+	}
+
+	if _, ok := known["222222222222"]; ok {
+		t.Error("expected removed account to be forgotten")
+	}
+	if _, ok := known["333333333333"]; !ok {
+		t.Error("expected new account to be known")
+	}
+	if slices.ContainsFunc(d.EKS.regions, func(r eksRegion) bool { return r.AccountID == "222222222222" }) {
+		t.Error("expected EKS discovery to forget the removed account")
+	}
+}
@@ -7,31 +7,62 @@ import (
 	"net/http"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsapigateway "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	awsapigatewayv2 "github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	awsapplicationautoscaling "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	awsathena "github.com/aws/aws-sdk-go-v2/service/athena"
 	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awsbackup "github.com/aws/aws-sdk-go-v2/service/backup"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
 	awscloudfront "github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awscloudtrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awscloudwatchlogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awscodebuild "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awscodepipeline "github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	awscognitoidentity "github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	awscognitoidentityprovider "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awsconfigservice "github.com/aws/aws-sdk-go-v2/service/configservice"
 	awsdirectconnect "github.com/aws/aws-sdk-go-v2/service/directconnect"
 	awsdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
 	awsefs "github.com/aws/aws-sdk-go-v2/service/efs"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awselasticache "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awselasticloadbalancing "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	awselasticloadbalancingv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awseventbridge "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	awsfirehose "github.com/aws/aws-sdk-go-v2/service/firehose"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	awshealth "github.com/aws/aws-sdk-go-v2/service/health"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awskafka "github.com/aws/aws-sdk-go-v2/service/kafka"
+	awskinesis "github.com/aws/aws-sdk-go-v2/service/kinesis"
 	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
 	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
 	awsnetworkfirewall "github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awsnetworkmanager "github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	awsopensearch "github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	awsresourcegroupstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awss3control "github.com/aws/aws-sdk-go-v2/service/s3control"
+	awssecretsmanager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awssfn "github.com/aws/aws-sdk-go-v2/service/sfn"
+	awsshield "github.com/aws/aws-sdk-go-v2/service/shield"
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awsssoadmin "github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"github.com/cenkalti/backoff/v5"
 	"github.com/sourcegraph/conc/pool"
 
@@ -59,6 +90,9 @@ type AwsAuthConfig struct {
 	Profile         string
 	AutoConfig      bool
 
+	// Used by the `organizations` strategy, see `NewOrganizationDiscovery()`
+	Organizations OrganizationsConfig
+
 	Regions []string
 }
 
@@ -160,6 +194,8 @@ func (c AwsAuthConfig) GetAWSConfig(region string) (aws.Config, error) {
 		options = append(options, config.WithSharedConfigProfile(c.Profile))
 
 		return config.LoadDefaultConfig(ctx, options...)
+	case "organizations":
+		return aws.Config{}, errors.New("the organizations strategy creates a config per account, use NewOrganizationDiscovery() instead")
 	default:
 		return aws.Config{}, errors.New("invalid aws-access-strategy")
 	}
@@ -219,7 +255,13 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 		return nil, errors.New("No configs specified")
 	}
 
-	var globalDone atomic.Bool
+	// Tracks the accounts for which the global adapters have been added
+	var globalDone sync.Map
+	addGlobal := func(accountID string) bool {
+		_, loaded := globalDone.LoadOrStore(accountID, true)
+		return !loaded
+	}
+
 	b := backoff.NewExponentialBackOff()
 	b.MaxInterval = 30 * time.Second
 	tick := backoff.NewTicker(b)
@@ -246,303 +288,7 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 
 			for _, cfg := range configs {
 				p.Go(func(ctx context.Context) error {
-					configCtx, configCancel := context.WithTimeout(ctx, 10*time.Second)
-					defer configCancel()
-
-					log.WithFields(log.Fields{
-						"region": cfg.Region,
-					}).Info("Initializing AWS source")
-
-					// Work out what account we're using. This will be used in item scopes
-					stsClient := sts.NewFromConfig(cfg)
-
-					callerID, err := stsClient.GetCallerIdentity(configCtx, &sts.GetCallerIdentityInput{})
-					if err != nil {
-						lf := log.Fields{
-							"region": cfg.Region,
-						}
-						log.WithError(err).WithFields(lf).Error("Error retrieving account information")
-						return fmt.Errorf("error getting caller identity for region %v: %w", cfg.Region, err)
-					}
-
-					// Create shared clients for each API
-					autoscalingClient := awsautoscaling.NewFromConfig(cfg, func(o *awsautoscaling.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					cloudfrontClient := awscloudfront.NewFromConfig(cfg, func(o *awscloudfront.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					cloudwatchClient := awscloudwatch.NewFromConfig(cfg, func(o *awscloudwatch.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					directconnectClient := awsdirectconnect.NewFromConfig(cfg, func(o *awsdirectconnect.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					dynamodbClient := awsdynamodb.NewFromConfig(cfg, func(o *awsdynamodb.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ec2Client := awsec2.NewFromConfig(cfg, func(o *awsec2.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ecsClient := awsecs.NewFromConfig(cfg, func(o *awsecs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					efsClient := awsefs.NewFromConfig(cfg, func(o *awsefs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					eksClient := awseks.NewFromConfig(cfg, func(o *awseks.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					elbClient := awselasticloadbalancing.NewFromConfig(cfg, func(o *awselasticloadbalancing.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					elbv2Client := awselasticloadbalancingv2.NewFromConfig(cfg, func(o *awselasticloadbalancingv2.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					lambdaClient := awslambda.NewFromConfig(cfg, func(o *awslambda.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					networkfirewallClient := awsnetworkfirewall.NewFromConfig(cfg, func(o *awsnetworkfirewall.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					rdsClient := awsrds.NewFromConfig(cfg, func(o *awsrds.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					snsClient := awssns.NewFromConfig(cfg, func(o *awssns.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					sqsClient := awssqs.NewFromConfig(cfg, func(o *awssqs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					route53Client := awsroute53.NewFromConfig(cfg, func(o *awsroute53.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					iamClient := awsiam.NewFromConfig(cfg, func(o *awsiam.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-						// Increase this from the default of 3 since IAM as such low rate limits
-						o.RetryMaxAttempts = 5
-					})
-					kmsClient := awskms.NewFromConfig(cfg, func(o *awskms.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					apigatewayClient := awsapigateway.NewFromConfig(cfg, func(o *awsapigateway.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ssmClient := ssm.NewFromConfig(cfg, func(o *ssm.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-
-					configuredAdapters := []discovery.Adapter{
-						// EC2
-						adapters.NewEC2AddressAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2CapacityReservationFleetAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2CapacityReservationAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2EgressOnlyInternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2IamInstanceProfileAssociationAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2ImageAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceEventWindowAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2KeyPairAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2LaunchTemplateAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2LaunchTemplateVersionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NatGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkAclAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkInterfacePermissionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkInterfaceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2PlacementGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2ReservedInstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2RouteTableAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SecurityGroupRuleAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SecurityGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SnapshotAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SubnetAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VolumeAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VolumeStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcEndpointAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcPeeringConnectionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcAdapter(ec2Client, *callerID.Account, cfg.Region),
-
-						// EFS (I'm assuming it shares its rate limit with EC2))
-						adapters.NewEFSAccessPointAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSBackupPolicyAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSFileSystemAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSMountTargetAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSReplicationConfigurationAdapter(efsClient, *callerID.Account, cfg.Region),
-
-						// EKS
-						adapters.NewEKSAddonAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSClusterAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSFargateProfileAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSNodegroupAdapter(eksClient, *callerID.Account, cfg.Region),
-
-						// Route 53
-						adapters.NewRoute53HealthCheckAdapter(route53Client, *callerID.Account, cfg.Region),
-						adapters.NewRoute53HostedZoneAdapter(route53Client, *callerID.Account, cfg.Region),
-						adapters.NewRoute53ResourceRecordSetAdapter(route53Client, *callerID.Account, cfg.Region),
-
-						// Cloudwatch
-						adapters.NewCloudwatchAlarmAdapter(cloudwatchClient, *callerID.Account, cfg.Region),
-
-						// Lambda
-						adapters.NewLambdaFunctionAdapter(lambdaClient, *callerID.Account, cfg.Region),
-						adapters.NewLambdaLayerAdapter(lambdaClient, *callerID.Account, cfg.Region),
-						adapters.NewLambdaLayerVersionAdapter(lambdaClient, *callerID.Account, cfg.Region),
-						adapters.NewLambdaEventSourceMappingAdapter(lambdaClient, *callerID.Account, cfg.Region),
-
-						// ECS
-						adapters.NewECSCapacityProviderAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSClusterAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSContainerInstanceAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSServiceAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSTaskDefinitionAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSTaskAdapter(ecsClient, *callerID.Account, cfg.Region),
-
-						// DynamoDB
-						adapters.NewDynamoDBBackupAdapter(dynamodbClient, *callerID.Account, cfg.Region),
-						adapters.NewDynamoDBTableAdapter(dynamodbClient, *callerID.Account, cfg.Region),
-
-						// RDS
-						adapters.NewRDSDBClusterParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBClusterAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBInstanceAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBSubnetGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSOptionGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-
-						// Autoscaling
-						adapters.NewAutoScalingGroupAdapter(autoscalingClient, *callerID.Account, cfg.Region),
-
-						// ELB
-						adapters.NewELBInstanceHealthAdapter(elbClient, *callerID.Account, cfg.Region),
-						adapters.NewELBLoadBalancerAdapter(elbClient, *callerID.Account, cfg.Region),
-
-						// ELBv2
-						adapters.NewELBv2ListenerAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2LoadBalancerAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2RuleAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2TargetGroupAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2TargetHealthAdapter(elbv2Client, *callerID.Account, cfg.Region),
-
-						// Network Firewall
-						adapters.NewNetworkFirewallFirewallAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallFirewallPolicyAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallRuleGroupAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallTLSInspectionConfigurationAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-
-						// Direct Connect
-						adapters.NewDirectConnectGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAssociationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAssociationProposalAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAttachmentAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectVirtualInterfaceAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectVirtualGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectCustomerMetadataAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectLagAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectLocationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectHostedConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectInterconnectAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectRouterConfigurationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-
-						// Network Manager
-						adapters.NewNetworkManagerConnectAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerConnectPeerAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerCoreNetworkPolicyAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerCoreNetworkAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerNetworkResourceRelationshipsAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerSiteToSiteVpnAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayPeeringAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayRegistrationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayRouteTableAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerVPCAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-
-						// SQS
-						adapters.NewSQSQueueAdapter(sqsClient, *callerID.Account, cfg.Region),
-
-						// SNS
-						adapters.NewSNSSubscriptionAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSTopicAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSPlatformApplicationAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSEndpointAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSDataProtectionPolicyAdapter(snsClient, *callerID.Account, cfg.Region),
-
-						// KMS
-						adapters.NewKMSKeyAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSCustomKeyStoreAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSAliasAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSGrantAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSKeyPolicyAdapter(kmsClient, *callerID.Account, cfg.Region),
-
-						// ApiGateway
-						adapters.NewAPIGatewayRestApiAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayResourceAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayDomainNameAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayMethodAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayMethodResponseAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayIntegrationAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayApiKeyAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayAuthorizerAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayDeploymentAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayStageAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayModelAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-
-						// SSM
-						adapters.NewSSMParameterAdapter(ssmClient, *callerID.Account, cfg.Region),
-					}
-
-					err = e.AddAdapters(configuredAdapters...)
-					if err != nil {
-						return err
-					}
-
-					// Add "global" sources (those that aren't tied to a region, like
-					// cloudfront). but only do this once for the first region. For
-					// these APIs it doesn't matter which region we call them from, we
-					// get global results
-					if globalDone.CompareAndSwap(false, true) {
-						err = e.AddAdapters(
-							// Cloudfront
-							adapters.NewCloudfrontCachePolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontContinuousDeploymentPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontDistributionAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontCloudfrontFunctionAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontKeyGroupAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontOriginAccessControlAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontOriginRequestPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontResponseHeadersPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontRealtimeLogConfigsAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontStreamingDistributionAdapter(cloudfrontClient, *callerID.Account),
-
-							// S3
-							adapters.NewS3Adapter(cfg, *callerID.Account),
-
-							// Networkmanager
-							adapters.NewNetworkManagerGlobalNetworkAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerSiteAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerLinkAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerDeviceAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerLinkAssociationAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerConnectionAdapter(networkmanagerClient, *callerID.Account),
-
-							// IAM
-							adapters.NewIAMPolicyAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMGroupAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMInstanceProfileAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMRoleAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMUserAdapter(iamClient, *callerID.Account),
-						)
-						if err != nil {
-							return err
-						}
-					}
-					return nil
+					return addAdaptersForConfig(ctx, e, cfg, addGlobal)
 				})
 			}
 
@@ -565,3 +311,558 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 		}
 	}
 }
+
+// addAdaptersForConfig adds the adapters for the account and region of the
+// config to the engine. The global adapters of the account are added if
+// `addGlobal` returns true
+func addAdaptersForConfig(ctx context.Context, e *discovery.Engine, cfg aws.Config, addGlobal func(accountID string) bool) error {
+	configCtx, configCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer configCancel()
+
+	log.WithFields(log.Fields{
+		"region": cfg.Region,
+	}).Info("Initializing AWS source")
+
+	// Work out what account we're using. This will be used in item scopes
+	stsClient := sts.NewFromConfig(cfg)
+
+	callerID, err := stsClient.GetCallerIdentity(configCtx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		lf := log.Fields{
+			"region": cfg.Region,
+		}
+		log.WithError(err).WithFields(lf).Error("Error retrieving account information")
+		return fmt.Errorf("error getting caller identity for region %v: %w", cfg.Region, err)
+	}
+
+	// Create shared clients for each API
+	autoscalingClient := awsautoscaling.NewFromConfig(cfg, func(o *awsautoscaling.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cloudfrontClient := awscloudfront.NewFromConfig(cfg, func(o *awscloudfront.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cloudwatchClient := awscloudwatch.NewFromConfig(cfg, func(o *awscloudwatch.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	directconnectClient := awsdirectconnect.NewFromConfig(cfg, func(o *awsdirectconnect.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	dynamodbClient := awsdynamodb.NewFromConfig(cfg, func(o *awsdynamodb.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ec2Client := awsec2.NewFromConfig(cfg, func(o *awsec2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ecsClient := awsecs.NewFromConfig(cfg, func(o *awsecs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	efsClient := awsefs.NewFromConfig(cfg, func(o *awsefs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	eksClient := awseks.NewFromConfig(cfg, func(o *awseks.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elbClient := awselasticloadbalancing.NewFromConfig(cfg, func(o *awselasticloadbalancing.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elbv2Client := awselasticloadbalancingv2.NewFromConfig(cfg, func(o *awselasticloadbalancingv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	lambdaClient := awslambda.NewFromConfig(cfg, func(o *awslambda.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	networkfirewallClient := awsnetworkfirewall.NewFromConfig(cfg, func(o *awsnetworkfirewall.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	rdsClient := awsrds.NewFromConfig(cfg, func(o *awsrds.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	snsClient := awssns.NewFromConfig(cfg, func(o *awssns.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	sqsClient := awssqs.NewFromConfig(cfg, func(o *awssqs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	route53Client := awsroute53.NewFromConfig(cfg, func(o *awsroute53.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	iamClient := awsiam.NewFromConfig(cfg, func(o *awsiam.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+		// Increase this from the default of 3 since IAM as such low rate limits
+		o.RetryMaxAttempts = 5
+	})
+	kmsClient := awskms.NewFromConfig(cfg, func(o *awskms.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	apigatewayClient := awsapigateway.NewFromConfig(cfg, func(o *awsapigateway.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	apigatewayv2Client := awsapigatewayv2.NewFromConfig(cfg, func(o *awsapigatewayv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cognitoIdentityProviderClient := awscognitoidentityprovider.NewFromConfig(cfg, func(o *awscognitoidentityprovider.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cognitoIdentityClient := awscognitoidentity.NewFromConfig(cfg, func(o *awscognitoidentity.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ssoadminClient := awsssoadmin.NewFromConfig(cfg, func(o *awsssoadmin.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cloudtrailClient := awscloudtrail.NewFromConfig(cfg, func(o *awscloudtrail.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	configClient := awsconfigservice.NewFromConfig(cfg, func(o *awsconfigservice.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	backupClient := awsbackup.NewFromConfig(cfg, func(o *awsbackup.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	taggingClient := awsresourcegroupstaggingapi.NewFromConfig(cfg, func(o *awsresourcegroupstaggingapi.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	logsClient := awscloudwatchlogs.NewFromConfig(cfg, func(o *awscloudwatchlogs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	s3controlClient := awss3control.NewFromConfig(cfg, func(o *awss3control.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	batchClient := awsbatch.NewFromConfig(cfg, func(o *awsbatch.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	glueClient := awsglue.NewFromConfig(cfg, func(o *awsglue.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	athenaClient := awsathena.NewFromConfig(cfg, func(o *awsathena.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	route53resolverClient := awsroute53resolver.NewFromConfig(cfg, func(o *awsroute53resolver.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	applicationautoscalingClient := awsapplicationautoscaling.NewFromConfig(cfg, func(o *awsapplicationautoscaling.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ssmClient := ssm.NewFromConfig(cfg, func(o *ssm.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	secretsmanagerClient := awssecretsmanager.NewFromConfig(cfg, func(o *awssecretsmanager.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	acmClient := awsacm.NewFromConfig(cfg, func(o *awsacm.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ecrClient := awsecr.NewFromConfig(cfg, func(o *awsecr.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	codebuildClient := awscodebuild.NewFromConfig(cfg, func(o *awscodebuild.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	codepipelineClient := awscodepipeline.NewFromConfig(cfg, func(o *awscodepipeline.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	eventbridgeClient := awseventbridge.NewFromConfig(cfg, func(o *awseventbridge.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	sfnClient := awssfn.NewFromConfig(cfg, func(o *awssfn.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	kinesisClient := awskinesis.NewFromConfig(cfg, func(o *awskinesis.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	firehoseClient := awsfirehose.NewFromConfig(cfg, func(o *awsfirehose.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elasticacheClient := awselasticache.NewFromConfig(cfg, func(o *awselasticache.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	opensearchClient := awsopensearch.NewFromConfig(cfg, func(o *awsopensearch.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	redshiftClient := awsredshift.NewFromConfig(cfg, func(o *awsredshift.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	kafkaClient := awskafka.NewFromConfig(cfg, func(o *awskafka.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	wafv2Client := awswafv2.NewFromConfig(cfg, func(o *awswafv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+
+	configuredAdapters := []discovery.Adapter{
+		// EC2
+		adapters.NewEC2AddressAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2CapacityReservationFleetAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2CapacityReservationAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2CustomerGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2EgressOnlyInternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2FlowLogAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2IamInstanceProfileAssociationAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2ImageAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2InstanceEventWindowAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2InstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2InstanceStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2InternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2KeyPairAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2LaunchTemplateAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2LaunchTemplateVersionAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2ManagedPrefixListAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2NatGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2NetworkAclAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2NetworkInterfacePermissionAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2NetworkInterfaceAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2PlacementGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2ReservedInstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2RouteTableAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2SecurityGroupRuleAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2SecurityGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2SnapshotAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2SubnetAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2TransitGatewayAttachmentAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2TransitGatewayRouteTableAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2TransitGatewayRouteAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2TransitGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VolumeAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VolumeStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VpcEndpointAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VpcPeeringConnectionAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VpcAdapter(ec2Client, *callerID.Account, cfg.Region),
+		adapters.NewEC2VpnConnectionAdapter(ec2Client, *callerID.Account, cfg.Region),
+
+		// EFS (I'm assuming it shares its rate limit with EC2))
+		adapters.NewEFSAccessPointAdapter(efsClient, *callerID.Account, cfg.Region),
+		adapters.NewEFSBackupPolicyAdapter(efsClient, *callerID.Account, cfg.Region),
+		adapters.NewEFSFileSystemAdapter(efsClient, *callerID.Account, cfg.Region),
+		adapters.NewEFSMountTargetAdapter(efsClient, *callerID.Account, cfg.Region),
+		adapters.NewEFSReplicationConfigurationAdapter(efsClient, *callerID.Account, cfg.Region),
+
+		// EKS
+		adapters.NewEKSAddonAdapter(eksClient, *callerID.Account, cfg.Region),
+		adapters.NewEKSClusterAdapter(eksClient, *callerID.Account, cfg.Region),
+		adapters.NewEKSFargateProfileAdapter(eksClient, *callerID.Account, cfg.Region),
+		adapters.NewEKSNodegroupAdapter(eksClient, *callerID.Account, cfg.Region),
+
+		// Route 53
+		adapters.NewRoute53HealthCheckAdapter(route53Client, *callerID.Account, cfg.Region),
+		adapters.NewRoute53HostedZoneAdapter(route53Client, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResourceRecordSetAdapter(route53Client, *callerID.Account, cfg.Region),
+		adapters.NewRoute53HostedZoneVPCAssociationAdapter(route53Client, *callerID.Account, cfg.Region),
+
+		// Cloudwatch
+		adapters.NewCloudwatchAlarmAdapter(cloudwatchClient, *callerID.Account, cfg.Region),
+
+		// Lambda
+		adapters.NewLambdaFunctionAdapter(lambdaClient, *callerID.Account, cfg.Region),
+		adapters.NewLambdaLayerAdapter(lambdaClient, *callerID.Account, cfg.Region),
+		adapters.NewLambdaLayerVersionAdapter(lambdaClient, *callerID.Account, cfg.Region),
+		adapters.NewLambdaEventSourceMappingAdapter(lambdaClient, *callerID.Account, cfg.Region),
+
+		// ECS
+		adapters.NewECSCapacityProviderAdapter(ecsClient, *callerID.Account, cfg.Region),
+		adapters.NewECSClusterAdapter(ecsClient, *callerID.Account, cfg.Region),
+		adapters.NewECSContainerInstanceAdapter(ecsClient, *callerID.Account, cfg.Region),
+		adapters.NewECSServiceAdapter(ecsClient, *callerID.Account, cfg.Region),
+		adapters.NewECSTaskDefinitionAdapter(ecsClient, *callerID.Account, cfg.Region),
+		adapters.NewECSTaskAdapter(ecsClient, *callerID.Account, cfg.Region),
+
+		// DynamoDB
+		adapters.NewDynamoDBBackupAdapter(dynamodbClient, *callerID.Account, cfg.Region),
+		adapters.NewDynamoDBTableAdapter(dynamodbClient, *callerID.Account, cfg.Region),
+
+		// RDS
+		adapters.NewRDSDBClusterParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
+		adapters.NewRDSDBClusterAdapter(rdsClient, *callerID.Account, cfg.Region),
+		adapters.NewRDSDBInstanceAdapter(rdsClient, *callerID.Account, cfg.Region),
+		adapters.NewRDSDBParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
+		adapters.NewRDSDBSubnetGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
+		adapters.NewRDSOptionGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
+
+		// Autoscaling
+		adapters.NewAutoScalingGroupAdapter(autoscalingClient, *callerID.Account, cfg.Region),
+		adapters.NewAutoScalingLifecycleHookAdapter(autoscalingClient, *callerID.Account, cfg.Region),
+		adapters.NewAutoScalingWarmPoolAdapter(autoscalingClient, *callerID.Account, cfg.Region),
+		adapters.NewAutoScalingScheduledActionAdapter(autoscalingClient, *callerID.Account, cfg.Region),
+
+		// ELB
+		adapters.NewELBInstanceHealthAdapter(elbClient, *callerID.Account, cfg.Region),
+		adapters.NewELBLoadBalancerAdapter(elbClient, *callerID.Account, cfg.Region),
+
+		// ELBv2
+		adapters.NewELBv2ListenerAdapter(elbv2Client, *callerID.Account, cfg.Region),
+		adapters.NewELBv2LoadBalancerAdapter(elbv2Client, *callerID.Account, cfg.Region),
+		adapters.NewELBv2RuleAdapter(elbv2Client, *callerID.Account, cfg.Region),
+		adapters.NewELBv2TargetGroupAdapter(elbv2Client, *callerID.Account, cfg.Region),
+		adapters.NewELBv2TargetHealthAdapter(elbv2Client, *callerID.Account, cfg.Region),
+
+		// Network Firewall
+		adapters.NewNetworkFirewallFirewallAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkFirewallFirewallPolicyAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkFirewallRuleGroupAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkFirewallTLSInspectionConfigurationAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
+
+		// Direct Connect
+		adapters.NewDirectConnectGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectGatewayAssociationAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectGatewayAssociationProposalAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectGatewayAttachmentAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectVirtualInterfaceAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectVirtualGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectCustomerMetadataAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectLagAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectLocationAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectHostedConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectInterconnectAdapter(directconnectClient, *callerID.Account, cfg.Region),
+		adapters.NewDirectConnectRouterConfigurationAdapter(directconnectClient, *callerID.Account, cfg.Region),
+
+		// Network Manager
+		adapters.NewNetworkManagerConnectAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerConnectPeerAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerCoreNetworkPolicyAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerCoreNetworkAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerNetworkResourceRelationshipsAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerSiteToSiteVpnAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayPeeringAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayRegistrationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayRouteTableAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+		adapters.NewNetworkManagerVPCAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
+
+		// SQS
+		adapters.NewSQSQueueAdapter(sqsClient, *callerID.Account, cfg.Region),
+
+		// SNS
+		adapters.NewSNSSubscriptionAdapter(snsClient, *callerID.Account, cfg.Region),
+		adapters.NewSNSTopicAdapter(snsClient, *callerID.Account, cfg.Region),
+		adapters.NewSNSPlatformApplicationAdapter(snsClient, *callerID.Account, cfg.Region),
+		adapters.NewSNSEndpointAdapter(snsClient, *callerID.Account, cfg.Region),
+		adapters.NewSNSDataProtectionPolicyAdapter(snsClient, *callerID.Account, cfg.Region),
+
+		// KMS
+		adapters.NewKMSKeyAdapter(kmsClient, *callerID.Account, cfg.Region),
+		adapters.NewKMSCustomKeyStoreAdapter(kmsClient, *callerID.Account, cfg.Region),
+		adapters.NewKMSAliasAdapter(kmsClient, *callerID.Account, cfg.Region),
+		adapters.NewKMSGrantAdapter(kmsClient, *callerID.Account, cfg.Region),
+		adapters.NewKMSKeyPolicyAdapter(kmsClient, *callerID.Account, cfg.Region),
+
+		// ApiGateway
+		adapters.NewAPIGatewayRestApiAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayResourceAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayDomainNameAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayMethodAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayMethodResponseAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayIntegrationAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayApiKeyAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayAuthorizerAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayDeploymentAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayStageAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayModelAdapter(apigatewayClient, *callerID.Account, cfg.Region),
+
+		// ApiGatewayV2
+		adapters.NewAPIGatewayV2APIAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2StageAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2RouteAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2IntegrationAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2AuthorizerAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2DomainNameAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+		adapters.NewAPIGatewayV2VpcLinkAdapter(apigatewayv2Client, *callerID.Account, cfg.Region),
+
+		// Cognito
+		adapters.NewCognitoUserPoolAdapter(cognitoIdentityProviderClient, *callerID.Account, cfg.Region),
+		adapters.NewCognitoUserPoolClientAdapter(cognitoIdentityProviderClient, *callerID.Account, cfg.Region),
+		adapters.NewCognitoUserPoolDomainAdapter(cognitoIdentityProviderClient, *callerID.Account, cfg.Region),
+		adapters.NewCognitoIdentityPoolAdapter(cognitoIdentityClient, *callerID.Account, cfg.Region),
+
+		// Identity Center
+		adapters.NewSSOAdminPermissionSetAdapter(ssoadminClient, *callerID.Account, cfg.Region),
+		adapters.NewSSOAdminAccountAssignmentAdapter(ssoadminClient, *callerID.Account, cfg.Region),
+
+		// CloudTrail
+		adapters.NewCloudtrailTrailAdapter(cloudtrailClient, *callerID.Account, cfg.Region),
+
+		// Config
+		adapters.NewConfigConfigurationRecorderAdapter(configClient, *callerID.Account, cfg.Region),
+		adapters.NewConfigRuleAdapter(configClient, *callerID.Account, cfg.Region),
+
+		// Backup
+		adapters.NewBackupVaultAdapter(backupClient, *callerID.Account, cfg.Region),
+		adapters.NewBackupPlanAdapter(backupClient, *callerID.Account, cfg.Region),
+		adapters.NewBackupSelectionAdapter(backupClient, taggingClient, *callerID.Account, cfg.Region),
+
+		// CloudWatch Logs
+		adapters.NewLogsLogGroupAdapter(logsClient, *callerID.Account, cfg.Region),
+		adapters.NewLogsMetricFilterAdapter(logsClient, *callerID.Account, cfg.Region),
+		adapters.NewLogsSubscriptionFilterAdapter(logsClient, *callerID.Account, cfg.Region),
+
+		// S3 Access Points
+		adapters.NewS3AccessPointAdapter(s3controlClient, *callerID.Account, cfg.Region),
+		adapters.NewS3ObjectLambdaAccessPointAdapter(s3controlClient, *callerID.Account, cfg.Region),
+
+		// Batch
+		adapters.NewBatchJobQueueAdapter(batchClient, *callerID.Account, cfg.Region),
+		adapters.NewBatchComputeEnvironmentAdapter(batchClient, *callerID.Account, cfg.Region),
+
+		// Glue
+		adapters.NewGlueJobAdapter(glueClient, *callerID.Account, cfg.Region),
+		adapters.NewGlueCrawlerAdapter(glueClient, *callerID.Account, cfg.Region),
+		adapters.NewGlueDatabaseAdapter(glueClient, *callerID.Account, cfg.Region),
+		adapters.NewGlueTableAdapter(glueClient, *callerID.Account, cfg.Region),
+
+		// Athena
+		adapters.NewAthenaWorkGroupAdapter(athenaClient, *callerID.Account, cfg.Region),
+
+		// Route 53 Resolver
+		adapters.NewRoute53ResolverEndpointAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResolverRuleAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResolverRuleAssociationAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResolverFirewallRuleGroupAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResolverFirewallRuleGroupAssociationAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+		adapters.NewRoute53ResolverDNSAdapter(route53resolverClient, route53Client, *callerID.Account, cfg.Region),
+
+		// Application Auto Scaling
+		adapters.NewApplicationAutoScalingScalableTargetAdapter(applicationautoscalingClient, *callerID.Account, cfg.Region),
+		adapters.NewApplicationAutoScalingScalingPolicyAdapter(applicationautoscalingClient, *callerID.Account, cfg.Region),
+
+		// SSM
+		adapters.NewSSMParameterAdapter(ssmClient, *callerID.Account, cfg.Region),
+
+		// Secrets Manager
+		adapters.NewSecretsManagerSecretAdapter(secretsmanagerClient, *callerID.Account, cfg.Region),
+
+		// ACM
+		adapters.NewACMCertificateAdapter(acmClient, *callerID.Account, cfg.Region),
+
+		// ECR
+		adapters.NewECRRepositoryAdapter(ecrClient, *callerID.Account, cfg.Region),
+		adapters.NewECRImageAdapter(ecrClient, *callerID.Account, cfg.Region),
+
+		// CodeBuild
+		adapters.NewCodeBuildProjectAdapter(codebuildClient, *callerID.Account, cfg.Region),
+
+		// CodePipeline
+		adapters.NewCodePipelinePipelineAdapter(codepipelineClient, *callerID.Account, cfg.Region),
+
+		// EventBridge
+		adapters.NewEventsEventBusAdapter(eventbridgeClient, *callerID.Account, cfg.Region),
+		adapters.NewEventsRuleAdapter(eventbridgeClient, *callerID.Account, cfg.Region),
+
+		// Step Functions
+		adapters.NewStatesStateMachineAdapter(sfnClient, *callerID.Account, cfg.Region),
+
+		// Kinesis
+		adapters.NewKinesisStreamAdapter(kinesisClient, *callerID.Account, cfg.Region),
+
+		// Firehose
+		adapters.NewFirehoseDeliveryStreamAdapter(firehoseClient, *callerID.Account, cfg.Region),
+
+		// ElastiCache
+		adapters.NewElastiCacheCacheClusterAdapter(elasticacheClient, *callerID.Account, cfg.Region),
+		adapters.NewElastiCacheReplicationGroupAdapter(elasticacheClient, *callerID.Account, cfg.Region),
+		adapters.NewElastiCacheSubnetGroupAdapter(elasticacheClient, *callerID.Account, cfg.Region),
+
+		// OpenSearch
+		adapters.NewOpenSearchDomainAdapter(opensearchClient, *callerID.Account, cfg.Region),
+
+		// Redshift
+		adapters.NewRedshiftClusterAdapter(redshiftClient, *callerID.Account, cfg.Region),
+
+		// MSK
+		adapters.NewKafkaClusterAdapter(kafkaClient, *callerID.Account, cfg.Region),
+
+		// WAFv2
+		adapters.NewWAFv2WebACLAdapter(wafv2Client, *callerID.Account, cfg.Region),
+		adapters.NewWAFv2RuleGroupAdapter(wafv2Client, *callerID.Account, cfg.Region),
+		adapters.NewWAFv2IPSetAdapter(wafv2Client, *callerID.Account, cfg.Region),
+		adapters.NewWAFv2RegexPatternSetAdapter(wafv2Client, *callerID.Account, cfg.Region),
+	}
+
+	err = e.AddAdapters(configuredAdapters...)
+	if err != nil {
+		return err
+	}
+
+	// Add "global" sources (those that aren't tied to a region, like
+	// cloudfront). but only do this once for the first region of each
+	// account. For these APIs it doesn't matter which region we call them
+	// from, we get global results
+	if addGlobal(*callerID.Account) {
+		// WAF resources that protect CloudFront distributions, and Shield, can
+		// only be managed from us-east-1
+		cloudfrontWAFv2Client := awswafv2.NewFromConfig(cfg, func(o *awswafv2.Options) {
+			o.RetryMode = aws.RetryModeAdaptive
+			o.Region = "us-east-1"
+		})
+		shieldClient := awsshield.NewFromConfig(cfg, func(o *awsshield.Options) {
+			o.RetryMode = aws.RetryModeAdaptive
+			o.Region = "us-east-1"
+		})
+		// The Health API is only available in us-east-1 and returns the events
+		// of all regions
+		healthClient := awshealth.NewFromConfig(cfg, func(o *awshealth.Options) {
+			o.RetryMode = aws.RetryModeAdaptive
+			o.Region = "us-east-1"
+		})
+		// The Global Accelerator API is only available in us-west-2
+		globalacceleratorClient := awsglobalaccelerator.NewFromConfig(cfg, func(o *awsglobalaccelerator.Options) {
+			o.RetryMode = aws.RetryModeAdaptive
+			o.Region = "us-west-2"
+		})
+
+		err = e.AddAdapters(
+			// Cloudfront
+			adapters.NewCloudfrontCachePolicyAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontContinuousDeploymentPolicyAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontDistributionAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontCloudfrontFunctionAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontKeyGroupAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontOriginAccessControlAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontOriginRequestPolicyAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontResponseHeadersPolicyAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontRealtimeLogConfigsAdapter(cloudfrontClient, *callerID.Account),
+			adapters.NewCloudfrontStreamingDistributionAdapter(cloudfrontClient, *callerID.Account),
+
+			// S3
+			adapters.NewS3Adapter(cfg, *callerID.Account),
+
+			// Networkmanager
+			adapters.NewNetworkManagerGlobalNetworkAdapter(networkmanagerClient, *callerID.Account),
+			adapters.NewNetworkManagerSiteAdapter(networkmanagerClient, *callerID.Account),
+			adapters.NewNetworkManagerLinkAdapter(networkmanagerClient, *callerID.Account),
+			adapters.NewNetworkManagerDeviceAdapter(networkmanagerClient, *callerID.Account),
+			adapters.NewNetworkManagerLinkAssociationAdapter(networkmanagerClient, *callerID.Account),
+			adapters.NewNetworkManagerConnectionAdapter(networkmanagerClient, *callerID.Account),
+
+			// IAM
+			adapters.NewIAMPolicyAdapter(iamClient, *callerID.Account),
+			adapters.NewIAMGroupAdapter(iamClient, *callerID.Account),
+			adapters.NewIAMInstanceProfileAdapter(iamClient, *callerID.Account),
+			adapters.NewIAMRoleAdapter(iamClient, *callerID.Account),
+			adapters.NewIAMUserAdapter(iamClient, *callerID.Account),
+
+			// WAFv2 for CloudFront
+			adapters.NewWAFv2WebACLAdapter(cloudfrontWAFv2Client, *callerID.Account, ""),
+			adapters.NewWAFv2RuleGroupAdapter(cloudfrontWAFv2Client, *callerID.Account, ""),
+			adapters.NewWAFv2IPSetAdapter(cloudfrontWAFv2Client, *callerID.Account, ""),
+			adapters.NewWAFv2RegexPatternSetAdapter(cloudfrontWAFv2Client, *callerID.Account, ""),
+
+			// Shield
+			adapters.NewShieldProtectionAdapter(shieldClient, *callerID.Account),
+
+			// Global Accelerator
+			adapters.NewGlobalAcceleratorAcceleratorAdapter(globalacceleratorClient, *callerID.Account),
+			adapters.NewGlobalAcceleratorListenerAdapter(globalacceleratorClient, *callerID.Account),
+			adapters.NewGlobalAcceleratorEndpointGroupAdapter(globalacceleratorClient, *callerID.Account),
+
+			// Health
+			adapters.NewHealthEventAdapter(healthClient, *callerID.Account),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package proc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/cli/aws-source/replay"
+	"github.com/overmindtech/cli/discovery"
+)
+
+func TestInitializeAwsSourceEngineReplay(t *testing.T) {
+	fixture, err := replay.LoadFixture(replay.FixturePath(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	e, err := InitializeAwsSourceEngine(ctx, &discovery.EngineConfig{
+		MaxParallelExecutions: 1,
+	}, 1, replay.Config(fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scopes, metadata := e.GetAvailableScopesAndMetadata()
+
+	for _, scope := range []string{"123456789012", "123456789012.eu-west-2"} {
+		if !slices.Contains(scopes, scope) {
+			t.Errorf("expected scope %v, got %v", scope, scopes)
+		}
+	}
+
+	if len(metadata) == 0 {
+		t.Error("expected adapters to be added")
+	}
+}
@@ -0,0 +1,166 @@
+package replay
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FakeAccountID is the ID that the first account found in a recording is
+// replaced with. This is usually the account that was used to record
+const FakeAccountID = "123456789012"
+
+// accountPatterns find account IDs. Only IDs in these places are learnt, but
+// once an ID is known it is replaced everywhere, e.g. in EC2 owner IDs and
+// SQS queue URLs
+var accountPatterns = []*regexp.Regexp{
+	// ARNs, e.g. arn:aws:ec2:eu-west-2:123456789012:instance/i-1234
+	regexp.MustCompile(`arn:aws[\w-]*:[\w-]*:[\w-]*:(\d{12}):`),
+	// Fields that contain account IDs in XML and JSON responses, e.g.
+	// <Account>123456789012</Account>, <ownerId>123456789012</ownerId> or
+	// "AccountId":"123456789012"
+	regexp.MustCompile(`(?i)(?:account|accountid|ownerid|owner)["']?\s*[:>=]\s*["']?(\d{12})\b`),
+	// URLs that include the account, e.g. SQS queue URLs
+	regexp.MustCompile(`amazonaws\.com(?:%2F|/)(\d{12})(?:%2F|/)`),
+}
+
+// secretPatterns find values that are removed entirely
+var secretPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Temporary credentials returned by STS
+	{regexp.MustCompile(`(<(SecretAccessKey|SessionToken)>)[^<]*(</(SecretAccessKey|SessionToken)>)`), "${1}REDACTED${3}"},
+	{regexp.MustCompile(`("(SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`), "${1}REDACTED${3}"},
+	// Access key IDs
+	{regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`), "${1}IOSFODNN7EXAMPLE"},
+	// The unique IDs of IAM users and roles
+	{regexp.MustCompile(`(<UserId>)[^<]*(</UserId>)`), "${1}AIDAEXAMPLE${2}"},
+	// Session names, which are often email addresses
+	{regexp.MustCompile(`(:assumed-role/[\w+=,.@-]+/)[\w+=,.@-]+`), "${1}session"},
+}
+
+// Redactor replaces sensitive values in recordings. Account IDs are replaced
+// with fake IDs consistently, so an account has the same fake ID everywhere
+// that it appears. The first account that is learnt becomes
+// `FakeAccountID`, and the others get sequential IDs
+type Redactor struct {
+	mu           sync.Mutex
+	accounts     map[string]string
+	fakes        map[string]bool
+	replacements map[string]string
+}
+
+// NewRedactor returns a redactor that doesn't know any accounts yet
+func NewRedactor() *Redactor {
+	return &Redactor{
+		accounts:     make(map[string]string),
+		fakes:        make(map[string]bool),
+		replacements: make(map[string]string),
+	}
+}
+
+// AddReplacement replaces a value everywhere that it appears, such as the
+// name of a resource that shouldn't be committed
+func (r *Redactor) AddReplacement(value, replacement string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.replacements[value] = replacement
+}
+
+// Learn finds the account IDs in a value so that they are replaced by
+// `Redact()`. IDs that are already fake are ignored so that redacting a
+// fixture again doesn't change it
+func (r *Redactor) Learn(value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, pattern := range accountPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(value, -1) {
+			r.learnAccount(match[1])
+		}
+	}
+}
+
+func (r *Redactor) learnAccount(id string) {
+	if _, ok := r.accounts[id]; ok || r.fakes[id] {
+		return
+	}
+
+	// IDs from a fixture that has already been redacted are kept as they
+	// are, but still reserved so that new accounts get different IDs
+	if isFakeAccountID(id) {
+		r.fakes[id] = true
+		return
+	}
+
+	fake := FakeAccountID
+	for n := 1; r.fakes[fake]; n++ {
+		fake = fmt.Sprintf("%012d", fakeAccountBase+n)
+	}
+
+	r.accounts[id] = fake
+	r.fakes[fake] = true
+}
+
+// Accounts other than the first are replaced with IDs counting up from here
+const fakeAccountBase = 100000000000
+
+func isFakeAccountID(id string) bool {
+	return id == FakeAccountID || strings.HasPrefix(id, "1000000")
+}
+
+// Redact learns the accounts in a value and returns it with the accounts,
+// credentials and replacements redacted
+func (r *Redactor) Redact(value string) string {
+	r.Learn(value)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, secret := range secretPatterns {
+		value = secret.pattern.ReplaceAllString(value, secret.replacement)
+	}
+
+	for id, fake := range r.accounts {
+		// Only whole numbers are replaced so that longer numbers that happen
+		// to contain an account ID, such as timestamps, are left alone. Word
+		// boundaries can't be used since IDs in encoded URLs follow "%2F".
+		// Adjacent IDs share a separator so the second pass replaces the
+		// ones that the first pass skipped
+		pattern := regexp.MustCompile(`(^|[^0-9])` + id + `([^0-9]|$)`)
+		for range 2 {
+			value = pattern.ReplaceAllString(value, "${1}"+fake+"${2}")
+		}
+	}
+
+	pairs := make([]string, 0, 2*len(r.replacements))
+
+	// Longer values are replaced first so that values which contain other
+	// values are replaced whole
+	values := make([]string, 0, len(r.replacements))
+	for v := range r.replacements {
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+
+		return values[i] < values[j]
+	})
+
+	for _, v := range values {
+		pairs = append(pairs, v, r.replacements[v])
+	}
+
+	if len(pairs) == 0 {
+		return value
+	}
+
+	return strings.NewReplacer(pairs...).Replace(value)
+}
@@ -0,0 +1,146 @@
+// Command redact redacts replay fixtures again. Fixtures are redacted when
+// they are recorded, but this can be used to replace values that the
+// redactor doesn't know about, such as the names of resources, or to check
+// that committed fixtures don't contain anything that should be redacted.
+// The same redactor is used for every fixture so that an account gets the
+// same fake ID in all of them.
+//
+// Usage:
+//
+//	go run ./aws-source/replay/redact [-check] [-replace value=replacement]... path...
+//
+// Paths can be fixtures or directories, which are searched for fixtures
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/overmindtech/cli/aws-source/replay"
+)
+
+type replacements []string
+
+func (r *replacements) String() string {
+	return strings.Join(*r, ",")
+}
+
+func (r *replacements) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("invalid replacement %q, expected value=replacement", value)
+	}
+
+	*r = append(*r, value)
+
+	return nil
+}
+
+func main() {
+	var replace replacements
+
+	check := flag.Bool("check", false, "Don't write the fixtures, and exit with an error if any of them would change")
+	flag.Var(&replace, "replace", "A value to replace in every fixture, in the format value=replacement. Can be repeated")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: redact [-check] [-replace value=replacement]... path...")
+		os.Exit(2)
+	}
+
+	redactor := replay.NewRedactor()
+
+	for _, r := range replace {
+		value, replacement, _ := strings.Cut(r, "=")
+		redactor.AddReplacement(value, replacement)
+	}
+
+	paths, err := fixturePaths(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	changed := 0
+
+	for _, path := range paths {
+		fixture, err := replay.LoadFixture(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		original, err := replay.LoadFixture(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fixture.Redact(redactor)
+
+		if reflect.DeepEqual(fixture, original) {
+			continue
+		}
+
+		changed++
+
+		if *check {
+			fmt.Printf("%v needs redacting\n", path)
+			continue
+		}
+
+		if err := fixture.Save(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Redacted %v\n", path)
+	}
+
+	if *check && changed > 0 {
+		os.Exit(1)
+	}
+}
+
+// Returns the fixtures in the paths, searching directories for JSON files
+func fixturePaths(args []string) ([]string, error) {
+	paths := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+
+		err = filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() && filepath.Ext(path) == ".json" {
+				paths = append(paths, path)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New("no fixtures found")
+	}
+
+	return paths, nil
+}
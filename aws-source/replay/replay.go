@@ -0,0 +1,192 @@
+// Package replay records the responses of AWS APIs and replays them, so that
+// adapters can be tested end to end without credentials or a network.
+//
+// Only tests that have a fixture in testdata/replay run offline. Fixtures can
+// only be recorded with AWS credentials, so most adapter tests don't have one
+// yet: these still call AWS and are skipped when CI is set to "true" and no
+// credentials are available.
+//
+// Recording is done by an HTTP transport that sits under the AWS SDK. The
+// responses are redacted before they are saved so that fixtures can be
+// committed: account IDs are replaced with fake IDs that are the same
+// everywhere they appear, including inside ARNs and URLs, and credentials are
+// removed. When replaying, requests are matched to the recorded requests and
+// the recorded responses are returned in the order that they were recorded.
+//
+// The mode is set with the AWS_SOURCE_REPLAY environment variable:
+//
+//   - "record": call AWS with the local credentials and save the responses
+//   - "replay": only use saved responses, failing if there aren't any
+//   - "off": always call AWS
+//
+// If it isn't set, tests replay their fixture if there is one and call AWS
+// otherwise. To re-record the fixtures of an adapter, run its tests with
+// AWS_SOURCE_REPLAY=record, e.g.
+//
+//	AWS_SOURCE_REPLAY=record go test ./aws-source/adapters -run TestNewEC2InstanceAdapter
+//
+// Fixtures that need further redaction, such as resource names, can be
+// rewritten with the tool in the redact directory
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Mode is how requests to AWS are handled
+type Mode string
+
+const (
+	// Replay the fixture if it exists, otherwise call AWS
+	ModeAuto Mode = ""
+	// Call AWS and save the responses
+	ModeRecord Mode = "record"
+	// Only use saved responses
+	ModeReplay Mode = "replay"
+	// Call AWS without recording
+	ModeOff Mode = "off"
+)
+
+// EnvVar is the environment variable that sets the mode
+const EnvVar = "AWS_SOURCE_REPLAY"
+
+// ModeFromEnv returns the mode set by the environment
+func ModeFromEnv() (Mode, error) {
+	mode := Mode(strings.ToLower(strings.TrimSpace(os.Getenv(EnvVar))))
+
+	switch mode {
+	case ModeAuto, ModeRecord, ModeReplay, ModeOff:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %v %q, valid values are record, replay and off", EnvVar, mode)
+	}
+}
+
+// ModeFor returns the mode for a test, resolving ModeAuto based on whether
+// the fixture for the test exists
+func ModeFor(t testing.TB) (Mode, error) {
+	mode, err := ModeFromEnv()
+	if err != nil {
+		return "", err
+	}
+
+	if mode != ModeAuto {
+		return mode, nil
+	}
+
+	if _, err := os.Stat(FixturePath(t)); err == nil {
+		return ModeReplay, nil
+	}
+
+	return ModeOff, nil
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// FixturePath returns the file that the fixture of a test is saved in. This
+// is relative to the package of the test, which is the working directory
+// when tests are run
+func FixturePath(t testing.TB) string {
+	return filepath.Join("testdata", "replay", unsafeFileChars.ReplaceAllString(t.Name(), "_")+".json")
+}
+
+// Fixture is a recording of the requests made to AWS and their responses
+type Fixture struct {
+	// The region that was used when recording. Replaying uses the same
+	// region so that requests go to the same endpoints
+	Region string `json:"region"`
+
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of a request that is used to match it when replaying.
+// Headers aren't saved since they contain signatures and credentials, apart
+// from the target of JSON APIs which identifies the operation
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Target string `json:"target,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body,omitempty"`
+
+	// Bodies that aren't text are base64 encoded, and aren't redacted
+	Base64 bool `json:"base64,omitempty"`
+}
+
+// LoadFixture reads a fixture from a file
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read fixture: %w", err)
+	}
+
+	var fixture Fixture
+
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("could not parse fixture %v: %w", path, err)
+	}
+
+	return &fixture, nil
+}
+
+// Save writes the fixture to a file, creating the directory if needed
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create directory for fixture: %w", err)
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// Redact redacts every interaction in the fixture with the redactor. The
+// accounts in the whole fixture are found first so that the same fake ID is
+// used for an account even where it appears before the response that
+// revealed it
+func (f *Fixture) Redact(r *Redactor) {
+	for _, interaction := range f.Interactions {
+		r.Learn(interaction.Request.URL)
+		r.Learn(interaction.Request.Body)
+
+		if !interaction.Response.Base64 {
+			r.Learn(interaction.Response.Body)
+		}
+	}
+
+	for i := range f.Interactions {
+		interaction := &f.Interactions[i]
+
+		interaction.Request.URL = r.Redact(interaction.Request.URL)
+		interaction.Request.Body = r.Redact(interaction.Request.Body)
+
+		if !interaction.Response.Base64 {
+			interaction.Response.Body = r.Redact(interaction.Response.Body)
+		}
+
+		for key, value := range interaction.Response.Header {
+			interaction.Response.Header[key] = r.Redact(value)
+		}
+	}
+}
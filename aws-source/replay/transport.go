@@ -0,0 +1,325 @@
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrNoInteraction is returned when replaying a request that wasn't recorded
+var ErrNoInteraction = errors.New("no recorded response for request")
+
+// The response headers that are recorded. Other headers are either
+// different on every request, like request IDs, or would be wrong once the
+// body has been redacted, like checksums
+var recordedHeaders = []string{
+	"Content-Type",
+	"X-Amzn-Errortype",
+	"X-Amzn-Query-Error",
+	"X-Amz-Bucket-Region",
+	"Location",
+}
+
+// Recorder is an HTTP transport that records requests and their responses.
+// Responses are passed through unchanged, and are only redacted when they
+// are saved
+type Recorder struct {
+	transport http.RoundTripper
+
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+// NewRecorder returns a transport that sends requests with the transport and
+// records them. The region is saved so that the recording can be replayed
+// against the same endpoints
+func NewRecorder(transport http.RoundTripper, region string) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{
+		transport: transport,
+		fixture: Fixture{
+			Region: region,
+		},
+	}
+}
+
+// RoundTrip sends the request and records the response
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("could not read response to record: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response, err := newResponse(resp, body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, Interaction{
+		Request:  request,
+		Response: response,
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Fixture returns the interactions that have been recorded so far, redacted
+// with the redactor
+func (r *Recorder) Fixture(redactor *Redactor) *Fixture {
+	r.mu.Lock()
+	fixture := Fixture{
+		Region:       r.fixture.Region,
+		Interactions: make([]Interaction, len(r.fixture.Interactions)),
+	}
+
+	for i, interaction := range r.fixture.Interactions {
+		fixture.Interactions[i] = interaction
+		fixture.Interactions[i].Response.Header = make(map[string]string, len(interaction.Response.Header))
+
+		for key, value := range interaction.Response.Header {
+			fixture.Interactions[i].Response.Header[key] = value
+		}
+	}
+	r.mu.Unlock()
+
+	fixture.Redact(redactor)
+
+	return &fixture
+}
+
+// Save redacts the recording and writes it to a file
+func (r *Recorder) Save(path string, redactor *Redactor) error {
+	return r.Fixture(redactor).Save(path)
+}
+
+// Player is an HTTP transport that responds to requests with the responses
+// from a fixture, without sending them. Requests are matched to recorded
+// requests exactly where possible. Requests that differ, for example because
+// they contain the current time, are matched by their operation instead.
+// Requests that match several interactions get the responses in the order
+// that they were recorded, and then the last response is repeated
+type Player struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewPlayer returns a transport that replays the fixture
+func NewPlayer(fixture *Fixture) *Player {
+	return &Player{
+		interactions: fixture.Interactions,
+		used:         make([]bool, len(fixture.Interactions)),
+	}
+}
+
+// RoundTrip returns the recorded response for the request
+func (p *Player) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	index := p.match(func(recorded Request) bool {
+		return recorded == request
+	})
+
+	if index < 0 {
+		operation := request.operation()
+		index = p.match(func(recorded Request) bool {
+			return recorded.operation() == operation
+		})
+	}
+
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %v %v %v", ErrNoInteraction, request.Method, request.URL, request.Target)
+	}
+
+	p.used[index] = true
+
+	return p.interactions[index].Response.httpResponse(req)
+}
+
+// Returns the first unused interaction that matches, or the last one that
+// matches if they have all been used. Returns -1 if none match
+func (p *Player) match(matches func(Request) bool) int {
+	last := -1
+
+	for i, interaction := range p.interactions {
+		if !matches(interaction.Request) {
+			continue
+		}
+
+		if !p.used[i] {
+			return i
+		}
+
+		last = i
+	}
+
+	return last
+}
+
+// Returns the request in the form that it is recorded in. The body is read
+// and replaced so that it can still be sent
+func newRequest(req *http.Request) (Request, error) {
+	var body []byte
+
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return Request{}, fmt.Errorf("could not read request to record: %w", err)
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	u := *req.URL
+	u.RawQuery = u.Query().Encode()
+
+	return Request{
+		Method: req.Method,
+		URL:    u.String(),
+		Target: req.Header.Get("X-Amz-Target"),
+		Body:   normaliseBody(req.Header.Get("Content-Type"), body),
+	}, nil
+}
+
+// Returns the body with the parameters in a consistent order, since the
+// order of map keys isn't stable
+func normaliseBody(contentType string, body []byte) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Encode()
+		}
+	case strings.Contains(mediaType, "json"):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err == nil {
+			if normalised, err := json.Marshal(v); err == nil {
+				return string(normalised)
+			}
+		}
+	}
+
+	return string(body)
+}
+
+// Returns the operation of the request, which is the method, endpoint and
+// target or action, without the parameters
+func (r Request) operation() string {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return r.Method + " " + r.URL
+	}
+
+	operation := r.Method + " " + u.Host + u.Path + " " + r.Target
+
+	// Query APIs like EC2 put the action in the body or the query string
+	if values, err := url.ParseQuery(r.Body); err == nil && values.Get("Action") != "" {
+		operation += " " + values.Get("Action")
+	} else if action := u.Query().Get("Action"); action != "" {
+		operation += " " + action
+	}
+
+	return operation
+}
+
+func newResponse(resp *http.Response, body []byte) (Response, error) {
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return Response{}, fmt.Errorf("could not decompress response to record: %w", err)
+		}
+
+		body, err = io.ReadAll(reader)
+		if err != nil {
+			return Response{}, fmt.Errorf("could not decompress response to record: %w", err)
+		}
+	}
+
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Header:     make(map[string]string),
+	}
+
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			response.Header[name] = value
+		}
+	}
+
+	if utf8.Valid(body) {
+		response.Body = string(body)
+	} else {
+		response.Body = base64.StdEncoding.EncodeToString(body)
+		response.Base64 = true
+	}
+
+	return response, nil
+}
+
+func (r Response) httpResponse(req *http.Request) (*http.Response, error) {
+	body := []byte(r.Body)
+
+	if r.Base64 {
+		var err error
+
+		body, err = base64.StdEncoding.DecodeString(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode recorded response: %w", err)
+		}
+	}
+
+	header := make(http.Header, len(r.Header))
+	for name, value := range r.Header {
+		header.Set(name, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"sync/atomic"
 
 	"atomicgo.dev/keyboard"
 	"atomicgo.dev/keyboard/keys"
@@ -40,7 +41,11 @@ The CLI automatically discovers and uses:
 - GCP providers from your Terraform configuration (google and google-beta)
 - Falls back to default cloud provider credentials if no Terraform providers are found
 
-For GCP, ensure you have appropriate permissions (roles/browser or equivalent) to access project metadata.`,
+For GCP, ensure you have appropriate permissions (roles/browser or equivalent) to access project metadata.
+
+The sources can also be configured explicitly in .overmind/sources.yaml, including AWS profiles and regions, GCP projects, kubeconfig contexts, parallelism and the adapters to include or exclude. Use --sources to select which sources to run, e.g. --sources aws,k8s.
+
+Use --tui to browse the results of a query (--query-type, --query-scope, --query-method, --query) or a snapshot (--snapshot-id) in the terminal instead of the browser.`,
 	PreRun: PreRunSetup,
 	RunE:   Explore,
 
@@ -70,6 +75,18 @@ func StartLocalSources(ctx context.Context, oi sdp.OvermindInstance, token *oaut
 		return func() {}, fmt.Errorf("failed to get hostname: %w", err)
 	}
 
+	sourcesCfg, fromFile, err := checkForAndLoadLocalSourcesConfigFile(ctx, viper.GetString("sources-config"))
+	if err != nil {
+		return func() {}, err
+	}
+	selected, err := selectLocalSources(viper.GetStringSlice("sources"), sourcesCfg, fromFile)
+	if err != nil {
+		return func() {}, err
+	}
+	enabled := func(source string) bool {
+		return slices.Contains(selected, source)
+	}
+
 	p := pool.NewWithResults[[]*discovery.Engine]().WithErrors()
 
 	// find all the terraform files
@@ -79,8 +96,13 @@ func StartLocalSources(ctx context.Context, oi sdp.OvermindInstance, token *oaut
 		return nil, err
 	}
 
+	// the terraform providers are only needed for cloud sources that aren't
+	// configured explicitly in the sources config
+	needsTerraform := (enabled(localSourceAWS) && !sourcesCfg.AWS.explicit()) ||
+		(enabled(localSourceGCP) && !sourcesCfg.GCP.explicit())
+
 	// if no terraform files are found, return an error
-	if len(tfFiles) == 0 && !failOverToDefaultLoginCfg {
+	if len(tfFiles) == 0 && needsTerraform && !failOverToDefaultLoginCfg {
 		currentDir, _ := os.Getwd()
 		msgLines := []string{
 			fmt.Sprintf("No Terraform configuration files found in %s", currentDir),
@@ -99,251 +121,349 @@ func StartLocalSources(ctx context.Context, oi sdp.OvermindInstance, token *oaut
 		return nil, errors.New(strings.Join(msgLines, "\n"))
 	}
 
-	stdlibSpinner, _ := pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting stdlib source engine")
-	awsSpinner, _ := pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting AWS source engine")
-	gcpSpinner, _ := pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting GCP source engine")
+	var stdlibSpinner, awsSpinner, gcpSpinner, k8sSpinner *pterm.SpinnerPrinter
+	if enabled(localSourceStdlib) {
+		stdlibSpinner, _ = pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting stdlib source engine")
+	}
+	if enabled(localSourceAWS) {
+		awsSpinner, _ = pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting AWS source engine")
+	}
+	if enabled(localSourceGCP) {
+		gcpSpinner, _ = pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting GCP source engine")
+	}
+	if enabled(localSourceK8s) {
+		k8sSpinner, _ = pterm.DefaultSpinner.WithWriter(multi.NewWriter()).Start("Starting k8s source engine")
+	}
 	statusArea := pterm.DefaultParagraph.WithWriter(multi.NewWriter())
 
-	foundCloudProvider := false
-
-	p.Go(func() ([]*discovery.Engine, error) { //nolint:contextcheck // todo: pass in context with timeout to abort timely and allow Ctrl-C to work
-		ec := discovery.EngineConfig{
-			Version:               fmt.Sprintf("cli-%v", tracing.Version()),
-			EngineType:            "cli-stdlib",
-			SourceName:            fmt.Sprintf("stdlib-source-%v", hostname),
-			SourceUUID:            uuid.New(),
-			App:                   oi.ApiUrl.Host,
-			ApiKey:                token.AccessToken,
-			NATSOptions:           &natsOpts,
-			MaxParallelExecutions: 2_000,
-			HeartbeatOptions:      heartbeatOptions(oi, token),
-		}
-		stdlibEngine, err := stdlibSource.InitializeEngine(
-			&ec,
-			true,
-		)
-		if err != nil {
-			stdlibSpinner.Fail("Failed to initialize stdlib source engine")
-			return nil, fmt.Errorf("failed to initialize stdlib source engine: %w", err)
-		}
-		// todo: pass in context with timeout to abort timely and allow Ctrl-C to work
-		err = stdlibEngine.Start()
-		if err != nil {
-			stdlibSpinner.Fail("Failed to start stdlib source engine")
-			return nil, fmt.Errorf("failed to start stdlib source engine: %w", err)
-		}
-		stdlibSpinner.Success("Stdlib source engine started")
-		return []*discovery.Engine{stdlibEngine}, nil
-	})
+	var foundCloudProvider atomic.Bool
 
-	p.Go(func() ([]*discovery.Engine, error) {
-		tfEval, err := tfutils.LoadEvalContext(tfArgs, os.Environ())
-		if err != nil {
-			awsSpinner.Fail("Failed to load variables from the environment")
-			return nil, fmt.Errorf("failed to load variables from the environment: %w", err)
-		}
+	if enabled(localSourceStdlib) {
+		p.Go(func() ([]*discovery.Engine, error) { //nolint:contextcheck // todo: pass in context with timeout to abort timely and allow Ctrl-C to work
+			ec := discovery.EngineConfig{
+				Version:               fmt.Sprintf("cli-%v", tracing.Version()),
+				EngineType:            "cli-stdlib",
+				SourceName:            fmt.Sprintf("stdlib-source-%v", hostname),
+				SourceUUID:            uuid.New(),
+				App:                   oi.ApiUrl.Host,
+				ApiKey:                token.AccessToken,
+				NATSOptions:           &natsOpts,
+				MaxParallelExecutions: sourcesCfg.engineOptions(localSourceStdlib).maxParallelExecutions(),
+				HeartbeatOptions:      heartbeatOptions(oi, token),
+			}
+			stdlibEngine, err := stdlibSource.InitializeEngine(
+				&ec,
+				sourcesCfg.Stdlib.reverseDNS(),
+			)
+			if err != nil {
+				stdlibSpinner.Fail("Failed to initialize stdlib source engine")
+				return nil, fmt.Errorf("failed to initialize stdlib source engine: %w", err)
+			}
+			sourcesCfg.engineOptions(localSourceStdlib).applyTo(stdlibEngine)
+			// todo: pass in context with timeout to abort timely and allow Ctrl-C to work
+			err = stdlibEngine.Start()
+			if err != nil {
+				stdlibSpinner.Fail("Failed to start stdlib source engine")
+				return nil, fmt.Errorf("failed to start stdlib source engine: %w", err)
+			}
+			stdlibSpinner.Success("Stdlib source engine started")
+			return []*discovery.Engine{stdlibEngine}, nil
+		})
+	}
 
-		awsProviders, err := tfutils.ParseAWSProviders(".", tfEval, tfRecursive)
-		if err != nil {
-			awsSpinner.Fail("Failed to parse AWS providers")
-			return nil, fmt.Errorf("failed to parse AWS providers: %w", err)
-		}
+	if enabled(localSourceAWS) {
+		p.Go(func() ([]*discovery.Engine, error) {
+			var configs []aws.Config
+			if sourcesCfg.AWS.explicit() {
+				var err error
+				configs, err = sourcesCfg.AWS.awsConfigs(ctx)
+				if err != nil {
+					awsSpinner.Fail("Failed to load AWS config from the sources config: ", err)
+					return nil, err
+				}
+				statusArea.Println(fmt.Sprintf("Using %d AWS configs from the sources config.", len(configs)))
+			} else {
+				tfEval, err := tfutils.LoadEvalContext(tfArgs, os.Environ())
+				if err != nil {
+					awsSpinner.Fail("Failed to load variables from the environment")
+					return nil, fmt.Errorf("failed to load variables from the environment: %w", err)
+				}
 
-		if len(awsProviders) == 0 && !failOverToDefaultLoginCfg {
-			awsSpinner.Warning("No AWS terraform providers found, skipping AWS source initialization.")
-			return nil, nil // skip AWS if there are no awsProviders
-		}
+				awsProviders, err := tfutils.ParseAWSProviders(".", tfEval, tfRecursive)
+				if err != nil {
+					awsSpinner.Fail("Failed to parse AWS providers")
+					return nil, fmt.Errorf("failed to parse AWS providers: %w", err)
+				}
 
-		configs := []aws.Config{}
-		for _, p := range awsProviders {
-			if p.Error != nil {
-				// skip providers that had errors. This allows us to use
-				// providers we _could_ detect, while still failing if there is
-				// a true syntax error and no providers are available at all.
-				statusArea.Println(fmt.Sprintf("Skipping AWS provider in %s with %s.", p.FilePath, p.Error.Error()))
-				continue
-			}
-			c, err := tfutils.ConfigFromProvider(ctx, *p.Provider)
-			if err != nil {
-				awsSpinner.Fail("Error when converting AWS Terraform provider to config: ", err)
-				return nil, fmt.Errorf("error when converting AWS Terraform provider to config: %w", err)
+				if len(awsProviders) == 0 && !failOverToDefaultLoginCfg {
+					awsSpinner.Warning("No AWS terraform providers found, skipping AWS source initialization.")
+					return nil, nil // skip AWS if there are no awsProviders
+				}
+
+				configs = []aws.Config{}
+				for _, p := range awsProviders {
+					if p.Error != nil {
+						// skip providers that had errors. This allows us to use
+						// providers we _could_ detect, while still failing if there is
+						// a true syntax error and no providers are available at all.
+						statusArea.Println(fmt.Sprintf("Skipping AWS provider in %s with %s.", p.FilePath, p.Error.Error()))
+						continue
+					}
+					c, err := tfutils.ConfigFromProvider(ctx, *p.Provider)
+					if err != nil {
+						awsSpinner.Fail("Error when converting AWS Terraform provider to config: ", err)
+						return nil, fmt.Errorf("error when converting AWS Terraform provider to config: %w", err)
+					}
+					credentials, _ := c.Credentials.Retrieve(ctx)
+					aliasInfo := ""
+					if p.Provider.Alias != "" {
+						aliasInfo = fmt.Sprintf(" (alias: %s)", p.Provider.Alias)
+					}
+					statusArea.Println(fmt.Sprintf("Using AWS provider %s%s in %s with %s.", p.Provider.Name, aliasInfo, p.FilePath, credentials.Source))
+					configs = append(configs, c)
+				}
+				if len(configs) == 0 && failOverToDefaultLoginCfg {
+					statusArea.Println("No AWS terraform providers found. Attempting to use AWS default credentials for configuration.")
+					userConfig, err := config.LoadDefaultConfig(ctx)
+					if err != nil {
+						awsSpinner.Fail("Failed to load default AWS config: ", err)
+						return nil, fmt.Errorf("failed to load default AWS config: %w", err)
+					}
+					configs = append(configs, userConfig)
+				}
 			}
-			credentials, _ := c.Credentials.Retrieve(ctx)
-			aliasInfo := ""
-			if p.Provider.Alias != "" {
-				aliasInfo = fmt.Sprintf(" (alias: %s)", p.Provider.Alias)
+			ec := discovery.EngineConfig{
+				EngineType:            "cli-aws",
+				Version:               fmt.Sprintf("cli-%v", tracing.Version()),
+				SourceName:            fmt.Sprintf("aws-source-%v", hostname),
+				SourceUUID:            uuid.New(),
+				App:                   oi.ApiUrl.Host,
+				ApiKey:                token.AccessToken,
+				MaxParallelExecutions: sourcesCfg.engineOptions(localSourceAWS).maxParallelExecutions(),
+				NATSOptions:           &natsOpts,
+				HeartbeatOptions:      heartbeatOptions(oi, token),
 			}
-			statusArea.Println(fmt.Sprintf("Using AWS provider %s%s in %s with %s.", p.Provider.Name, aliasInfo, p.FilePath, credentials.Source))
-			configs = append(configs, c)
-		}
-		if len(configs) == 0 && failOverToDefaultLoginCfg {
-			statusArea.Println("No AWS terraform providers found. Attempting to use AWS default credentials for configuration.")
-			userConfig, err := config.LoadDefaultConfig(ctx)
+			awsEngine, err := proc.InitializeAwsSourceEngine(
+				ctx,
+				&ec,
+				1, // Don't retry as we want the user to get notified immediately
+				configs...,
+			)
 			if err != nil {
-				awsSpinner.Fail("Failed to load default AWS config: ", err)
-				return nil, fmt.Errorf("failed to load default AWS config: %w", err)
+				if os.Getenv("AWS_PROFILE") == "" {
+					// look for the AWS_PROFILE env var and suggest setting it
+					awsSpinner.Fail("Failed to initialize AWS source engine. Consider setting AWS_PROFILE to use the default AWS CLI profile.")
+				} else {
+					awsSpinner.Fail("Failed to initialize AWS source engine")
+				}
+				return nil, fmt.Errorf("failed to initialize AWS source engine: %w", err)
 			}
-			configs = append(configs, userConfig)
-		}
-		ec := discovery.EngineConfig{
-			EngineType:            "cli-aws",
-			Version:               fmt.Sprintf("cli-%v", tracing.Version()),
-			SourceName:            fmt.Sprintf("aws-source-%v", hostname),
-			SourceUUID:            uuid.New(),
-			App:                   oi.ApiUrl.Host,
-			ApiKey:                token.AccessToken,
-			MaxParallelExecutions: 2_000,
-			NATSOptions:           &natsOpts,
-			HeartbeatOptions:      heartbeatOptions(oi, token),
-		}
-		awsEngine, err := proc.InitializeAwsSourceEngine(
-			ctx,
-			&ec,
-			1, // Don't retry as we want the user to get notified immediately
-			configs...,
-		)
-		if err != nil {
-			if os.Getenv("AWS_PROFILE") == "" {
-				// look for the AWS_PROFILE env var and suggest setting it
-				awsSpinner.Fail("Failed to initialize AWS source engine. Consider setting AWS_PROFILE to use the default AWS CLI profile.")
-			} else {
-				awsSpinner.Fail("Failed to initialize AWS source engine")
+
+			sourcesCfg.engineOptions(localSourceAWS).applyTo(awsEngine)
+			err = awsEngine.Start() //nolint:contextcheck // todo: pass in context with timeout to abort timely and allow Ctrl-C to work
+			if err != nil {
+				awsSpinner.Fail("Failed to start AWS source engine")
+				return nil, fmt.Errorf("failed to start AWS source engine: %w", err)
 			}
-			return nil, fmt.Errorf("failed to initialize AWS source engine: %w", err)
-		}
 
-		err = awsEngine.Start() //nolint:contextcheck // todo: pass in context with timeout to abort timely and allow Ctrl-C to work
-		if err != nil {
-			awsSpinner.Fail("Failed to start AWS source engine")
-			return nil, fmt.Errorf("failed to start AWS source engine: %w", err)
-		}
+			awsSpinner.Success("AWS source engine started")
+			foundCloudProvider.Store(true)
+			return []*discovery.Engine{awsEngine}, nil
+		})
+	}
 
-		awsSpinner.Success("AWS source engine started")
-		foundCloudProvider = true
-		return []*discovery.Engine{awsEngine}, nil
-	})
+	if enabled(localSourceGCP) {
+		p.Go(func() ([]*discovery.Engine, error) {
+			var gcpConfigs []*gcpproc.GCPConfig
+			if sourcesCfg.GCP.explicit() {
+				gcpConfigs = sourcesCfg.GCP.gcpConfigs()
+				for _, c := range gcpConfigs {
+					statusArea.Println(fmt.Sprintf("Using GCP project %s from the sources config.", c.ProjectID))
+				}
+			} else {
+				// Parse GCP providers from Terraform configuration
+				tfEval, err := tfutils.LoadEvalContext(tfArgs, os.Environ())
+				if err != nil {
+					gcpSpinner.Fail("Failed to load variables from the environment for GCP")
+					return nil, fmt.Errorf("failed to load variables from the environment for GCP: %w", err)
+				}
 
-	p.Go(func() ([]*discovery.Engine, error) {
-		// Parse GCP providers from Terraform configuration
-		tfEval, err := tfutils.LoadEvalContext(tfArgs, os.Environ())
-		if err != nil {
-			gcpSpinner.Fail("Failed to load variables from the environment for GCP")
-			return nil, fmt.Errorf("failed to load variables from the environment for GCP: %w", err)
-		}
+				gcpProviders, err := tfutils.ParseGCPProviders(".", tfEval, tfRecursive)
+				if err != nil {
+					gcpSpinner.Fail("Failed to parse GCP providers")
+					return nil, fmt.Errorf("failed to parse GCP providers: %w", err)
+				}
 
-		gcpProviders, err := tfutils.ParseGCPProviders(".", tfEval, tfRecursive)
-		if err != nil {
-			gcpSpinner.Fail("Failed to parse GCP providers")
-			return nil, fmt.Errorf("failed to parse GCP providers: %w", err)
-		}
+				if len(gcpProviders) == 0 && !failOverToDefaultLoginCfg {
+					gcpSpinner.Warning("No GCP terraform providers found, skipping GCP source initialization.")
+					return nil, nil // skip GCP if there are no providers
+				}
 
-		if len(gcpProviders) == 0 && !failOverToDefaultLoginCfg {
-			gcpSpinner.Warning("No GCP terraform providers found, skipping GCP source initialization.")
-			return nil, nil // skip GCP if there are no providers
-		}
+				// Process GCP providers and extract configurations
+				gcpConfigs = []*gcpproc.GCPConfig{}
+
+				for _, p := range gcpProviders {
+					if p.Error != nil {
+						statusArea.Println(fmt.Sprintf("Skipping GCP provider in %s: %s", p.FilePath, p.Error.Error()))
+						continue
+					}
+
+					config, err := tfutils.ConfigFromGCPProvider(*p.Provider)
+					if err != nil {
+						statusArea.Println(fmt.Sprintf("Error configuring GCP provider %s in %s: %s", p.Provider.Name, p.FilePath, err.Error()))
+						continue
+					}
+
+					gcpConfigs = append(gcpConfigs, &gcpproc.GCPConfig{
+						ProjectID: config.ProjectID,
+						Regions:   config.Regions,
+						Zones:     config.Zones,
+					})
+
+					aliasInfo := ""
+					if config.Alias != "" {
+						aliasInfo = fmt.Sprintf(" (alias: %s)", config.Alias)
+					}
+					statusArea.Println(fmt.Sprintf("Using GCP provider in %s with project %s%s.", p.FilePath, config.ProjectID, aliasInfo))
+				}
 
-		// Process GCP providers and extract configurations
-		gcpConfigs := []*gcpproc.GCPConfig{}
+				gcpConfigs = unifiedGCPConfigs(gcpConfigs)
 
-		for _, p := range gcpProviders {
-			if p.Error != nil {
-				statusArea.Println(fmt.Sprintf("Skipping GCP provider in %s: %s", p.FilePath, p.Error.Error()))
-				continue
+				// Fallback to default GCP config if no terraform providers found
+				if len(gcpConfigs) == 0 && failOverToDefaultLoginCfg {
+					statusArea.Println("No GCP terraform providers found. Attempting to use GCP Application Default Credentials for configuration.")
+					// Try to use Application Default Credentials by passing nil config
+					gcpConfigs = append(gcpConfigs, nil)
+				}
 			}
 
-			config, err := tfutils.ConfigFromGCPProvider(*p.Provider)
-			if err != nil {
-				statusArea.Println(fmt.Sprintf("Error configuring GCP provider %s in %s: %s", p.Provider.Name, p.FilePath, err.Error()))
-				continue
-			}
+			// Start multiple GCP engines for each configuration
+			gcpEngines := []*discovery.Engine{}
+			for i, gcpConfig := range gcpConfigs {
+				engineSuffix := ""
+				if len(gcpConfigs) > 1 {
+					engineSuffix = fmt.Sprintf("-%d", i)
+				}
 
-			gcpConfigs = append(gcpConfigs, &gcpproc.GCPConfig{
-				ProjectID: config.ProjectID,
-				Regions:   config.Regions,
-				Zones:     config.Zones,
-			})
+				ec := discovery.EngineConfig{
+					EngineType:            "cli-gcp",
+					Version:               fmt.Sprintf("cli-%v", tracing.Version()),
+					SourceName:            fmt.Sprintf("gcp-source-%v%s", hostname, engineSuffix),
+					SourceUUID:            uuid.New(),
+					App:                   oi.ApiUrl.Host,
+					ApiKey:                token.AccessToken,
+					MaxParallelExecutions: sourcesCfg.engineOptions(localSourceGCP).maxParallelExecutions(),
+					NATSOptions:           &natsOpts,
+					HeartbeatOptions:      heartbeatOptions(oi, token),
+				}
 
-			aliasInfo := ""
-			if config.Alias != "" {
-				aliasInfo = fmt.Sprintf(" (alias: %s)", config.Alias)
-			}
-			statusArea.Println(fmt.Sprintf("Using GCP provider in %s with project %s%s.", p.FilePath, config.ProjectID, aliasInfo))
-		}
+				gcpEngine, err := gcpproc.Initialize(ctx, &ec, gcpConfig)
+				if err != nil {
+					if gcpConfig == nil {
+						// Default config failed
+						statusArea.Println(fmt.Sprintf("Failed to initialize GCP source with default credentials: %s", err.Error()))
+					} else {
+						statusArea.Println(fmt.Sprintf("Failed to initialize GCP source for project %s: %s", gcpConfig.ProjectID, err.Error()))
+					}
+					continue // Skip this engine but continue with others
+				}
 
-		gcpConfigs = unifiedGCPConfigs(gcpConfigs)
+				sourcesCfg.engineOptions(localSourceGCP).applyTo(gcpEngine)
+				err = gcpEngine.Start() //nolint:contextcheck
+				if err != nil {
+					if gcpConfig == nil {
+						statusArea.Println(fmt.Sprintf("Failed to start GCP source with default credentials: %s", err.Error()))
+					} else {
+						statusArea.Println(fmt.Sprintf("Failed to start GCP source for project %s: %s", gcpConfig.ProjectID, err.Error()))
+					}
+					continue // Skip this engine but continue with others
+				}
 
-		// Fallback to default GCP config if no terraform providers found
-		if len(gcpConfigs) == 0 && failOverToDefaultLoginCfg {
-			statusArea.Println("No GCP terraform providers found. Attempting to use GCP Application Default Credentials for configuration.")
-			// Try to use Application Default Credentials by passing nil config
-			gcpConfigs = append(gcpConfigs, nil)
-		}
+				gcpEngines = append(gcpEngines, gcpEngine)
+			}
 
-		// Start multiple GCP engines for each configuration
-		gcpEngines := []*discovery.Engine{}
-		for i, gcpConfig := range gcpConfigs {
-			engineSuffix := ""
-			if len(gcpConfigs) > 1 {
-				engineSuffix = fmt.Sprintf("-%d", i)
+			if len(gcpEngines) == 0 {
+				gcpSpinner.Fail("Failed to initialize any GCP source engines")
+				return nil, nil // skip GCP if there are no valid configurations
 			}
 
-			ec := discovery.EngineConfig{
-				EngineType:            "cli-gcp",
-				Version:               fmt.Sprintf("cli-%v", tracing.Version()),
-				SourceName:            fmt.Sprintf("gcp-source-%v%s", hostname, engineSuffix),
-				SourceUUID:            uuid.New(),
-				App:                   oi.ApiUrl.Host,
-				ApiKey:                token.AccessToken,
-				MaxParallelExecutions: 2_000,
-				NATSOptions:           &natsOpts,
-				HeartbeatOptions:      heartbeatOptions(oi, token),
+			if len(gcpEngines) == 1 {
+				gcpSpinner.Success("GCP source engine started")
+			} else {
+				gcpSpinner.Success(fmt.Sprintf("%d GCP source engines started", len(gcpEngines)))
 			}
 
-			gcpEngine, err := gcpproc.Initialize(ctx, &ec, gcpConfig)
-			if err != nil {
-				if gcpConfig == nil {
-					// Default config failed
-					statusArea.Println(fmt.Sprintf("Failed to initialize GCP source with default credentials: %s", err.Error()))
-				} else {
-					statusArea.Println(fmt.Sprintf("Failed to initialize GCP source for project %s: %s", gcpConfig.ProjectID, err.Error()))
-				}
-				continue // Skip this engine but continue with others
+			foundCloudProvider.Store(true)
+			return gcpEngines, nil
+		})
+	}
+
+	if enabled(localSourceK8s) {
+		p.Go(func() ([]*discovery.Engine, error) {
+			contexts := []string{""}
+			if sourcesCfg.K8s != nil && len(sourcesCfg.K8s.Contexts) > 0 {
+				contexts = sourcesCfg.K8s.Contexts
 			}
 
-			err = gcpEngine.Start() //nolint:contextcheck
-			if err != nil {
-				if gcpConfig == nil {
-					statusArea.Println(fmt.Sprintf("Failed to start GCP source with default credentials: %s", err.Error()))
-				} else {
-					statusArea.Println(fmt.Sprintf("Failed to start GCP source for project %s: %s", gcpConfig.ProjectID, err.Error()))
+			// Start an engine for each context
+			k8sEngines := []*discovery.Engine{}
+			for i, kubeContext := range contexts {
+				engineSuffix := ""
+				if len(contexts) > 1 {
+					engineSuffix = fmt.Sprintf("-%d", i)
 				}
-				continue // Skip this engine but continue with others
-			}
 
-			gcpEngines = append(gcpEngines, gcpEngine)
-		}
+				ec := discovery.EngineConfig{
+					EngineType:            "cli-k8s",
+					Version:               fmt.Sprintf("cli-%v", tracing.Version()),
+					SourceName:            fmt.Sprintf("k8s-source-%v%s", hostname, engineSuffix),
+					SourceUUID:            uuid.New(),
+					App:                   oi.ApiUrl.Host,
+					ApiKey:                token.AccessToken,
+					MaxParallelExecutions: sourcesCfg.engineOptions(localSourceK8s).maxParallelExecutions(),
+					NATSOptions:           &natsOpts,
+					HeartbeatOptions:      heartbeatOptions(oi, token),
+				}
 
-		if len(gcpEngines) == 0 {
-			gcpSpinner.Fail("Failed to initialize any GCP source engines")
-			return nil, nil // skip GCP if there are no valid configurations
-		}
+				k8sEngine, clusterName, err := initializeK8sEngine(ctx, &ec, sourcesCfg.K8s, kubeContext)
+				if err != nil {
+					statusArea.Println(fmt.Sprintf("Failed to initialize k8s source: %s", err.Error()))
+					continue // Skip this engine but continue with others
+				}
 
-		if len(gcpEngines) == 1 {
-			gcpSpinner.Success("GCP source engine started")
-		} else {
-			gcpSpinner.Success(fmt.Sprintf("%d GCP source engines started", len(gcpEngines)))
-		}
+				sourcesCfg.engineOptions(localSourceK8s).applyTo(k8sEngine)
+				err = k8sEngine.Start() //nolint:contextcheck
+				if err != nil {
+					statusArea.Println(fmt.Sprintf("Failed to start k8s source for cluster %s: %s", clusterName, err.Error()))
+					continue // Skip this engine but continue with others
+				}
 
-		foundCloudProvider = true
-		return gcpEngines, nil
-	})
+				statusArea.Println(fmt.Sprintf("Using k8s cluster %s.", clusterName))
+				k8sEngines = append(k8sEngines, k8sEngine)
+			}
+
+			if len(k8sEngines) == 0 {
+				k8sSpinner.Fail("Failed to initialize any k8s source engines")
+				return nil, nil // skip k8s if there are no valid contexts
+			}
+
+			if len(k8sEngines) == 1 {
+				k8sSpinner.Success("k8s source engine started")
+			} else {
+				k8sSpinner.Success(fmt.Sprintf("%d k8s source engines started", len(k8sEngines)))
+			}
+
+			foundCloudProvider.Store(true)
+			return k8sEngines, nil
+		})
+	}
 
 	engines, err := p.Wait()
 	if err != nil {
 		return func() {}, fmt.Errorf("error starting sources: %w", err)
 	}
 
-	if !foundCloudProvider {
+	if !foundCloudProvider.Load() && needsTerraform {
 		statusArea.Println(`No cloud providers found in Terraform configuration.
 
 The Overmind CLI requires access to cloud provider configurations to interrogate resources. Without configured providers, the CLI cannot determine which cloud resources to query and as a result calculate a successful blast radius.
@@ -376,7 +496,13 @@ func Explore(cmd *cobra.Command, args []string) error {
 	defer func() {
 		_, _ = multi.Stop()
 	}()
-	ctx, oi, token, err := login(ctx, cmd, []string{"request:receive", "api:read"}, multi.NewWriter())
+	tui := viper.GetBool("tui")
+	scopes := []string{"request:receive", "api:read"}
+	if tui {
+		// the explorer runs queries and stores bookmarks itself
+		scopes = append(scopes, "explore:read", "changes:write")
+	}
+	ctx, oi, token, err := login(ctx, cmd, scopes, multi.NewWriter())
 	_, _ = multi.Stop()
 	if err != nil {
 		return err
@@ -388,6 +514,10 @@ func Explore(cmd *cobra.Command, args []string) error {
 	}
 	defer cleanup()
 
+	if tui {
+		return ExploreTUI(ctx, cmd, oi, scopes)
+	}
+
 	exploreURL := fmt.Sprintf("%v/explore", oi.FrontendUrl)
 	_ = browser.OpenURL(exploreURL) // ignore error, we can't do anything about it
 
@@ -420,6 +550,18 @@ func init() {
 	addAPIFlags(exploreCmd)
 	// flag to opt-out of recursion and only scan the current folder for *.tf files
 	exploreCmd.PersistentFlags().Bool("no-recursion", false, "Only scan the current directory for Terraform files (non-recursive).")
+	addLocalSourcesFlags(exploreCmd)
+
+	// terminal explorer
+	exploreCmd.PersistentFlags().Bool("tui", false, "Browse the results in the terminal instead of opening the Explore page.")
+	exploreCmd.PersistentFlags().String("query-method", "list", "The method of the initial query for --tui (get, list, search)")
+	exploreCmd.PersistentFlags().String("query-type", "", "The type of the initial query for --tui")
+	exploreCmd.PersistentFlags().String("query", "", "The initial query to send for --tui")
+	exploreCmd.PersistentFlags().String("query-scope", "*", "The scope of the initial query for --tui")
+	exploreCmd.PersistentFlags().Uint32("link-depth", 0, "How deeply to link the initial query for --tui")
+	exploreCmd.PersistentFlags().Bool("blast-radius", false, "Whether to query using blast radius for --tui, note that if using this option, link-depth should be set to > 0")
+	exploreCmd.PersistentFlags().Bool("ignore-cache", false, "Set to true to ignore all caches in overmind.")
+	exploreCmd.PersistentFlags().String("snapshot-id", "", "Load the items of this snapshot into the terminal explorer instead of running a query")
 }
 
 // unifiedGCPConfigs collates the given GCP configs by project ID.
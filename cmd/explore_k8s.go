@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/overmindtech/cli/discovery"
+	k8sAdapters "github.com/overmindtech/cli/k8s-source/adapters"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// initializeK8sEngine creates an engine with all kubernetes adapters for a
+// single context of the kubeconfig. An empty context uses the current context.
+// Returns the engine and the name of the cluster that is used in the scopes of
+// the items.
+//
+// Unlike the k8s-source this doesn't watch for new namespaces since the local
+// sources only run for the duration of a single CLI invocation
+func initializeK8sEngine(ctx context.Context, ec *discovery.EngineConfig, cfg *K8sSourceConfig, kubeContext string) (*discovery.Engine, string, error) {
+	if cfg == nil {
+		cfg = &K8sSourceConfig{}
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if cfg.Kubeconfig != "" {
+		loadingRules.ExplicitPath = cfg.Kubeconfig
+	}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: kubeContext,
+	})
+
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not load kubernetes config: %w", err)
+	}
+	if kubeContext == "" {
+		kubeContext = rawConfig.CurrentContext
+	}
+	contextConfig, ok := rawConfig.Contexts[kubeContext]
+	if !ok {
+		return nil, "", fmt.Errorf("context %q not found in kubernetes config", kubeContext)
+	}
+	clusterName := contextConfig.Cluster
+	if clusterName == "" {
+		clusterName = kubeContext
+	}
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not load kubernetes config for context %q: %w", kubeContext, err)
+	}
+
+	qps := cfg.RateLimitQPS
+	if qps <= 0 {
+		qps = 10
+	}
+	burst := cfg.RateLimitBurst
+	if burst <= 0 {
+		burst = 30
+	}
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper { return otelhttp.NewTransport(rt) })
+	restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+
+	clientSet, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not create kubernetes client for context %q: %w", kubeContext, err)
+	}
+
+	if ec.HeartbeatOptions != nil {
+		ec.HeartbeatOptions.HealthCheck = func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			// Make sure we can list nodes in the cluster
+			_, err := clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+				Limit: 1,
+			})
+			if err != nil {
+				return fmt.Errorf("health check (listing nodes) failed: %w", err)
+			}
+			return nil
+		}
+	}
+
+	e, err := discovery.NewEngine(ec)
+	if err != nil {
+		return nil, "", fmt.Errorf("error initializing engine: %w", err)
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	list, err := clientSet.CoreV1().Namespaces().List(listCtx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("could not list namespaces in context %q: %w", kubeContext, err)
+	}
+
+	namespaces := make([]string, len(list.Items))
+	for i := range list.Items {
+		namespaces[i] = list.Items[i].Name
+	}
+
+	err = e.AddAdapters(k8sAdapters.LoadAllAdapters(clientSet, clusterName, namespaces)...)
+	if err != nil {
+		return nil, "", fmt.Errorf("error adding adapters to engine: %w", err)
+	}
+
+	return e, clusterName, nil
+}
@@ -0,0 +1,674 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"atomicgo.dev/keyboard"
+	"atomicgo.dev/keyboard/keys"
+	lipgloss "github.com/charmbracelet/lipgloss/v2"
+	"github.com/google/uuid"
+	"github.com/overmindtech/cli/sdp-go"
+	"github.com/overmindtech/cli/sdp-go/sdpws"
+	"github.com/overmindtech/pterm"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exploreTUIClient is the part of the gateway client that the terminal
+// explorer needs. This is implemented by *sdpws.Client
+type exploreTUIClient interface {
+	QueryOne(ctx context.Context, q *sdp.Query) ([]*sdp.Item, error)
+	StoreBookmark(ctx context.Context, name, description string, isSystem bool) (uuid.UUID, error)
+}
+
+// explorePane is the pane of the explorer that currently has the focus
+type explorePane int
+
+const (
+	explorePaneItems explorePane = iota
+	explorePaneLinks
+)
+
+// exploreActionKind describes what the driver of the explorer has to do after
+// a key has been handled by the model
+type exploreActionKind int
+
+const (
+	exploreActionNone exploreActionKind = iota
+	exploreActionQuit
+	exploreActionFollow
+	exploreActionBookmark
+)
+
+type exploreAction struct {
+	kind  exploreActionKind
+	query *sdp.Query
+	item  *sdp.Item
+}
+
+// exploreModel holds the state of the terminal explorer. Items are added from
+// the gateway message handler while keys are handled on the keyboard
+// goroutine, so all access is guarded by the mutex.
+type exploreModel struct {
+	mu sync.Mutex
+
+	// all items in the order they were received
+	items     []*sdp.Item
+	itemIndex map[string]int
+
+	typeFilter  string
+	scopeFilter string
+
+	pane       explorePane
+	cursor     int
+	linkCursor int
+
+	// the items that have been traversed to get to the current selection
+	breadcrumb []*sdp.Item
+
+	// the link that is being followed and the item it was followed from, nil
+	// while no query is running
+	following     *sdp.Query
+	followingFrom *sdp.Item
+
+	status string
+}
+
+func newExploreModel() *exploreModel {
+	return &exploreModel{
+		itemIndex: make(map[string]int),
+	}
+}
+
+// AddItems adds new items to the model, replacing any previously received
+// version of the same item
+func (m *exploreModel) AddItems(items ...*sdp.Item) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addItems(items...)
+}
+
+func (m *exploreModel) addItems(items ...*sdp.Item) {
+	for _, item := range items {
+		gun := item.GloballyUniqueName()
+		if i, ok := m.itemIndex[gun]; ok {
+			m.items[i] = item
+			continue
+		}
+		m.itemIndex[gun] = len(m.items)
+		m.items = append(m.items, item)
+	}
+}
+
+// SetStatus sets the message displayed in the footer
+func (m *exploreModel) SetStatus(status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status = status
+}
+
+// visibleItems returns all items that match the current filters
+func (m *exploreModel) visibleItems() []*sdp.Item {
+	visible := make([]*sdp.Item, 0, len(m.items))
+	for _, item := range m.items {
+		if m.typeFilter != "" && item.GetType() != m.typeFilter {
+			continue
+		}
+		if m.scopeFilter != "" && item.GetScope() != m.scopeFilter {
+			continue
+		}
+		visible = append(visible, item)
+	}
+	return visible
+}
+
+// selected returns the currently selected item, or nil if nothing is visible
+func (m *exploreModel) selected() *sdp.Item {
+	visible := m.visibleItems()
+	if len(visible) == 0 {
+		return nil
+	}
+	m.cursor = max(0, min(m.cursor, len(visible)-1))
+	return visible[m.cursor]
+}
+
+// selectItem moves the cursor to the specified item, clearing the filters if
+// they would hide it
+func (m *exploreModel) selectItem(item *sdp.Item) {
+	if (m.typeFilter != "" && item.GetType() != m.typeFilter) ||
+		(m.scopeFilter != "" && item.GetScope() != m.scopeFilter) {
+		m.typeFilter = ""
+		m.scopeFilter = ""
+	}
+	gun := item.GloballyUniqueName()
+	for i, v := range m.visibleItems() {
+		if v.GloballyUniqueName() == gun {
+			m.cursor = i
+			break
+		}
+	}
+	m.linkCursor = 0
+}
+
+// exploreLinks returns the queries that can be used to navigate from the
+// specified item to its linked items
+func exploreLinks(item *sdp.Item) []*sdp.Query {
+	links := make([]*sdp.Query, 0, len(item.GetLinkedItems())+len(item.GetLinkedItemQueries()))
+	for _, li := range item.GetLinkedItems() {
+		q := li.GetItem().ToQuery()
+		q.Method = sdp.QueryMethod_GET
+		links = append(links, q)
+	}
+	for _, liq := range item.GetLinkedItemQueries() {
+		links = append(links, liq.GetQuery())
+	}
+	return links
+}
+
+// distinctValues returns the sorted, distinct values of the specified item
+// field
+func distinctValues(items []*sdp.Item, field func(*sdp.Item) string) []string {
+	values := make([]string, 0)
+	for _, item := range items {
+		v := field(item)
+		if !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// nextFilter cycles through the available values, starting and ending with
+// no filter
+func nextFilter(current string, values []string) string {
+	i := slices.Index(values, current)
+	if i+1 >= len(values) {
+		return ""
+	}
+	return values[i+1]
+}
+
+// HandleKey updates the model according to the pressed key and returns the
+// action that the driver needs to take
+func (m *exploreModel) HandleKey(key keys.Key) exploreAction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch key.Code {
+	case keys.CtrlC, keys.Esc:
+		return exploreAction{kind: exploreActionQuit}
+	case keys.Up:
+		m.moveCursor(-1)
+	case keys.Down:
+		m.moveCursor(1)
+	case keys.Tab, keys.Right:
+		m.togglePane()
+	case keys.Left, keys.Backspace:
+		m.back()
+	case keys.Enter:
+		return m.enter()
+	case keys.RuneKey:
+		switch key.String() {
+		case "q":
+			return exploreAction{kind: exploreActionQuit}
+		case "k":
+			m.moveCursor(-1)
+		case "j":
+			m.moveCursor(1)
+		case "l":
+			m.togglePane()
+		case "h":
+			m.back()
+		case "t":
+			m.typeFilter = nextFilter(m.typeFilter, distinctValues(m.items, (*sdp.Item).GetType))
+			m.cursor = 0
+			m.linkCursor = 0
+		case "s":
+			m.scopeFilter = nextFilter(m.scopeFilter, distinctValues(m.items, (*sdp.Item).GetScope))
+			m.cursor = 0
+			m.linkCursor = 0
+		case "b":
+			if item := m.selected(); item != nil {
+				m.status = fmt.Sprintf("Storing bookmark for %v...", item.GloballyUniqueName())
+				return exploreAction{kind: exploreActionBookmark, item: item}
+			}
+		}
+	default:
+		// ignore all other keys
+	}
+
+	return exploreAction{kind: exploreActionNone}
+}
+
+func (m *exploreModel) moveCursor(delta int) {
+	if m.pane == explorePaneLinks {
+		item := m.selected()
+		if item == nil {
+			return
+		}
+		m.linkCursor = max(0, min(m.linkCursor+delta, len(exploreLinks(item))-1))
+		return
+	}
+	m.cursor = max(0, min(m.cursor+delta, len(m.visibleItems())-1))
+	m.linkCursor = 0
+}
+
+func (m *exploreModel) togglePane() {
+	if m.pane == explorePaneLinks {
+		m.pane = explorePaneItems
+		return
+	}
+	item := m.selected()
+	if item != nil && len(exploreLinks(item)) > 0 {
+		m.pane = explorePaneLinks
+	}
+}
+
+// back returns to the previous item of the breadcrumb
+func (m *exploreModel) back() {
+	if m.pane == explorePaneLinks {
+		m.pane = explorePaneItems
+		return
+	}
+	if len(m.breadcrumb) == 0 {
+		return
+	}
+	previous := m.breadcrumb[len(m.breadcrumb)-1]
+	m.breadcrumb = m.breadcrumb[:len(m.breadcrumb)-1]
+	m.selectItem(previous)
+}
+
+func (m *exploreModel) enter() exploreAction {
+	item := m.selected()
+	if item == nil {
+		return exploreAction{kind: exploreActionNone}
+	}
+	if m.pane == explorePaneItems {
+		m.togglePane()
+		return exploreAction{kind: exploreActionNone}
+	}
+	links := exploreLinks(item)
+	if len(links) == 0 {
+		return exploreAction{kind: exploreActionNone}
+	}
+	if m.following != nil {
+		m.status = fmt.Sprintf("Still querying %v...", exploreQueryString(m.following))
+		return exploreAction{kind: exploreActionNone}
+	}
+	m.linkCursor = max(0, min(m.linkCursor, len(links)-1))
+	m.following = links[m.linkCursor]
+	m.followingFrom = item
+	m.status = fmt.Sprintf("Querying %v...", exploreQueryString(m.following))
+	return exploreAction{
+		kind:  exploreActionFollow,
+		query: m.following,
+	}
+}
+
+// Followed records the result of following a link. The item that the link was
+// followed from is added to the breadcrumb and the first result is selected.
+func (m *exploreModel) Followed(q *sdp.Query, items []*sdp.Item, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	from := m.followingFrom
+	m.following = nil
+	m.followingFrom = nil
+
+	if err != nil {
+		m.status = fmt.Sprintf("Query %v failed: %v", exploreQueryString(q), err)
+		return
+	}
+	if len(items) == 0 {
+		m.status = fmt.Sprintf("No items found for %v", exploreQueryString(q))
+		return
+	}
+
+	m.addItems(items...)
+	if from != nil {
+		m.breadcrumb = append(m.breadcrumb, from)
+	}
+	m.pane = explorePaneItems
+	m.selectItem(items[0])
+	m.status = fmt.Sprintf("Found %v item(s) for %v", len(items), exploreQueryString(q))
+}
+
+// Selected returns the currently selected item
+func (m *exploreModel) Selected() *sdp.Item {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.selected()
+}
+
+func exploreQueryString(q *sdp.Query) string {
+	return fmt.Sprintf("%v %v %v %v", q.GetScope(), q.GetType(), q.GetMethod(), q.GetQuery())
+}
+
+// Render draws the whole explorer into a string of the specified size
+func (m *exploreModel) Render(width, height int) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	width = max(width, 40)
+	height = max(height, 10)
+
+	title := lipgloss.NewStyle().Foreground(ColorPalette.BgMain).Bold(true)
+	faint := lipgloss.NewStyle().Foreground(ColorPalette.LabelFaint)
+	highlight := lipgloss.NewStyle().Background(ColorPalette.BgMain).Foreground(ColorPalette.LabelContrast)
+
+	typeFilter := m.typeFilter
+	if typeFilter == "" {
+		typeFilter = "*"
+	}
+	scopeFilter := m.scopeFilter
+	if scopeFilter == "" {
+		scopeFilter = "*"
+	}
+	visible := m.visibleItems()
+	header := fmt.Sprintf("%v  %v",
+		title.Render("Overmind Explore"),
+		faint.Render(fmt.Sprintf("type: %v  scope: %v  items: %v/%v", typeFilter, scopeFilter, len(visible), len(m.items))),
+	)
+
+	crumbs := make([]string, 0, len(m.breadcrumb)+1)
+	for _, item := range m.breadcrumb {
+		crumbs = append(crumbs, fmt.Sprintf("%v/%v", item.GetType(), item.UniqueAttributeValue()))
+	}
+	selected := m.selected()
+	if selected != nil {
+		crumbs = append(crumbs, fmt.Sprintf("%v/%v", selected.GetType(), selected.UniqueAttributeValue()))
+	}
+	breadcrumb := faint.Render(truncate("› "+strings.Join(crumbs, " › "), width))
+
+	footer := faint.Render(truncate("↑/↓ move  tab links  enter follow  ← back  t type  s scope  b bookmark  q quit", width))
+	if m.status != "" {
+		footer = truncate(m.status, width) + "\n" + footer
+	}
+
+	// header, breadcrumb, footer and the borders around the panes
+	bodyHeight := max(height-4-lipgloss.Height(footer), 3)
+	listWidth := width / 3
+	detailWidth := width - listWidth - 4
+
+	// item list, scrolled so that the cursor is always visible
+	list := make([]string, 0, bodyHeight)
+	start := max(0, m.cursor-bodyHeight+1)
+	for i := start; i < len(visible) && len(list) < bodyHeight; i++ {
+		line := truncate(fmt.Sprintf("%v %v", visible[i].GetType(), visible[i].UniqueAttributeValue()), listWidth)
+		if i == m.cursor {
+			line = highlight.Render(line)
+		}
+		list = append(list, line)
+	}
+	if len(visible) == 0 {
+		list = append(list, faint.Render("waiting for items..."))
+	}
+
+	// details of the selected item: attributes and links
+	details := make([]string, 0)
+	if selected != nil {
+		details = append(details, title.Render(truncate(selected.GloballyUniqueName(), detailWidth)))
+		details = append(details, "")
+
+		links := exploreLinks(selected)
+		if len(links) > 0 {
+			details = append(details, title.Render("Links"))
+			for i, q := range links {
+				line := exploreQueryString(q)
+				if m.following != nil && selected == m.followingFrom && exploreQueryString(m.following) == line {
+					line += " (loading...)"
+				}
+				line = truncate(line, detailWidth)
+				if m.pane == explorePaneLinks && i == m.linkCursor {
+					line = highlight.Render(line)
+				}
+				details = append(details, line)
+			}
+			details = append(details, "")
+		}
+
+		details = append(details, title.Render("Attributes"))
+		attrs, err := json.MarshalIndent(selected.GetAttributes().GetAttrStruct().AsMap(), "", "  ")
+		if err != nil {
+			details = append(details, fmt.Sprintf("failed to render attributes: %v", err))
+		} else {
+			for _, line := range strings.Split(string(attrs), "\n") {
+				details = append(details, truncate(line, detailWidth))
+			}
+		}
+	}
+	if len(details) > bodyHeight {
+		details = details[:bodyHeight]
+	}
+
+	paneStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPalette.BgBorder).
+		Height(bodyHeight)
+	listPane := paneStyle.Width(listWidth).Render(strings.Join(list, "\n"))
+	detailPane := paneStyle.Width(detailWidth).Render(strings.Join(details, "\n"))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		breadcrumb,
+		lipgloss.JoinHorizontal(lipgloss.Top, listPane, detailPane),
+		footer,
+	)
+}
+
+// truncate shortens the string to the specified number of runes
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	if width <= 1 {
+		return string(r[:width])
+	}
+	return string(r[:width-1]) + "…"
+}
+
+// exploreTUIHandler feeds all items received from the gateway into the
+// explorer
+type exploreTUIHandler struct {
+	model *exploreModel
+
+	sdpws.NoopGatewayMessageHandler
+}
+
+// assert that exploreTUIHandler implements GatewayMessageHandler
+var _ sdpws.GatewayMessageHandler = (*exploreTUIHandler)(nil)
+var _ sdpws.ConnectionStateHandler = (*exploreTUIHandler)(nil)
+
+func (h *exploreTUIHandler) NewItem(ctx context.Context, item *sdp.Item) {
+	h.model.AddItems(item)
+}
+
+func (h *exploreTUIHandler) UpdateItem(ctx context.Context, item *sdp.Item) {
+	h.model.AddItems(item)
+}
+
+func (h *exploreTUIHandler) ConnectionStateChanged(ctx context.Context, state sdpws.ConnectionState) {
+	if state == sdpws.ConnectionStateReconnecting {
+		h.model.SetStatus("Lost connection to Overmind, reconnecting...")
+	}
+}
+
+// runExploreTUI takes over the terminal and runs the explorer until the user
+// quits. The screen is redrawn after every key press and periodically to show
+// items as they arrive from the gateway.
+func runExploreTUI(ctx context.Context, client exploreTUIClient, model *exploreModel) error {
+	// switch to the alternate screen and hide the cursor
+	fmt.Print("\x1b[?1049h\x1b[?25l")
+	defer fmt.Print("\x1b[?25h\x1b[?1049l")
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var drawMu sync.Mutex
+	draw := func() {
+		drawMu.Lock()
+		defer drawMu.Unlock()
+		// queries can finish after the explorer has been closed, which must
+		// not draw over the restored screen
+		if ctx.Err() != nil {
+			return
+		}
+		frame := model.Render(pterm.GetTerminalWidth(), pterm.GetTerminalHeight())
+		fmt.Print("\x1b[H\x1b[2J" + strings.ReplaceAll(frame, "\n", "\r\n"))
+	}
+	defer func() {
+		drawMu.Lock()
+		defer drawMu.Unlock()
+		cancel()
+	}()
+	draw()
+
+	go func() {
+		ticker := time.NewTicker(500 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				draw()
+			}
+		}
+	}()
+
+	return keyboard.Listen(func(key keys.Key) (bool, error) {
+		action := model.HandleKey(key)
+		switch action.kind {
+		case exploreActionQuit:
+			return true, nil
+		case exploreActionFollow:
+			// run the query in the background so that the explorer stays
+			// responsive, the model shows it as loading until it finishes
+			go func() {
+				q := sdpQueryWithUUID(action.query)
+				items, err := client.QueryOne(ctx, q)
+				model.Followed(q, items, err)
+				draw()
+			}()
+		case exploreActionBookmark:
+			// like following links, storing the bookmark waits for the
+			// gateway, which must not block the keyboard
+			go func() {
+				gun := action.item.GloballyUniqueName()
+				id, err := client.StoreBookmark(ctx, gun, fmt.Sprintf("Bookmarked from the terminal explorer: %v", gun), false)
+				if err != nil {
+					model.SetStatus(fmt.Sprintf("Failed to store bookmark: %v", err))
+				} else {
+					model.SetStatus(fmt.Sprintf("Stored bookmark %v", id))
+				}
+				draw()
+			}()
+		case exploreActionNone:
+			// nothing to do
+		}
+		draw()
+		return false, nil
+	})
+}
+
+// sdpQueryWithUUID returns a copy of the query with a fresh UUID and deadline
+// so that it can be sent to the gateway
+func sdpQueryWithUUID(q *sdp.Query) *sdp.Query {
+	u := uuid.New()
+	return &sdp.Query{
+		Type:               q.GetType(),
+		Method:             q.GetMethod(),
+		Query:              q.GetQuery(),
+		Scope:              q.GetScope(),
+		UUID:               u[:],
+		Deadline:           timestamppb.New(time.Now().Add(time.Minute)),
+		RecursionBehaviour: &sdp.Query_RecursionBehaviour{},
+	}
+}
+
+// ExploreTUI connects to the gateway, loads the initial items from either a
+// query or a snapshot and runs the terminal explorer over them. The scopes are
+// requested again when reconnecting to the gateway.
+func ExploreTUI(ctx context.Context, cmd *cobra.Command, oi sdp.OvermindInstance, scopes []string) error {
+	var snapshotID uuid.UUID
+	var q *sdp.Query
+	var err error
+	if s := viper.GetString("snapshot-id"); s != "" {
+		snapshotID, err = uuid.Parse(s)
+		if err != nil {
+			return flagError{usage: fmt.Sprintf("invalid --snapshot-id value '%v': %v\n\n%v", s, err, cmd.UsageString())}
+		}
+	} else {
+		if viper.GetString("query-type") == "" {
+			return flagError{usage: fmt.Sprintf("--tui requires either --query-type or --snapshot-id\n\n%v", cmd.UsageString())}
+		}
+		q, err = CreateQuery()
+		if err != nil {
+			return flagError{usage: fmt.Sprintf("invalid query: %v\n\n%v", err, cmd.UsageString())}
+		}
+	}
+
+	lf := log.Fields{}
+	model := newExploreModel()
+	gatewayUrl := oi.GatewayUrl()
+	lf["gateway-url"] = gatewayUrl
+	c, err := sdpws.DialBatchWithReconnect(ctx, gatewayUrl,
+		NewAuthenticatedClient(ctx, otelhttp.DefaultClient),
+		&exploreTUIHandler{model: model},
+		gatewayReconnectOptions(oi, scopes),
+	)
+	if err != nil {
+		return loggedError{
+			err:     err,
+			fields:  lf,
+			message: "Failed to connect to overmind API",
+		}
+	}
+	defer c.Close(ctx)
+
+	if q != nil {
+		model.SetStatus(fmt.Sprintf("Querying %v...", exploreQueryString(q)))
+		err = c.SendQuery(ctx, q)
+		if err != nil {
+			return loggedError{
+				err:     err,
+				fields:  lf,
+				message: "Failed to execute query",
+			}
+		}
+		go func() {
+			err := c.Wait(ctx, uuid.UUIDs{uuid.UUID(q.GetUUID())})
+			if err != nil {
+				model.SetStatus(fmt.Sprintf("Query failed: %v", err))
+				return
+			}
+			model.SetStatus("Query finished")
+		}()
+	} else {
+		lf["snapshot"] = snapshotID
+		model.SetStatus(fmt.Sprintf("Loading snapshot %v...", snapshotID))
+		go func() {
+			result, err := c.LoadSnapshot(ctx, snapshotID)
+			switch {
+			case err != nil:
+				model.SetStatus(fmt.Sprintf("Failed to load snapshot: %v", err))
+			case !result.GetSuccess():
+				model.SetStatus(fmt.Sprintf("Failed to load snapshot: %v", result.GetErrorMessage()))
+			default:
+				model.SetStatus("Snapshot loaded")
+			}
+		}()
+	}
+
+	return runExploreTUI(ctx, c, model)
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"atomicgo.dev/keyboard/keys"
+	"github.com/overmindtech/cli/sdp-go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newExploreTestItem(typ, scope, name string, links ...*sdp.Reference) *sdp.Item {
+	item := &sdp.Item{
+		Type:            typ,
+		UniqueAttribute: "name",
+		Scope:           scope,
+		Attributes: &sdp.ItemAttributes{
+			AttrStruct: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"name": structpb.NewStringValue(name),
+				},
+			},
+		},
+	}
+	for _, l := range links {
+		item.LinkedItems = append(item.LinkedItems, &sdp.LinkedItem{Item: l})
+	}
+	return item
+}
+
+func runeKey(r rune) keys.Key {
+	return keys.Key{Code: keys.RuneKey, Runes: []rune{r}}
+}
+
+func TestExploreModel(t *testing.T) {
+	sg := newExploreTestItem("ec2-security-group", "123.eu-west-2", "sg-1")
+	instance := newExploreTestItem("ec2-instance", "123.eu-west-2", "i-1", sg.Reference())
+	bucket := newExploreTestItem("s3-bucket", "123", "bucket")
+
+	t.Run("filters", func(t *testing.T) {
+		m := newExploreModel()
+		m.AddItems(instance, bucket)
+
+		if len(m.visibleItems()) != 2 {
+			t.Fatalf("expected 2 visible items, got %v", len(m.visibleItems()))
+		}
+
+		// types are cycled in sorted order
+		m.HandleKey(runeKey('t'))
+		if m.typeFilter != "ec2-instance" {
+			t.Errorf("expected type filter ec2-instance, got %v", m.typeFilter)
+		}
+		if len(m.visibleItems()) != 1 {
+			t.Errorf("expected 1 visible item, got %v", len(m.visibleItems()))
+		}
+		m.HandleKey(runeKey('t'))
+		m.HandleKey(runeKey('t'))
+		if m.typeFilter != "" {
+			t.Errorf("expected type filter to be cleared, got %v", m.typeFilter)
+		}
+
+		m.HandleKey(runeKey('s'))
+		if m.scopeFilter != "123" {
+			t.Errorf("expected scope filter 123, got %v", m.scopeFilter)
+		}
+		if m.Selected().GloballyUniqueName() != bucket.GloballyUniqueName() {
+			t.Errorf("expected bucket to be selected, got %v", m.Selected().GloballyUniqueName())
+		}
+	})
+
+	t.Run("follow links and go back", func(t *testing.T) {
+		m := newExploreModel()
+		m.AddItems(instance, bucket)
+
+		// entering the links pane and following the first link
+		m.HandleKey(keys.Key{Code: keys.Enter})
+		if m.pane != explorePaneLinks {
+			t.Fatal("expected links pane to be focused")
+		}
+		action := m.HandleKey(keys.Key{Code: keys.Enter})
+		if action.kind != exploreActionFollow {
+			t.Fatalf("expected follow action, got %v", action.kind)
+		}
+		if action.query.GetMethod() != sdp.QueryMethod_GET || action.query.GetQuery() != "sg-1" {
+			t.Errorf("unexpected query %v", action.query)
+		}
+
+		// the query runs in the background, so the link is shown as loading
+		// and can't be followed again until it has finished
+		if !strings.Contains(m.Render(120, 40), "(loading...)") {
+			t.Errorf("expected the link to be shown as loading, got %v", m.Render(120, 40))
+		}
+		if again := m.HandleKey(keys.Key{Code: keys.Enter}); again.kind != exploreActionNone {
+			t.Errorf("expected no action while the query is running, got %v", again.kind)
+		}
+
+		// moving the cursor while loading doesn't change where the link was
+		// followed from
+		m.HandleKey(keys.Key{Code: keys.Tab})
+		m.HandleKey(keys.Key{Code: keys.Down})
+
+		m.Followed(action.query, []*sdp.Item{sg}, nil)
+		if m.Selected().GloballyUniqueName() != sg.GloballyUniqueName() {
+			t.Errorf("expected security group to be selected, got %v", m.Selected().GloballyUniqueName())
+		}
+		if len(m.breadcrumb) != 1 {
+			t.Errorf("expected breadcrumb of 1, got %v", len(m.breadcrumb))
+		}
+		if !strings.Contains(m.Render(120, 40), "ec2-instance/i-1 › ec2-security-group/sg-1") {
+			t.Errorf("expected breadcrumb to be rendered, got %v", m.Render(120, 40))
+		}
+
+		m.HandleKey(keys.Key{Code: keys.Backspace})
+		if m.Selected().GloballyUniqueName() != instance.GloballyUniqueName() {
+			t.Errorf("expected instance to be selected again, got %v", m.Selected().GloballyUniqueName())
+		}
+		if len(m.breadcrumb) != 0 {
+			t.Errorf("expected empty breadcrumb, got %v", len(m.breadcrumb))
+		}
+	})
+
+	t.Run("bookmark and quit", func(t *testing.T) {
+		m := newExploreModel()
+		if action := m.HandleKey(runeKey('b')); action.kind != exploreActionNone {
+			t.Errorf("expected no bookmark without a selection, got %v", action.kind)
+		}
+		m.AddItems(bucket)
+		action := m.HandleKey(runeKey('b'))
+		if action.kind != exploreActionBookmark || action.item != bucket {
+			t.Errorf("expected bookmark action for the bucket, got %v for %v", action.kind, action.item)
+		}
+		if !strings.HasPrefix(m.status, "Storing bookmark") {
+			t.Errorf("expected storing status, got %q", m.status)
+		}
+		if action := m.HandleKey(runeKey('q')); action.kind != exploreActionQuit {
+			t.Errorf("expected quit action, got %v", action.kind)
+		}
+	})
+}
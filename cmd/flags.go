@@ -73,4 +73,11 @@ func addTerraformBaseFlags(cmd *cobra.Command) {
 	cobra.CheckErr(cmd.PersistentFlags().MarkHidden("aws-config"))
 	cobra.CheckErr(cmd.PersistentFlags().MarkHidden("aws-profile"))
 	cmd.PersistentFlags().Bool("only-use-managed-sources", false, "Set this to skip local autoconfiguration and only use the managed sources as configured in Overmind.")
+	addLocalSourcesFlags(cmd)
+}
+
+// Adds flags for selecting and configuring the sources that are run locally
+func addLocalSourcesFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSlice("sources", []string{}, "The local sources to run, as a comma separated list of: stdlib, aws, gcp, k8s. Defaults to the sources in the sources config file, or stdlib, aws and gcp if there is none.")
+	cmd.PersistentFlags().String("sources-config", "", "The path to the sources config file. If not provided, it will check the default location which is '.overmind/sources.yaml'.")
 }
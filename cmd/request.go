@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/overmindtech/cli/sdp-go"
@@ -9,6 +10,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // requestCmd represents the start command
@@ -47,6 +49,7 @@ type requestHandler struct {
 
 // assert that requestHandler implements GatewayMessageHandler
 var _ sdpws.GatewayMessageHandler = (*requestHandler)(nil)
+var _ sdpws.ConnectionStateHandler = (*requestHandler)(nil)
 
 func (l *requestHandler) NewItem(ctx context.Context, item *sdp.Item) {
 	l.LoggingGatewayMessageHandler.NewItem(ctx, item)
@@ -77,6 +80,15 @@ func (l *requestHandler) QueryError(ctx context.Context, err *sdp.QueryError) {
 	log.WithContext(ctx).WithFields(l.lf).Errorf("Error for %v from %v(%v): %v", uuid.Must(uuid.FromBytes(err.GetUUID())), err.GetResponderName(), err.GetSourceName(), err)
 }
 
+func (l *requestHandler) ConnectionStateChanged(ctx context.Context, state sdpws.ConnectionState) {
+	lf := log.WithContext(ctx).WithFields(l.lf).WithField("state", state.String())
+	if state == sdpws.ConnectionStateReconnecting {
+		lf.Warn("lost connection to overmind API, reconnecting")
+		return
+	}
+	lf.Debug("connection state changed")
+}
+
 func (l *requestHandler) QueryStatus(ctx context.Context, status *sdp.QueryStatus) {
 	l.LoggingGatewayMessageHandler.QueryStatus(ctx, status)
 	statusFields := log.Fields{
@@ -134,6 +146,23 @@ func (l *requestHandler) BookmarkLoadResult(ctx context.Context, result *sdp.Boo
 	l.bookmarkLoadResult <- result
 }
 
+// gatewayReconnectOptions returns the options used for reconnecting to the
+// gateway. Every reconnection attempt fetches a fresh token with the specified
+// scopes, as the original one may have expired while the connection was up. If
+// that fails, the token from the context is used.
+func gatewayReconnectOptions(oi sdp.OvermindInstance, scopes []string) sdpws.ReconnectOptions {
+	return sdpws.ReconnectOptions{
+		NewHTTPClient: func(ctx context.Context) *http.Client {
+			tokenCtx, _, err := ensureToken(ctx, oi, scopes)
+			if err != nil {
+				log.WithContext(ctx).WithError(err).Warn("Failed to refresh token for reconnecting to the gateway, reusing the previous one")
+				tokenCtx = ctx
+			}
+			return NewAuthenticatedClient(tokenCtx, otelhttp.DefaultClient)
+		},
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(requestCmd)
 
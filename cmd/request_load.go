@@ -44,7 +44,8 @@ func RequestLoad(cmd *cobra.Command, args []string) error {
 		return flagError{fmt.Sprintf("Failed to parse UUID '%v': %v\n\n%v", uuidString, err, cmd.UsageString())}
 	}
 
-	ctx, oi, _, err := login(ctx, cmd, []string{"explore:read", "changes:read"}, nil)
+	scopes := []string{"explore:read", "changes:read"}
+	ctx, oi, _, err := login(ctx, cmd, scopes, nil)
 	if err != nil {
 		return err
 	}
@@ -64,9 +65,10 @@ func RequestLoad(cmd *cobra.Command, args []string) error {
 	}
 	gatewayUrl := oi.GatewayUrl()
 	lf["gateway-url"] = gatewayUrl
-	c, err := sdpws.DialBatch(ctx, gatewayUrl,
+	c, err := sdpws.DialBatchWithReconnect(ctx, gatewayUrl,
 		NewAuthenticatedClient(ctx, otelhttp.DefaultClient),
 		handler,
+		gatewayReconnectOptions(oi, scopes),
 	)
 	if err != nil {
 		return loggedError{
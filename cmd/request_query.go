@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/overmindtech/cli/sdp-go"
+	"github.com/overmindtech/cli/sdp-go/sdpql"
 	"github.com/overmindtech/cli/sdp-go/sdpws"
+	"github.com/overmindtech/pterm"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,8 +21,21 @@ import (
 
 // requestQueryCmd represents the start command
 var requestQueryCmd = &cobra.Command{
-	Use:    "query",
-	Short:  "Runs an SDP query against the overmind API",
+	Use:   "query [expression]",
+	Short: "Runs an SDP query against the overmind API",
+	Long: `Runs an SDP query against the overmind API.
+
+Either specify the query using the --query-* flags, or pass an expression that
+filters, traverses and projects items, for example:
+
+  overmind request query 'ec2-instance where tags.env = "prod" | links ec2-security-group | select @id, groupName'
+
+Expressions start with "TYPE [in SCOPE] [list | get QUERY | search QUERY] [where PREDICATE]",
+followed by any number of "| links [TYPE] [where PREDICATE]" stages and an
+optional "| select ATTR, ..." stage. Predicates support =, !=, ~ (regex), <, <=,
+>, >=, "has ATTR", and, or, not and parentheses. Attributes use dot notation;
+tags.KEY, @type, @scope, @id and @health are also available.`,
+	Args:   cobra.MaximumNArgs(1),
 	PreRun: PreRunSetup,
 	RunE:   RequestQuery,
 }
@@ -27,7 +43,22 @@ var requestQueryCmd = &cobra.Command{
 func RequestQuery(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	ctx, oi, _, err := login(ctx, cmd, []string{"explore:read", "changes:read"}, nil)
+	var prog *sdpql.Program
+	if len(args) == 1 {
+		var err error
+		prog, err = sdpql.Parse(args[0])
+		if err != nil {
+			return flagError{usage: fmt.Sprintf("invalid expression: %v\n\n%v", err, cmd.UsageString())}
+		}
+		switch viper.GetString("output") {
+		case "table", "csv", "json":
+		default:
+			return flagError{usage: fmt.Sprintf("invalid --output value '%v', allowed values are table, csv and json\n\n%v", viper.GetString("output"), cmd.UsageString())}
+		}
+	}
+
+	scopes := []string{"explore:read", "changes:read"}
+	ctx, oi, _, err := login(ctx, cmd, scopes, nil)
 	if err != nil {
 		return err
 	}
@@ -44,9 +75,10 @@ func RequestQuery(cmd *cobra.Command, args []string) error {
 	}
 	gatewayUrl := oi.GatewayUrl()
 	lf["gateway-url"] = gatewayUrl
-	c, err := sdpws.DialBatch(ctx, gatewayUrl,
+	c, err := sdpws.DialBatchWithReconnect(ctx, gatewayUrl,
 		NewAuthenticatedClient(ctx, otelhttp.DefaultClient),
 		handler,
+		gatewayReconnectOptions(oi, scopes),
 	)
 	if err != nil {
 		log.WithContext(ctx).WithFields(lf).WithError(err).Error("Failed to connect to overmind API")
@@ -58,6 +90,10 @@ func RequestQuery(cmd *cobra.Command, args []string) error {
 	}
 	defer c.Close(ctx)
 
+	if prog != nil {
+		return runQueryExpression(ctx, c, prog, lf)
+	}
+
 	q, err := CreateQuery()
 	if err != nil {
 		return flagError{usage: fmt.Sprintf("invalid query: %v\n\n%v", err, cmd.UsageString())}
@@ -140,6 +176,49 @@ func RequestQuery(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runQueryExpression runs the compiled expression and prints the result to
+// stdout in the requested format
+func runQueryExpression(ctx context.Context, c *sdpws.Client, prog *sdpql.Program, lf log.Fields) error {
+	lf["expression"] = prog.String()
+	result, err := prog.Run(ctx, c, sdpql.Options{
+		IgnoreCache: viper.GetBool("ignore-cache"),
+	})
+	if err != nil {
+		return loggedError{
+			err:     err,
+			fields:  lf,
+			message: "Failed to run expression",
+		}
+	}
+	log.WithContext(ctx).WithFields(lf).WithField("items", len(result.Items)).Info("expression finished")
+	for _, linkErr := range result.LinkErrors {
+		log.WithContext(ctx).WithFields(lf).WithError(linkErr).Debug("Failed to follow link")
+	}
+	if len(result.LinkErrors) > 0 {
+		log.WithContext(ctx).WithFields(lf).WithField("errors", len(result.LinkErrors)).Warn("Some links could not be followed, results may be incomplete")
+	}
+
+	switch viper.GetString("output") {
+	case "csv":
+		err = result.WriteCSV(os.Stdout)
+	case "json":
+		err = result.WriteJSON(os.Stdout)
+	default:
+		err = pterm.DefaultTable.
+			WithHasHeader().
+			WithData(append([][]string{result.Columns}, result.Rows()...)).
+			Render()
+	}
+	if err != nil {
+		return loggedError{
+			err:     err,
+			fields:  lf,
+			message: "Failed to write results",
+		}
+	}
+	return nil
+}
+
 func MethodFromString(method string) (sdp.QueryMethod, error) {
 	var result sdp.QueryMethod
 
@@ -195,6 +274,8 @@ func init() {
 	requestQueryCmd.PersistentFlags().String("snapshot-name", "CLI", "The snapshot name of the query results")
 	requestQueryCmd.PersistentFlags().String("snapshot-description", "none", "The snapshot description of the query results")
 
+	requestQueryCmd.PersistentFlags().String("output", "table", "The output format for expressions (table, csv, json)")
+
 	requestQueryCmd.PersistentFlags().Uint32("link-depth", 0, "How deeply to link")
 	requestQueryCmd.PersistentFlags().Bool("blast-radius", false, "Whether to query using blast radius, note that if using this option, link-depth should be set to > 0")
 }
@@ -31,7 +31,8 @@ snapshot-specific parameters for naming and describing the snapshot.`,
 func CreateSnapshot(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	ctx, oi, _, err := login(ctx, cmd, []string{"explore:read", "changes:write", "reverselink:request"}, nil)
+	scopes := []string{"explore:read", "changes:write", "reverselink:request"}
+	ctx, oi, _, err := login(ctx, cmd, scopes, nil)
 	if err != nil {
 		return err
 	}
@@ -59,9 +60,10 @@ func CreateSnapshot(cmd *cobra.Command, args []string) error {
 
 	gatewayUrl := oi.GatewayUrl()
 	lf["gateway-url"] = gatewayUrl
-	c, err := sdpws.DialBatch(ctx, gatewayUrl,
+	c, err := sdpws.DialBatchWithReconnect(ctx, gatewayUrl,
 		NewAuthenticatedClient(ctx, otelhttp.DefaultClient),
 		handler,
+		gatewayReconnectOptions(oi, scopes),
 	)
 	if err != nil {
 		log.WithContext(ctx).WithFields(lf).WithError(err).Error("Failed to connect to overmind API")
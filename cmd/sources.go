@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// sourcesCmd represents the sources command
+var sourcesCmd = &cobra.Command{
+	Use:   "sources",
+	Short: "Inspect the sources that can be run locally",
+	Long: `The CLI can run the AWS, GCP, Kubernetes and stdlib sources locally when
+exploring infrastructure. These commands show information about the adapters
+of those sources.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(sourcesCmd)
+}
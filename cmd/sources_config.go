@@ -0,0 +1,410 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/overmindtech/cli/discovery"
+	gcpproc "github.com/overmindtech/cli/sources/gcp/proc"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// The types of sources that can be run locally
+const (
+	localSourceStdlib = "stdlib"
+	localSourceAWS    = "aws"
+	localSourceGCP    = "gcp"
+	localSourceK8s    = "k8s"
+)
+
+var localSourceTypes = []string{localSourceStdlib, localSourceAWS, localSourceGCP, localSourceK8s}
+
+// The sources that are run when neither `--sources` nor a config file select
+// any. k8s is opt-in since the current kube context is often unrelated to the
+// terraform config in the current directory
+var defaultLocalSources = []string{localSourceStdlib, localSourceAWS, localSourceGCP}
+
+// defaultMaxParallelExecutions is used for all local engines unless
+// configured otherwise
+const defaultMaxParallelExecutions = 2_000
+
+// LocalSourcesConfig describes the sources that the CLI runs locally. This is
+// loaded from `.overmind/sources.yaml`, for example:
+//
+//	aws:
+//	  profiles: [prod, staging]
+//	  regions: [eu-west-1, us-east-1]
+//	  exclude: [iam-*]
+//	gcp:
+//	  projects:
+//	    - project_id: my-project
+//	      regions: [europe-west2]
+//	k8s:
+//	  contexts: [prod-cluster]
+//	  max_parallel_executions: 50
+//	stdlib:
+//	  reverse_dns: false
+//
+// Only the sources that are present in the file are started, unless they are
+// selected explicitly using `--sources`. Sources without explicit
+// configuration are auto-configured from the terraform providers or the
+// default credentials.
+type LocalSourcesConfig struct {
+	Stdlib *StdlibSourceConfig `yaml:"stdlib"`
+	AWS    *AWSSourceConfig    `yaml:"aws"`
+	GCP    *GCPSourceConfig    `yaml:"gcp"`
+	K8s    *K8sSourceConfig    `yaml:"k8s"`
+}
+
+// EngineOptions are supported by all types of local sources
+type EngineOptions struct {
+	// The maximum number of queries that the engine executes in parallel
+	MaxParallelExecutions int `yaml:"max_parallel_executions"`
+	// If set, only adapters whose type matches one of these patterns are run.
+	// Patterns use the syntax of `path.Match`, e.g. `ec2-*`
+	Include []string `yaml:"include"`
+	// Adapters whose type matches one of these patterns are not run, this
+	// takes precedence over `Include`
+	Exclude []string `yaml:"exclude"`
+}
+
+// StdlibSourceConfig configures the local stdlib source
+type StdlibSourceConfig struct {
+	EngineOptions `yaml:",inline"`
+
+	// Whether to run reverse DNS lookups for IP addresses, defaults to true
+	ReverseDNS *bool `yaml:"reverse_dns"`
+}
+
+// AWSSourceConfig configures the local AWS source. If neither profiles nor
+// regions are set, the AWS providers of the terraform config are used
+type AWSSourceConfig struct {
+	EngineOptions `yaml:",inline"`
+
+	// The profiles from the shared AWS config to use, defaults to the default
+	// credential chain
+	Profiles []string `yaml:"profiles"`
+	// The regions to discover for each profile, defaults to the region of the
+	// profile
+	Regions []string `yaml:"regions"`
+}
+
+// GCPSourceConfig configures the local GCP source. If no projects are set, the
+// GCP providers of the terraform config are used
+type GCPSourceConfig struct {
+	EngineOptions `yaml:",inline"`
+
+	Projects []GCPProjectConfig `yaml:"projects"`
+}
+
+// GCPProjectConfig is a single project that should be discovered using
+// application default credentials
+type GCPProjectConfig struct {
+	ProjectID string   `yaml:"project_id"`
+	Regions   []string `yaml:"regions"`
+	Zones     []string `yaml:"zones"`
+}
+
+// K8sSourceConfig configures the local kubernetes source. A separate engine
+// is started for each context
+type K8sSourceConfig struct {
+	EngineOptions `yaml:",inline"`
+
+	// The kubeconfig file to use, defaults to $KUBECONFIG or ~/.kube/config
+	Kubeconfig string `yaml:"kubeconfig"`
+	// The contexts of the kubeconfig to discover, defaults to the current
+	// context
+	Contexts []string `yaml:"contexts"`
+	// The maximum sustained queries per second to the kubernetes API,
+	// defaults to 10
+	RateLimitQPS float32 `yaml:"rate_limit_qps"`
+	// The maximum burst of queries to the kubernetes API, defaults to 30
+	RateLimitBurst int `yaml:"rate_limit_burst"`
+}
+
+// maxParallelExecutions returns the configured parallelism or the default
+func (o EngineOptions) maxParallelExecutions() int {
+	if o.MaxParallelExecutions > 0 {
+		return o.MaxParallelExecutions
+	}
+	return defaultMaxParallelExecutions
+}
+
+// keepAdapter returns whether an adapter of the given type should be run
+// according to the include and exclude lists. The patterns need to be
+// validated using `validate()` beforehand
+func (o EngineOptions) keepAdapter(typ string) bool {
+	matches := func(patterns []string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			matched, _ := path.Match(pattern, typ)
+			return matched
+		})
+	}
+
+	if matches(o.Exclude) {
+		return false
+	}
+	return len(o.Include) == 0 || matches(o.Include)
+}
+
+// applyTo removes all adapters from the engine that were not selected. The
+// filter also applies to adapters that the engine adds later on
+func (o EngineOptions) applyTo(e *discovery.Engine) {
+	if len(o.Include) == 0 && len(o.Exclude) == 0 {
+		return
+	}
+	e.SetAdapterFilter(func(a discovery.Adapter) bool {
+		return o.keepAdapter(a.Type())
+	})
+}
+
+func (o EngineOptions) validate() error {
+	if o.MaxParallelExecutions < 0 {
+		return fmt.Errorf("max_parallel_executions must not be negative, got %v", o.MaxParallelExecutions)
+	}
+	for _, pattern := range slices.Concat(o.Include, o.Exclude) {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid adapter pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// reverseDNS returns whether the stdlib source should run reverse DNS lookups
+func (c *StdlibSourceConfig) reverseDNS() bool {
+	if c == nil || c.ReverseDNS == nil {
+		return true
+	}
+	return *c.ReverseDNS
+}
+
+// explicit returns true if the config replaces the terraform providers
+func (c *AWSSourceConfig) explicit() bool {
+	return c != nil && (len(c.Profiles) > 0 || len(c.Regions) > 0)
+}
+
+// awsConfigs loads a config for each combination of profile and region
+func (c *AWSSourceConfig) awsConfigs(ctx context.Context) ([]aws.Config, error) {
+	profiles := c.Profiles
+	if len(profiles) == 0 {
+		profiles = []string{""}
+	}
+	regions := c.Regions
+	if len(regions) == 0 {
+		regions = []string{""}
+	}
+
+	configs := make([]aws.Config, 0, len(profiles)*len(regions))
+	for _, profile := range profiles {
+		for _, region := range regions {
+			opts := []func(*config.LoadOptions) error{}
+			if profile != "" {
+				opts = append(opts, config.WithSharedConfigProfile(profile))
+			}
+			if region != "" {
+				opts = append(opts, config.WithRegion(region))
+			}
+
+			cfg, err := config.LoadDefaultConfig(ctx, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load AWS config for profile %q: %w", profile, err)
+			}
+			if cfg.Region == "" {
+				return nil, fmt.Errorf("no region configured for AWS profile %q, set regions in the sources config", profile)
+			}
+			configs = append(configs, cfg)
+		}
+	}
+
+	return configs, nil
+}
+
+// explicit returns true if the config replaces the terraform providers
+func (c *GCPSourceConfig) explicit() bool {
+	return c != nil && len(c.Projects) > 0
+}
+
+// gcpConfigs returns the configs of all projects
+func (c *GCPSourceConfig) gcpConfigs() []*gcpproc.GCPConfig {
+	configs := make([]*gcpproc.GCPConfig, 0, len(c.Projects))
+	for _, p := range c.Projects {
+		configs = append(configs, &gcpproc.GCPConfig{
+			ProjectID: p.ProjectID,
+			Regions:   p.Regions,
+			Zones:     p.Zones,
+		})
+	}
+	return configs
+}
+
+// engineOptions returns the options of the given source type, or the defaults
+// if the source is not configured
+func (c *LocalSourcesConfig) engineOptions(source string) EngineOptions {
+	switch source {
+	case localSourceStdlib:
+		if c.Stdlib != nil {
+			return c.Stdlib.EngineOptions
+		}
+	case localSourceAWS:
+		if c.AWS != nil {
+			return c.AWS.EngineOptions
+		}
+	case localSourceGCP:
+		if c.GCP != nil {
+			return c.GCP.EngineOptions
+		}
+	case localSourceK8s:
+		if c.K8s != nil {
+			return c.K8s.EngineOptions
+		}
+	}
+	return EngineOptions{}
+}
+
+// configured returns the types of the sources that are present in the config
+func (c *LocalSourcesConfig) configured() []string {
+	configured := []string{}
+	if c.Stdlib != nil {
+		configured = append(configured, localSourceStdlib)
+	}
+	if c.AWS != nil {
+		configured = append(configured, localSourceAWS)
+	}
+	if c.GCP != nil {
+		configured = append(configured, localSourceGCP)
+	}
+	if c.K8s != nil {
+		configured = append(configured, localSourceK8s)
+	}
+	return configured
+}
+
+func (c *LocalSourcesConfig) validate() error {
+	for _, source := range localSourceTypes {
+		err := c.engineOptions(source).validate()
+		if err != nil {
+			return fmt.Errorf("%v: %w", source, err)
+		}
+	}
+	if c.GCP != nil {
+		for _, p := range c.GCP.Projects {
+			if p.ProjectID == "" {
+				return errors.New("gcp: project_id is required for all projects")
+			}
+			if len(p.Regions) == 0 && len(p.Zones) == 0 {
+				return fmt.Errorf("gcp: project %v must specify at least one region or zone", p.ProjectID)
+			}
+		}
+	}
+	return nil
+}
+
+// selectLocalSources returns the types of the sources that should be run. The
+// `--sources` flag takes precedence over the sources in the config file,
+// which in turn take precedence over the defaults
+func selectLocalSources(flag []string, cfg *LocalSourcesConfig, fromFile bool) ([]string, error) {
+	if len(flag) > 0 {
+		selected := []string{}
+		for _, source := range flag {
+			source = strings.ToLower(strings.TrimSpace(source))
+			if !slices.Contains(localSourceTypes, source) {
+				return nil, flagError{fmt.Sprintf("unknown source %q, valid sources are: %v", source, strings.Join(localSourceTypes, ", "))}
+			}
+			if !slices.Contains(selected, source) {
+				selected = append(selected, source)
+			}
+		}
+		return selected, nil
+	}
+
+	if fromFile {
+		return cfg.configured(), nil
+	}
+
+	return defaultLocalSources, nil
+}
+
+func loadLocalSourcesConfigFile(sourcesConfigPath string) (*LocalSourcesConfig, error) {
+	// check if the file exists
+	_, err := os.Stat(sourcesConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("sources config file %q does not exist: %w", sourcesConfigPath, err)
+	}
+	// read the file
+	sourcesConfig, err := os.ReadFile(sourcesConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sources config file %q: %w", sourcesConfigPath, err)
+	}
+
+	cfg := &LocalSourcesConfig{}
+	dec := yaml.NewDecoder(bytes.NewReader(sourcesConfig))
+	dec.KnownFields(true)
+	err = dec.Decode(cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse sources config file %q: %w", sourcesConfigPath, err)
+	}
+
+	err = cfg.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sources config file %q: %w", sourcesConfigPath, err)
+	}
+
+	return cfg, nil
+}
+
+// order of precedence: flag > default config file. Returns an empty config if
+// no file was found, the boolean reports whether a file was loaded
+func checkForAndLoadLocalSourcesConfigFile(ctx context.Context, manualPath string) (*LocalSourcesConfig, bool, error) {
+	foundPath := ""
+	if manualPath != "" {
+		_, err := os.Stat(manualPath)
+		if err == nil {
+			// we found the file
+			foundPath = manualPath
+		} else {
+			// the specified file does not exist
+			// hard fail
+			return nil, false, fmt.Errorf("sources config file does not exist: %w", err)
+		}
+	}
+	// let's look for the default files
+	// yaml
+	if foundPath == "" {
+		_, err := os.Stat(".overmind/sources.yaml")
+		if err == nil {
+			// we found the file
+			foundPath = ".overmind/sources.yaml"
+		}
+	}
+	// yml
+	if foundPath == "" {
+		_, err := os.Stat(".overmind/sources.yml")
+		if err == nil {
+			// we found the file
+			foundPath = ".overmind/sources.yml"
+		}
+	}
+
+	if foundPath != "" {
+		// we found a file, load it
+		log.WithContext(ctx).WithField("sourcesConfig", foundPath).Info("Loading sources config")
+		cfg, err := loadLocalSourcesConfigFile(foundPath)
+		if err != nil {
+			return nil, false, err
+		}
+		return cfg, true, nil
+	}
+	// we didn't find any files, thats ok
+	return &LocalSourcesConfig{}, false, nil
+}
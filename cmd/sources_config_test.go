@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLoadLocalSourcesConfigFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		fileContent   string
+		errorContains string
+	}{
+		{
+			name:          "NonExistent.yaml",
+			errorContains: "does not exist",
+		},
+		{
+			name:          "UnknownField.yaml",
+			fileContent:   "aws:\n  profile: prod\n",
+			errorContains: "failed to parse",
+		},
+		{
+			name:          "InvalidPattern.yaml",
+			fileContent:   "aws:\n  include: ['ec2-[']\n",
+			errorContains: "invalid adapter pattern",
+		},
+		{
+			name:          "MissingGCPRegions.yaml",
+			fileContent:   "gcp:\n  projects:\n    - project_id: test\n",
+			errorContains: "at least one region or zone",
+		},
+		{
+			name:        "Empty.yaml",
+			fileContent: "\n",
+		},
+		{
+			name: "Valid.yaml",
+			fileContent: `
+aws:
+  profiles: [prod, staging]
+  regions: [eu-west-1]
+  exclude: ["iam-*"]
+gcp:
+  projects:
+    - project_id: test
+      regions: [europe-west2]
+k8s:
+  contexts: [kind-kind]
+  max_parallel_executions: 50
+stdlib:
+  reverse_dns: false
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), tt.name)
+			if tt.fileContent != "" {
+				err := os.WriteFile(filePath, []byte(tt.fileContent), 0644)
+				if err != nil {
+					t.Fatalf("Failed to write file %q: %v", filePath, err)
+				}
+			}
+
+			cfg, err := loadLocalSourcesConfigFile(filePath)
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Fatalf("Expected error to contain %q, got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.name != "Valid.yaml" {
+				return
+			}
+
+			if !cfg.AWS.explicit() || len(cfg.AWS.Profiles) != 2 {
+				t.Errorf("Expected 2 explicit AWS profiles, got %v", cfg.AWS.Profiles)
+			}
+			if !cfg.GCP.explicit() || cfg.GCP.gcpConfigs()[0].ProjectID != "test" {
+				t.Errorf("Expected GCP project test, got %v", cfg.GCP.Projects)
+			}
+			if cfg.engineOptions(localSourceK8s).maxParallelExecutions() != 50 {
+				t.Errorf("Expected k8s parallelism of 50, got %v", cfg.K8s.MaxParallelExecutions)
+			}
+			if cfg.engineOptions(localSourceAWS).maxParallelExecutions() != defaultMaxParallelExecutions {
+				t.Errorf("Expected default AWS parallelism, got %v", cfg.AWS.MaxParallelExecutions)
+			}
+			if cfg.Stdlib.reverseDNS() {
+				t.Error("Expected reverse DNS to be disabled")
+			}
+			if !slices.Equal(cfg.configured(), localSourceTypes) {
+				t.Errorf("Expected all sources to be configured, got %v", cfg.configured())
+			}
+		})
+	}
+}
+
+func TestSelectLocalSources(t *testing.T) {
+	cfg := &LocalSourcesConfig{
+		K8s: &K8sSourceConfig{},
+	}
+
+	tests := []struct {
+		name     string
+		flag     []string
+		fromFile bool
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "defaults",
+			expected: defaultLocalSources,
+		},
+		{
+			name:     "config file",
+			fromFile: true,
+			expected: []string{localSourceK8s},
+		},
+		{
+			name:     "flag takes precedence",
+			flag:     []string{"aws", " K8S", "aws"},
+			fromFile: true,
+			expected: []string{localSourceAWS, localSourceK8s},
+		},
+		{
+			name: "unknown source",
+			flag: []string{"azure"},
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := selectLocalSources(tt.flag, cfg, tt.fromFile)
+			if tt.err {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(selected, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, selected)
+			}
+		})
+	}
+}
+
+func TestEngineOptionsKeepAdapter(t *testing.T) {
+	opts := EngineOptions{
+		Include: []string{"ec2-*", "iam-role"},
+		Exclude: []string{"ec2-image"},
+	}
+
+	tests := map[string]bool{
+		"ec2-instance": true,
+		"iam-role":     true,
+		"ec2-image":    false,
+		"iam-user":     false,
+	}
+	for typ, expected := range tests {
+		if opts.keepAdapter(typ) != expected {
+			t.Errorf("Expected keepAdapter(%v) to be %v", typ, expected)
+		}
+	}
+
+	if !(EngineOptions{}).keepAdapter("anything") {
+		t.Error("Expected all adapters to be kept without include or exclude lists")
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	awsAdapters "github.com/overmindtech/cli/aws-source/adapters"
+	k8sAdapters "github.com/overmindtech/cli/k8s-source/adapters"
+	"github.com/overmindtech/cli/sdp-go"
+	gcpproc "github.com/overmindtech/cli/sources/gcp/proc"
+	"github.com/spf13/cobra"
+)
+
+// sourcesSchemaCmd represents the sources schema command
+var sourcesSchemaCmd = &cobra.Command{
+	Use:   "schema [type]",
+	Short: "Prints the JSON Schema of the attributes of an item type",
+	Long: `Prints the JSON Schema describing the attributes of the items of the given
+type, e.g. ec2-instance. The schema is generated from the Go types that the
+adapter converts into attributes, so every property is optional. Objects don't
+allow properties that aren't part of the schema, unless the schema sets
+additionalProperties.
+
+Without a type, all types that have a schema are listed.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: SourcesSchema,
+}
+
+// attributeSchemaSources are the metadata lists of all sources that publish
+// attribute schemas
+var attributeSchemaSources = []*sdp.AdapterMetadataList{
+	&awsAdapters.Metadata,
+	&gcpproc.Metadata,
+	&k8sAdapters.Metadata,
+}
+
+// findAttributeSchema returns the schema for the given type from any source
+func findAttributeSchema(itemType string) *sdp.AttributeSchema {
+	for _, source := range attributeSchemaSources {
+		if schema := source.AttributeSchema(itemType); schema != nil {
+			return schema
+		}
+	}
+	return nil
+}
+
+func SourcesSchema(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		types := make([]string, 0)
+		for _, source := range attributeSchemaSources {
+			for _, metadata := range source.AllAdapterMetadata() {
+				if source.AttributeSchema(metadata.GetType()) != nil {
+					types = append(types, metadata.GetType())
+				}
+			}
+		}
+		slices.Sort(types)
+		for _, t := range types {
+			fmt.Println(t)
+		}
+		return nil
+	}
+
+	schema := findAttributeSchema(args[0])
+	if schema == nil {
+		return flagError{usage: fmt.Sprintf("no schema found for type '%v'\n\n%v", args[0], cmd.UsageString())}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(schema)
+}
+
+func init() {
+	sourcesCmd.AddCommand(sourcesSchemaCmd)
+}
@@ -176,7 +176,20 @@ func TerraformPlanImpl(ctx context.Context, cmd *cobra.Command, oi sdp.OvermindI
 			printer = pterm.Error
 		}
 
-		line := printer.Sprintf("%v (%v)", mapping.TerraformName, mapping.Message)
+		message := mapping.Message
+		if mapping.CostDelta != nil {
+			message = fmt.Sprintf("%v, %v", message, mapping.CostDelta)
+		}
+
+		line := printer.Sprintf("%v (%v)", mapping.TerraformName, message)
+		_, err = fmt.Fprintf(resourceExtractionResults, "   %v\n", line)
+		if err != nil {
+			return fmt.Errorf("error writing to resource extraction results: %w", err)
+		}
+	}
+
+	if slices.ContainsFunc(mappingResponse.Results, func(r tfutils.PlannedChangeMapResult) bool { return r.CostDelta != nil }) {
+		line := pterm.Info.Sprintf("Estimated cost change: %v", tfutils.FormatMonthlyCostDelta(mappingResponse.MonthlyCostDelta()))
 		_, err = fmt.Fprintf(resourceExtractionResults, "   %v\n", line)
 		if err != nil {
 			return fmt.Errorf("error writing to resource extraction results: %w", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -20,7 +21,10 @@ import (
 type AdapterHost struct {
 	// Map of types to all adapters for that type
 	adapters []Adapter
-	mutex    sync.RWMutex
+	// Adapters for which this returns false are dropped when they are added,
+	// see `SetAdapterFilter()`
+	keep  func(Adapter) bool
+	mutex sync.RWMutex
 }
 
 func NewAdapterHost() *AdapterHost {
@@ -39,6 +43,10 @@ func (sh *AdapterHost) AddAdapters(adapters ...Adapter) error {
 	defer sh.mutex.Unlock()
 
 	for _, newAdapter := range adapters {
+		if sh.keep != nil && !sh.keep(newAdapter) {
+			log.Debugf("Skipping adapter %s as it was excluded by the adapter filter", newAdapter.Name())
+			continue
+		}
 		for _, existingAdapter := range sh.adapters {
 			if existingAdapter.Type() == newAdapter.Type() && scopesOverlap(existingAdapter.Scopes(), newAdapter.Scopes()) {
 				log.Errorf("Error: Adapter with type %s and overlapping scopes already exists. Existing adapter scopes: %v, New adapter scopes: %v",
@@ -181,6 +189,31 @@ func (sh *AdapterHost) ClearAllAdapters() {
 	sh.mutex.Unlock()
 }
 
+// FilterAdapters Removes all adapters for which `keep` returns false
+func (sh *AdapterHost) FilterAdapters(keep func(Adapter) bool) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+
+	sh.adapters = slices.DeleteFunc(sh.adapters, func(a Adapter) bool {
+		return !keep(a)
+	})
+}
+
+// SetAdapterFilter Removes all adapters for which `keep` returns false and
+// remembers the filter so that adapters that are added later are filtered too
+func (sh *AdapterHost) SetAdapterFilter(keep func(Adapter) bool) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+
+	sh.keep = keep
+	if keep == nil {
+		return
+	}
+	sh.adapters = slices.DeleteFunc(sh.adapters, func(a Adapter) bool {
+		return !keep(a)
+	})
+}
+
 // StartPurger Starts the purger for all caching adapters
 func (sh *AdapterHost) StartPurger(ctx context.Context) {
 	for _, s := range sh.Adapters() {
@@ -187,3 +187,66 @@ func TestAdapterHostAddAdapters(t *testing.T) {
 		t.Fatalf("Expected 1 adapters, got %v", x)
 	}
 }
+
+func TestAdapterHostFilterAdapters(t *testing.T) {
+	sh := NewAdapterHost()
+
+	err := sh.AddAdapters(
+		&TestAdapter{ReturnType: "person", ReturnScopes: []string{"test"}},
+		&TestAdapter{ReturnType: "dog", ReturnScopes: []string{"test"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sh.FilterAdapters(func(a Adapter) bool {
+		return a.Type() == "dog"
+	})
+
+	adapters := sh.Adapters()
+	if len(adapters) != 1 {
+		t.Fatalf("Expected 1 adapter, got %v", len(adapters))
+	}
+	if adapters[0].Type() != "dog" {
+		t.Errorf("Expected the dog adapter to be kept, got %v", adapters[0].Type())
+	}
+}
+
+func TestAdapterHostSetAdapterFilter(t *testing.T) {
+	sh := NewAdapterHost()
+
+	err := sh.AddAdapters(
+		&TestAdapter{ReturnType: "person", ReturnScopes: []string{"test"}},
+		&TestAdapter{ReturnType: "dog", ReturnScopes: []string{"test"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sh.SetAdapterFilter(func(a Adapter) bool {
+		return a.Type() == "dog"
+	})
+
+	if x := len(sh.Adapters()); x != 1 {
+		t.Fatalf("Expected 1 adapter after filtering, got %v", x)
+	}
+
+	// Adapters added later should be filtered too
+	err = sh.AddAdapters(
+		&TestAdapter{ReturnType: "person", ReturnScopes: []string{"other"}},
+		&TestAdapter{ReturnType: "dog", ReturnScopes: []string{"other"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adapters := sh.Adapters()
+	if len(adapters) != 2 {
+		t.Fatalf("Expected 2 adapters, got %v", len(adapters))
+	}
+	for _, a := range adapters {
+		if a.Type() != "dog" {
+			t.Errorf("Expected only dog adapters to be kept, got %v", a.Type())
+		}
+	}
+}
@@ -603,6 +603,21 @@ func (e *Engine) ClearAdapters() {
 	e.sh.ClearAllAdapters()
 }
 
+// FilterAdapters Removes all adapters from the engine for which `keep` returns
+// false. Note that this requires a restart using `Restart()` in order to take
+// effect
+func (e *Engine) FilterAdapters(keep func(Adapter) bool) {
+	e.sh.FilterAdapters(keep)
+}
+
+// SetAdapterFilter Removes all adapters from the engine for which `keep`
+// returns false, and drops any adapters that are added later and don't pass
+// the filter, e.g. when new accounts or clusters are discovered. Note that
+// this requires a restart using `Restart()` in order to take effect
+func (e *Engine) SetAdapterFilter(keep func(Adapter) bool) {
+	e.sh.SetAdapterFilter(keep)
+}
+
 // IsWildcard checks if a string is the wildcard. Use this instead of
 // implementing the wildcard check everywhere so that if we need to change the
 // wildcard at a later date we can do so here
@@ -44,4 +44,5 @@ var clusterRoleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newClusterRoleAdapter)
+	Metadata.RegisterSchema(clusterRoleAdapterMetadata, kubeAttributeSchema(&v1.ClusterRole{}))
 }
@@ -97,4 +97,5 @@ func newClusterRoleBindingAdapter(cs *kubernetes.Clientset, cluster string, name
 
 func init() {
 	registerAdapterLoader(newClusterRoleBindingAdapter)
+	Metadata.RegisterSchema(clusterRoleBindingAdapterMetadata, kubeAttributeSchema(&v1.ClusterRoleBinding{}))
 }
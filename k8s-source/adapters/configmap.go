@@ -48,4 +48,5 @@ var configMapAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newConfigMapAdapter)
+	Metadata.RegisterSchema(configMapAdapterMetadata, kubeAttributeSchema(&v1.ConfigMap{}))
 }
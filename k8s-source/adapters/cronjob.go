@@ -51,4 +51,5 @@ var cronJobAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newCronJobAdapter)
+	Metadata.RegisterSchema(cronJobAdapterMetadata, kubeAttributeSchema(&v1.CronJob{}))
 }
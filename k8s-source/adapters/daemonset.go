@@ -50,4 +50,5 @@ var daemonSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newDaemonSetAdapter)
+	Metadata.RegisterSchema(daemonSetAdapterMetadata, kubeAttributeSchema(&v1.DaemonSet{}))
 }
@@ -120,4 +120,5 @@ var deploymentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newDeploymentAdapter)
+	Metadata.RegisterSchema(deploymentAdapterMetadata, kubeAttributeSchema(&v1.Deployment{}))
 }
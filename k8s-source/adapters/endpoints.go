@@ -122,4 +122,5 @@ var endpointsAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newEndpointsAdapter)
+	Metadata.RegisterSchema(endpointsAdapterMetadata, kubeAttributeSchema(&v1.Endpoints{}))
 }
@@ -141,4 +141,5 @@ var endpointSliceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newEndpointSliceAdapter)
+	Metadata.RegisterSchema(endpointSliceAdapterMetadata, kubeAttributeSchema(&v1.EndpointSlice{}))
 }
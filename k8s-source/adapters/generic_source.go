@@ -58,6 +58,11 @@ type KubeTypeAdapter[Resource metav1.Object, ResourceList any] struct {
 	// optional
 	Redact func(resource Resource) Resource
 
+	// A function that matches resources to search queries that aren't list
+	// options, this is optional. For these queries every resource is listed
+	// and the ones that match are returned, e.g. nodes by their provider ID
+	SearchMatcher func(query string, resource Resource) bool
+
 	// Whether to automatically extract the query from the item's attributes.
 	// This should be enabled for resources that are likely to include
 	// unstructured but interesting data like environment variables
@@ -245,41 +250,75 @@ func (s *KubeTypeAdapter[Resource, ResourceList]) List(ctx context.Context, scop
 
 // listWithOptions Runs the inbuilt list method with the given options
 func (s *KubeTypeAdapter[Resource, ResourceList]) listWithOptions(ctx context.Context, scope string, opts metav1.ListOptions) ([]*sdp.Item, error) {
-	i, err := s.itemInterface(scope)
+	resourceList, err := s.listResources(ctx, scope, opts)
 	if err != nil {
-		return nil, &sdp.QueryError{
-			ErrorType:   sdp.QueryError_NOSCOPE,
-			ErrorString: err.Error(),
-		}
+		return nil, err
 	}
 
-	list, err := i.List(ctx, opts)
+	items, err := s.resourcesToItems(resourceList)
 	if err != nil {
 		return nil, err
 	}
 
-	resourceList, err := s.ListExtractor(list)
+	return items, nil
+}
+
+// listMatching Lists all resources and returns the ones that match the query
+// using the `SearchMatcher`
+func (s *KubeTypeAdapter[Resource, ResourceList]) listMatching(ctx context.Context, scope string, query string) ([]*sdp.Item, error) {
+	resourceList, err := s.listResources(ctx, scope, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	items, err := s.resourcesToItems(resourceList)
+	matching := make([]Resource, 0)
+
+	for _, resource := range resourceList {
+		if s.SearchMatcher(query, resource) {
+			matching = append(matching, resource)
+		}
+	}
+
+	return s.resourcesToItems(matching)
+}
+
+// listResources Runs the inbuilt list method with the given options and
+// extracts the resources
+func (s *KubeTypeAdapter[Resource, ResourceList]) listResources(ctx context.Context, scope string, opts metav1.ListOptions) ([]Resource, error) {
+	i, err := s.itemInterface(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: err.Error(),
+		}
+	}
+
+	list, err := i.List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return items, nil
+	return s.ListExtractor(list)
 }
 
 func (s *KubeTypeAdapter[Resource, ResourceList]) Search(ctx context.Context, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
+	s.ensureCache()
+
+	var items []*sdp.Item
+
 	opts, err := QueryToListOptions(query)
 	if err != nil {
-		return nil, err
+		if s.SearchMatcher == nil {
+			return nil, err
+		}
+
+		items, err = s.listMatching(ctx, scope, query)
+	} else {
+		items, err = s.listWithOptions(ctx, scope, opts)
 	}
 
 	ck := sdpcache.CacheKeyFromParts(s.Name(), sdp.QueryMethod_SEARCH, scope, s.Type(), query)
 
-	items, err := s.listWithOptions(ctx, scope, opts)
 	if err != nil {
 		s.cache.StoreError(err, s.cacheDuration(), ck)
 		return nil, err
@@ -327,6 +366,23 @@ func ignored(key string) bool {
 	return false
 }
 
+// kubeAttributeSchema generates the attribute schema for a resource type. Like
+// resourceToItem() this promotes the fields of the metadata to the top level
+func kubeAttributeSchema(resource metav1.Object) *sdp.AttributeSchema {
+	schema := sdp.AttributeSchemaFor(resource)
+
+	if metadata, ok := schema.Properties["metadata"]; ok {
+		for key, property := range metadata.Properties {
+			if !ignored(key) {
+				schema.Properties[key] = property
+			}
+		}
+		delete(schema.Properties, "metadata")
+	}
+
+	return schema
+}
+
 // resourcesToItems Converts a slice of resources to a slice of items
 func (s *KubeTypeAdapter[Resource, ResourceList]) resourcesToItems(resourceList []Resource) ([]*sdp.Item, error) {
 	items := make([]*sdp.Item, len(resourceList))
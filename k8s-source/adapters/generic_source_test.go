@@ -357,6 +357,10 @@ func TestAdapterGet(t *testing.T) {
 		if !foundAutomaticLink {
 			t.Errorf("expected automatic link to http://example.com, got none")
 		}
+
+		if err = Metadata.AttributeSchema("Pod").Validate(item.GetAttributes()); err != nil {
+			t.Errorf("expected item to match its attribute schema, got %v", err)
+		}
 	})
 
 	t.Run("get non-existent item", func(t *testing.T) {
@@ -475,6 +479,27 @@ func TestSearch(t *testing.T) {
 			t.Errorf("expected error, got none")
 		}
 	})
+
+	t.Run("with a search matcher", func(t *testing.T) {
+		adapter := createAdapter(false)
+		adapter.SearchMatcher = func(query string, resource *v1.Pod) bool {
+			return resource.Name == query
+		}
+
+		items, err := adapter.Search(context.Background(), "foo", "bar", false)
+
+		if err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+
+		if len(items) != 1 {
+			t.Fatalf("expected 1 item, got %d", len(items))
+		}
+
+		if items[0].UniqueAttributeValue() != "bar" {
+			t.Errorf("expected bar, got %v", items[0].UniqueAttributeValue())
+		}
+	})
 }
 
 func TestRedact(t *testing.T) {
@@ -758,3 +783,21 @@ func TestObjectReferenceToQuery(t *testing.T) {
 		}
 	})
 }
+
+func TestAttributeSchemas(t *testing.T) {
+	for _, metadata := range Metadata.AllAdapterMetadata() {
+		schema := Metadata.AttributeSchema(metadata.GetType())
+		if schema == nil {
+			t.Errorf("expected a schema for %v", metadata.GetType())
+			continue
+		}
+
+		// metadata is promoted to the top level
+		if _, ok := schema.Properties["metadata"]; ok {
+			t.Errorf("expected metadata of %v to be promoted", metadata.GetType())
+		}
+		if _, ok := schema.Properties["namespace"]; !ok {
+			t.Errorf("expected %v to have a namespace property", metadata.GetType())
+		}
+	}
+}
@@ -66,4 +66,5 @@ var horizontalPodAutoscalerAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 
 func init() {
 	registerAdapterLoader(newHorizontalPodAutoscalerAdapter)
+	Metadata.RegisterSchema(horizontalPodAutoscalerAdapterMetadata, kubeAttributeSchema(&v2.HorizontalPodAutoscaler{}))
 }
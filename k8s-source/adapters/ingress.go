@@ -165,4 +165,5 @@ var ingressAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newIngressAdapter)
+	Metadata.RegisterSchema(ingressAdapterMetadata, kubeAttributeSchema(&v1.Ingress{}))
 }
@@ -74,4 +74,5 @@ var jobAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newJobAdapter)
+	Metadata.RegisterSchema(jobAdapterMetadata, kubeAttributeSchema(&v1.Job{}))
 }
@@ -47,4 +47,5 @@ var limitRangeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newLimitRangeAdapter)
+	Metadata.RegisterSchema(limitRangeAdapterMetadata, kubeAttributeSchema(&v1.LimitRange{}))
 }
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"github.com/overmindtech/cli/discovery"
+	"github.com/overmindtech/cli/sdp-go"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func newNamespaceAdapter(cs *kubernetes.Clientset, cluster string, namespaces []string) discovery.ListableAdapter {
+	return &KubeTypeAdapter[*v1.Namespace, *v1.NamespaceList]{
+		ClusterName: cluster,
+		Namespaces:  namespaces,
+		TypeName:    "Namespace",
+		ClusterInterfaceBuilder: func() ItemInterface[*v1.Namespace, *v1.NamespaceList] {
+			return cs.CoreV1().Namespaces()
+		},
+		ListExtractor: func(list *v1.NamespaceList) ([]*v1.Namespace, error) {
+			extracted := make([]*v1.Namespace, len(list.Items))
+
+			for i := range list.Items {
+				extracted[i] = &list.Items[i]
+			}
+
+			return extracted, nil
+		},
+		HealthExtractor: func(resource *v1.Namespace) *sdp.Health {
+			switch resource.Status.Phase {
+			case v1.NamespaceActive:
+				return sdp.Health_HEALTH_OK.Enum()
+			case v1.NamespaceTerminating:
+				return sdp.Health_HEALTH_WARNING.Enum()
+			}
+
+			return nil
+		},
+		AdapterMetadata: namespaceAdapterMetadata,
+	}
+}
+
+var namespaceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:                  "Namespace",
+	DescriptiveName:       "Namespace",
+	Category:              sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: DefaultSupportedQueryMethods("Namespace"),
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "kubernetes_namespace.metadata[0].name",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "kubernetes_namespace_v1.metadata[0].name",
+		},
+	},
+})
+
+func init() {
+	registerAdapterLoader(newNamespaceAdapter)
+	Metadata.RegisterSchema(namespaceAdapterMetadata, kubeAttributeSchema(&v1.Namespace{}))
+}
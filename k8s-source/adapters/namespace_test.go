@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"testing"
+)
+
+func TestNamespaceAdapter(t *testing.T) {
+	sd := ScopeDetails{
+		ClusterName: CurrentCluster.Name,
+	}
+
+	adapter := newNamespaceAdapter(CurrentCluster.ClientSet, sd.ClusterName, []string{"default"})
+
+	st := AdapterTests{
+		Adapter:       adapter,
+		GetQuery:      "default",
+		GetScope:      sd.String(),
+		GetQueryTests: QueryTests{},
+	}
+
+	st.Execute(t)
+}
@@ -107,4 +107,5 @@ var networkPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newNetworkPolicyAdapter)
+	Metadata.RegisterSchema(networkPolicyAdapterMetadata, kubeAttributeSchema(&v1.NetworkPolicy{}))
 }
@@ -72,9 +72,45 @@ func linkedItemExtractor(resource *v1.Node, scope string) ([]*sdp.LinkedItemQuer
 		}
 	}
 
+	if instanceID := awsInstanceID(resource.Spec.ProviderID); instanceID != "" {
+		queries = append(queries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  instanceID,
+				Scope:  "*",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the instance will affect the node
+				In: true,
+				// Changes to the node can affect the workloads on the
+				// instance, but not the instance itself
+				Out: false,
+			},
+		})
+	}
+
 	return queries, nil
 }
 
+// awsInstanceID returns the ID of the EC2 instance of a node from its provider
+// ID, which is in the format aws:///eu-west-2a/i-0123456789abcdef0. Returns
+// an empty string if the node isn't an EC2 instance
+func awsInstanceID(providerID string) string {
+	if !strings.HasPrefix(providerID, "aws://") {
+		return ""
+	}
+
+	sections := strings.Split(providerID, "/")
+	instanceID := sections[len(sections)-1]
+
+	if !strings.HasPrefix(instanceID, "i-") {
+		return ""
+	}
+
+	return instanceID
+}
+
 func newNodeAdapter(cs *kubernetes.Clientset, cluster string, namespaces []string) discovery.ListableAdapter {
 	return &KubeTypeAdapter[*v1.Node, *v1.NodeList]{
 		ClusterName: cluster,
@@ -93,16 +129,28 @@ func newNodeAdapter(cs *kubernetes.Clientset, cluster string, namespaces []strin
 			return extracted, nil
 		},
 		LinkedItemQueryExtractor: linkedItemExtractor,
-		AdapterMetadata:          nodeAdapterMetadata,
+		// Nodes can also be searched for by their provider ID, so that
+		// cloud instances can link to their nodes
+		SearchMatcher: func(query string, resource *v1.Node) bool {
+			return resource.Spec.ProviderID == query
+		},
+		AdapterMetadata: nodeAdapterMetadata,
 	}
 }
 
 var nodeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
-	Type:                  "Node",
-	DescriptiveName:       "Node",
-	Category:              sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
-	PotentialLinks:        []string{"dns", "ip", "ec2-volume"},
-	SupportedQueryMethods: DefaultSupportedQueryMethods("Node"),
+	Type:            "Node",
+	DescriptiveName: "Node",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	PotentialLinks:  []string{"dns", "ip", "ec2-volume", "ec2-instance"},
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get a Node by name",
+		List:              true,
+		ListDescription:   "List all Nodes",
+		Search:            true,
+		SearchDescription: `Search for a Node using the ListOptions JSON format e.g. {"labelSelector": "app=wordpress"}, or by provider ID e.g. aws:///eu-west-2a/i-0123456789abcdef0`,
+	},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{
 			TerraformMethod:   sdp.QueryMethod_GET,
@@ -113,4 +161,5 @@ var nodeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newNodeAdapter)
+	Metadata.RegisterSchema(nodeAdapterMetadata, kubeAttributeSchema(&v1.Node{}))
 }
@@ -31,3 +31,18 @@ func TestNodeAdapter(t *testing.T) {
 
 	st.Execute(t)
 }
+
+func TestAWSInstanceID(t *testing.T) {
+	tests := map[string]string{
+		"aws:///eu-west-2a/i-0123456789abcdef0":          "i-0123456789abcdef0",
+		"aws:///eu-west-2a/fargate-ip-10-0-0-1.internal": "",
+		"kind://docker/local-tests/control-plane":        "",
+		"": "",
+	}
+
+	for providerID, expected := range tests {
+		if actual := awsInstanceID(providerID); actual != expected {
+			t.Errorf("expected %q for %q, got %q", expected, providerID, actual)
+		}
+	}
+}
@@ -133,4 +133,5 @@ var persistentVolumeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newPersistentVolumeAdapter)
+	Metadata.RegisterSchema(persistentVolumeAdapterMetadata, kubeAttributeSchema(&v1.PersistentVolume{}))
 }
@@ -79,4 +79,5 @@ var persistentVolumeClaimAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 
 func init() {
 	registerAdapterLoader(newPersistentVolumeClaimAdapter)
+	Metadata.RegisterSchema(persistentVolumeClaimAdapterMetadata, kubeAttributeSchema(&v1.PersistentVolumeClaim{}))
 }
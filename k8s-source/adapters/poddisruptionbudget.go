@@ -68,4 +68,5 @@ var podDisruptionBudgetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newPodDisruptionBudgetAdapter)
+	Metadata.RegisterSchema(podDisruptionBudgetAdapterMetadata, kubeAttributeSchema(&v1.PodDisruptionBudget{}))
 }
@@ -414,4 +414,5 @@ var podAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newPodAdapter)
+	Metadata.RegisterSchema(podAdapterMetadata, kubeAttributeSchema(&v1.Pod{}))
 }
@@ -48,4 +48,5 @@ var priorityClassAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newPriorityClassAdapter)
+	Metadata.RegisterSchema(priorityClassAdapterMetadata, kubeAttributeSchema(&v1.PriorityClass{}))
 }
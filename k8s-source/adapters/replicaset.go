@@ -64,4 +64,5 @@ var replicaSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newReplicaSetAdapter)
+	Metadata.RegisterSchema(replicaSetAdapterMetadata, kubeAttributeSchema(&v1.ReplicaSet{}))
 }
@@ -76,4 +76,5 @@ var replicationControllerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 
 func init() {
 	registerAdapterLoader(newReplicationControllerAdapter)
+	Metadata.RegisterSchema(replicationControllerAdapterMetadata, kubeAttributeSchema(&v1.ReplicationController{}))
 }
@@ -47,4 +47,5 @@ var resourceQuotaAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newResourceQuotaAdapter)
+	Metadata.RegisterSchema(resourceQuotaAdapterMetadata, kubeAttributeSchema(&v1.ResourceQuota{}))
 }
@@ -48,4 +48,5 @@ var roleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newRoleAdapter)
+	Metadata.RegisterSchema(roleAdapterMetadata, kubeAttributeSchema(&v1.Role{}))
 }
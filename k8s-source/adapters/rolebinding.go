@@ -114,4 +114,5 @@ var roleBindingAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newRoleBindingAdapter)
+	Metadata.RegisterSchema(roleBindingAdapterMetadata, kubeAttributeSchema(&v1.RoleBinding{}))
 }
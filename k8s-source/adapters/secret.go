@@ -67,4 +67,5 @@ var secretAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newSecretAdapter)
+	Metadata.RegisterSchema(secretAdapterMetadata, kubeAttributeSchema(&v1.Secret{}))
 }
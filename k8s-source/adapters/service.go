@@ -170,4 +170,5 @@ var serviceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newServiceAdapter)
+	Metadata.RegisterSchema(serviceAdapterMetadata, kubeAttributeSchema(&v1.Service{}))
 }
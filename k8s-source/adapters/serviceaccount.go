@@ -91,4 +91,5 @@ var serviceAccountAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newServiceAccountAdapter)
+	Metadata.RegisterSchema(serviceAccountAdapterMetadata, kubeAttributeSchema(&v1.ServiceAccount{}))
 }
@@ -108,4 +108,5 @@ var statefulSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newStatefulSetAdapter)
+	Metadata.RegisterSchema(statefulSetAdapterMetadata, kubeAttributeSchema(&v1.StatefulSet{}))
 }
@@ -48,4 +48,5 @@ var storageClassAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newStorageClassAdapter)
+	Metadata.RegisterSchema(storageClassAdapterMetadata, kubeAttributeSchema(&v1.StorageClass{}))
 }
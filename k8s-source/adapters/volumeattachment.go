@@ -85,4 +85,5 @@ var volumeAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 
 func init() {
 	registerAdapterLoader(newVolumeAttachmentAdapter)
+	Metadata.RegisterSchema(volumeAttachmentAdapterMetadata, kubeAttributeSchema(&v1.VolumeAttachment{}))
 }
@@ -0,0 +1,358 @@
+package sdp
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect that attribute schemas are
+// written in
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// SchemaTypes is the value of the `type` keyword of a JSON Schema. A schema
+// with a single type is encoded as a string, multiple types as an array
+type SchemaTypes []string
+
+func (t SchemaTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+func (t *SchemaTypes) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*t = SchemaTypes{single}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(t))
+}
+
+// AttributeSchema is a JSON Schema describing the attributes of the items of a
+// single type. Schemas are generated from the Go types that adapters convert
+// into attributes using `AttributeSchemaFor()` so only the subset of JSON
+// Schema required to describe those is supported. A schema without a type
+// accepts any value. Objects only accept the properties in `Properties`, plus
+// any others if `AdditionalProperties` is set. Objects without
+// `AdditionalProperties` are encoded with `"additionalProperties": false` so
+// that other JSON Schema validators follow the same rule as `Validate()`.
+type AttributeSchema struct {
+	Schema               string                      `json:"$schema,omitempty"`
+	Title                string                      `json:"title,omitempty"`
+	Description          string                      `json:"description,omitempty"`
+	Type                 SchemaTypes                 `json:"type,omitempty"`
+	Format               string                      `json:"format,omitempty"`
+	Properties           map[string]*AttributeSchema `json:"properties,omitempty"`
+	AdditionalProperties *AttributeSchema            `json:"additionalProperties,omitempty"`
+	Items                *AttributeSchema            `json:"items,omitempty"`
+}
+
+// attributeSchemaFields has the fields of AttributeSchema without its JSON
+// methods, so that they can be used to implement them
+type attributeSchemaFields AttributeSchema
+
+func (s AttributeSchema) MarshalJSON() ([]byte, error) {
+	encoded := struct {
+		attributeSchemaFields
+		AdditionalProperties any `json:"additionalProperties,omitempty"`
+	}{
+		attributeSchemaFields: attributeSchemaFields(s),
+	}
+
+	switch {
+	case s.AdditionalProperties != nil:
+		encoded.AdditionalProperties = s.AdditionalProperties
+	case slices.Contains(s.Type, "object"):
+		encoded.AdditionalProperties = false
+	}
+
+	return json.Marshal(encoded)
+}
+
+func (s *AttributeSchema) UnmarshalJSON(b []byte) error {
+	var decoded struct {
+		attributeSchemaFields
+		AdditionalProperties json.RawMessage `json:"additionalProperties,omitempty"`
+	}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return err
+	}
+
+	*s = AttributeSchema(decoded.attributeSchemaFields)
+	s.AdditionalProperties = nil
+
+	switch string(decoded.AdditionalProperties) {
+	case "", "false":
+	case "true":
+		s.AdditionalProperties = &AttributeSchema{}
+	default:
+		s.AdditionalProperties = &AttributeSchema{}
+		return json.Unmarshal(decoded.AdditionalProperties, s.AdditionalProperties)
+	}
+	return nil
+}
+
+// AttributeSchemaFor generates the schema of the attributes that are created
+// when converting `v` using `ToAttributesViaJson()`. This follows the rules of
+// `encoding/json`, so struct tags are respected and fields that can be nil are
+// nullable. Top-level properties listed in `exclusions` are removed from the
+// schema, mirroring the `ToAttributesWithExclude` helpers of the sources.
+//
+// Properties are never required since most of the fields of the AWS SDK, GCP
+// and Kubernetes types are optional. Attributes that adapters add must be part
+// of `v`, since properties that the schema doesn't know about are violations.
+func AttributeSchemaFor(v any, exclusions ...string) *AttributeSchema {
+	g := schemaGenerator{
+		inProgress: make(map[reflect.Type]bool),
+	}
+
+	schema := g.schema(reflect.TypeOf(v))
+	// The top level is always an object, even if the value is a pointer
+	schema.Type = SchemaTypes{"object"}
+	schema.Schema = JSONSchemaDialect
+
+	for _, exclusion := range exclusions {
+		delete(schema.Properties, exclusion)
+	}
+
+	return schema
+}
+
+// WithProperty adds a property to the schema and returns it. This is for the
+// attributes that adapters add to those of the type that the schema was
+// generated from, such as synthesised unique attributes
+func (s *AttributeSchema) WithProperty(name string, property *AttributeSchema) *AttributeSchema {
+	if s.Properties == nil {
+		s.Properties = make(map[string]*AttributeSchema)
+	}
+	s.Properties[name] = property
+
+	return s
+}
+
+type schemaGenerator struct {
+	// Types that are currently being generated, used to break cycles in
+	// recursive types
+	inProgress map[reflect.Type]bool
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func nullable(s *AttributeSchema) *AttributeSchema {
+	if len(s.Type) > 0 && !slices.Contains(s.Type, "null") {
+		s.Type = append(s.Type, "null")
+	}
+	return s
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) *AttributeSchema {
+	if t == nil {
+		return &AttributeSchema{}
+	}
+
+	if t.Kind() == reflect.Pointer {
+		return nullable(g.schema(t.Elem()))
+	}
+
+	switch {
+	case t == timeType:
+		return &AttributeSchema{Type: SchemaTypes{"string"}, Format: "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		// We can't know what a custom marshaller produces
+		return &AttributeSchema{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &AttributeSchema{Type: SchemaTypes{"string"}}
+	}
+
+	switch t.Kind() { //nolint:exhaustive // everything else is accepted as any value
+	case reflect.Bool:
+		return &AttributeSchema{Type: SchemaTypes{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &AttributeSchema{Type: SchemaTypes{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &AttributeSchema{Type: SchemaTypes{"number"}}
+	case reflect.String:
+		return &AttributeSchema{Type: SchemaTypes{"string"}}
+	case reflect.Interface:
+		return &AttributeSchema{}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// []byte is base64 encoded
+			return &AttributeSchema{Type: SchemaTypes{"string", "null"}}
+		}
+		return &AttributeSchema{Type: SchemaTypes{"array", "null"}, Items: g.schema(t.Elem())}
+	case reflect.Array:
+		return &AttributeSchema{Type: SchemaTypes{"array"}, Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &AttributeSchema{Type: SchemaTypes{"object", "null"}, AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if g.inProgress[t] {
+			// Recursive types are cut off at the second level, accepting any
+			// properties below that
+			return &AttributeSchema{Type: SchemaTypes{"object"}, AdditionalProperties: &AttributeSchema{}}
+		}
+		g.inProgress[t] = true
+		defer delete(g.inProgress, t)
+
+		s := &AttributeSchema{
+			Type:       SchemaTypes{"object"},
+			Properties: make(map[string]*AttributeSchema),
+		}
+		g.addFields(s, t)
+		return s
+	default:
+		return &AttributeSchema{}
+	}
+}
+
+// addFields adds the properties of all fields of the struct type to the
+// schema. Like encoding/json the fields of embedded structs are promoted,
+// unless they are shadowed by a field of the outer struct
+func (g *schemaGenerator) addFields(s *AttributeSchema, t reflect.Type) {
+	embedded := make([]reflect.Type, 0)
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				// This includes unexported embedded structs
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		s.Properties[name] = g.schema(field.Type)
+	}
+
+	for _, et := range embedded {
+		promoted := &AttributeSchema{
+			Properties: make(map[string]*AttributeSchema),
+		}
+		g.addFields(promoted, et)
+		for name, property := range promoted.Properties {
+			if _, exists := s.Properties[name]; !exists {
+				s.Properties[name] = property
+			}
+		}
+	}
+}
+
+// Validate checks that the attributes conform to the schema and returns an
+// error describing all violations
+func (s *AttributeSchema) Validate(attributes *ItemAttributes) error {
+	var value any = map[string]any{}
+	if attributes.GetAttrStruct() != nil {
+		value = attributes.GetAttrStruct().AsMap()
+	}
+
+	violations := s.validate("", value)
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Strings(violations)
+	return errors.New(strings.Join(violations, "; "))
+}
+
+func (s *AttributeSchema) validate(path string, value any) []string {
+	if s == nil || len(s.Type) == 0 {
+		return nil
+	}
+
+	actual := jsonType(value)
+	ok := slices.Contains(s.Type, actual)
+	if !ok && actual == "integer" {
+		ok = slices.Contains(s.Type, "number")
+	}
+	if !ok {
+		name := path
+		if name == "" {
+			name = "attributes"
+		}
+		return []string{fmt.Sprintf("%v: expected %v, got %v", name, strings.Join(s.Type, " or "), actual)}
+	}
+
+	var violations []string
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			childPath := joinAttributePath(path, key)
+			childSchema, known := s.Properties[key]
+			if !known {
+				if s.AdditionalProperties == nil {
+					violations = append(violations, fmt.Sprintf("%v: unknown property", childPath))
+					continue
+				}
+				childSchema = s.AdditionalProperties
+			}
+			violations = append(violations, childSchema.validate(childPath, child)...)
+		}
+	case []any:
+		for i, child := range v {
+			violations = append(violations, s.Items.validate(fmt.Sprintf("%v[%v]", path, i), child)...)
+		}
+	}
+	return violations
+}
+
+func joinAttributePath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// jsonType returns the JSON Schema type of a value as returned by
+// `structpb.Struct.AsMap()`
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,204 @@
+package sdp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+type schemaTestEnum string
+
+type schemaTestChild struct {
+	Name     *string
+	Children []schemaTestChild
+}
+
+type schemaTestEmbedded struct {
+	Embedded bool
+}
+
+type schemaTestStruct struct {
+	schemaTestEmbedded
+
+	Name      *string
+	Count     int32
+	Ratio     float64
+	Enabled   *bool
+	Created   *time.Time
+	State     schemaTestEnum
+	Tags      map[string]string
+	Data      []byte
+	Child     *schemaTestChild
+	Anything  any
+	Renamed   string `json:"renamedField,omitempty"`
+	Ignored   string `json:"-"`
+	unexposed string
+}
+
+func TestAttributeSchemaFor(t *testing.T) {
+	schema := AttributeSchemaFor(&schemaTestStruct{}, "Ratio")
+
+	if schema.Schema != JSONSchemaDialect {
+		t.Errorf("expected $schema to be %v, got %v", JSONSchemaDialect, schema.Schema)
+	}
+
+	tests := map[string]SchemaTypes{
+		"Name":         {"string", "null"},
+		"Count":        {"integer"},
+		"Enabled":      {"boolean", "null"},
+		"Created":      {"string", "null"},
+		"State":        {"string"},
+		"Tags":         {"object", "null"},
+		"Data":         {"string", "null"},
+		"Child":        {"object", "null"},
+		"Embedded":     {"boolean"},
+		"renamedField": {"string"},
+		"Anything":     nil,
+	}
+	for name, expected := range tests {
+		prop, ok := schema.Properties[name]
+		if !ok {
+			t.Errorf("expected property %v", name)
+			continue
+		}
+		if strings.Join(prop.Type, ",") != strings.Join(expected, ",") {
+			t.Errorf("expected %v to have type %v, got %v", name, expected, prop.Type)
+		}
+	}
+
+	for _, name := range []string{"Ratio", "Renamed", "Ignored", "unexposed", "schemaTestEmbedded"} {
+		if _, ok := schema.Properties[name]; ok {
+			t.Errorf("expected property %v to be absent", name)
+		}
+	}
+
+	// The recursion is cut off rather than looping forever
+	children := schema.Properties["Child"].Properties["Children"]
+	if children.Items == nil || children.Items.Properties != nil {
+		t.Errorf("expected recursive children to be cut off, got %+v", children.Items)
+	}
+
+	// Single types are encoded as a string
+	b, err := json.Marshal(schema.Properties["Count"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"type":"integer"}` {
+		t.Errorf("unexpected encoding %v", string(b))
+	}
+}
+
+func TestAttributeSchemaValidate(t *testing.T) {
+	schema := AttributeSchemaFor(schemaTestStruct{})
+
+	name := "foo"
+	created := time.Now()
+	valid, err := ToAttributesViaJson(schemaTestStruct{
+		Name:    &name,
+		Count:   3,
+		Created: &created,
+		Tags:    map[string]string{"env": "prod"},
+		Data:    []byte("data"),
+		Child: &schemaTestChild{
+			Children: []schemaTestChild{{Name: &name}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(valid); err != nil {
+		t.Errorf("expected attributes to be valid, got %v", err)
+	}
+
+	invalid, err := ToAttributes(map[string]any{
+		"Count": 1.5,
+		"Tags": map[string]any{
+			"env": true,
+		},
+		"extra": 1.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = schema.Validate(invalid)
+	if err == nil {
+		t.Fatal("expected attributes to be invalid")
+	}
+	for _, expected := range []string{"Count: expected integer, got number", "Tags.env: expected string, got boolean", "extra: unknown property"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestAttributeSchemaJSON(t *testing.T) {
+	schema := AttributeSchemaFor(schemaTestStruct{})
+
+	b, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var encoded map[string]any
+	if err := json.Unmarshal(b, &encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	// Structs don't allow unknown properties, like `Validate()`
+	if encoded["additionalProperties"] != false {
+		t.Errorf("expected additionalProperties to be false, got %v", encoded["additionalProperties"])
+	}
+	tags := encoded["properties"].(map[string]any)["Tags"].(map[string]any)
+	if _, ok := tags["additionalProperties"].(map[string]any); !ok {
+		t.Errorf("expected maps to have a schema for additionalProperties, got %v", tags["additionalProperties"])
+	}
+
+	var decoded AttributeSchema
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.AdditionalProperties != nil {
+		t.Errorf("expected no additional properties after decoding, got %+v", decoded.AdditionalProperties)
+	}
+	if decoded.Properties["Tags"].AdditionalProperties == nil {
+		t.Error("expected the additional properties of maps to be decoded")
+	}
+}
+
+func TestAdapterMetadataListRegisterSchema(t *testing.T) {
+	list := AdapterMetadataList{}
+	metadata := list.Register(&AdapterMetadata{
+		Type:            "test-type",
+		DescriptiveName: "Test Type",
+	})
+	list.RegisterSchema(metadata, AttributeSchemaFor(schemaTestStruct{}))
+
+	schema := list.AttributeSchema("test-type")
+	if schema == nil {
+		t.Fatal("expected schema to be registered")
+	}
+	if schema.Title != "test-type" || schema.Description != "Test Type" {
+		t.Errorf("expected title and description from metadata, got %v, %v", schema.Title, schema.Description)
+	}
+	if list.AttributeSchema("other-type") != nil {
+		t.Error("expected no schema for unknown type")
+	}
+}
+
+func TestAttributeSchemaForShadowedFields(t *testing.T) {
+	type enriched struct {
+		*schemaTestStruct
+		// Replaces the string from the embedded struct
+		Name *schemaTestChild
+	}
+
+	schema := AttributeSchemaFor(enriched{})
+	if strings.Join(schema.Properties["Name"].Type, ",") != "object,null" {
+		t.Errorf("expected outer field to shadow the embedded one, got %v", schema.Properties["Name"].Type)
+	}
+	if _, ok := schema.Properties["Count"]; !ok {
+		t.Error("expected fields of the embedded struct to be promoted")
+	}
+}
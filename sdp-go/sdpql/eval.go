@@ -0,0 +1,190 @@
+package sdpql
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/overmindtech/cli/sdp-go"
+)
+
+// Predicate filters items on the client side
+type Predicate interface {
+	Match(item *sdp.Item) bool
+	String() string
+}
+
+// Resolve returns the value of the specified attribute of the item. Next to
+// regular attribute paths in dot notation (e.g. `state.name`) this supports:
+//
+//   - `tags.KEY`: the value of the tag KEY
+//   - `@type`, `@scope`, `@id`: the type, scope and unique attribute value
+//   - `@health`: the health of the item
+func Resolve(item *sdp.Item, attr string) (any, bool) {
+	switch attr {
+	case "@type":
+		return item.GetType(), true
+	case "@scope":
+		return item.GetScope(), true
+	case "@id":
+		return item.UniqueAttributeValue(), true
+	case "@health":
+		if item.Health == nil {
+			return nil, false
+		}
+		return item.GetHealth().String(), true
+	}
+
+	if key, ok := strings.CutPrefix(attr, "tags."); ok {
+		if v, ok := item.GetTags()[key]; ok {
+			return v, true
+		}
+	}
+
+	v, err := item.GetAttributes().Get(attr)
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// Format returns the string representation of an attribute value as used for
+// comparisons and output
+func Format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+type comparison struct {
+	attr  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func newComparison(attr, op, value string) (Predicate, error) {
+	c := comparison{attr: attr, op: op, value: value}
+	if op == "~" {
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", value, err)
+		}
+		c.re = re
+	}
+	return c, nil
+}
+
+func (c comparison) Match(item *sdp.Item) bool {
+	v, ok := Resolve(item, c.attr)
+	if !ok {
+		// a missing attribute is never equal to anything
+		return c.op == "!="
+	}
+	s := Format(v)
+
+	switch c.op {
+	case "=":
+		return s == c.value
+	case "!=":
+		return s != c.value
+	case "~":
+		return c.re.MatchString(s)
+	}
+
+	// ordering comparisons are numeric if both sides are numbers
+	cmp := strings.Compare(s, c.value)
+	a, errA := strconv.ParseFloat(s, 64)
+	b, errB := strconv.ParseFloat(c.value, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		default:
+			cmp = 0
+		}
+	}
+
+	switch c.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+func (c comparison) String() string {
+	return fmt.Sprintf("%v %v %q", c.attr, c.op, c.value)
+}
+
+type hasPredicate struct {
+	attr string
+}
+
+func (h hasPredicate) Match(item *sdp.Item) bool {
+	_, ok := Resolve(item, h.attr)
+	return ok
+}
+
+func (h hasPredicate) String() string {
+	return fmt.Sprintf("has %v", h.attr)
+}
+
+type andPredicate struct {
+	left, right Predicate
+}
+
+func (a andPredicate) Match(item *sdp.Item) bool {
+	return a.left.Match(item) && a.right.Match(item)
+}
+
+func (a andPredicate) String() string {
+	return fmt.Sprintf("(%v and %v)", a.left, a.right)
+}
+
+type orPredicate struct {
+	left, right Predicate
+}
+
+func (o orPredicate) Match(item *sdp.Item) bool {
+	return o.left.Match(item) || o.right.Match(item)
+}
+
+func (o orPredicate) String() string {
+	return fmt.Sprintf("(%v or %v)", o.left, o.right)
+}
+
+type notPredicate struct {
+	inner Predicate
+}
+
+func (n notPredicate) Match(item *sdp.Item) bool {
+	return !n.inner.Match(item)
+}
+
+func (n notPredicate) String() string {
+	return fmt.Sprintf("not %v", n.inner)
+}
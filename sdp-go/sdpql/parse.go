@@ -0,0 +1,353 @@
+package sdpql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenPipe
+	tokenComma
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("%q", t.value)
+	default:
+		return fmt.Sprintf("'%v'", t.value)
+	}
+}
+
+// isWordRune returns true for all runes that can be part of an unquoted word.
+// This is deliberately generous so that types, scopes, ARNs and attribute
+// paths can be written without quotes.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_.:/*@#$+", r)
+}
+
+// lex splits the expression into tokens
+func lex(input string) ([]token, error) {
+	tokens := make([]token, 0)
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '|':
+			tokens = append(tokens, token{kind: tokenPipe, value: "|", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", pos: i})
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, value: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, value: ")", pos: i})
+			i++
+		case r == '=' || r == '~':
+			tokens = append(tokens, token{kind: tokenOperator, value: string(r), pos: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenOperator, value: string(runes[i : i+2]), pos: i})
+				i += 2
+				continue
+			}
+			if r == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %v, did you mean '!='?", i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: string(r), pos: i})
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %v", start)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, value: sb.String(), pos: start})
+		case isWordRune(r):
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[start:i]), pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at position %v", r, i)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes)})
+	return tokens, nil
+}
+
+// parser is a simple recursive descent parser over the token stream
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isKeyword returns true if the next token is the specified keyword
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+func (p *parser) expectKind(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, fmt.Errorf("expected %v at position %v, got %v", what, t.pos, t)
+	}
+	return t, nil
+}
+
+// value parses a word or a quoted string
+func (p *parser) value(what string) (string, error) {
+	t := p.next()
+	if t.kind != tokenWord && t.kind != tokenString {
+		return "", fmt.Errorf("expected %v at position %v, got %v", what, t.pos, t)
+	}
+	return t.value, nil
+}
+
+// Parse compiles the expression into a Program. See the package documentation
+// for the syntax.
+func Parse(expression string) (*Program, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+
+	prog := &Program{}
+	prog.Source, err = p.source()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenPipe {
+		p.next()
+		switch {
+		case p.isKeyword("links"):
+			p.next()
+			stage, err := p.links()
+			if err != nil {
+				return nil, err
+			}
+			if prog.Select != nil {
+				return nil, fmt.Errorf("'links' can not follow 'select' at position %v", p.peek().pos)
+			}
+			prog.Links = append(prog.Links, stage)
+		case p.isKeyword("select"):
+			p.next()
+			if prog.Select != nil {
+				return nil, fmt.Errorf("duplicate 'select' at position %v", p.peek().pos)
+			}
+			prog.Select, err = p.selectList()
+			if err != nil {
+				return nil, err
+			}
+		default:
+			t := p.peek()
+			return nil, fmt.Errorf("expected 'links' or 'select' at position %v, got %v", t.pos, t)
+		}
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %v at position %v", t, t.pos)
+	}
+
+	return prog, nil
+}
+
+// source parses `TYPE [in SCOPE] [get|search|list [QUERY]] [where PREDICATE]`
+func (p *parser) source() (SourceStage, error) {
+	s := SourceStage{
+		Scope:  "*",
+		Method: "list",
+	}
+
+	t, err := p.expectKind(tokenWord, "an item type")
+	if err != nil {
+		return s, err
+	}
+	s.Type = t.value
+
+	for {
+		switch {
+		case p.isKeyword("in"):
+			p.next()
+			s.Scope, err = p.value("a scope")
+			if err != nil {
+				return s, err
+			}
+		case p.isKeyword("get"), p.isKeyword("search"):
+			s.Method = strings.ToLower(p.next().value)
+			s.Query, err = p.value("a query")
+			if err != nil {
+				return s, err
+			}
+		case p.isKeyword("list"):
+			p.next()
+			s.Method = "list"
+		case p.isKeyword("where"):
+			p.next()
+			s.Where, err = p.or()
+			return s, err
+		default:
+			return s, nil
+		}
+	}
+}
+
+// links parses `links [TYPE] [where PREDICATE]`
+func (p *parser) links() (LinksStage, error) {
+	stage := LinksStage{Type: "*"}
+	if t := p.peek(); (t.kind == tokenWord || t.kind == tokenString) && !p.isKeyword("where") {
+		stage.Type = p.next().value
+	}
+	if p.isKeyword("where") {
+		p.next()
+		var err error
+		stage.Where, err = p.or()
+		if err != nil {
+			return stage, err
+		}
+	}
+	return stage, nil
+}
+
+// selectList parses `select ATTR [, ATTR]...`
+func (p *parser) selectList() ([]string, error) {
+	attrs := make([]string, 0)
+	for {
+		attr, err := p.value("an attribute name")
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, attr)
+		if p.peek().kind != tokenComma {
+			return attrs, nil
+		}
+		p.next()
+	}
+}
+
+func (p *parser) or() (Predicate, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = orPredicate{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (Predicate, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		left = andPredicate{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) not() (Predicate, error) {
+	if p.isKeyword("not") {
+		p.next()
+		inner, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return notPredicate{inner}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (Predicate, error) {
+	if p.peek().kind == tokenLParen {
+		p.next()
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		_, err = p.expectKind(tokenRParen, "')'")
+		return inner, err
+	}
+
+	if p.isKeyword("has") {
+		p.next()
+		attr, err := p.value("an attribute name")
+		if err != nil {
+			return nil, err
+		}
+		return hasPredicate{attr: attr}, nil
+	}
+
+	attr, err := p.value("an attribute name")
+	if err != nil {
+		return nil, err
+	}
+	op, err := p.expectKind(tokenOperator, "a comparison operator")
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.value("a value")
+	if err != nil {
+		return nil, err
+	}
+	return newComparison(attr, op.value, value)
+}
@@ -0,0 +1,71 @@
+package sdpql
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{
+			expression: "ec2-instance",
+			expected:   "ec2-instance in * list",
+		},
+		{
+			expression: `ec2-instance in 123.eu-west-2 get i-123`,
+			expected:   `ec2-instance in 123.eu-west-2 get "i-123"`,
+		},
+		{
+			expression: `ec2-instance where tags.env = "prod" | links ec2-security-group`,
+			expected:   `ec2-instance in * list where tags.env = "prod" | links ec2-security-group`,
+		},
+		{
+			expression: `s3-bucket search 'arn:aws:s3:::bucket' | select @id, name`,
+			expected:   `s3-bucket in * search "arn:aws:s3:::bucket" | select @id, name`,
+		},
+		{
+			expression: `rds-db-instance where not (engine = mysql or engine ~ "^aurora") and allocatedStorage >= 100 | links | links kms-key where has keyManager`,
+			expected:   `rds-db-instance in * list where (not (engine = "mysql" or engine ~ "^aurora") and allocatedStorage >= "100") | links * | links kms-key where has keyManager`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			prog, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if prog.String() != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, prog.String())
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"ec2-instance where",
+		"ec2-instance where name",
+		"ec2-instance where name = ",
+		`ec2-instance where name = "unterminated`,
+		"ec2-instance | frobnicate",
+		"ec2-instance | select",
+		"ec2-instance | select a | links ec2-vpc",
+		"ec2-instance where name ~ '('",
+		"ec2-instance where (name = a",
+		"ec2-instance where name ! a",
+		"ec2-instance ;",
+	}
+
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			_, err := Parse(expression)
+			if err == nil {
+				t.Errorf("expected error for %q", expression)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// Package sdpql implements a small expression language over SDP items. An
+// expression is compiled into one or more sdp.Query's and client side filters,
+// for example:
+//
+//	ec2-instance where tags.env = "prod" | links ec2-security-group | select @id, groupName
+//
+// An expression starts with a source stage that selects the initial items:
+//
+//	TYPE [in SCOPE] [list | get QUERY | search QUERY] [where PREDICATE]
+//
+// The scope defaults to `*` and the method to `list`. The source can be
+// followed by any number of `links` stages that traverse the linked items of
+// the current result set, optionally restricted to a type and filtered:
+//
+//	| links [TYPE] [where PREDICATE]
+//
+// And finally a `select` stage that projects attributes into columns:
+//
+//	| select ATTR [, ATTR]...
+//
+// Predicates compare attributes using `=`, `!=`, `~` (regular expression),
+// `<`, `<=`, `>` and `>=`, test for the presence of an attribute using `has
+// ATTR` and can be combined with `and`, `or`, `not` and parentheses. See
+// `Resolve()` for the supported attribute names.
+package sdpql
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/overmindtech/cli/sdp-go"
+	"github.com/sourcegraph/conc/pool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QueryRunner executes a single query and returns all items that were found
+// for it. This is implemented by *sdpws.Client
+type QueryRunner interface {
+	QueryOne(ctx context.Context, q *sdp.Query) ([]*sdp.Item, error)
+}
+
+// SourceStage describes the initial query of a program
+type SourceStage struct {
+	Type   string
+	Scope  string
+	Method string
+	Query  string
+	Where  Predicate
+}
+
+// LinksStage describes a traversal along the linked items of the previous
+// stage's results
+type LinksStage struct {
+	// The type of linked items to follow, or `*` for all types
+	Type  string
+	Where Predicate
+}
+
+// Program is a compiled expression
+type Program struct {
+	Source SourceStage
+	Links  []LinksStage
+	// The attributes to output, nil if no projection was requested
+	Select []string
+}
+
+// DefaultColumns are used as output columns when an expression has no `select`
+// stage
+var DefaultColumns = []string{"@type", "@scope", "@id"}
+
+// Options influence the queries that are generated by a program
+type Options struct {
+	// Passed through to all generated queries
+	IgnoreCache bool
+	// How long each generated query may run. Defaults to one minute.
+	Timeout time.Duration
+	// The maximum number of link queries that are run in parallel. Defaults
+	// to 10.
+	MaxParallel int
+}
+
+// Query returns the initial query of the program
+func (p *Program) Query(opts Options) (*sdp.Query, error) {
+	var method sdp.QueryMethod
+	switch p.Source.Method {
+	case "get":
+		method = sdp.QueryMethod_GET
+	case "list":
+		method = sdp.QueryMethod_LIST
+	case "search":
+		method = sdp.QueryMethod_SEARCH
+	default:
+		return nil, fmt.Errorf("query method '%v' not supported", p.Source.Method)
+	}
+
+	return newQuery(&sdp.Query{
+		Type:   p.Source.Type,
+		Method: method,
+		Query:  p.Source.Query,
+		Scope:  p.Source.Scope,
+	}, opts), nil
+}
+
+// newQuery returns a copy of the query with a fresh UUID and the options
+// applied
+func newQuery(q *sdp.Query, opts Options) *sdp.Query {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+	u := uuid.New()
+	return &sdp.Query{
+		Type:               q.GetType(),
+		Method:             q.GetMethod(),
+		Query:              q.GetQuery(),
+		Scope:              q.GetScope(),
+		UUID:               u[:],
+		Deadline:           timestamppb.New(time.Now().Add(timeout)),
+		RecursionBehaviour: &sdp.Query_RecursionBehaviour{},
+		IgnoreCache:        opts.IgnoreCache,
+	}
+}
+
+// Columns returns the names of the output columns
+func (p *Program) Columns() []string {
+	if p.Select == nil {
+		return DefaultColumns
+	}
+	return p.Select
+}
+
+// Result is the output of running a program
+type Result struct {
+	Columns []string
+	Items   []*sdp.Item
+	// Errors from link queries that failed. A broken link doesn't fail the
+	// whole expression, but means that the items may be incomplete
+	LinkErrors []error
+}
+
+// Rows returns the projected attribute values of all items
+func (r *Result) Rows() [][]string {
+	rows := make([][]string, 0, len(r.Items))
+	for _, item := range r.Items {
+		row := make([]string, len(r.Columns))
+		for i, c := range r.Columns {
+			v, ok := Resolve(item, c)
+			if ok {
+				row[i] = Format(v)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// WriteCSV writes the result as CSV including a header row
+func (r *Result) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write(r.Columns)
+	if err != nil {
+		return err
+	}
+	err = cw.WriteAll(r.Rows())
+	if err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// WriteJSON writes the result as a JSON array of objects keyed by column
+func (r *Result) WriteJSON(w io.Writer) error {
+	out := make([]map[string]any, 0, len(r.Items))
+	for _, item := range r.Items {
+		obj := make(map[string]any, len(r.Columns))
+		for _, c := range r.Columns {
+			v, ok := Resolve(item, c)
+			if ok {
+				obj[c] = v
+			}
+		}
+		out = append(out, obj)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// Run executes the program and returns the final set of items
+func (p *Program) Run(ctx context.Context, runner QueryRunner, opts Options) (*Result, error) {
+	q, err := p.Query(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := runner.QueryOne(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("error running query for %v: %w", p.Source.Type, err)
+	}
+	items = filter(items, p.Source.Where)
+
+	var linkErrors []error
+	for _, stage := range p.Links {
+		var stageErrors []error
+		items, stageErrors, err = stage.run(ctx, runner, items, opts)
+		if err != nil {
+			return nil, err
+		}
+		linkErrors = append(linkErrors, stageErrors...)
+	}
+
+	return &Result{
+		Columns:    p.Columns(),
+		Items:      items,
+		LinkErrors: linkErrors,
+	}, nil
+}
+
+// LinkQueries returns the deduplicated queries that would find the linked
+// items of the specified type for all items
+func LinkQueries(items []*sdp.Item, typ string) []*sdp.Query {
+	seen := make(map[string]bool)
+	queries := make([]*sdp.Query, 0)
+	add := func(q *sdp.Query) {
+		if typ != "*" && q.GetType() != typ {
+			return
+		}
+		key := fmt.Sprintf("%v|%v|%v|%v", q.GetScope(), q.GetType(), q.GetMethod(), q.GetQuery())
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		queries = append(queries, q)
+	}
+
+	for _, item := range items {
+		for _, li := range item.GetLinkedItems() {
+			add(li.GetItem().ToQuery())
+		}
+		for _, liq := range item.GetLinkedItemQueries() {
+			add(liq.GetQuery())
+		}
+	}
+	return queries
+}
+
+// run follows the links of all items and returns the linked items, along with
+// the errors of any link queries that failed
+func (s LinksStage) run(ctx context.Context, runner QueryRunner, items []*sdp.Item, opts Options) ([]*sdp.Item, []error, error) {
+	maxParallel := opts.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = 10
+	}
+
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	results := make([]*sdp.Item, 0)
+	var linkErrors []error
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(maxParallel)
+	for _, q := range LinkQueries(items, s.Type) {
+		p.Go(func(ctx context.Context) error {
+			found, err := runner.QueryOne(ctx, newQuery(q, opts))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				// a single broken link should not fail the whole expression
+				linkErrors = append(linkErrors, fmt.Errorf("error following link to %v %v %q in %v: %w", q.GetType(), q.GetMethod(), q.GetQuery(), q.GetScope(), err))
+				return nil
+			}
+			for _, item := range filter(found, s.Where) {
+				gun := item.GloballyUniqueName()
+				if seen[gun] {
+					continue
+				}
+				seen[gun] = true
+				results = append(results, item)
+			}
+			return nil
+		})
+	}
+
+	err := p.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, linkErrors, nil
+}
+
+// filter returns all items that match the predicate
+func filter(items []*sdp.Item, where Predicate) []*sdp.Item {
+	if where == nil {
+		return items
+	}
+	matched := make([]*sdp.Item, 0, len(items))
+	for _, item := range items {
+		if where.Match(item) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// String returns the canonical form of the program
+func (p *Program) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%v in %v %v", p.Source.Type, p.Source.Scope, p.Source.Method)
+	if p.Source.Query != "" {
+		fmt.Fprintf(&sb, " %q", p.Source.Query)
+	}
+	if p.Source.Where != nil {
+		fmt.Fprintf(&sb, " where %v", p.Source.Where)
+	}
+	for _, l := range p.Links {
+		fmt.Fprintf(&sb, " | links %v", l.Type)
+		if l.Where != nil {
+			fmt.Fprintf(&sb, " where %v", l.Where)
+		}
+	}
+	if p.Select != nil {
+		fmt.Fprintf(&sb, " | select %v", strings.Join(p.Select, ", "))
+	}
+	return sb.String()
+}
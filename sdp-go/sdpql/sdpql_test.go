@@ -0,0 +1,194 @@
+package sdpql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/overmindtech/cli/sdp-go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type testRunner struct {
+	items map[string][]*sdp.Item
+
+	queries   []*sdp.Query
+	queriesMu sync.Mutex
+}
+
+func (r *testRunner) QueryOne(ctx context.Context, q *sdp.Query) ([]*sdp.Item, error) {
+	r.queriesMu.Lock()
+	defer r.queriesMu.Unlock()
+	r.queries = append(r.queries, q)
+
+	items, ok := r.items[q.GetType()+"/"+q.GetQuery()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return items, nil
+}
+
+func newTestItem(typ, name string, tags map[string]string, attrs map[string]any, links ...*sdp.Reference) *sdp.Item {
+	fields := map[string]any{"name": name}
+	for k, v := range attrs {
+		fields[k] = v
+	}
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		panic(err)
+	}
+	item := &sdp.Item{
+		Type:            typ,
+		UniqueAttribute: "name",
+		Scope:           "123.eu-west-2",
+		Attributes:      &sdp.ItemAttributes{AttrStruct: s},
+		Tags:            tags,
+	}
+	for _, l := range links {
+		item.LinkedItems = append(item.LinkedItems, &sdp.LinkedItem{Item: l})
+	}
+	return item
+}
+
+func TestRun(t *testing.T) {
+	sg1 := newTestItem("ec2-security-group", "sg-1", nil, map[string]any{"groupName": "web"})
+	sg2 := newTestItem("ec2-security-group", "sg-2", nil, map[string]any{"groupName": "db"})
+	vpc := newTestItem("ec2-vpc", "vpc-1", nil, nil)
+	prod := newTestItem("ec2-instance", "i-1", map[string]string{"env": "prod"}, map[string]any{"cpus": 4}, sg1.Reference(), sg2.Reference(), vpc.Reference())
+	prod2 := newTestItem("ec2-instance", "i-2", map[string]string{"env": "prod"}, map[string]any{"cpus": 16}, sg1.Reference())
+	dev := newTestItem("ec2-instance", "i-3", map[string]string{"env": "dev"}, map[string]any{"cpus": 2}, sg2.Reference())
+
+	runner := &testRunner{
+		items: map[string][]*sdp.Item{
+			"ec2-instance/":           {prod, prod2, dev},
+			"ec2-security-group/sg-1": {sg1},
+			"ec2-security-group/sg-2": {sg2},
+			"ec2-vpc/vpc-1":           {vpc},
+		},
+	}
+
+	prog, err := Parse(`ec2-instance where tags.env = prod and cpus < 10 | links ec2-security-group | select @id, groupName`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := prog.Run(context.Background(), runner, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(result.Items))
+	}
+
+	// the initial query and one per linked security group, the vpc is not
+	// followed
+	if len(runner.queries) != 3 {
+		t.Errorf("expected 3 queries, got %v", len(runner.queries))
+	}
+	if runner.queries[0].GetMethod() != sdp.QueryMethod_LIST || runner.queries[0].GetScope() != "*" {
+		t.Errorf("unexpected initial query %v", runner.queries[0])
+	}
+	for _, q := range runner.queries[1:] {
+		if q.GetMethod() != sdp.QueryMethod_GET {
+			t.Errorf("expected GET query for link, got %v", q)
+		}
+	}
+
+	var csvOut bytes.Buffer
+	err = result.WriteCSV(&csvOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// links are followed in parallel, so accept any order
+	expected := []string{
+		"@id,groupName\nsg-1,web\nsg-2,db\n",
+		"@id,groupName\nsg-2,db\nsg-1,web\n",
+	}
+	if csvOut.String() != expected[0] && csvOut.String() != expected[1] {
+		t.Errorf("unexpected CSV output: %q", csvOut.String())
+	}
+
+	var jsonOut bytes.Buffer
+	err = result.WriteJSON(&jsonOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(jsonOut.Bytes(), []byte(`"groupName": "web"`)) {
+		t.Errorf("unexpected JSON output: %v", jsonOut.String())
+	}
+}
+
+func TestRunLinkErrors(t *testing.T) {
+	sg := newTestItem("ec2-security-group", "sg-1", nil, nil)
+	missing := newTestItem("ec2-security-group", "sg-missing", nil, nil)
+	instance := newTestItem("ec2-instance", "i-1", nil, nil, sg.Reference(), missing.Reference())
+
+	runner := &testRunner{
+		items: map[string][]*sdp.Item{
+			"ec2-instance/":           {instance},
+			"ec2-security-group/sg-1": {sg},
+		},
+	}
+
+	prog, err := Parse(`ec2-instance | links ec2-security-group`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := prog.Run(context.Background(), runner, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Items) != 1 {
+		t.Errorf("expected 1 item, got %v", len(result.Items))
+	}
+
+	if len(result.LinkErrors) != 1 {
+		t.Fatalf("expected 1 link error, got %v", len(result.LinkErrors))
+	}
+
+	if !strings.Contains(result.LinkErrors[0].Error(), "sg-missing") {
+		t.Errorf("expected the error to name the failed query, got %v", result.LinkErrors[0])
+	}
+}
+
+func TestResolve(t *testing.T) {
+	item := newTestItem("ec2-instance", "i-1", map[string]string{"env": "prod"}, map[string]any{
+		"state": map[string]any{"name": "running"},
+		"cpus":  4,
+	})
+
+	tests := []struct {
+		attr     string
+		expected string
+		found    bool
+	}{
+		{"@type", "ec2-instance", true},
+		{"@scope", "123.eu-west-2", true},
+		{"@id", "i-1", true},
+		{"@health", "", false},
+		{"tags.env", "prod", true},
+		{"tags.missing", "", false},
+		{"state.name", "running", true},
+		{"state", `{"name":"running"}`, true},
+		{"cpus", "4", true},
+		{"missing", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attr, func(t *testing.T) {
+			v, ok := Resolve(item, tt.attr)
+			if ok != tt.found {
+				t.Fatalf("expected found=%v, got %v", tt.found, ok)
+			}
+			if Format(v) != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, Format(v))
+			}
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"slices"
 	"sync"
+	"time"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/coder/websocket"
 	"github.com/google/uuid"
 	"github.com/overmindtech/cli/sdp-go"
@@ -44,11 +46,39 @@ import (
 // Alternatively, pass in a GatewayMessageHandler to receive all messages as
 // they come in and send messages directly using `Send()` and then `Wait()` for
 // all request IDs.
+//
+// Clients created with `DialWithReconnect()` or `DialBatchWithReconnect()`
+// survive a dropped connection: they redial the gateway with exponential
+// backoff and replay every request that has not finished yet. Items and edges
+// that were already received before the connection dropped are not delivered a
+// second time.
 type Client struct {
-	conn *websocket.Conn
+	url         string
+	httpClient  *http.Client
+	interactive bool
+
+	conn   *websocket.Conn
+	connMu sync.RWMutex
 
 	handler GatewayMessageHandler
 
+	// reconnect is nil if the client should not try to reconnect
+	reconnect *ReconnectOptions
+
+	// pendingRequests holds all requests that have been sent but not finished
+	// yet, in the order they were sent. These will be replayed after a
+	// reconnect. Only populated if reconnecting is enabled.
+	pendingRequests   []pendingRequest
+	pendingRequestsMu sync.Mutex
+
+	// seenItems and seenEdges are used to deduplicate items and edges that
+	// are sent again by the gateway after a request has been replayed. Items
+	// are tracked per request and forgotten once the request has finished,
+	// edges are forgotten once no requests are pending. Only accessed from the
+	// receive goroutine.
+	seenItems map[uuid.UUID]map[string]struct{}
+	seenEdges map[string]struct{}
+
 	requestMap   map[uuid.UUID]chan *sdp.GatewayResponse
 	requestMapMu sync.RWMutex
 
@@ -59,11 +89,74 @@ type Client struct {
 	err   error
 	errMu sync.Mutex
 
+	closing    bool
 	closed     bool
 	closedCond *sync.Cond
 	closedMu   sync.Mutex
 }
 
+// ReconnectOptions configures how a Client recovers from a broken websocket
+// connection.
+type ReconnectOptions struct {
+	// NewHTTPClient is called before every reconnection attempt to get a fresh
+	// http.Client, e.g. to re-authenticate using `NewAuthenticatedClient()`.
+	// If nil, the http.Client passed when dialing is reused.
+	NewHTTPClient func(ctx context.Context) *http.Client
+
+	// MaxAttempts is the number of reconnection attempts after which the
+	// client gives up and fails all outstanding requests. Defaults to 10.
+	MaxAttempts int
+
+	// InitialInterval is the delay before the first reconnection attempt.
+	// Defaults to 500ms.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the exponential backoff between attempts. Defaults to
+	// 30s.
+	MaxInterval time.Duration
+}
+
+// pendingRequest is a request that has been sent, but not finished yet
+type pendingRequest struct {
+	id  uuid.UUID
+	msg *sdp.GatewayRequest
+}
+
+// ConnectionState describes the state of the connection to the gateway. Changes
+// are reported to the GatewayMessageHandler if it implements
+// ConnectionStateHandler.
+type ConnectionState int
+
+const (
+	// The client is connected to the gateway
+	ConnectionStateConnected ConnectionState = iota
+	// The connection was lost and the client is trying to reconnect
+	ConnectionStateReconnecting
+	// The client is closed and will not send or receive any more messages
+	ConnectionStateDisconnected
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionStateConnected:
+		return "connected"
+	case ConnectionStateReconnecting:
+		return "reconnecting"
+	case ConnectionStateDisconnected:
+		return "disconnected"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// connectionStateChanged notifies the handler of a state change, if it is
+// interested in them
+func (c *Client) connectionStateChanged(ctx context.Context, state ConnectionState) {
+	if h, ok := c.handler.(ConnectionStateHandler); ok {
+		h.ConnectionStateChanged(ctx, state)
+	}
+}
+
 // Dial connects to the given URL and returns a new Client. Pass nil as handler
 // if you do not need per-message callbacks.
 //
@@ -75,7 +168,14 @@ type Client struct {
 // client, err := sdpws.Dial(ctx, gatewayUrl, NewAuthenticatedClient(ctx, otelhttp.DefaultClient), nil)
 // ```
 func Dial(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler) (*Client, error) {
-	return dialImpl(ctx, u, httpClient, handler, true)
+	return dialImpl(ctx, u, httpClient, handler, true, nil)
+}
+
+// DialWithReconnect connects to the given URL and returns a new Client that
+// automatically reconnects and replays outstanding requests when the connection
+// is lost. Otherwise this is equivalent to `Dial()`
+func DialWithReconnect(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler, opts ReconnectOptions) (*Client, error) {
+	return dialImpl(ctx, u, httpClient, handler, true, &opts)
 }
 
 // DialBatch connects to the given URL and returns a new Client. Pass nil as
@@ -83,24 +183,60 @@ func Dial(ctx context.Context, u string, httpClient *http.Client, handler Gatewa
 // batch processing and sets up opentelemetry propagation. Otherwise this
 // equivalent to `Dial()`
 func DialBatch(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler) (*Client, error) {
-	return dialImpl(ctx, u, httpClient, handler, false)
+	return dialImpl(ctx, u, httpClient, handler, false, nil)
+}
+
+// DialBatchWithReconnect is the batch processing equivalent of
+// `DialWithReconnect()`
+func DialBatchWithReconnect(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler, opts ReconnectOptions) (*Client, error) {
+	return dialImpl(ctx, u, httpClient, handler, false, &opts)
 }
 
-func dialImpl(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler, interactive bool) (*Client, error) {
+func dialImpl(ctx context.Context, u string, httpClient *http.Client, handler GatewayMessageHandler, interactive bool, reconnect *ReconnectOptions) (*Client, error) {
 	if httpClient == nil {
 		httpClient = otelhttp.DefaultClient
 	}
+
+	c := &Client{
+		url:                u,
+		httpClient:         httpClient,
+		interactive:        interactive,
+		handler:            handler,
+		reconnect:          reconnect,
+		requestMap:         make(map[uuid.UUID]chan *sdp.GatewayResponse),
+		finishedRequestMap: make(map[uuid.UUID]bool),
+		seenItems:          make(map[uuid.UUID]map[string]struct{}),
+		seenEdges:          make(map[string]struct{}),
+	}
+	c.closedCond = sync.NewCond(&c.closedMu)
+	c.finishedRequestMapCond = sync.NewCond(&c.finishedRequestMapMu)
+
+	conn, err := c.dial(ctx, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+
+	c.connectionStateChanged(ctx, ConnectionStateConnected)
+
+	go c.receive(ctx)
+
+	return c, nil
+}
+
+// dial opens a new websocket connection to the gateway
+func (c *Client) dial(ctx context.Context, httpClient *http.Client) (*websocket.Conn, error) {
 	options := &websocket.DialOptions{
 		HTTPClient: httpClient,
 	}
-	if !interactive {
+	if !c.interactive {
 		options.HTTPHeader = http.Header{
 			"X-overmind-interactive": []string{"false"},
 		}
 	}
 
 	//nolint: bodyclose // github.com/coder/websocket reads the body internally
-	conn, _, err := websocket.Dial(ctx, u, options)
+	conn, _, err := websocket.Dial(ctx, c.url, options)
 	if err != nil {
 		return nil, err
 	}
@@ -108,32 +244,34 @@ func dialImpl(ctx context.Context, u string, httpClient *http.Client, handler Ga
 	// the default, 32kB is too small for cert bundles and rds-db-cluster-parameter-groups
 	conn.SetReadLimit(2 * 1024 * 1024)
 
-	c := &Client{
-		conn:               conn,
-		handler:            handler,
-		requestMap:         make(map[uuid.UUID]chan *sdp.GatewayResponse),
-		finishedRequestMap: make(map[uuid.UUID]bool),
-	}
-	c.closedCond = sync.NewCond(&c.closedMu)
-	c.finishedRequestMapCond = sync.NewCond(&c.finishedRequestMapMu)
-
-	go c.receive(ctx)
+	return conn, nil
+}
 
-	return c, nil
+// getConn returns the current websocket connection
+func (c *Client) getConn() *websocket.Conn {
+	c.connMu.RLock()
+	defer c.connMu.RUnlock()
+	return c.conn
 }
 
 func (c *Client) receive(ctx context.Context) {
 	defer tracing.LogRecoverToReturn(ctx, "sdpws.Client.receive")
+	defer c.connectionStateChanged(ctx, ConnectionStateDisconnected)
 	for {
 		msg := &sdp.GatewayResponse{}
 
-		typ, r, err := c.conn.Reader(ctx)
+		conn := c.getConn()
+		typ, r, err := conn.Reader(ctx)
 		if err != nil {
-			c.abort(ctx, fmt.Errorf("failed to initialise websocket reader: %w", err))
+			err = fmt.Errorf("failed to initialise websocket reader: %w", err)
+			if c.tryReconnect(ctx, err) {
+				continue
+			}
+			c.abort(ctx, err)
 			return
 		}
 		if typ != websocket.MessageBinary {
-			c.conn.Close(websocket.StatusUnsupportedData, "expected binary message")
+			conn.Close(websocket.StatusUnsupportedData, "expected binary message")
 			c.abort(ctx, fmt.Errorf("expected binary message for protobuf but got: %v", typ))
 			return
 		}
@@ -141,7 +279,11 @@ func (c *Client) receive(ctx context.Context) {
 		b := new(bytes.Buffer)
 		_, err = b.ReadFrom(r)
 		if err != nil {
-			c.abort(ctx, fmt.Errorf("failed to read from websocket: %w", err))
+			err = fmt.Errorf("failed to read from websocket: %w", err)
+			if c.tryReconnect(ctx, err) {
+				continue
+			}
+			c.abort(ctx, err)
 			return
 		}
 
@@ -154,16 +296,22 @@ func (c *Client) receive(ctx context.Context) {
 		switch msg.GetResponseType().(type) {
 		case *sdp.GatewayResponse_NewItem:
 			item := msg.GetNewItem()
+			u, err := uuid.FromBytes(item.GetMetadata().GetSourceQuery().GetUUID())
+			if err == nil && c.seenItemBefore(u, item) {
+				continue
+			}
 			if c.handler != nil {
 				c.handler.NewItem(ctx, item)
 			}
-			u, err := uuid.FromBytes(item.GetMetadata().GetSourceQuery().GetUUID())
 			if err == nil {
 				c.postRequestChan(u, msg)
 			}
 
 		case *sdp.GatewayResponse_NewEdge:
 			edge := msg.GetNewEdge()
+			if c.seenBefore(c.seenEdges, edgeKey(edge)) {
+				continue
+			}
 			if c.handler != nil {
 				c.handler.NewEdge(ctx, edge)
 			}
@@ -215,6 +363,7 @@ func (c *Client) receive(ctx context.Context) {
 			}
 			u, err := uuid.FromBytes(result.GetMsgID())
 			if err == nil {
+				c.removePendingRequest(u)
 				c.postRequestChan(u, msg)
 			}
 
@@ -225,6 +374,7 @@ func (c *Client) receive(ctx context.Context) {
 			}
 			u, err := uuid.FromBytes(result.GetMsgID())
 			if err == nil {
+				c.removePendingRequest(u)
 				c.postRequestChan(u, msg)
 			}
 
@@ -235,6 +385,7 @@ func (c *Client) receive(ctx context.Context) {
 			}
 			u, err := uuid.FromBytes(result.GetMsgID())
 			if err == nil {
+				c.removePendingRequest(u)
 				c.postRequestChan(u, msg)
 			}
 
@@ -245,6 +396,7 @@ func (c *Client) receive(ctx context.Context) {
 			}
 			u, err := uuid.FromBytes(result.GetMsgID())
 			if err == nil {
+				c.removePendingRequest(u)
 				c.postRequestChan(u, msg)
 			}
 
@@ -260,6 +412,8 @@ func (c *Client) receive(ctx context.Context) {
 
 			switch qs.GetStatus() { //nolint: exhaustive // ignore sdp.QueryStatus_UNSPECIFIED, sdp.QueryStatus_STARTED
 			case sdp.QueryStatus_FINISHED, sdp.QueryStatus_CANCELLED, sdp.QueryStatus_ERRORED:
+				c.removePendingRequest(u)
+				c.forgetSeen(u)
 				c.finishRequestChan(u)
 			}
 
@@ -298,15 +452,250 @@ func (c *Client) send(ctx context.Context, msg *sdp.GatewayRequest) error {
 		return err
 	}
 
-	err = c.conn.Write(ctx, websocket.MessageBinary, buf)
+	// hold the read lock while writing, so that a reconnect can not swap the
+	// connection between recording the request and writing it
+	c.connMu.RLock()
+	c.addPendingRequest(msg)
+	conn := c.conn
+	err = conn.Write(ctx, websocket.MessageBinary, buf)
+	c.connMu.RUnlock()
+
 	if err != nil {
 		log.WithContext(ctx).WithError(err).WithField("request", msg).Trace("error writing request to websocket")
+		if c.reconnect != nil && ctx.Err() == nil && !c.isClosing() {
+			// the request is pending and will be replayed once the receive
+			// loop has reconnected. Make sure the reader notices the broken
+			// connection.
+			_ = conn.CloseNow()
+			return nil
+		}
 		c.abort(ctx, err)
 		return err
 	}
 	return nil
 }
 
+// tryReconnect attempts to re-establish the connection after the specified
+// error. It returns true if the client is connected again and all pending
+// requests have been replayed. This must only be called from the receive
+// goroutine.
+func (c *Client) tryReconnect(ctx context.Context, cause error) bool {
+	if c.reconnect == nil || c.isClosing() || ctx.Err() != nil {
+		return false
+	}
+
+	var ce websocket.CloseError
+	if errors.As(cause, &ce) && ce.Code == websocket.StatusNormalClosure {
+		// the gateway closed the connection on purpose
+		return false
+	}
+
+	log.WithContext(ctx).WithError(cause).Warn("connection to gateway lost, reconnecting")
+	c.connectionStateChanged(ctx, ConnectionStateReconnecting)
+
+	maxAttempts := c.reconnect.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = 500 * time.Millisecond
+	if c.reconnect.InitialInterval > 0 {
+		b.InitialInterval = c.reconnect.InitialInterval
+	}
+	b.MaxInterval = 30 * time.Second
+	if c.reconnect.MaxInterval > 0 {
+		b.MaxInterval = c.reconnect.MaxInterval
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(b.NextBackOff()):
+		}
+
+		if c.isClosing() {
+			return false
+		}
+
+		httpClient := c.httpClient
+		if c.reconnect.NewHTTPClient != nil {
+			httpClient = c.reconnect.NewHTTPClient(ctx)
+		}
+
+		lf := log.Fields{
+			"attempt":     attempt,
+			"maxAttempts": maxAttempts,
+		}
+
+		conn, err := c.dial(ctx, httpClient)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithFields(lf).Warn("failed to reconnect to gateway")
+			continue
+		}
+
+		err = c.swapConn(ctx, conn)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithFields(lf).Warn("failed to replay requests after reconnecting")
+			continue
+		}
+
+		log.WithContext(ctx).WithFields(lf).Info("reconnected to gateway")
+		c.connectionStateChanged(ctx, ConnectionStateConnected)
+		return true
+	}
+
+	log.WithContext(ctx).WithError(cause).Errorf("giving up after %d attempts to reconnect to gateway", maxAttempts)
+	return false
+}
+
+// swapConn replaces the current connection with the specified one and replays
+// all pending requests on it. Sending new requests is blocked until all
+// pending requests have been replayed to keep them in order.
+func (c *Client) swapConn(ctx context.Context, conn *websocket.Conn) error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.isClosing() {
+		_ = conn.CloseNow()
+		return errors.New("client closed")
+	}
+
+	old := c.conn
+	c.conn = conn
+	_ = old.CloseNow()
+
+	c.pendingRequestsMu.Lock()
+	pending := slices.Clone(c.pendingRequests)
+	c.pendingRequestsMu.Unlock()
+
+	for _, p := range pending {
+		buf, err := proto.Marshal(p.msg)
+		if err != nil {
+			return err
+		}
+		err = conn.Write(ctx, websocket.MessageBinary, buf)
+		if err != nil {
+			_ = conn.CloseNow()
+			return err
+		}
+		log.WithContext(ctx).WithField("request", p.id).Debug("replayed request")
+	}
+
+	return nil
+}
+
+// requestID returns the ID of the specified request and whether the request
+// should be replayed after a reconnect.
+func requestID(msg *sdp.GatewayRequest) (uuid.UUID, bool) {
+	var id []byte
+	switch msg.GetRequestType().(type) {
+	case *sdp.GatewayRequest_Query:
+		id = msg.GetQuery().GetUUID()
+	case *sdp.GatewayRequest_StoreSnapshot:
+		id = msg.GetStoreSnapshot().GetMsgID()
+	case *sdp.GatewayRequest_LoadSnapshot:
+		id = msg.GetLoadSnapshot().GetMsgID()
+	case *sdp.GatewayRequest_StoreBookmark:
+		id = msg.GetStoreBookmark().GetMsgID()
+	case *sdp.GatewayRequest_LoadBookmark:
+		id = msg.GetLoadBookmark().GetMsgID()
+	default:
+		return uuid.Nil, false
+	}
+	u, err := uuid.FromBytes(id)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return u, true
+}
+
+// addPendingRequest records the request for replaying after a reconnect
+func (c *Client) addPendingRequest(msg *sdp.GatewayRequest) {
+	if c.reconnect == nil {
+		return
+	}
+
+	if cq := msg.GetCancelQuery(); cq != nil {
+		// no need to replay a cancelled query
+		u, err := uuid.FromBytes(cq.GetUUID())
+		if err == nil {
+			c.removePendingRequest(u)
+		}
+		return
+	}
+
+	u, ok := requestID(msg)
+	if !ok {
+		return
+	}
+
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+	c.pendingRequests = append(c.pendingRequests, pendingRequest{
+		id:  u,
+		msg: msg,
+	})
+}
+
+// removePendingRequest removes a finished request from the replay list
+func (c *Client) removePendingRequest(u uuid.UUID) {
+	if c.reconnect == nil {
+		return
+	}
+
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+	c.pendingRequests = slices.DeleteFunc(c.pendingRequests, func(p pendingRequest) bool {
+		return p.id == u
+	})
+}
+
+// seenBefore records the key in the specified set and returns whether it was
+// already present. This always returns false if reconnecting is disabled as
+// duplicates can only be caused by replaying requests.
+func (c *Client) seenBefore(seen map[string]struct{}, key string) bool {
+	if c.reconnect == nil {
+		return false
+	}
+	if _, ok := seen[key]; ok {
+		return true
+	}
+	seen[key] = struct{}{}
+	return false
+}
+
+// seenItemBefore records the item for the query that found it and returns
+// whether it was already delivered for that query. The same item returned by
+// different queries is still delivered to each.
+func (c *Client) seenItemBefore(u uuid.UUID, item *sdp.Item) bool {
+	if c.reconnect == nil {
+		return false
+	}
+	seen, ok := c.seenItems[u]
+	if !ok {
+		seen = make(map[string]struct{})
+		c.seenItems[u] = seen
+	}
+	return c.seenBefore(seen, item.GloballyUniqueName())
+}
+
+// forgetSeen drops the deduplication state of a finished request, as it can
+// no longer be replayed
+func (c *Client) forgetSeen(u uuid.UUID) {
+	delete(c.seenItems, u)
+
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+	if len(c.pendingRequests) == 0 {
+		clear(c.seenEdges)
+	}
+}
+
+func edgeKey(edge *sdp.Edge) string {
+	return fmt.Sprintf("%v->%v", edge.GetFrom().GloballyUniqueName(), edge.GetTo().GloballyUniqueName())
+}
+
 // Wait blocks until all specified requests have been finished. Waiting on a
 // closed client returns immediately with no error.
 func (c *Client) Wait(ctx context.Context, reqIDs uuid.UUIDs) error {
@@ -351,6 +740,8 @@ func (c *Client) abort(ctx context.Context, err error) {
 		c.closedMu.Unlock()
 		return
 	}
+	// stop the receive loop from reconnecting
+	c.closing = true
 	c.closedMu.Unlock()
 
 	isNormalClosure := false
@@ -369,7 +760,7 @@ func (c *Client) abort(ctx context.Context, err error) {
 
 	// call this outside of the lock to avoid deadlock should other parts of the
 	// code try to call abort() when crashing out of a read or write
-	err = c.conn.Close(websocket.StatusNormalClosure, "normal closure")
+	err = c.getConn().Close(websocket.StatusNormalClosure, "normal closure")
 
 	c.errMu.Lock()
 	c.err = errors.Join(c.err, err)
@@ -402,6 +793,13 @@ func (c *Client) Closed() bool {
 	return c.closed
 }
 
+// isClosing returns true once the client has started shutting down
+func (c *Client) isClosing() bool {
+	c.closedMu.Lock()
+	defer c.closedMu.Unlock()
+	return c.closing || c.closed
+}
+
 func (c *Client) createRequestChan(u uuid.UUID) chan *sdp.GatewayResponse {
 	r := make(chan *sdp.GatewayResponse, 1)
 	c.requestMapMu.Lock()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/overmindtech/cli/sdp-go"
 	"go.uber.org/goleak"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // Helper function to check if a slice contains a string
@@ -122,6 +124,48 @@ func (ts *testServer) inject(ctx context.Context, msg *sdp.GatewayResponse) {
 	}
 }
 
+// dropConnection closes the current connection without a close handshake to
+// simulate a network failure
+func (ts *testServer) dropConnection() {
+	ts.connMu.Lock()
+	defer ts.connMu.Unlock()
+	_ = ts.conn.CloseNow()
+}
+
+// waitForRequests waits until the server has received at least n requests.
+// This doesn't fail the test itself so that it can be used from other
+// goroutines.
+func (ts *testServer) waitForRequests(n int) ([]*sdp.GatewayRequest, error) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		ts.requestsMu.Lock()
+		if len(ts.requests) >= n {
+			requests := append([]*sdp.GatewayRequest{}, ts.requests...)
+			ts.requestsMu.Unlock()
+			return requests, nil
+		}
+		ts.requestsMu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil, fmt.Errorf("timed out waiting for %v requests", n)
+}
+
+// connectionStateHandler records all items and connection state changes
+type connectionStateHandler struct {
+	states   []ConnectionState
+	statesMu sync.Mutex
+
+	StoreEverythingHandler
+}
+
+var _ ConnectionStateHandler = (*connectionStateHandler)(nil)
+
+func (h *connectionStateHandler) ConnectionStateChanged(ctx context.Context, state ConnectionState) {
+	h.statesMu.Lock()
+	defer h.statesMu.Unlock()
+	h.states = append(h.states, state)
+}
+
 func TestClient(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
@@ -324,57 +368,65 @@ func TestClient(t *testing.T) {
 		}
 	})
 
-	t.Run("StoreBookmark", func(t *testing.T) {
-		defer goleak.VerifyNone(t)
-		ctx := context.Background()
+	for _, isSystem := range []bool{true, false} {
+		t.Run(fmt.Sprintf("StoreBookmark/isSystem=%v", isSystem), func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			ctx := context.Background()
 
-		ts, closeFn := newTestServer(ctx, t)
-		defer closeFn()
+			ts, closeFn := newTestServer(ctx, t)
+			defer closeFn()
 
-		c, err := Dial(ctx, ts.url, nil, nil)
-		if err != nil {
-			t.Fatal(err)
-		}
-		defer func() {
-			_ = c.Close(ctx)
-		}()
+			c, err := Dial(ctx, ts.url, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = c.Close(ctx)
+			}()
 
-		u := uuid.New()
+			u := uuid.New()
 
-		go func() {
-			time.Sleep(100 * time.Millisecond)
-			ts.requestsMu.Lock()
-			msgID := ts.requests[0].GetStoreBookmark().GetMsgID()
-			ts.requestsMu.Unlock()
+			go func() {
+				time.Sleep(100 * time.Millisecond)
+				ts.requestsMu.Lock()
+				msgID := ts.requests[0].GetStoreBookmark().GetMsgID()
+				ts.requestsMu.Unlock()
 
-			ts.inject(ctx, &sdp.GatewayResponse{
-				ResponseType: &sdp.GatewayResponse_BookmarkStoreResult{
-					BookmarkStoreResult: &sdp.BookmarkStoreResult{
-						Success:      true,
-						ErrorMessage: "",
-						MsgID:        msgID,
-						BookmarkID:   u[:],
+				ts.inject(ctx, &sdp.GatewayResponse{
+					ResponseType: &sdp.GatewayResponse_BookmarkStoreResult{
+						BookmarkStoreResult: &sdp.BookmarkStoreResult{
+							Success:      true,
+							ErrorMessage: "",
+							MsgID:        msgID,
+							BookmarkID:   u[:],
+						},
 					},
-				},
-			})
-		}()
+				})
+			}()
 
-		// this will block until the above goroutine has injected the response
-		snapu, err := c.StoreBookmark(ctx, "name", "description", true)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if snapu != u {
-			t.Errorf("expected bookmark id %v, got %v", u, snapu)
-		}
+			// this will block until the above goroutine has injected the response
+			snapu, err := c.StoreBookmark(ctx, "name", "description", isSystem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if snapu != u {
+				t.Errorf("expected bookmark id %v, got %v", u, snapu)
+			}
 
-		ts.requestsMu.Lock()
-		defer ts.requestsMu.Unlock()
+			ts.requestsMu.Lock()
+			defer ts.requestsMu.Unlock()
 
-		if len(ts.requests) != 1 {
-			t.Fatalf("expected 1 request, got %v: %v", len(ts.requests), ts.requests)
-		}
-	})
+			if len(ts.requests) != 1 {
+				t.Fatalf("expected 1 request, got %v: %v", len(ts.requests), ts.requests)
+			}
+
+			// the flag is sent as given, rather than always storing system
+			// bookmarks
+			if got := ts.requests[0].GetStoreBookmark().GetIsSystem(); got != isSystem {
+				t.Errorf("expected IsSystem to be %v, got %v", isSystem, got)
+			}
+		})
+	}
 
 	t.Run("ConcurrentQueries", func(t *testing.T) {
 		defer goleak.VerifyNone(t)
@@ -838,4 +890,128 @@ func TestClient(t *testing.T) {
 			t.Errorf("Query B got wrong item: expected 'item-B', got '%s'", resultsB[0].items[0].GetUniqueAttribute())
 		}
 	})
+
+	t.Run("ReconnectAndReplay", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		ctx := context.Background()
+
+		ts, closeFn := newTestServer(ctx, t)
+		defer closeFn()
+
+		handler := &connectionStateHandler{}
+		c, err := DialWithReconnect(ctx, ts.url, nil, handler, ReconnectOptions{
+			InitialInterval: 10 * time.Millisecond,
+			MaxInterval:     50 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			_ = c.Close(ctx)
+		}()
+
+		u := uuid.New()
+		q := &sdp.Query{
+			UUID:               u[:],
+			Type:               "test",
+			Method:             sdp.QueryMethod_GET,
+			Query:              "query",
+			RecursionBehaviour: &sdp.Query_RecursionBehaviour{},
+			Scope:              "test",
+		}
+		newItem := func(name string) *sdp.GatewayResponse {
+			return &sdp.GatewayResponse{
+				ResponseType: &sdp.GatewayResponse_NewItem{
+					NewItem: &sdp.Item{
+						Type:            "test",
+						UniqueAttribute: "name",
+						Attributes: &sdp.ItemAttributes{
+							AttrStruct: &structpb.Struct{
+								Fields: map[string]*structpb.Value{
+									"name": structpb.NewStringValue(name),
+								},
+							},
+						},
+						Scope: "test",
+						Metadata: &sdp.Metadata{
+							SourceQuery: q,
+						},
+					},
+				},
+			}
+		}
+
+		// the server side runs in the background and reports failures here,
+		// as t.Fatal must only be called from the test goroutine
+		serverErr := make(chan error, 1)
+		go func() {
+			defer close(serverErr)
+			_, err := ts.waitForRequests(1)
+			if err != nil {
+				serverErr <- err
+				return
+			}
+			ts.inject(ctx, newItem("item-1"))
+			time.Sleep(50 * time.Millisecond)
+			ts.dropConnection()
+
+			// wait for the query to be replayed on the new connection
+			_, err = ts.waitForRequests(2)
+			if err != nil {
+				serverErr <- err
+				return
+			}
+			ts.inject(ctx, newItem("item-1"))
+			ts.inject(ctx, newItem("item-2"))
+			ts.inject(ctx, &sdp.GatewayResponse{
+				ResponseType: &sdp.GatewayResponse_QueryStatus{
+					QueryStatus: &sdp.QueryStatus{
+						UUID:   u[:],
+						Status: sdp.QueryStatus_FINISHED,
+					},
+				},
+			})
+		}()
+
+		// don't wait for the query forever if the server side failed
+		queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		items, err := c.QueryOne(queryCtx, q)
+		if sErr := <-serverErr; sErr != nil {
+			t.Fatal(sErr)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 2 {
+			t.Errorf("expected 2 items, got %v", len(items))
+		}
+		if len(handler.Items) != 2 {
+			t.Errorf("expected handler to receive 2 items, got %v", len(handler.Items))
+		}
+		// the query has finished, so it can't be replayed again
+		if len(c.seenItems) != 0 {
+			t.Errorf("expected seen items to be forgotten, got %v", c.seenItems)
+		}
+
+		requests, err := ts.waitForRequests(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(requests) != 2 {
+			t.Fatalf("expected 2 requests, got %v", len(requests))
+		}
+		for _, r := range requests {
+			if uuid.UUID(r.GetQuery().GetUUID()) != u {
+				t.Errorf("expected replayed query %v, got %v", u, r)
+			}
+		}
+
+		handler.statesMu.Lock()
+		defer handler.statesMu.Unlock()
+		expected := []ConnectionState{ConnectionStateConnected, ConnectionStateReconnecting, ConnectionStateConnected}
+		if !slices.Equal(handler.states, expected) {
+			t.Errorf("expected states %v, got %v", expected, handler.states)
+		}
+	})
 }
@@ -32,12 +32,21 @@ type GatewayMessageHandler interface {
 	ToolFinish(context.Context, *sdp.ToolFinish)
 }
 
+// ConnectionStateHandler can optionally be implemented by a
+// GatewayMessageHandler to be notified when the connection to the gateway is
+// lost, re-established or closed. It is called from the same thread as the
+// other methods of the handler.
+type ConnectionStateHandler interface {
+	ConnectionStateChanged(context.Context, ConnectionState)
+}
+
 type LoggingGatewayMessageHandler struct {
 	Level log.Level
 }
 
 // assert that LoggingGatewayMessageHandler implements GatewayMessageHandler
 var _ GatewayMessageHandler = (*LoggingGatewayMessageHandler)(nil)
+var _ ConnectionStateHandler = (*LoggingGatewayMessageHandler)(nil)
 
 func (l *LoggingGatewayMessageHandler) NewItem(ctx context.Context, item *sdp.Item) {
 	log.WithContext(ctx).WithField("item", item).Log(l.Level, "received new item")
@@ -103,10 +112,15 @@ func (l *LoggingGatewayMessageHandler) ToolFinish(ctx context.Context, toolFinis
 	log.WithContext(ctx).WithField("toolFinish", toolFinish).Log(l.Level, "received tool finish")
 }
 
+func (l *LoggingGatewayMessageHandler) ConnectionStateChanged(ctx context.Context, state ConnectionState) {
+	log.WithContext(ctx).WithField("state", state.String()).Log(l.Level, "connection state changed")
+}
+
 type NoopGatewayMessageHandler struct{}
 
 // assert that NoopGatewayMessageHandler implements GatewayMessageHandler
 var _ GatewayMessageHandler = (*NoopGatewayMessageHandler)(nil)
+var _ ConnectionStateHandler = (*NoopGatewayMessageHandler)(nil)
 
 func (l *NoopGatewayMessageHandler) NewItem(ctx context.Context, item *sdp.Item) {
 }
@@ -156,6 +170,9 @@ func (l *NoopGatewayMessageHandler) ToolStart(ctx context.Context, toolStart *sd
 func (l *NoopGatewayMessageHandler) ToolFinish(ctx context.Context, toolFinish *sdp.ToolFinish) {
 }
 
+func (l *NoopGatewayMessageHandler) ConnectionStateChanged(ctx context.Context, state ConnectionState) {
+}
+
 var _ GatewayMessageHandler = (*StoreEverythingHandler)(nil)
 
 // A handler that stores all the items and edges it receives
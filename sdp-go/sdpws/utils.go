@@ -278,7 +278,8 @@ func (c *Client) SendStoreBookmark(ctx context.Context, b *sdp.StoreBookmark) er
 }
 
 // Store a bookmark and wait for it to complete, returning the UUID of the
-// bookmark that was created.
+// bookmark that was created. `isSystem` marks the bookmark as created by the
+// system rather than by a user.
 func (c *Client) StoreBookmark(ctx context.Context, name, description string, isSystem bool) (uuid.UUID, error) {
 	if c.Closed() {
 		return uuid.UUID{}, errors.New("client closed")
@@ -289,7 +290,7 @@ func (c *Client) StoreBookmark(ctx context.Context, name, description string, is
 		Name:        name,
 		Description: description,
 		MsgID:       u[:],
-		IsSystem:    true,
+		IsSystem:    isSystem,
 	}
 	r := c.createRequestChan(u)
 
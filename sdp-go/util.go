@@ -61,8 +61,15 @@ type AdapterMetadataProvider interface {
 // for a given source so that it can be retrieved later for the purposes of
 // generating documentation and Terraform mappings
 type AdapterMetadataList struct {
+	// Properties that are added to every schema registered with the list,
+	// for attributes that the source adds to the items of all types
+	SchemaProperties map[string]*AttributeSchema
+
 	// The list of adapter metadata
 	list []*AdapterMetadata
+
+	// The schemas of the item attributes, keyed by type
+	schemas map[string]*AttributeSchema
 }
 
 // AllAdapterMetadata returns all the adapter metadata
@@ -82,6 +89,42 @@ func (a *AdapterMetadataList) Register(metadata *AdapterMetadata) *AdapterMetada
 	return metadata
 }
 
+// RegisterSchema registers the schema of the attributes of items returned by
+// the adapter described by the metadata. The title and description of the
+// schema are taken from the metadata if they haven't been set, and the
+// `SchemaProperties` of the list are added to it
+func (a *AdapterMetadataList) RegisterSchema(metadata *AdapterMetadata, schema *AttributeSchema) *AttributeSchema {
+	if a == nil {
+		return schema
+	}
+
+	if schema.Title == "" {
+		schema.Title = metadata.GetType()
+	}
+	if schema.Description == "" {
+		schema.Description = metadata.GetDescriptiveName()
+	}
+	for name, property := range a.SchemaProperties {
+		schema.WithProperty(name, property)
+	}
+
+	if a.schemas == nil {
+		a.schemas = make(map[string]*AttributeSchema)
+	}
+	a.schemas[metadata.GetType()] = schema
+
+	return schema
+}
+
+// AttributeSchema returns the schema of the item attributes for the given type,
+// or nil if no schema has been registered
+func (a *AdapterMetadataList) AttributeSchema(itemType string) *AttributeSchema {
+	if a == nil {
+		return nil
+	}
+	return a.schemas[itemType]
+}
+
 type RoutineRollUp struct {
 	ChangeId uuid.UUID
 	Gun      string
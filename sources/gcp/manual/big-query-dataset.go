@@ -67,6 +67,11 @@ func (b BigQueryDatasetWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (b BigQueryDatasetWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&bigquery.DatasetMetadata{}, "labels").WithProperty("id", &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}})
+}
+
 // GetLookups returns the lookups for the BigQuery dataset
 func (b BigQueryDatasetWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -47,6 +47,11 @@ func (m BigQueryModelWrapper) PredefinedRole() string {
 	return "roles/bigquery.metadataViewer"
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (m BigQueryModelWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&bigquery.ModelMetadata{}, "labels")
+}
+
 func (m BigQueryModelWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
 		BigQueryDatasetLookupByID,
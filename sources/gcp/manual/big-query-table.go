@@ -69,6 +69,11 @@ func (b BigQueryTableWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (b BigQueryTableWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&bigquery.TableMetadata{}, "labels").WithProperty("id", &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}})
+}
+
 // GetLookups returns the lookups for the BigQuery dataset
 func (b BigQueryTableWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -65,6 +65,11 @@ func (c cloudKMSCryptoKeyWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	return nil
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c cloudKMSCryptoKeyWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&kmspb.CryptoKey{}, "labels").WithProperty("uniqueAttr", &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}})
+}
+
 // GetLookups returns the lookups for the CryptoKey wrapper.
 func (c cloudKMSCryptoKeyWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
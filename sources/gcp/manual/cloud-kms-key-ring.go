@@ -69,6 +69,11 @@ func (c cloudKMSKeyRingWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c cloudKMSKeyRingWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&kmspb.KeyRing{}).WithProperty("uniqueAttr", &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}})
+}
+
 // GetLookups returns the lookups for the KeyRing wrapper.
 func (c cloudKMSKeyRingWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -70,6 +70,11 @@ func (c computeAddressWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeAddressWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Address{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute address wrapper
 // This defines how the source can be queried for specific item
 // In this case, it will be: gcp-compute-engine-address-name
@@ -64,6 +64,11 @@ func (c computeAutoscalerWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeAutoscalerWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Autoscaler{})
+}
+
 func (c computeAutoscalerWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
 		ComputeAutoscalerLookupByName,
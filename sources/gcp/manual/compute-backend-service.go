@@ -68,6 +68,11 @@ func (c computeBackendServiceWrapper) TerraformMappings() []*sdp.TerraformMappin
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeBackendServiceWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.BackendService{})
+}
+
 // GetLookups returns the lookups for the compute backend service wrapper
 func (c computeBackendServiceWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
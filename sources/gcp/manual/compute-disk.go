@@ -73,6 +73,11 @@ func (c computeDiskWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeDiskWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Disk{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute disk wrapper
 func (c computeDiskWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
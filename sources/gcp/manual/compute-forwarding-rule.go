@@ -70,6 +70,11 @@ func (c computeForwardingRuleWrapper) TerraformMappings() []*sdp.TerraformMappin
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeForwardingRuleWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.ForwardingRule{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute forwarding rule wrapper
 func (c computeForwardingRuleWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -56,6 +56,11 @@ func (c computeHealthCheckWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeHealthCheckWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.HealthCheck{})
+}
+
 func (c computeHealthCheckWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
 		ComputeHealthCheckLookupByName,
@@ -57,6 +57,11 @@ func (c computeImageWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeImageWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Image{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute image wrapper
 func (c computeImageWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -73,6 +73,11 @@ func (c computeInstanceGroupManagerWrapper) TerraformMappings() []*sdp.Terraform
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeInstanceGroupManagerWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.InstanceGroupManager{})
+}
+
 // GetLookups returns the lookups for the compute instance group manager wrapper
 func (c computeInstanceGroupManagerWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
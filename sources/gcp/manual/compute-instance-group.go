@@ -66,6 +66,11 @@ func (c computeInstanceGroupWrapper) TerraformMappings() []*sdp.TerraformMapping
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeInstanceGroupWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.InstanceGroup{})
+}
+
 // GetLookups returns the lookups for the compute instance group wrapper
 func (c computeInstanceGroupWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
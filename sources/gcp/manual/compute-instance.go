@@ -71,6 +71,11 @@ func (c computeInstanceWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeInstanceWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Instance{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute instance wrapper
 // This defines how the source can be queried for specific item
 // In this case, it will be: gcp-compute-engine-instance-name
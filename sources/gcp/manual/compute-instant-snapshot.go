@@ -65,6 +65,11 @@ func (c computeInstantSnapshotWrapper) TerraformMappings() []*sdp.TerraformMappi
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeInstantSnapshotWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.InstantSnapshot{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute instant snapshot wrapper
 func (c computeInstantSnapshotWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -67,6 +67,11 @@ func (c computeMachineImageWrapper) TerraformMappings() []*sdp.TerraformMapping
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeMachineImageWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.MachineImage{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute machine image wrapper
 func (c computeMachineImageWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -72,6 +72,11 @@ func (c computeNodeGroupWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeNodeGroupWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.NodeGroup{})
+}
+
 // GetLookups defines how the source can be queried for specific items.
 func (c computeNodeGroupWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
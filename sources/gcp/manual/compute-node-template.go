@@ -63,6 +63,11 @@ func (c computeNodeTemplateWrapper) TerraformMappings() []*sdp.TerraformMapping
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeNodeTemplateWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.NodeTemplate{})
+}
+
 func (c computeNodeTemplateWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
 		ComputeNodeTemplateLookupByName,
@@ -69,6 +69,11 @@ func (c computeRegionBackendServiceWrapper) TerraformMappings() []*sdp.Terraform
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeRegionBackendServiceWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.BackendService{})
+}
+
 // GetLookups returns the lookups for the compute region backend service wrapper
 func (c computeRegionBackendServiceWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
@@ -68,6 +68,11 @@ func (c computeReservationWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeReservationWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Reservation{})
+}
+
 // GetLookups returns the lookups for the compute reservation wrapper
 func (c computeReservationWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
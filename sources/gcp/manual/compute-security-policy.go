@@ -65,6 +65,11 @@ func (c computeSecurityPolicyWrapper) TerraformMappings() []*sdp.TerraformMappin
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeSecurityPolicyWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.SecurityPolicy{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute security policy wrapper
 func (c computeSecurityPolicyWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
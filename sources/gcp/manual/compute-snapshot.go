@@ -68,6 +68,11 @@ func (c computeSnapshotWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c computeSnapshotWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&computepb.Snapshot{}, "labels")
+}
+
 // GetLookups returns the lookups for the compute snapshot wrapper
 func (c computeSnapshotWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
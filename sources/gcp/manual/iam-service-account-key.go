@@ -63,6 +63,11 @@ func (c iamServiceAccountKeyWrapper) TerraformMappings() []*sdp.TerraformMapping
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c iamServiceAccountKeyWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&adminpb.ServiceAccountKey{}).WithProperty("uniqueAttr", &sdp.AttributeSchema{Type: sdp.SchemaTypes{"string"}})
+}
+
 // GetLookups returns the lookups for the IAM Service Account Key wrapper
 func (c iamServiceAccountKeyWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
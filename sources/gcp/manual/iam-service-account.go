@@ -70,6 +70,11 @@ func (c iamServiceAccountWrapper) TerraformMappings() []*sdp.TerraformMapping {
 	}
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (c iamServiceAccountWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&adminpb.ServiceAccount{})
+}
+
 // GetLookups returns the lookups for the IAM ServiceAccount wrapper
 func (c iamServiceAccountWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
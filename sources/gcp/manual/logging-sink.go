@@ -49,6 +49,11 @@ type loggingSinkWrapper struct {
 	*gcpshared.ProjectBase
 }
 
+// AttributeSchema returns the schema of the attributes of the items
+func (l loggingSinkWrapper) AttributeSchema() *sdp.AttributeSchema {
+	return sdp.AttributeSchemaFor(&loggingpb.LogSink{})
+}
+
 func (l loggingSinkWrapper) GetLookups() sources.ItemTypeLookups {
 	return sources.ItemTypeLookups{
 		LoggingSinkLookupByName,
@@ -12,6 +12,7 @@ import (
 
 	"github.com/overmindtech/cli/discovery"
 	"github.com/overmindtech/cli/sdp-go"
+	"github.com/overmindtech/cli/sources"
 	"github.com/overmindtech/cli/sources/gcp/dynamic"
 	_ "github.com/overmindtech/cli/sources/gcp/dynamic/adapters" // Import all adapters to register them
 	"github.com/overmindtech/cli/sources/gcp/manual"
@@ -47,7 +48,13 @@ func init() {
 	}
 
 	for _, adapter := range discoveryAdapters {
-		Metadata.Register(adapter.Metadata())
+		metadata := Metadata.Register(adapter.Metadata())
+
+		if provider, ok := adapter.(sources.AttributeSchemaProvider); ok {
+			if schema := provider.AttributeSchema(); schema != nil {
+				Metadata.RegisterSchema(metadata, schema)
+			}
+		}
 	}
 
 	log.Debug("Registered GCP source metadata", " with ", len(Metadata.AllAdapterMetadata()), " adapters")
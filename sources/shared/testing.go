@@ -33,6 +33,8 @@ func RunStaticTests(t *testing.T, adapter discovery.Adapter, item *sdp.Item, que
 		t.Fatalf("Item %s failed validation: %v", item.GetType(), item.Validate())
 	}
 
+	ValidateAttributeSchema(t, adapter, item)
+
 	if queryTests == nil {
 		t.Skipf("Skipping test because no query test provided")
 	}
@@ -60,6 +62,26 @@ func ValidateAdapter(t *testing.T, adapter discovery.Adapter) {
 	}
 }
 
+// ValidateAttributeSchema validates the attributes of the item against the
+// schema published by the adapter, if it has one
+func ValidateAttributeSchema(t *testing.T, adapter discovery.Adapter, item *sdp.Item) {
+	provider, ok := adapter.(interface {
+		AttributeSchema() *sdp.AttributeSchema
+	})
+	if !ok {
+		return
+	}
+
+	schema := provider.AttributeSchema()
+	if schema == nil {
+		return
+	}
+
+	if err := schema.Validate(item.GetAttributes()); err != nil {
+		t.Fatalf("Item %s does not match its attribute schema: %v", item.GetType(), err)
+	}
+}
+
 // QueryTest is a struct that defines the expected properties of a linked item query.
 type QueryTest struct {
 	ExpectedType             string
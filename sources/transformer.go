@@ -44,6 +44,12 @@ type Wrapper interface {
 	PredefinedRole() string
 }
 
+// AttributeSchemaProvider defines an optional interface for wrappers, and the
+// adapters created from them, that publish the schema of their item attributes.
+type AttributeSchemaProvider interface {
+	AttributeSchema() *sdp.AttributeSchema
+}
+
 // ListableWrapper defines an optional interface for resources that support listing.
 type ListableWrapper interface {
 	Wrapper
@@ -256,6 +262,15 @@ func (s *standardAdapterCore) Scopes() []string {
 	return s.wrapper.Scopes()
 }
 
+// AttributeSchema returns the schema of the item attributes if the wrapper
+// provides one.
+func (s *standardAdapterCore) AttributeSchema() *sdp.AttributeSchema {
+	if provider, ok := s.wrapper.(AttributeSchemaProvider); ok {
+		return provider.AttributeSchema()
+	}
+	return nil
+}
+
 func (s *standardAdapterCore) validateScopes(scope string) error {
 	for _, expectedScope := range s.Scopes() {
 		if scope == expectedScope {
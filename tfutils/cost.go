@@ -0,0 +1,265 @@
+package tfutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/overmindtech/cli/aws-source/adapterhelpers"
+	"github.com/overmindtech/cli/aws-source/cost"
+	"github.com/overmindtech/cli/sdp-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// CostPricer prices the resources in plans. It uses the built in price list so
+// that plans can be priced offline
+var CostPricer cost.Pricer = cost.NewPriceList()
+
+// The region that is assumed when neither the resource nor its provider
+// config have one, which matches the default of the AWS provider when no
+// region is configured in the environment
+const defaultCostRegion = "us-east-1"
+
+// CostDelta is the change in the estimated cost of a resource that a planned
+// change will make. Before is nil for created resources and After is nil for
+// deleted resources
+type CostDelta struct {
+	Before *cost.Estimate
+	After  *cost.Estimate
+}
+
+// Hourly returns the change in the hourly cost in USD
+func (d *CostDelta) Hourly() float64 {
+	var before, after float64
+
+	if d.Before != nil {
+		before = d.Before.Hourly
+	}
+
+	if d.After != nil {
+		after = d.After.Hourly
+	}
+
+	return after - before
+}
+
+// Monthly returns the change in the monthly cost in USD
+func (d *CostDelta) Monthly() float64 {
+	var before, after float64
+
+	if d.Before != nil {
+		before = d.Before.Monthly
+	}
+
+	if d.After != nil {
+		after = d.After.Monthly
+	}
+
+	return math.Round((after-before)*100) / 100
+}
+
+// String formats the monthly change, e.g. "+$12.41/mo"
+func (d *CostDelta) String() string {
+	return FormatMonthlyCostDelta(d.Monthly())
+}
+
+// FormatMonthlyCostDelta formats a change in monthly cost in USD, e.g.
+// "-$3.65/mo"
+func FormatMonthlyCostDelta(monthly float64) string {
+	sign := "+"
+	if monthly < 0 {
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%v$%.2f/mo", sign, math.Abs(monthly))
+}
+
+// MonthlyCostDelta returns the total change in the monthly cost in USD of the
+// resources that could be priced
+func (r *PlanMappingResult) MonthlyCostDelta() float64 {
+	var total float64
+
+	for _, result := range r.Results {
+		if result.CostDelta != nil {
+			total += result.CostDelta.Monthly()
+		}
+	}
+
+	return math.Round(total*100) / 100
+}
+
+// Adds the cost delta to the results of resources that can be priced. Errors
+// from the pricer are logged and the resource is left without a delta, since
+// the cost is only an estimate and shouldn't stop the plan from being mapped
+func addCostDeltas(ctx context.Context, pricer cost.Pricer, plan Plan, results []PlannedChangeMapResult) {
+	for i := range results {
+		diff := results[i].GetItem()
+		if diff == nil {
+			continue
+		}
+
+		region := providerRegion(plan, results[i].TerraformName)
+
+		// Mapped items have the type of the item that they were mapped to,
+		// so the Terraform type comes from the result
+		terraformType := results[i].TerraformType
+
+		before, err := estimateTerraformItem(pricer, terraformType, diff.GetBefore(), region)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithField("terraform-address", results[i].TerraformName).Warn("Failed to estimate cost before planned change")
+			continue
+		}
+
+		after, err := estimateTerraformItem(pricer, terraformType, diff.GetAfter(), region)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithField("terraform-address", results[i].TerraformName).Warn("Failed to estimate cost after planned change")
+			continue
+		}
+
+		if before == nil && after == nil {
+			continue
+		}
+
+		// A resource that can be priced after the change but not before, or
+		// the other way around, is most likely missing a price rather than
+		// costing nothing, so only complete deltas are reported
+		if (before == nil && diff.GetBefore() != nil) || (after == nil && diff.GetAfter() != nil) {
+			continue
+		}
+
+		results[i].CostDelta = &CostDelta{
+			Before: before,
+			After:  after,
+		}
+	}
+}
+
+// Returns the estimated cost of a Terraform resource, or nil if it isn't a
+// resource that can be priced or there is no price for it
+func estimateTerraformItem(pricer cost.Pricer, terraformType string, item *sdp.Item, defaultRegion string) (*cost.Estimate, error) {
+	if item == nil {
+		return nil, nil
+	}
+
+	resource, ok := resourceFromTerraformItem(terraformType, item, defaultRegion)
+	if !ok {
+		return nil, nil
+	}
+
+	estimate, err := cost.EstimateResource(pricer, resource)
+	if err != nil {
+		if errors.Is(err, cost.ErrNoPrice) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return estimate, nil
+}
+
+// Returns the resource that the attributes of a Terraform resource describe.
+// Attributes that are known after apply are missing, so resources that
+// depend on them can't be priced
+func resourceFromTerraformItem(terraformType string, item *sdp.Item, defaultRegion string) (cost.Resource, bool) {
+	attrs := item.GetAttributes()
+
+	region := defaultRegion
+	if a, err := adapterhelpers.ParseARN(stringValue(attrs, "arn")); err == nil && a.Region != "" {
+		region = a.Region
+	} else if r := cost.RegionFromAvailabilityZone(stringValue(attrs, "availability_zone")); r != "" {
+		region = r
+	}
+
+	switch terraformType {
+	case "aws_instance":
+		if stringValue(attrs, "instance_state") == "stopped" {
+			return cost.Resource{}, false
+		}
+
+		instanceType := stringValue(attrs, "instance_type")
+		if instanceType == "" {
+			return cost.Resource{}, false
+		}
+
+		return cost.NewInstanceResource(region, instanceType), true
+	case "aws_ebs_volume":
+		size := numberValue(attrs, "size")
+		if size <= 0 {
+			return cost.Resource{}, false
+		}
+
+		return cost.NewVolumeResource(region, stringValue(attrs, "type"), size), true
+	case "aws_db_instance":
+		instanceClass := stringValue(attrs, "instance_class")
+		if instanceClass == "" {
+			return cost.Resource{}, false
+		}
+
+		multiAZ, _ := attributeValue(attrs, "multi_az").(bool)
+
+		return cost.NewDBInstanceResource(
+			region,
+			instanceClass,
+			stringValue(attrs, "engine"),
+			multiAZ,
+			stringValue(attrs, "storage_type"),
+			numberValue(attrs, "allocated_storage"),
+		), true
+	case "aws_nat_gateway":
+		return cost.NewNATGatewayResource(region), true
+	case "aws_lb", "aws_alb":
+		return cost.NewLoadBalancerResource(region, stringValue(attrs, "load_balancer_type")), true
+	case "aws_elb":
+		return cost.NewLoadBalancerResource(region, "classic"), true
+	}
+
+	return cost.Resource{}, false
+}
+
+// Returns the region of the AWS provider that a resource uses, if it is set
+// as a constant in the config
+func providerRegion(plan Plan, address string) string {
+	providerKey := "aws"
+	if resource := plan.Config.RootModule.DigResource(address); resource != nil && resource.ProviderConfigKey != "" {
+		providerKey = resource.ProviderConfigKey
+	}
+
+	provider, ok := plan.Config.ProviderConfigs[providerKey]
+	if !ok {
+		return defaultCostRegion
+	}
+
+	region, ok := provider.Expressions["region"].(map[string]interface{})
+	if !ok {
+		return defaultCostRegion
+	}
+
+	if value, ok := region["constant_value"].(string); ok && value != "" {
+		return value
+	}
+
+	return defaultCostRegion
+}
+
+func attributeValue(attrs *sdp.ItemAttributes, name string) interface{} {
+	value, err := attrs.Get(name)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func stringValue(attrs *sdp.ItemAttributes, name string) string {
+	s, _ := attributeValue(attrs, name).(string)
+
+	return s
+}
+
+func numberValue(attrs *sdp.ItemAttributes, name string) float64 {
+	n, _ := attributeValue(attrs, name).(float64)
+
+	return n
+}
@@ -0,0 +1,223 @@
+package tfutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/overmindtech/cli/aws-source/cost"
+	log "github.com/sirupsen/logrus"
+)
+
+// Returns a plan that changes the resources. Each change is the type, name,
+// actions, before and after values of a resource
+func testCostPlan(t *testing.T, region string, changes [][]interface{}) []byte {
+	t.Helper()
+
+	resourceChanges := make([]interface{}, 0, len(changes))
+	plannedResources := make([]interface{}, 0, len(changes))
+	configResources := make([]interface{}, 0, len(changes))
+
+	for _, change := range changes {
+		resourceType := change[0].(string)
+		address := resourceType + "." + change[1].(string)
+
+		resourceChanges = append(resourceChanges, map[string]interface{}{
+			"address": address,
+			"mode":    "managed",
+			"type":    resourceType,
+			"name":    change[1],
+			"change": map[string]interface{}{
+				"actions":          change[2],
+				"before":           change[3],
+				"after":            change[4],
+				"before_sensitive": false,
+				"after_sensitive":  false,
+				"after_unknown":    map[string]interface{}{},
+			},
+		})
+
+		values := change[4]
+		if values == nil {
+			values = change[3]
+		}
+
+		plannedResources = append(plannedResources, map[string]interface{}{
+			"address": address,
+			"mode":    "managed",
+			"type":    resourceType,
+			"name":    change[1],
+			"values":  values,
+		})
+
+		configResources = append(configResources, map[string]interface{}{
+			"address":             address,
+			"mode":                "managed",
+			"type":                resourceType,
+			"name":                change[1],
+			"provider_config_key": "aws",
+		})
+	}
+
+	plan, err := json.Marshal(map[string]interface{}{
+		"format_version":   "1.2",
+		"resource_changes": resourceChanges,
+		"planned_values": map[string]interface{}{
+			"root_module": map[string]interface{}{
+				"resources": plannedResources,
+			},
+		},
+		"configuration": map[string]interface{}{
+			"provider_config": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"name": "aws",
+					"expressions": map[string]interface{}{
+						"region": map[string]interface{}{
+							"constant_value": region,
+						},
+					},
+				},
+			},
+			"root_module": map[string]interface{}{
+				"resources": configResources,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return plan
+}
+
+func TestPlanCostDeltas(t *testing.T) {
+	plan := testCostPlan(t, "us-east-1", [][]interface{}{
+		{
+			"aws_instance", "web", []string{"update"},
+			map[string]interface{}{"id": "i-0123456789abcdef0", "instance_type": "t3.micro"},
+			map[string]interface{}{"id": "i-0123456789abcdef0", "instance_type": "t3.medium"},
+		},
+		{
+			"aws_nat_gateway", "main", []string{"create"},
+			nil,
+			map[string]interface{}{"connectivity_type": "public"},
+		},
+		{
+			"aws_ebs_volume", "data", []string{"delete"},
+			map[string]interface{}{"id": "vol-0123456789abcdef0", "type": "gp3", "size": 100, "availability_zone": "us-east-1a"},
+			nil,
+		},
+		{
+			"aws_iam_policy", "read", []string{"create"},
+			nil,
+			map[string]interface{}{"name": "read"},
+		},
+	})
+
+	results, err := MappedItemDiffsFromPlan(context.Background(), plan, "plan.json", "scope", log.Fields{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]float64{
+		"aws_instance.web":     22.78,
+		"aws_nat_gateway.main": 32.85,
+		"aws_ebs_volume.data":  -8,
+	}
+
+	for _, result := range results.Results {
+		monthly, ok := expected[result.TerraformName]
+		if !ok {
+			if result.CostDelta != nil {
+				t.Errorf("expected no cost delta for %v, got %v", result.TerraformName, result.CostDelta)
+			}
+
+			continue
+		}
+
+		if result.CostDelta == nil {
+			t.Errorf("expected a cost delta for %v", result.TerraformName)
+			continue
+		}
+
+		if result.CostDelta.Monthly() != monthly {
+			t.Errorf("expected monthly cost delta %v for %v, got %v", monthly, result.TerraformName, result.CostDelta.Monthly())
+		}
+	}
+
+	if total := results.MonthlyCostDelta(); total != 47.63 {
+		t.Errorf("expected total monthly cost delta 47.63, got %v", total)
+	}
+}
+
+func TestPlanCostDeltasWithoutPrices(t *testing.T) {
+	// The built in prices don't cover this region
+	plan := testCostPlan(t, "eu-west-2", [][]interface{}{
+		{
+			"aws_instance", "web", []string{"create"},
+			nil,
+			map[string]interface{}{"instance_type": "t3.micro"},
+		},
+	})
+
+	results, err := MappedItemDiffsFromPlan(context.Background(), plan, "plan.json", "scope", log.Fields{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, result := range results.Results {
+		if result.CostDelta != nil {
+			t.Errorf("expected no cost delta for %v, got %v", result.TerraformName, result.CostDelta)
+		}
+	}
+}
+
+// failingPricer fails to price anything, like a price list that can't be
+// fetched
+type failingPricer struct{}
+
+func (failingPricer) Price(key cost.PriceKey) (float64, error) {
+	return 0, errors.New("pricing unavailable")
+}
+
+func TestPlanCostDeltasWithPricerErrors(t *testing.T) {
+	pricer := CostPricer
+	CostPricer = failingPricer{}
+	t.Cleanup(func() {
+		CostPricer = pricer
+	})
+
+	plan := testCostPlan(t, "us-east-1", [][]interface{}{
+		{
+			"aws_instance", "web", []string{"create"},
+			nil,
+			map[string]interface{}{"instance_type": "t3.micro"},
+		},
+	})
+
+	results, err := MappedItemDiffsFromPlan(context.Background(), plan, "plan.json", "scope", log.Fields{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, result := range results.Results {
+		if result.CostDelta != nil {
+			t.Errorf("expected no cost delta for %v, got %v", result.TerraformName, result.CostDelta)
+		}
+	}
+}
+
+func TestFormatMonthlyCostDelta(t *testing.T) {
+	tests := map[float64]string{
+		12.4:  "+$12.40/mo",
+		-3.65: "-$3.65/mo",
+		0:     "+$0.00/mo",
+	}
+
+	for monthly, expected := range tests {
+		if got := FormatMonthlyCostDelta(monthly); got != expected {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+}
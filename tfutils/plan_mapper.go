@@ -60,6 +60,10 @@ type PlannedChangeMapResult struct {
 	// "missing arn"
 	Message string
 
+	// The change in the estimated cost of the resource, or nil if the
+	// resource can't be priced
+	CostDelta *CostDelta
+
 	*sdp.MappedItemDiff
 }
 
@@ -243,6 +247,8 @@ func MappedItemDiffsFromPlan(ctx context.Context, planJson []byte, fileName stri
 		results.Results = append(results.Results, mapResourceToQuery(itemDiff, currentResource, relevantMappings))
 	}
 
+	addCostDeltas(ctx, CostPricer, plan, results.Results)
+
 	// Attach failed mappings to the span
 	for _, result := range results.Results {
 		switch result.Status {